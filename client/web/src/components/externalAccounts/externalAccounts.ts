@@ -47,6 +47,10 @@ export const defaultExternalAccounts: Record<ExternalAccountKind, ExternalAccoun
         title: 'SAML',
         icon: AccountCircleIcon,
     },
+    ldap: {
+        title: 'LDAP',
+        icon: AccountCircleIcon,
+    },
     bitbucketCloud: {
         title: 'Bitbucket Cloud',
         icon: BitbucketIcon,
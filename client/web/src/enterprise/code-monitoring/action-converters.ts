@@ -10,6 +10,8 @@ import {
     MonitorSlackWebhookInput,
     MonitorWebhookFields,
     MonitorSlackWebhookFields,
+    MonitorHTTPActionInput,
+    MonitorHTTPActionFields,
     MonitorEmailFields,
 } from '../../graphql-operations'
 
@@ -42,6 +44,19 @@ function convertWebhookAction(action: MonitorWebhookFields): MonitorWebhookInput
     }
 }
 
+function convertHTTPAction(action: MonitorHTTPActionFields): MonitorHTTPActionInput {
+    return {
+        enabled: action.enabled,
+        includeResults: action.includeResults,
+        url: action.url,
+        preset: action.preset,
+        payloadTemplate: action.payloadTemplate,
+        routingKey: action.routingKey,
+        maxRetries: action.maxRetries,
+        retryBackoffSeconds: action.retryBackoffSeconds,
+    }
+}
+
 export function convertActionsForCreate(
     actions: CodeMonitorFields['actions']['nodes'],
     authenticatedUserId: AuthenticatedUser['id']
@@ -60,6 +75,10 @@ export function convertActionsForCreate(
                 return {
                     webhook: convertWebhookAction(action),
                 }
+            case 'MonitorHTTPAction':
+                return {
+                    httpAction: convertHTTPAction(action),
+                }
         }
     })
 }
@@ -92,6 +111,13 @@ export function convertActionsForUpdate(
                         update: convertWebhookAction(action),
                     },
                 }
+            case 'MonitorHTTPAction':
+                return {
+                    httpAction: {
+                        id: action.id || null,
+                        update: convertHTTPAction(action),
+                    },
+                }
         }
     })
 }
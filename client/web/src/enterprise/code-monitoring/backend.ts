@@ -61,6 +61,21 @@ const MonitorSlackWebhookFragment = gql`
     }
 `
 
+const MonitorHTTPActionFragment = gql`
+    fragment MonitorHTTPActionFields on MonitorHTTPAction {
+        __typename
+        id
+        enabled
+        includeResults
+        url
+        preset
+        payloadTemplate
+        routingKey
+        maxRetries
+        retryBackoffSeconds
+    }
+`
+
 const CodeMonitorFragment = gql`
     fragment CodeMonitorFields on Monitor {
         id
@@ -78,12 +93,14 @@ const CodeMonitorFragment = gql`
                 ...MonitorEmailFields
                 ...MonitorWebhookFields
                 ...MonitorSlackWebhookFields
+                ...MonitorHTTPActionFields
             }
         }
     }
     ${MonitorEmailFragment}
     ${MonitorWebhookFragment}
     ${MonitorSlackWebhookFragment}
+    ${MonitorHTTPActionFragment}
 `
 
 const ListCodeMonitorsFragment = gql`
@@ -227,6 +244,12 @@ export const fetchCodeMonitor = (id: string): Observable<FetchCodeMonitorResult>
                                 includeResults
                                 url
                             }
+                            ... on MonitorHTTPAction {
+                                id
+                                enabled
+                                includeResults
+                                url
+                            }
                         }
                     }
                     trigger {
@@ -57,10 +57,15 @@ export const FormActionArea: React.FunctionComponent<React.PropsWithChildren<Act
         actions.nodes.find(action => action.__typename === 'MonitorWebhook')
     )
 
+    // HTTP actions can't be edited here yet, so they are passed through unchanged.
+    const [httpActions] = useState<MonitorAction[]>(
+        actions.nodes.filter(action => action.__typename === 'MonitorHTTPAction')
+    )
+
     // Form is completed if there is at least one action
     useEffect(() => {
-        setActionsCompleted(!!emailAction || !!slackWebhookAction || !!webhookAction)
-    }, [emailAction, setActionsCompleted, slackWebhookAction, webhookAction])
+        setActionsCompleted(!!emailAction || !!slackWebhookAction || !!webhookAction || httpActions.length > 0)
+    }, [emailAction, httpActions, setActionsCompleted, slackWebhookAction, webhookAction])
 
     useEffect(() => {
         const actions: CodeMonitorFields['actions'] = { nodes: [] }
@@ -73,8 +78,9 @@ export const FormActionArea: React.FunctionComponent<React.PropsWithChildren<Act
         if (webhookAction) {
             actions.nodes.push(webhookAction)
         }
+        actions.nodes.push(...httpActions)
         onActionsChange(actions)
-    }, [emailAction, onActionsChange, slackWebhookAction, webhookAction])
+    }, [emailAction, httpActions, onActionsChange, slackWebhookAction, webhookAction])
 
     const showWebhooks = useExperimentalFeatures(features => features.codeMonitoringWebHooks)
 
@@ -15,6 +15,7 @@ export interface AuthProvider {
         | 'gitlab'
         | 'bitbucketCloud'
         | 'http-header'
+        | 'ldap'
         | 'openidconnect'
         | 'sourcegraph-operator'
         | 'saml'
@@ -89,6 +89,16 @@ const notebooksFragment = gql`
                     symbolKind
                 }
             }
+            ... on ComputeBlock {
+                __typename
+                id
+                computeInput
+            }
+            ... on InsightBlock {
+                __typename
+                id
+                insightInput
+            }
         }
     }
 `
@@ -55,29 +55,46 @@ export const NotebookContent: React.FunctionComponent<React.PropsWithChildren<No
     }) => {
         const initializerBlocks: BlockInit[] = useMemo(
             () =>
-                blocks.map(block => {
+                blocks.flatMap((block): BlockInit[] => {
                     switch (block.__typename) {
                         case 'MarkdownBlock':
-                            return { id: block.id, type: 'md', input: { text: block.markdownInput } }
+                            return [{ id: block.id, type: 'md', input: { text: block.markdownInput } }]
                         case 'QueryBlock':
-                            return { id: block.id, type: 'query', input: { query: block.queryInput } }
+                            return [{ id: block.id, type: 'query', input: { query: block.queryInput } }]
                         case 'FileBlock':
-                            return {
-                                id: block.id,
-                                type: 'file',
-                                input: { ...block.fileInput, revision: block.fileInput.revision ?? '' },
-                            }
+                            return [
+                                {
+                                    id: block.id,
+                                    type: 'file',
+                                    input: { ...block.fileInput, revision: block.fileInput.revision ?? '' },
+                                },
+                            ]
                         case 'SymbolBlock':
-                            return {
-                                id: block.id,
-                                type: 'symbol',
-                                input: { ...block.symbolInput, revision: block.symbolInput.revision ?? '' },
-                            }
+                            return [
+                                {
+                                    id: block.id,
+                                    type: 'symbol',
+                                    input: { ...block.symbolInput, revision: block.symbolInput.revision ?? '' },
+                                },
+                            ]
+                        case 'ComputeBlock':
+                        case 'InsightBlock':
+                            // Compute and insight blocks are not rendered in the web app yet.
+                            return []
                     }
                 }),
             [blocks]
         )
 
+        // Saving a notebook replaces all of its blocks, so a notebook containing blocks
+        // that cannot be rendered here is kept read-only to avoid dropping them.
+        const canManage = useMemo(
+            () =>
+                viewerCanManage &&
+                !blocks.some(block => block.__typename === 'ComputeBlock' || block.__typename === 'InsightBlock'),
+            [viewerCanManage, blocks]
+        )
+
         return (
             <NotebookComponent
                 streamSearch={streamSearch}
@@ -89,9 +106,9 @@ export const NotebookContent: React.FunctionComponent<React.PropsWithChildren<No
                 authenticatedUser={authenticatedUser}
                 settingsCascade={settingsCascade}
                 platformContext={platformContext}
-                isReadOnly={!viewerCanManage}
+                isReadOnly={!canManage}
                 blocks={initializerBlocks}
-                onSerializeBlocks={viewerCanManage ? onUpdateBlocks : noop}
+                onSerializeBlocks={canManage ? onUpdateBlocks : noop}
                 exportedFileName={exportedFileName}
                 onCopyNotebook={onCopyNotebook}
                 outlineContainerElement={outlineContainerElement}
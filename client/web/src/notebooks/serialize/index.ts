@@ -192,6 +192,10 @@ export function GQLBlockToGQLInput(block: NotebookFields['blocks'][number]): Cre
                 type: NotebookBlockType.SYMBOL,
                 symbolInput: block.symbolInput,
             }
+        case 'ComputeBlock':
+            return { id: block.id, type: NotebookBlockType.COMPUTE, computeInput: block.computeInput }
+        case 'InsightBlock':
+            return { id: block.id, type: NotebookBlockType.INSIGHT, insightInput: block.insightInput }
     }
 }
 
@@ -21,14 +21,17 @@ import (
 // enterprise frontend setup hook.
 type Services struct {
 	// Batch Changes Services
-	BatchesGitHubWebhook            webhooks.Registerer
-	BatchesGitLabWebhook            webhooks.RegistererHandler
-	BatchesBitbucketServerWebhook   webhooks.RegistererHandler
-	BatchesBitbucketCloudWebhook    webhooks.RegistererHandler
-	BatchesAzureDevOpsWebhook       webhooks.Registerer
-	BatchesChangesFileGetHandler    http.Handler
-	BatchesChangesFileExistsHandler http.Handler
-	BatchesChangesFileUploadHandler http.Handler
+	BatchesGitHubWebhook             webhooks.Registerer
+	BatchesGitLabWebhook             webhooks.RegistererHandler
+	BatchesBitbucketServerWebhook    webhooks.RegistererHandler
+	BatchesBitbucketCloudWebhook     webhooks.RegistererHandler
+	BatchesAzureDevOpsWebhook        webhooks.Registerer
+	BatchesChangesFileGetHandler     http.Handler
+	BatchesChangesFileExistsHandler  http.Handler
+	BatchesChangesFileUploadHandler  http.Handler
+	BatchesChangesArtifactGetHandler http.Handler
+	// BatchesChangesArtifactUploadHandler is only served to executors.
+	BatchesChangesArtifactUploadHandler http.Handler
 
 	// Repo related webhook handlers, currently only handle `push` events.
 	ReposGithubWebhook          webhooks.Registerer
@@ -41,6 +44,9 @@ type Services struct {
 	// Handler for exporting code insights data.
 	CodeInsightsDataExportHandler http.Handler
 
+	// Handler exposing the latest values of code insights series as Prometheus metrics.
+	CodeInsightsMetricsHandler http.Handler
+
 	// Handler for completions stream.
 	NewCompletionsStreamHandler NewCompletionsStreamHandler
 
@@ -50,6 +56,7 @@ type Services struct {
 	NewExecutorProxyHandler   NewExecutorProxyHandler
 	NewGitHubAppSetupHandler  NewGitHubAppSetupHandler
 	NewComputeStreamHandler   NewComputeStreamHandler
+	NewComputeExportHandler   NewComputeExportHandler
 	EnterpriseSearchJobs      jobutil.EnterpriseJobs
 	graphqlbackend.OptionalResolver
 }
@@ -77,34 +84,41 @@ type NewGitHubAppSetupHandler func() http.Handler
 // NewComputeStreamHandler creates a new handler for the Sourcegraph Compute streaming endpoint.
 type NewComputeStreamHandler func() http.Handler
 
+// NewComputeExportHandler creates a new handler for the Sourcegraph Compute export endpoint.
+type NewComputeExportHandler func() http.Handler
+
 // NewCompletionsStreamHandler creates a new handler for the completions streaming endpoint.
 type NewCompletionsStreamHandler func() http.Handler
 
 // DefaultServices creates a new Services value that has default implementations for all services.
 func DefaultServices() Services {
 	return Services{
-		ReposGithubWebhook:              &emptyWebhookHandler{name: "github sync webhook"},
-		ReposGitLabWebhook:              &emptyWebhookHandler{name: "gitlab sync webhook"},
-		ReposBitbucketServerWebhook:     &emptyWebhookHandler{name: "bitbucket server sync webhook"},
-		ReposBitbucketCloudWebhook:      &emptyWebhookHandler{name: "bitbucket cloud sync webhook"},
-		PermissionsGitHubWebhook:        &emptyWebhookHandler{name: "permissions github webhook"},
-		BatchesGitHubWebhook:            &emptyWebhookHandler{name: "batches github webhook"},
-		BatchesGitLabWebhook:            &emptyWebhookHandler{name: "batches gitlab webhook"},
-		BatchesBitbucketServerWebhook:   &emptyWebhookHandler{name: "batches bitbucket server webhook"},
-		BatchesBitbucketCloudWebhook:    &emptyWebhookHandler{name: "batches bitbucket cloud webhook"},
-		BatchesAzureDevOpsWebhook:       &emptyWebhookHandler{name: "batches azure devops webhook"},
-		BatchesChangesFileGetHandler:    makeNotFoundHandler("batches file get handler"),
-		BatchesChangesFileExistsHandler: makeNotFoundHandler("batches file exists handler"),
-		BatchesChangesFileUploadHandler: makeNotFoundHandler("batches file upload handler"),
-		SCIMHandler:                     makeNotFoundHandler("SCIM handler"),
-		NewCodeIntelUploadHandler:       func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
-		RankingService:                  stubRankingService{},
-		NewExecutorProxyHandler:         func() http.Handler { return makeNotFoundHandler("executor proxy") },
-		NewGitHubAppSetupHandler:        func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
-		NewComputeStreamHandler:         func() http.Handler { return makeNotFoundHandler("compute streaming endpoint") },
-		CodeInsightsDataExportHandler:   makeNotFoundHandler("code insights data export handler"),
-		NewCompletionsStreamHandler:     func() http.Handler { return makeNotFoundHandler("completions streaming endpoint") },
-		EnterpriseSearchJobs:            jobutil.NewUnimplementedEnterpriseJobs(),
+		ReposGithubWebhook:                  &emptyWebhookHandler{name: "github sync webhook"},
+		ReposGitLabWebhook:                  &emptyWebhookHandler{name: "gitlab sync webhook"},
+		ReposBitbucketServerWebhook:         &emptyWebhookHandler{name: "bitbucket server sync webhook"},
+		ReposBitbucketCloudWebhook:          &emptyWebhookHandler{name: "bitbucket cloud sync webhook"},
+		PermissionsGitHubWebhook:            &emptyWebhookHandler{name: "permissions github webhook"},
+		BatchesGitHubWebhook:                &emptyWebhookHandler{name: "batches github webhook"},
+		BatchesGitLabWebhook:                &emptyWebhookHandler{name: "batches gitlab webhook"},
+		BatchesBitbucketServerWebhook:       &emptyWebhookHandler{name: "batches bitbucket server webhook"},
+		BatchesBitbucketCloudWebhook:        &emptyWebhookHandler{name: "batches bitbucket cloud webhook"},
+		BatchesAzureDevOpsWebhook:           &emptyWebhookHandler{name: "batches azure devops webhook"},
+		BatchesChangesFileGetHandler:        makeNotFoundHandler("batches file get handler"),
+		BatchesChangesFileExistsHandler:     makeNotFoundHandler("batches file exists handler"),
+		BatchesChangesFileUploadHandler:     makeNotFoundHandler("batches file upload handler"),
+		BatchesChangesArtifactGetHandler:    makeNotFoundHandler("batches artifact get handler"),
+		BatchesChangesArtifactUploadHandler: makeNotFoundHandler("batches artifact upload handler"),
+		SCIMHandler:                         makeNotFoundHandler("SCIM handler"),
+		NewCodeIntelUploadHandler:           func(_ bool) http.Handler { return makeNotFoundHandler("code intel upload") },
+		RankingService:                      stubRankingService{},
+		NewExecutorProxyHandler:             func() http.Handler { return makeNotFoundHandler("executor proxy") },
+		NewGitHubAppSetupHandler:            func() http.Handler { return makeNotFoundHandler("Sourcegraph GitHub App setup") },
+		NewComputeStreamHandler:             func() http.Handler { return makeNotFoundHandler("compute streaming endpoint") },
+		NewComputeExportHandler:             func() http.Handler { return makeNotFoundHandler("compute export endpoint") },
+		CodeInsightsDataExportHandler:       makeNotFoundHandler("code insights data export handler"),
+		CodeInsightsMetricsHandler:          makeNotFoundHandler("code insights metrics handler"),
+		NewCompletionsStreamHandler:         func() http.Handler { return makeNotFoundHandler("completions streaming endpoint") },
+		EnterpriseSearchJobs:                jobutil.NewUnimplementedEnterpriseJobs(),
 	}
 }
 
@@ -0,0 +1,9 @@
+// Package userpasswd exports symbols from frontend/internal/auth/userpasswd. See the
+// parent package godoc for more information.
+package userpasswd
+
+import "github.com/sourcegraph/sourcegraph/cmd/frontend/internal/auth/userpasswd"
+
+type LockoutStore = userpasswd.LockoutStore
+
+var NewLockoutStoreFromConf = userpasswd.NewLockoutStoreFromConf
@@ -50,6 +50,11 @@ type MoveBatchChangeArgs struct {
 	NewNamespace *graphql.ID
 }
 
+type SetBatchChangeScheduleArgs struct {
+	BatchChange graphql.ID
+	Schedule    *string
+}
+
 type DeleteBatchChangeArgs struct {
 	BatchChange graphql.ID
 }
@@ -270,6 +275,7 @@ type BatchChangesResolver interface {
 	ApplyBatchChange(ctx context.Context, args *ApplyBatchChangeArgs) (BatchChangeResolver, error)
 	CloseBatchChange(ctx context.Context, args *CloseBatchChangeArgs) (BatchChangeResolver, error)
 	MoveBatchChange(ctx context.Context, args *MoveBatchChangeArgs) (BatchChangeResolver, error)
+	SetBatchChangeSchedule(ctx context.Context, args *SetBatchChangeScheduleArgs) (BatchChangeResolver, error)
 	DeleteBatchChange(ctx context.Context, args *DeleteBatchChangeArgs) (*EmptyResponse, error)
 	CreateBatchChangesCredential(ctx context.Context, args *CreateBatchChangesCredentialArgs) (BatchChangesCredentialResolver, error)
 	DeleteBatchChangesCredential(ctx context.Context, args *DeleteBatchChangesCredentialArgs) (*EmptyResponse, error)
@@ -662,6 +668,32 @@ type BatchChangeResolver interface {
 	CurrentSpec(ctx context.Context) (BatchSpecResolver, error)
 	BulkOperations(ctx context.Context, args *ListBatchChangeBulkOperationArgs) (BulkOperationConnectionResolver, error)
 	BatchSpecs(ctx context.Context, args *ListBatchSpecArgs) (BatchSpecConnectionResolver, error)
+	Schedule(ctx context.Context) (BatchChangeScheduleResolver, error)
+	ScheduledRuns(ctx context.Context, args *ListBatchChangeScheduledRunsArgs) (BatchChangeScheduledRunConnectionResolver, error)
+}
+
+type ListBatchChangeScheduledRunsArgs struct {
+	First int32
+	After *string
+}
+
+type BatchChangeScheduleResolver interface {
+	Schedule() string
+	NextRunAt() gqlutil.DateTime
+}
+
+type BatchChangeScheduledRunResolver interface {
+	State() string
+	BatchSpec(ctx context.Context) (BatchSpecResolver, error)
+	FailureMessage() *string
+	StartedAt() gqlutil.DateTime
+	FinishedAt() *gqlutil.DateTime
+}
+
+type BatchChangeScheduledRunConnectionResolver interface {
+	Nodes(ctx context.Context) ([]BatchChangeScheduledRunResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
 
 type BatchChangesConnectionResolver interface {
@@ -898,6 +930,15 @@ type VisibleBatchSpecWorkspaceResolver interface {
 	SearchResultPaths() []string
 	ChangesetSpecs(ctx context.Context) (*[]VisibleChangesetSpecResolver, error)
 	Executor(ctx context.Context) (*ExecutorResolver, error)
+	Artifacts(ctx context.Context) ([]BatchSpecWorkspaceArtifactResolver, error)
+}
+
+type BatchSpecWorkspaceArtifactResolver interface {
+	Name() string
+	Path() string
+	Step() int32
+	ByteSize() BigInt
+	DownloadURL() string
 }
 
 type ResolvedBatchSpecWorkspaceResolver interface {
@@ -928,6 +969,7 @@ type BatchSpecWorkspaceStepResolver interface {
 	Container() string
 	IfCondition() *string
 	CachedResultFound() bool
+	SharedCachedResultFound() bool
 	Skipped() bool
 	OutputLines(ctx context.Context, args *BatchSpecWorkspaceStepOutputLinesArgs) BatchSpecWorkspaceStepOutputLineConnectionResolver
 
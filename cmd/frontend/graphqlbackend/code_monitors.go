@@ -51,6 +51,7 @@ type MonitorTrigger interface {
 type MonitorQueryResolver interface {
 	ID() graphql.ID
 	Query() string
+	Mode() string
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorTriggerEventConnectionResolver, error)
 }
 
@@ -81,6 +82,7 @@ type MonitorAction interface {
 	ToMonitorEmail() (MonitorEmailResolver, bool)
 	ToMonitorWebhook() (MonitorWebhookResolver, bool)
 	ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, bool)
+	ToMonitorHTTPAction() (MonitorHTTPActionResolver, bool)
 }
 
 type MonitorEmailResolver interface {
@@ -109,6 +111,19 @@ type MonitorSlackWebhookResolver interface {
 	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
 }
 
+type MonitorHTTPActionResolver interface {
+	ID() graphql.ID
+	Enabled() bool
+	IncludeResults() bool
+	URL() string
+	Preset() string
+	PayloadTemplate() string
+	RoutingKey() string
+	MaxRetries() int32
+	RetryBackoffSeconds() int32
+	Events(ctx context.Context, args *ListEventsArgs) (MonitorActionEventConnectionResolver, error)
+}
+
 type MonitorEmailRecipient interface {
 	ToUser() (*UserResolver, bool)
 }
@@ -160,12 +175,14 @@ type CreateCodeMonitorArgs struct {
 
 type CreateTriggerArgs struct {
 	Query string
+	Mode  *string
 }
 
 type CreateActionArgs struct {
 	Email        *CreateActionEmailArgs
 	Webhook      *CreateActionWebhookArgs
 	SlackWebhook *CreateActionSlackWebhookArgs
+	HTTPAction   *CreateActionHTTPActionArgs
 }
 
 type CreateActionEmailArgs struct {
@@ -188,6 +205,17 @@ type CreateActionSlackWebhookArgs struct {
 	URL            string
 }
 
+type CreateActionHTTPActionArgs struct {
+	Enabled             bool
+	IncludeResults      bool
+	URL                 string
+	Preset              string
+	PayloadTemplate     string
+	RoutingKey          string
+	MaxRetries          int32
+	RetryBackoffSeconds int32
+}
+
 type ToggleCodeMonitorArgs struct {
 	Id      graphql.ID
 	Enabled bool
@@ -240,10 +268,16 @@ type EditActionSlackWebhookArgs struct {
 	Update *CreateActionSlackWebhookArgs
 }
 
+type EditActionHTTPActionArgs struct {
+	Id     *graphql.ID
+	Update *CreateActionHTTPActionArgs
+}
+
 type EditActionArgs struct {
 	Email        *EditActionEmailArgs
 	Webhook      *EditActionWebhookArgs
 	SlackWebhook *EditActionSlackWebhookArgs
+	HTTPAction   *EditActionHTTPActionArgs
 }
 
 type EditTriggerArgs struct {
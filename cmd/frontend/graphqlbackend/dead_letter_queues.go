@@ -0,0 +1,62 @@
+package graphqlbackend
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+type DeadLetterQueuesResolver interface {
+	DeadLetterQueues(ctx context.Context) ([]DeadLetterQueueResolver, error)
+	DeadLetterQueue(ctx context.Context, args *DeadLetterQueueArgs) (DeadLetterQueueResolver, error)
+
+	RequeueFailedWorkerRecords(ctx context.Context, args *BulkFailedWorkerRecordsArgs) (int32, error)
+	CancelFailedWorkerRecords(ctx context.Context, args *BulkFailedWorkerRecordsArgs) (int32, error)
+	PurgeFailedWorkerRecords(ctx context.Context, args *BulkFailedWorkerRecordsArgs) (int32, error)
+}
+
+type DeadLetterQueueArgs struct {
+	Name string
+}
+
+type FailedWorkerRecordsFilter struct {
+	IDs            *[]int32
+	FailureMessage *string
+	FailedBefore   *gqlutil.DateTime
+	FailedAfter    *gqlutil.DateTime
+}
+
+type ListFailedWorkerRecordsArgs struct {
+	graphqlutil.ConnectionArgs
+	After  *string
+	Filter *FailedWorkerRecordsFilter
+}
+
+type BulkFailedWorkerRecordsArgs struct {
+	Queue  string
+	Filter *FailedWorkerRecordsFilter
+}
+
+type DeadLetterQueueResolver interface {
+	Name() string
+	FailedRecords(ctx context.Context, args *ListFailedWorkerRecordsArgs) (FailedWorkerRecordConnectionResolver, error)
+}
+
+type FailedWorkerRecordConnectionResolver interface {
+	Nodes(ctx context.Context) ([]FailedWorkerRecordResolver, error)
+	TotalCount(ctx context.Context) (int32, error)
+	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
+}
+
+type FailedWorkerRecordResolver interface {
+	RecordID() int32
+	Queue() string
+	FailureMessage() *string
+	QueuedAt() gqlutil.DateTime
+	StartedAt() *gqlutil.DateTime
+	FailedAt() *gqlutil.DateTime
+	NumResets() int32
+	NumFailures() int32
+	ExecutionLogs() []ExecutionLogEntryResolver
+}
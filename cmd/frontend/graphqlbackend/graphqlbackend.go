@@ -424,6 +424,10 @@ func NewSchemaWithCompletionsResolver(db database.DB, completionsResolver Comple
 	return NewSchema(db, gitserver.NewClient(), nil, OptionalResolver{CompletionsResolver: completionsResolver})
 }
 
+func NewSchemaWithDeadLetterQueuesResolver(db database.DB, deadLetterQueuesResolver DeadLetterQueuesResolver) (*graphql.Schema, error) {
+	return NewSchema(db, gitserver.NewClient(), nil, OptionalResolver{DeadLetterQueuesResolver: deadLetterQueuesResolver})
+}
+
 func NewSchema(
 	db database.DB,
 	gitserverClient gitserver.Client,
@@ -575,6 +579,12 @@ func NewSchema(
 		schemas = append(schemas, completionSchema)
 	}
 
+	if deadLetterQueuesResolver := optional.DeadLetterQueuesResolver; deadLetterQueuesResolver != nil {
+		EnterpriseResolvers.deadLetterQueuesResolver = deadLetterQueuesResolver
+		resolver.DeadLetterQueuesResolver = deadLetterQueuesResolver
+		schemas = append(schemas, deadLetterQueuesSchema)
+	}
+
 	if appResolver := optional.AppResolver; appResolver != nil {
 		// Not under enterpriseResolvers, as this is a OSS schema extension.
 		resolver.AppResolver = appResolver
@@ -635,6 +645,7 @@ type OptionalResolver struct {
 	OwnResolver
 	AppResolver
 	CompletionsResolver
+	DeadLetterQueuesResolver
 }
 
 // newSchemaResolver will return a new, safely instantiated schemaResolver with some
@@ -744,6 +755,7 @@ var EnterpriseResolvers = struct {
 	rbacResolver                RBACResolver
 	ownResolver                 OwnResolver
 	completionsResolver         CompletionsResolver
+	deadLetterQueuesResolver    DeadLetterQueuesResolver
 }{}
 
 // Root returns a new schemaResolver.
@@ -50,6 +50,11 @@ type InsightsResolver interface {
 	RetryInsightSeriesBackfill(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToFrontOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
 	MoveInsightSeriesBackfillToBackOfQueue(ctx context.Context, args *BackfillArgs) (*BackfillQueueItemResolver, error)
+
+	// Alert rules
+	InsightSeriesAlertRules(ctx context.Context, args *InsightSeriesAlertRulesArgs) ([]InsightSeriesAlertRuleResolver, error)
+	CreateInsightSeriesAlertRule(ctx context.Context, args *CreateInsightSeriesAlertRuleArgs) (InsightSeriesAlertRuleResolver, error)
+	DeleteInsightSeriesAlertRule(ctx context.Context, args *DeleteInsightSeriesAlertRuleArgs) (*EmptyResponse, error)
 }
 
 type SearchInsightLivePreviewArgs struct {
@@ -239,6 +244,7 @@ type SearchInsightDataSeriesDefinitionResolver interface {
 	GeneratedFromCaptureGroups() (bool, error)
 	IsCalculated() (bool, error)
 	GroupBy() (*string, error)
+	LanguageComposition() (bool, error)
 }
 
 type InsightPresentation interface {
@@ -310,6 +316,42 @@ type InsightSeriesMetadataPayloadResolver interface {
 	Series(ctx context.Context) InsightSeriesMetadataResolver
 }
 
+type InsightSeriesAlertRulesArgs struct {
+	SeriesId string
+}
+
+type CreateInsightSeriesAlertRuleArgs struct {
+	Input CreateInsightSeriesAlertRuleInput
+}
+
+type CreateInsightSeriesAlertRuleInput struct {
+	SeriesId        string
+	Kind            string
+	Comparator      string
+	Value           float64
+	WindowSize      *int32
+	EmailRecipients *[]string
+}
+
+type DeleteInsightSeriesAlertRuleArgs struct {
+	Id graphql.ID
+}
+
+type InsightSeriesAlertRuleResolver interface {
+	ID() graphql.ID
+	SeriesId() string
+	Kind() string
+	Comparator() string
+	Value() float64
+	WindowSize() int32
+	EmailRecipients() []string
+	State() string
+	LastValue() *float64
+	LastEvaluatedAt() *gqlutil.DateTime
+	LastFiredAt() *gqlutil.DateTime
+	CreatedAt() gqlutil.DateTime
+}
+
 type InsightSeriesQueryStatusResolver interface {
 	SeriesId(ctx context.Context) (string, error)
 	Query(ctx context.Context) (string, error)
@@ -429,6 +471,7 @@ type LineChartSearchInsightDataSeriesInput struct {
 	Options                    LineChartDataSeriesOptionsInput
 	GeneratedFromCaptureGroups *bool
 	GroupBy                    *string
+	LanguageComposition        *bool
 }
 
 type LineChartDataSeriesOptionsInput struct {
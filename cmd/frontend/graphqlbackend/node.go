@@ -79,6 +79,11 @@ func (r *NodeResolver) ToMonitorSlackWebhook() (MonitorSlackWebhookResolver, boo
 	return n, ok
 }
 
+func (r *NodeResolver) ToMonitorHTTPAction() (MonitorHTTPActionResolver, bool) {
+	n, ok := r.Node.(MonitorHTTPActionResolver)
+	return n, ok
+}
+
 func (r *NodeResolver) ToMonitorActionEvent() (MonitorActionEventResolver, bool) {
 	n, ok := r.Node.(MonitorActionEventResolver)
 	return n, ok
@@ -366,3 +371,8 @@ func (r *NodeResolver) ToCodeownersIngestedFile() (CodeownersIngestedFileResolve
 	n, ok := r.Node.(CodeownersIngestedFileResolver)
 	return n, ok
 }
+
+func (r *NodeResolver) ToCodeownersRepoPattern() (CodeownersRepoPatternResolver, bool) {
+	n, ok := r.Node.(CodeownersRepoPatternResolver)
+	return n, ok
+}
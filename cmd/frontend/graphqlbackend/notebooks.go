@@ -67,6 +67,8 @@ type NotebookBlockResolver interface {
 	ToQueryBlock() (QueryBlockResolver, bool)
 	ToFileBlock() (FileBlockResolver, bool)
 	ToSymbolBlock() (SymbolBlockResolver, bool)
+	ToComputeBlock() (ComputeBlockResolver, bool)
+	ToInsightBlock() (InsightBlockResolver, bool)
 }
 
 type MarkdownBlockResolver interface {
@@ -106,6 +108,16 @@ type SymbolBlockInputResolver interface {
 	SymbolKind() string
 }
 
+type ComputeBlockResolver interface {
+	ID() string
+	ComputeInput() string
+}
+
+type InsightBlockResolver interface {
+	ID() string
+	InsightInput() string
+}
+
 type FileBlockLineRangeResolver interface {
 	StartLine() int32
 	EndLine() int32
@@ -118,6 +130,8 @@ const (
 	NotebookQueryBlockType    NotebookBlockType = "QUERY"
 	NotebookFileBlockType     NotebookBlockType = "FILE"
 	NotebookSymbolBlockType   NotebookBlockType = "SYMBOL"
+	NotebookComputeBlockType  NotebookBlockType = "COMPUTE"
+	NotebookInsightBlockType  NotebookBlockType = "INSIGHT"
 )
 
 type CreateNotebookInputArgs struct {
@@ -147,6 +161,8 @@ type CreateNotebookBlockInputArgs struct {
 	QueryInput    *string                 `json:"queryInput"`
 	FileInput     *CreateFileBlockInput   `json:"fileInput"`
 	SymbolInput   *CreateSymbolBlockInput `json:"symbolInput"`
+	ComputeInput  *string                 `json:"computeInput"`
+	InsightInput  *string                 `json:"insightInput"`
 }
 
 type CreateFileBlockInput struct {
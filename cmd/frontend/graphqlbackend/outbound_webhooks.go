@@ -17,6 +17,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+
+	// Register the repository, permissions, embeddings and code intelligence
+	// event types, which are emitted by other services.
+	_ "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 )
 
 const outboundWebhookIDKind = "OutboundWebhook"
@@ -28,11 +28,15 @@ type OwnResolver interface {
 	// Codeowners queries
 	CodeownersIngestedFiles(context.Context, *CodeownersIngestedFilesArgs) (CodeownersIngestedFileConnectionResolver, error)
 	RepoIngestedCodeowners(context.Context, api.RepoID) (CodeownersIngestedFileResolver, error)
+	CodeownersRepoPatterns(context.Context) ([]CodeownersRepoPatternResolver, error)
 
 	// Codeowners mutations
 	AddCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
 	UpdateCodeownersFile(context.Context, *CodeownersFileArgs) (CodeownersIngestedFileResolver, error)
 	DeleteCodeownersFiles(context.Context, *DeleteCodeownersFileArgs) (*EmptyResponse, error)
+	AddCodeownersRepoPattern(context.Context, *CodeownersRepoPatternArgs) (CodeownersRepoPatternResolver, error)
+	UpdateCodeownersRepoPattern(context.Context, *CodeownersRepoPatternArgs) (CodeownersRepoPatternResolver, error)
+	DeleteCodeownersRepoPatterns(context.Context, *DeleteCodeownersRepoPatternsArgs) (*EmptyResponse, error)
 }
 
 type OwnershipConnectionResolver interface {
@@ -59,6 +63,7 @@ type OwnerResolver interface {
 
 type OwnershipReasonResolver interface {
 	ToCodeownersFileEntry() (CodeownersFileEntryResolver, bool)
+	ToGitHistoryOwnershipSignal() (GitHistoryOwnershipSignalResolver, bool)
 }
 
 type CodeownersFileEntryResolver interface {
@@ -66,6 +71,22 @@ type CodeownersFileEntryResolver interface {
 	Description(context.Context) (string, error)
 	CodeownersFile(context.Context) (FileResolver, error)
 	RuleLineMatch(context.Context) (int32, error)
+	Section(context.Context) (CodeownersSectionResolver, error)
+}
+
+type CodeownersSectionResolver interface {
+	Name() string
+	Optional() bool
+	ApprovalsRequired() int32
+	LineNumber() int32
+}
+
+type GitHistoryOwnershipSignalResolver interface {
+	Title(context.Context) (string, error)
+	Description(context.Context) (string, error)
+	RecentCommitCount() int32
+	LastCommitDate() *gqlutil.DateTime
+	BlameLineShare() float64
 }
 
 type CodeownersFileArgs struct {
@@ -76,6 +97,7 @@ type CodeownersFileInput struct {
 	FileContents string
 	RepoID       *graphql.ID
 	RepoName     *string
+	Mode         *string
 }
 
 type DeleteCodeownersFilesInput struct {
@@ -96,6 +118,7 @@ type CodeownersIngestedFileResolver interface {
 	ID() graphql.ID
 	Contents() string
 	Repository() *RepositoryResolver
+	Mode() string
 	CreatedAt() gqlutil.DateTime
 	UpdatedAt() gqlutil.DateTime
 }
@@ -105,3 +128,26 @@ type CodeownersIngestedFileConnectionResolver interface {
 	TotalCount(ctx context.Context) (int32, error)
 	PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error)
 }
+
+type CodeownersRepoPatternArgs struct {
+	Input CodeownersRepoPatternInput
+}
+
+type CodeownersRepoPatternInput struct {
+	RepoPattern  string
+	FileContents string
+	Mode         *string
+}
+
+type DeleteCodeownersRepoPatternsArgs struct {
+	RepoPatterns []string
+}
+
+type CodeownersRepoPatternResolver interface {
+	ID() graphql.ID
+	RepoPattern() string
+	Contents() string
+	Mode() string
+	CreatedAt() gqlutil.DateTime
+	UpdatedAt() gqlutil.DateTime
+}
@@ -94,3 +94,8 @@ var appSchema string
 //
 //go:embed completions.graphql
 var completionSchema string
+
+// deadLetterQueuesSchema is the raw graphql schema of failed records of background workers.
+//
+//go:embed dead_letter_queues.graphql
+var deadLetterQueuesSchema string
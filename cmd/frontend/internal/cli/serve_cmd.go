@@ -269,24 +269,27 @@ func makeExternalAPI(db database.DB, logger sglog.Logger, schema *graphql.Schema
 		schema,
 		rateLimiter,
 		&httpapi.Handlers{
-			GitHubSyncWebhook:               enterprise.ReposGithubWebhook,
-			GitLabSyncWebhook:               enterprise.ReposGitLabWebhook,
-			BitbucketServerSyncWebhook:      enterprise.ReposBitbucketServerWebhook,
-			BitbucketCloudSyncWebhook:       enterprise.ReposBitbucketCloudWebhook,
-			PermissionsGitHubWebhook:        enterprise.PermissionsGitHubWebhook,
-			BatchesGitHubWebhook:            enterprise.BatchesGitHubWebhook,
-			BatchesGitLabWebhook:            enterprise.BatchesGitLabWebhook,
-			BatchesBitbucketServerWebhook:   enterprise.BatchesBitbucketServerWebhook,
-			BatchesBitbucketCloudWebhook:    enterprise.BatchesBitbucketCloudWebhook,
-			BatchesAzureDevOpsWebhook:       enterprise.BatchesAzureDevOpsWebhook,
-			BatchesChangesFileGetHandler:    enterprise.BatchesChangesFileGetHandler,
-			BatchesChangesFileExistsHandler: enterprise.BatchesChangesFileExistsHandler,
-			BatchesChangesFileUploadHandler: enterprise.BatchesChangesFileUploadHandler,
-			SCIMHandler:                     enterprise.SCIMHandler,
-			NewCodeIntelUploadHandler:       enterprise.NewCodeIntelUploadHandler,
-			NewComputeStreamHandler:         enterprise.NewComputeStreamHandler,
-			CodeInsightsDataExportHandler:   enterprise.CodeInsightsDataExportHandler,
-			NewCompletionsStreamHandler:     enterprise.NewCompletionsStreamHandler,
+			GitHubSyncWebhook:                enterprise.ReposGithubWebhook,
+			GitLabSyncWebhook:                enterprise.ReposGitLabWebhook,
+			BitbucketServerSyncWebhook:       enterprise.ReposBitbucketServerWebhook,
+			BitbucketCloudSyncWebhook:        enterprise.ReposBitbucketCloudWebhook,
+			PermissionsGitHubWebhook:         enterprise.PermissionsGitHubWebhook,
+			BatchesGitHubWebhook:             enterprise.BatchesGitHubWebhook,
+			BatchesGitLabWebhook:             enterprise.BatchesGitLabWebhook,
+			BatchesBitbucketServerWebhook:    enterprise.BatchesBitbucketServerWebhook,
+			BatchesBitbucketCloudWebhook:     enterprise.BatchesBitbucketCloudWebhook,
+			BatchesAzureDevOpsWebhook:        enterprise.BatchesAzureDevOpsWebhook,
+			BatchesChangesFileGetHandler:     enterprise.BatchesChangesFileGetHandler,
+			BatchesChangesFileExistsHandler:  enterprise.BatchesChangesFileExistsHandler,
+			BatchesChangesFileUploadHandler:  enterprise.BatchesChangesFileUploadHandler,
+			BatchesChangesArtifactGetHandler: enterprise.BatchesChangesArtifactGetHandler,
+			SCIMHandler:                      enterprise.SCIMHandler,
+			NewCodeIntelUploadHandler:        enterprise.NewCodeIntelUploadHandler,
+			NewComputeStreamHandler:          enterprise.NewComputeStreamHandler,
+			NewComputeExportHandler:          enterprise.NewComputeExportHandler,
+			CodeInsightsDataExportHandler:    enterprise.CodeInsightsDataExportHandler,
+			CodeInsightsMetricsHandler:       enterprise.CodeInsightsMetricsHandler,
+			NewCompletionsStreamHandler:      enterprise.NewCompletionsStreamHandler,
 		},
 		enterprise.NewExecutorProxyHandler,
 		enterprise.NewGitHubAppSetupHandler,
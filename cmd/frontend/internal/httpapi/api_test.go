@@ -47,6 +47,7 @@ func newTest(t *testing.T) *httptestutil.Client {
 			SCIMHandler:                   enterpriseServices.SCIMHandler,
 			NewCodeIntelUploadHandler:     enterpriseServices.NewCodeIntelUploadHandler,
 			NewComputeStreamHandler:       enterpriseServices.NewComputeStreamHandler,
+			NewComputeExportHandler:       enterpriseServices.NewComputeExportHandler,
 			PermissionsGitHubWebhook:      enterpriseServices.PermissionsGitHubWebhook,
 			NewCompletionsStreamHandler:   enterpriseServices.NewCompletionsStreamHandler,
 		},
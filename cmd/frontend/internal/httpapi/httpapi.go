@@ -52,14 +52,15 @@ type Handlers struct {
 	PermissionsGitHubWebhook webhooks.Registerer
 
 	// Batch changes
-	BatchesGitHubWebhook            webhooks.Registerer
-	BatchesGitLabWebhook            webhooks.RegistererHandler
-	BatchesBitbucketServerWebhook   webhooks.RegistererHandler
-	BatchesBitbucketCloudWebhook    webhooks.RegistererHandler
-	BatchesAzureDevOpsWebhook       webhooks.Registerer
-	BatchesChangesFileGetHandler    http.Handler
-	BatchesChangesFileExistsHandler http.Handler
-	BatchesChangesFileUploadHandler http.Handler
+	BatchesGitHubWebhook             webhooks.Registerer
+	BatchesGitLabWebhook             webhooks.RegistererHandler
+	BatchesBitbucketServerWebhook    webhooks.RegistererHandler
+	BatchesBitbucketCloudWebhook     webhooks.RegistererHandler
+	BatchesAzureDevOpsWebhook        webhooks.Registerer
+	BatchesChangesFileGetHandler     http.Handler
+	BatchesChangesFileExistsHandler  http.Handler
+	BatchesChangesFileUploadHandler  http.Handler
+	BatchesChangesArtifactGetHandler http.Handler
 
 	// SCIM
 	SCIMHandler http.Handler
@@ -69,9 +70,11 @@ type Handlers struct {
 
 	// Compute
 	NewComputeStreamHandler enterprise.NewComputeStreamHandler
+	NewComputeExportHandler enterprise.NewComputeExportHandler
 
 	// Code Insights
 	CodeInsightsDataExportHandler http.Handler
+	CodeInsightsMetricsHandler    http.Handler
 
 	// Completions stream
 	NewCompletionsStreamHandler enterprise.NewCompletionsStreamHandler
@@ -146,13 +149,16 @@ func NewHandler(
 	m.Get(apirouter.BatchesFileGet).Handler(trace.Route(handlers.BatchesChangesFileGetHandler))
 	m.Get(apirouter.BatchesFileExists).Handler(trace.Route(handlers.BatchesChangesFileExistsHandler))
 	m.Get(apirouter.BatchesFileUpload).Handler(trace.Route(handlers.BatchesChangesFileUploadHandler))
+	m.Get(apirouter.BatchesArtifactGet).Handler(trace.Route(handlers.BatchesChangesArtifactGetHandler))
 	m.Get(apirouter.LSIFUpload).Handler(trace.Route(lsifDeprecationHandler))
 	m.Get(apirouter.SCIPUpload).Handler(trace.Route(handlers.NewCodeIntelUploadHandler(true)))
 	m.Get(apirouter.SCIPUploadExists).Handler(trace.Route(noopHandler))
 	m.Get(apirouter.ComputeStream).Handler(trace.Route(handlers.NewComputeStreamHandler()))
+	m.Get(apirouter.ComputeExport).Handler(trace.Route(handlers.NewComputeExportHandler()))
 	m.Get(apirouter.CompletionsStream).Handler(trace.Route(handlers.NewCompletionsStreamHandler()))
 
 	m.Get(apirouter.CodeInsightsDataExport).Handler(trace.Route(handlers.CodeInsightsDataExportHandler))
+	m.Get(apirouter.CodeInsightsMetrics).Handler(trace.Route(handlers.CodeInsightsMetricsHandler))
 
 	if envvar.SourcegraphDotComMode() {
 		m.Path("/updates").Methods("GET", "POST").Name("updatecheck").Handler(trace.Route(http.HandlerFunc(updatecheck.HandlerWithLog(logger))))
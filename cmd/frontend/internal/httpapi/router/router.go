@@ -16,6 +16,7 @@ const (
 
 	SearchStream      = "search.stream"
 	ComputeStream     = "compute.stream"
+	ComputeExport     = "compute.export"
 	GitBlameStream    = "git.blame.stream"
 	CompletionsStream = "completions.stream"
 
@@ -36,11 +37,13 @@ const (
 
 	SCIM = "scim"
 
-	BatchesFileGet    = "batches.file.get"
-	BatchesFileExists = "batches.file.exists"
-	BatchesFileUpload = "batches.file.upload"
+	BatchesFileGet     = "batches.file.get"
+	BatchesFileExists  = "batches.file.exists"
+	BatchesFileUpload  = "batches.file.upload"
+	BatchesArtifactGet = "batches.artifact.get"
 
 	CodeInsightsDataExport = "insights.data.export"
+	CodeInsightsMetrics    = "insights.metrics"
 
 	ExternalURL            = "internal.app-url"
 	SendEmail              = "internal.send-email"
@@ -76,15 +79,18 @@ func New(base *mux.Router) *mux.Router {
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("GET").Name(BatchesFileGet)
 	base.Path("/files/batch-changes/{spec}/{file}").Methods("HEAD").Name(BatchesFileExists)
 	base.Path("/files/batch-changes/{spec}").Methods("POST").Name(BatchesFileUpload)
+	base.Path("/files/batch-changes/workspaces/{workspace}/artifacts/{name}").Methods("GET").Name(BatchesArtifactGet)
 	base.Path("/lsif/upload").Methods("POST").Name(LSIFUpload)
 	base.Path("/scip/upload").Methods("POST").Name(SCIPUpload)
 	base.Path("/scip/upload").Methods("HEAD").Name(SCIPUploadExists)
 	base.Path("/search/stream").Methods("GET").Name(SearchStream)
 	base.Path("/compute/stream").Methods("GET", "POST").Name(ComputeStream)
+	base.Path("/compute/export").Methods("GET", "POST").Name(ComputeExport)
 	base.Path("/blame/" + routevar.Repo + routevar.RepoRevSuffix + "/stream/{Path:.*}").Methods("GET").Name(GitBlameStream)
 	base.Path("/src-cli/versions/{rest:.*}").Methods("GET", "POST").Name(SrcCliVersionCache)
 	base.Path("/src-cli/{rest:.*}").Methods("GET").Name(SrcCli)
 	base.Path("/insights/export/{id}").Methods("GET").Name(CodeInsightsDataExport)
+	base.Path("/insights/metrics").Methods("GET").Name(CodeInsightsMetrics)
 	base.Path("/completions/stream").Methods("POST").Name(CompletionsStream)
 
 	// repo contains routes that are NOT specific to a revision. In these routes, the URL may not contain a revspec after the repo (that is, no "github.com/foo/bar@myrevspec").
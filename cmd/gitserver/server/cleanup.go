@@ -333,10 +333,11 @@ func (s *Server) cleanupRepos(ctx context.Context, gitServerAddrs gitserver.Gits
 			}
 		}
 
-		// We believe converting a Perforce depot to a Git repository is generally a
-		// very expensive operation, therefore we do not try to re-clone/redo the
-		// conversion only because it is old or slow to do "git gc".
-		if repoType == "perforce" && reason != maybeCorrupt {
+		// We believe converting a Perforce depot or a Mercurial repository to a Git
+		// repository is generally a very expensive operation, therefore we do not
+		// try to re-clone/redo the conversion only because it is old or slow to do
+		// "git gc".
+		if (repoType == "perforce" || repoType == "hg") && reason != maybeCorrupt {
 			reason = ""
 		}
 
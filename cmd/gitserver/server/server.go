@@ -61,6 +61,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/trace/ot"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/wrexec"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -320,6 +321,10 @@ type Server struct {
 	// shared db handle
 	DB database.DB
 
+	// EnqueueCloneWebhooks enables the repo:cloned and repo:clone_failed
+	// outbound webhook events, which are enqueued in DB.
+	EnqueueCloneWebhooks bool
+
 	// CloneQueue is a threadsafe queue used by DoBackgroundClones to process incoming clone
 	// requests asynchronously.
 	CloneQueue *cloneQueue
@@ -2324,6 +2329,18 @@ func (s *Server) doClone(ctx context.Context, repo api.RepoName, dir GitDir, syn
 		// Use a background context to ensure we still update the DB even if we time out
 		s.setCloneStatusNonFatal(bgCtx, repo, cloneStatus(repoCloned(dir), false))
 	}()
+	defer func() {
+		if !s.EnqueueCloneWebhooks {
+			return
+		}
+		// Clone errors may contain the remote URL, so they are redacted before
+		// being sent to webhook receivers.
+		if err != nil {
+			events.Enqueue(bgCtx, logger, s.DB, events.RepoCloneFailed, events.Repo{Name: repo, Error: newURLRedactor(remoteURL).redact(err.Error())})
+		} else {
+			events.Enqueue(bgCtx, logger, s.DB, events.RepoCloned, events.Repo{Name: repo})
+		}
+	}()
 
 	cmd, err := syncer.CloneCommand(ctx, remoteURL, tmpPath)
 	if err != nil {
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"os"
+	"os/exec"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/vcs"
+	"github.com/sourcegraph/sourcegraph/internal/wrexec"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// hgSyncer is a syncer for Mercurial repositories. Mercurial repositories are
+// converted to Git repositories using the git-remote-hg remote helper, which
+// keeps a copy of the Mercurial repository inside the Git directory. This makes
+// subsequent fetches incremental: only changesets that haven't been converted
+// yet are imported.
+//
+// Mercurial bookmarks are stored as refs/heads/<bookmark>, named branches as
+// refs/heads/branches/<branch> and tags as refs/tags/<tag>.
+type hgSyncer struct{}
+
+var _ VCSSyncer = &hgSyncer{}
+
+// NewMercurialSyncer returns a VCSSyncer for Mercurial repositories.
+func NewMercurialSyncer() VCSSyncer {
+	return &hgSyncer{}
+}
+
+func (s *hgSyncer) Type() string {
+	return "hg"
+}
+
+// IsCloneable checks to see if the Mercurial remote URL is cloneable.
+func (s *hgSyncer) IsCloneable(ctx context.Context, remoteURL *vcs.URL) error {
+	args := []string{"identify", "--noninteractive", "--id", remoteURL.String()}
+	ctx, cancel := context.WithTimeout(ctx, shortGitCommandTimeout(args))
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "hg", args...)
+	out, err := runWith(ctx, wrexec.Wrap(ctx, log.NoOp(), cmd), false, nil)
+	if err != nil {
+		if ctxerr := ctx.Err(); ctxerr != nil {
+			err = ctxerr
+		}
+		if len(out) > 0 {
+			err = &GitCommandError{Err: err, Output: newURLRedactor(remoteURL).redact(string(out))}
+		}
+		return err
+	}
+	return nil
+}
+
+// CloneCommand returns the command to be executed for converting a Mercurial
+// repository to a Git repository.
+func (s *hgSyncer) CloneCommand(ctx context.Context, remoteURL *vcs.URL, tmpPath string) (*exec.Cmd, error) {
+	if err := os.MkdirAll(tmpPath, os.ModePerm); err != nil {
+		return nil, errors.Wrapf(err, "clone failed to create tmp dir")
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "init", "--bare", ".")
+	cmd.Dir = tmpPath
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(&GitCommandError{Err: err}, "clone setup failed")
+	}
+
+	cmd = s.fetchCommand(ctx, remoteURL)
+	cmd.Dir = tmpPath
+	return cmd, nil
+}
+
+// Fetch imports changesets that were added to the Mercurial repository since
+// the last conversion.
+func (s *hgSyncer) Fetch(ctx context.Context, remoteURL *vcs.URL, dir GitDir, _ string) error {
+	cmd := s.fetchCommand(ctx, remoteURL)
+	dir.Set(cmd)
+	if output, err := runWith(ctx, wrexec.Wrap(ctx, log.NoOp(), cmd), false, nil); err != nil {
+		return &GitCommandError{Err: err, Output: newURLRedactor(remoteURL).redact(string(output))}
+	}
+	return nil
+}
+
+// RemoteShowCommand returns the command to be executed for showing the remote
+// of a Mercurial repository.
+func (s *hgSyncer) RemoteShowCommand(ctx context.Context, remoteURL *vcs.URL) (*exec.Cmd, error) {
+	return exec.CommandContext(ctx, "git", "remote", "show", hgRemote(remoteURL)), nil
+}
+
+func (s *hgSyncer) fetchCommand(ctx context.Context, remoteURL *vcs.URL) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", hgFetchArgs(remoteURL)...)
+	// Ignore user configuration and aliases that could change the behaviour of
+	// the hg commands run by git-remote-hg.
+	cmd.Env = append(os.Environ(), "HGPLAIN=1")
+	return cmd
+}
+
+// hgFetchArgs returns the git arguments used to fetch all bookmarks, branches
+// and tags from a Mercurial repository.
+func hgFetchArgs(remoteURL *vcs.URL) []string {
+	return []string{
+		// Expose named branches in addition to bookmarks.
+		"-c", "remote-hg.track-branches=true",
+		"fetch", "--progress", "--prune", hgRemote(remoteURL),
+		"+refs/heads/*:refs/heads/*", "+refs/tags/*:refs/tags/*",
+	}
+}
+
+// hgRemote returns the remote passed to git so that the git-remote-hg helper
+// is used to talk to the Mercurial server.
+func hgRemote(remoteURL *vcs.URL) string {
+	return "hg::" + remoteURL.String()
+}
@@ -148,6 +148,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		},
 		Hostname:                externalAddress(),
 		DB:                      db,
+		EnqueueCloneWebhooks:    true,
 		CloneQueue:              server.NewCloneQueue(list.New()),
 		GlobalBatchLogSemaphore: semaphore.NewWeighted(int64(batchLogGlobalConcurrencyLimit)),
 	}
@@ -479,6 +480,8 @@ func getVCSSyncer(
 			FusionConfig: configureFusionClient(c),
 			P4Home:       p4Home,
 		}, nil
+	case extsvc.TypeMercurial:
+		return server.NewMercurialSyncer(), nil
 	case extsvc.TypeJVMPackages:
 		var c schema.JVMPackagesConnection
 		if _, err := extractOptions(&c); err != nil {
@@ -48,6 +48,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/service"
 	"github.com/sourcegraph/sourcegraph/internal/trace"
 	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -166,7 +167,7 @@ func Main(ctx context.Context, observationCtx *observation.Context, ready servic
 		enterpriseInit(observationCtx, db, store, keyring.Default(), cf, server)
 	}
 
-	go watchSyncer(ctx, logger, syncer, updateScheduler, server.ChangesetSyncRegistry)
+	go watchSyncer(ctx, logger, db, syncer, updateScheduler, server.ChangesetSyncRegistry)
 	go func() {
 		err := syncer.Run(ctx, store, repos.RunOptions{
 			EnqueueInterval: repos.ConfRepoListUpdateInterval,
@@ -458,6 +459,7 @@ func repoUpdaterStatsHandler(debugDumpers map[string]debugserver.Dumper) http.Ha
 func watchSyncer(
 	ctx context.Context,
 	logger log.Logger,
+	db database.DB,
 	syncer *repos.Syncer,
 	sched *repos.UpdateScheduler,
 	changesetSyncer batches.UnarchivedChangesetSyncRegistry,
@@ -482,6 +484,13 @@ func watchSyncer(
 					}
 				}
 			}
+
+			for _, repo := range diff.Added {
+				events.Enqueue(ctx, logger, db, events.RepoAdded, events.Repo{ID: repo.ID, Name: repo.Name})
+			}
+			for _, repo := range diff.Deleted {
+				events.Enqueue(ctx, logger, db, events.RepoRemoved, events.Repo{ID: repo.ID, Name: repo.Name})
+			}
 		}
 	}
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"time"
 
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
@@ -19,6 +20,13 @@ import (
 func execPost(ctx context.Context, stepIdx int, executionInput batcheslib.WorkspacesExecutionInput, previousResult execution.AfterStepResult) error {
 	step := executionInput.Steps[stepIdx]
 
+	// Collect the artifacts before generating the diff, so that they don't end
+	// up in the changes.
+	artifacts, err := collectArtifacts(ctx, stepIdx, step, executionInput.Path, previousResult.Artifacts)
+	if err != nil {
+		return err
+	}
+
 	// Generate the diff.
 	if _, err := runGitCmd(ctx, "git", "add", "--all"); err != nil {
 		return errors.Wrap(err, "git add --all failed")
@@ -47,7 +55,8 @@ func execPost(ctx context.Context, stepIdx int, executionInput batcheslib.Worksp
 		StepIndex: stepIdx,
 		Diff:      diff,
 		// Those will be set below.
-		Outputs: make(map[string]interface{}),
+		Outputs:   make(map[string]interface{}),
+		Artifacts: artifacts,
 	}
 
 	// Render the step outputs.
@@ -128,6 +137,76 @@ func execPost(ctx context.Context, stepIdx int, executionInput batcheslib.Worksp
 	return nil
 }
 
+// maxArtifactSize is the maximum size of a single artifact file in bytes.
+const maxArtifactSize = 1024 * 1024
+
+// artifactsDir is the directory in the job workspace that the content of the
+// artifacts is written to, named by their keys. The executor uploads the files
+// in it after the job ran.
+const artifactsDir = "artifacts"
+
+// collectArtifacts copies the artifact files declared by the step into the
+// artifacts directory and returns them together with the artifacts of the
+// previous steps. Artifact files that are not tracked in the repository are
+// removed from the workspace afterwards.
+func collectArtifacts(ctx context.Context, stepIdx int, step batcheslib.Step, workspacePath string, previous map[string]execution.Artifact) (map[string]execution.Artifact, error) {
+	if len(step.Artifacts) == 0 {
+		return previous, nil
+	}
+
+	artifacts := make(map[string]execution.Artifact, len(previous)+len(step.Artifacts))
+	for name, a := range previous {
+		artifacts[name] = a
+	}
+
+	if err := os.MkdirAll(artifactsDir, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "creating artifacts directory")
+	}
+
+	for _, a := range step.Artifacts {
+		relPath := filepath.Join(workspacePath, a.Path)
+		p := filepath.Join("repository", relPath)
+
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "artifact %q", a.Name)
+		}
+		if fi.IsDir() {
+			return nil, errors.Newf("artifact %q: %s is a directory", a.Name, a.Path)
+		}
+		if fi.Size() > maxArtifactSize {
+			return nil, errors.Newf("artifact %q: %s is larger than %d bytes", a.Name, a.Path, maxArtifactSize)
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "artifact %q", a.Name)
+		}
+		key := execution.ArtifactKey(content)
+		if err := os.WriteFile(filepath.Join(artifactsDir, key), content, os.ModePerm); err != nil {
+			return nil, errors.Wrapf(err, "writing artifact %q", a.Name)
+		}
+		artifacts[a.Name] = execution.Artifact{
+			Path:      a.Path,
+			StepIndex: stepIdx,
+			Key:       key,
+			ByteSize:  int64(len(content)),
+		}
+
+		tracked, err := runGitCmd(ctx, "git", "ls-files", "--", relPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "git ls-files failed")
+		}
+		if len(tracked) == 0 {
+			if err := os.Remove(p); err != nil {
+				return nil, errors.Wrapf(err, "removing artifact %q from the workspace", a.Name)
+			}
+		}
+	}
+
+	return artifacts, nil
+}
+
 func runGitCmd(ctx context.Context, args ...string) ([]byte, error) {
 	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 	cmd.Dir = "repository"
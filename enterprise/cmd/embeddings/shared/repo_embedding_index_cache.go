@@ -12,6 +12,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/env"
 )
@@ -72,6 +73,12 @@ func getCachedRepoEmbeddingIndex(
 		if err != nil {
 			return nil, errors.Wrap(err, "downloading repo embedding index")
 		}
+		// Indexes that were created before quantization was enabled are
+		// quantized before they are cached, so that they use less memory.
+		if c := conf.Get().Embeddings; c != nil && c.QuantizeIndexes {
+			embeddingIndex.CodeIndex.Quantize()
+			embeddingIndex.TextIndex.Quantize()
+		}
 		cache.Add(repoEmbeddingIndexName, repoEmbeddingIndexCacheEntry{index: embeddingIndex, finishedAt: *finishedAt})
 		return embeddingIndex, nil
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings"
 	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -41,11 +42,11 @@ func searchRepoEmbeddingIndex(
 	}
 
 	var codeResults, textResults []embeddings.EmbeddingSearchResult
-	if params.CodeResultsCount > 0 && len(embeddingIndex.CodeIndex.Embeddings) > 0 {
+	if params.CodeResultsCount > 0 && len(embeddingIndex.CodeIndex.RowMetadata) > 0 {
 		codeResults = searchEmbeddingIndex(ctx, logger, embeddingIndex.RepoName, embeddingIndex.Revision, &embeddingIndex.CodeIndex, readFile, embeddedQuery, params.CodeResultsCount, opts)
 	}
 
-	if params.TextResultsCount > 0 && len(embeddingIndex.TextIndex.Embeddings) > 0 {
+	if params.TextResultsCount > 0 && len(embeddingIndex.TextIndex.RowMetadata) > 0 {
 		textResults = searchEmbeddingIndex(ctx, logger, embeddingIndex.RepoName, embeddingIndex.Revision, &embeddingIndex.TextIndex, readFile, embeddedQuery, params.TextResultsCount, opts)
 	}
 
@@ -66,7 +67,16 @@ func searchEmbeddingIndex(
 	opts embeddings.SearchOptions,
 ) []embeddings.EmbeddingSearchResult {
 	numWorkers := runtime.GOMAXPROCS(0)
-	rows := index.SimilaritySearch(query, nResults, embeddings.WorkerOptions{NumWorkers: numWorkers, MinRowsToSplit: SIMILARITY_SEARCH_MIN_ROWS_TO_SPLIT}, opts)
+	workerOptions := embeddings.WorkerOptions{NumWorkers: numWorkers, MinRowsToSplit: SIMILARITY_SEARCH_MIN_ROWS_TO_SPLIT}
+
+	var rows []embeddings.EmbeddingSearchResult
+	// Small indexes are always searched exhaustively, even if they have an
+	// approximate index from a previous configuration.
+	if approximateOpts, ok := embeddings.ApproximateSearchOptionsFromConfig(conf.Get().Embeddings); ok && index.IVF != nil && len(index.RowMetadata) >= approximateOpts.MinRows {
+		rows = index.ApproximateSimilaritySearch(query, nResults, approximateOpts.Probes, workerOptions, opts)
+	} else {
+		rows = index.SimilaritySearch(query, nResults, workerOptions, opts)
+	}
 
 	// Hydrate content
 	for idx, row := range rows {
@@ -81,3 +81,18 @@ func (c *Client) Get(ctx context.Context, job types.Job, bucket string, key stri
 	}
 	return body, nil
 }
+
+func (c *Client) Upload(ctx context.Context, job types.Job, bucket string, key string, content io.Reader) (err error) {
+	ctx, _, endObservation := c.operations.upload.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.String("bucket", bucket),
+		otlog.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	req, err := c.client.NewRequest(job.ID, job.Token, http.MethodPost, fmt.Sprintf("%s/%s", bucket, key), content)
+	if err != nil {
+		return err
+	}
+
+	return c.client.DoAndDrop(ctx, req)
+}
@@ -5,10 +5,13 @@ import (
 	"net/url"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/c2h5oh/datasize"
 	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
 	"github.com/sourcegraph/sourcegraph/internal/conf/confdefaults"
@@ -51,6 +54,15 @@ type Config struct {
 	dockerAuthConfigStr            string
 	dockerAuthConfigUnmarshalError error
 
+	UseKubernetes                        bool
+	KubernetesConfigPath                 string
+	KubernetesNamespace                  string
+	KubernetesPersistenceVolumeClaimName string
+	KubernetesVolumeMountPath            string
+	KubernetesNodeSelector               string
+	KubernetesResourceRequestCPU         string
+	KubernetesResourceRequestMemory      string
+
 	defaultFrontendPassword string
 }
 
@@ -66,7 +78,8 @@ func (c *Config) Load() {
 	c.QueueName = c.Get("EXECUTOR_QUEUE_NAME", "", "The name of the queue to listen to.")
 	c.QueuePollInterval = c.GetInterval("EXECUTOR_QUEUE_POLL_INTERVAL", "1s", "Interval between dequeue requests.")
 	c.MaximumNumJobs = c.GetInt("EXECUTOR_MAXIMUM_NUM_JOBS", "1", "Number of virtual machines or containers that can be running at once.")
-	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux"), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only.")
+	c.UseKubernetes = c.GetBool("EXECUTOR_USE_KUBERNETES", "false", "Whether to run each step of a job in its own Kubernetes pod. Requires the executor to run in, or have access to, a Kubernetes cluster.")
+	c.UseFirecracker = c.GetBool("EXECUTOR_USE_FIRECRACKER", strconv.FormatBool(runtime.GOOS == "linux" && !c.UseKubernetes), "Whether to isolate commands in virtual machines. Requires ignite and firecracker. Linux hosts only.")
 	c.FirecrackerImage = c.Get("EXECUTOR_FIRECRACKER_IMAGE", DefaultFirecrackerImage, "The base image to use for virtual machines.")
 	c.FirecrackerKernelImage = c.Get("EXECUTOR_FIRECRACKER_KERNEL_IMAGE", DefaultFirecrackerKernelImage, "The base image containing the kernel binary to use for virtual machines.")
 	c.FirecrackerSandboxImage = c.Get("EXECUTOR_FIRECRACKER_SANDBOX_IMAGE", DefaultFirecrackerSandboxImage, "The OCI image for the ignite VM sandbox.")
@@ -87,6 +100,13 @@ func (c *Config) Load() {
 	c.MaxActiveTime = c.GetInterval("EXECUTOR_MAX_ACTIVE_TIME", "0", "The maximum time that can be spent by the worker dequeueing records to be handled.")
 	c.DockerRegistryMirrorURL = c.GetOptional("EXECUTOR_DOCKER_REGISTRY_MIRROR_URL", "The address of a docker registry mirror to use in firecracker VMs. Supports multiple values, separated with a comma.")
 	c.DockerAddHostGateway = c.GetBool("EXECUTOR_DOCKER_ADD_HOST_GATEWAY", "false", "If true, host.docker.internal will be exposed to the docker commands run by the runtime. Warn: Can be insecure. Only use this if you understand what you're doing. This is mostly used for running against a Sourcegraph on the same host.")
+	c.KubernetesConfigPath = c.GetOptional("EXECUTOR_KUBERNETES_CONFIG_PATH", "The path to the kubeconfig file used to create pods. If not set, the in-cluster configuration is used.")
+	c.KubernetesNamespace = c.Get("EXECUTOR_KUBERNETES_NAMESPACE", "default", "The namespace to create the pods of job steps in.")
+	c.KubernetesPersistenceVolumeClaimName = c.Get("EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_CLAIM_NAME", "sg-executor-pvc", "The name of the persistent volume claim shared by the executor and the pods of job steps, which holds the job workspaces.")
+	c.KubernetesVolumeMountPath = c.Get("EXECUTOR_KUBERNETES_VOLUME_MOUNT_PATH", "/data", "The path the persistent volume claim is mounted at in the executor.")
+	c.KubernetesNodeSelector = c.GetOptional("EXECUTOR_KUBERNETES_NODE_SELECTOR", "A comma separated list of key=value labels that nodes must have for the pods of job steps to be scheduled on them.")
+	c.KubernetesResourceRequestCPU = c.GetOptional("EXECUTOR_KUBERNETES_RESOURCE_REQUEST_CPU", "The amount of CPU requested for the pods of job steps, as a Kubernetes quantity. The limit is set by EXECUTOR_JOB_NUM_CPUS.")
+	c.KubernetesResourceRequestMemory = c.GetOptional("EXECUTOR_KUBERNETES_RESOURCE_REQUEST_MEMORY", "The amount of memory requested for the pods of job steps, as a Kubernetes quantity. The limit is set by EXECUTOR_JOB_MEMORY.")
 	c.dockerAuthConfigStr = c.GetOptional("EXECUTOR_DOCKER_AUTH_CONFIG", "The content of the docker config file including auth for services. If using firecracker, only static credentials are supported, not credential stores nor credential helpers.")
 
 	if c.dockerAuthConfigStr != "" {
@@ -118,6 +138,36 @@ func (c *Config) Validate() error {
 		c.AddError(errors.Wrap(c.dockerAuthConfigUnmarshalError, "invalid EXECUTOR_DOCKER_AUTH_CONFIG, failed to parse"))
 	}
 
+	if c.UseKubernetes {
+		if c.UseFirecracker {
+			c.AddError(errors.New("EXECUTOR_USE_KUBERNETES and EXECUTOR_USE_FIRECRACKER cannot both be enabled"))
+		}
+		if c.KubernetesPersistenceVolumeClaimName == "" {
+			c.AddError(errors.New("EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_CLAIM_NAME must be set when EXECUTOR_USE_KUBERNETES is enabled"))
+		}
+		// The pods of job steps are named and labeled after the VM name of the job,
+		// which is the prefix followed by a UUID.
+		if errs := validation.IsDNS1123Label(c.VMPrefix + "-" + uuid.Nil.String()); len(errs) > 0 {
+			c.AddError(errors.Newf("EXECUTOR_VM_PREFIX %q cannot be used in Kubernetes pod names: %s", c.VMPrefix, strings.Join(errs, ", ")))
+		}
+		if _, err := resource.ParseQuantity(c.JobMemory); err != nil {
+			c.AddError(errors.Wrapf(err, "invalid Kubernetes quantity provided for EXECUTOR_JOB_MEMORY: %q", c.JobMemory))
+		}
+		if c.KubernetesResourceRequestCPU != "" {
+			if _, err := resource.ParseQuantity(c.KubernetesResourceRequestCPU); err != nil {
+				c.AddError(errors.Wrapf(err, "invalid Kubernetes quantity provided for EXECUTOR_KUBERNETES_RESOURCE_REQUEST_CPU: %q", c.KubernetesResourceRequestCPU))
+			}
+		}
+		if c.KubernetesResourceRequestMemory != "" {
+			if _, err := resource.ParseQuantity(c.KubernetesResourceRequestMemory); err != nil {
+				c.AddError(errors.Wrapf(err, "invalid Kubernetes quantity provided for EXECUTOR_KUBERNETES_RESOURCE_REQUEST_MEMORY: %q", c.KubernetesResourceRequestMemory))
+			}
+		}
+		if _, err := ParseKubernetesNodeSelector(c.KubernetesNodeSelector); err != nil {
+			c.AddError(errors.Wrap(err, "invalid EXECUTOR_KUBERNETES_NODE_SELECTOR"))
+		}
+	}
+
 	if c.UseFirecracker {
 		// Validate that firecracker can work on this host.
 		if runtime.GOOS != "linux" {
@@ -141,3 +191,20 @@ func (c *Config) Validate() error {
 
 	return c.BaseConfig.Validate()
 }
+
+// ParseKubernetesNodeSelector parses a comma separated list of key=value labels.
+func ParseKubernetesNodeSelector(nodeSelector string) (map[string]string, error) {
+	if nodeSelector == "" {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for _, label := range strings.Split(nodeSelector, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(label), "=")
+		if !ok || key == "" {
+			return nil, errors.Newf("label %q must be in the format key=value", label)
+		}
+		labels[key] = value
+	}
+	return labels, nil
+}
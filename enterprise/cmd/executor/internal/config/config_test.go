@@ -1,6 +1,7 @@
 package config
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -39,4 +40,100 @@ func TestValidateConfig(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("Kubernetes", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			conf        Config
+			expectedErr string
+		}{
+			{
+				name: "Valid",
+				conf: Config{
+					UseKubernetes:                        true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					KubernetesNodeSelector:               "pool=executors,disk=ssd",
+					KubernetesResourceRequestCPU:         "500m",
+					KubernetesResourceRequestMemory:      "1Gi",
+					JobMemory:                            "12G",
+				},
+			},
+			{
+				name: "Firecracker enabled",
+				conf: Config{
+					UseKubernetes:                        true,
+					UseFirecracker:                       true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					JobMemory:                            "12G",
+				},
+				expectedErr: "EXECUTOR_USE_KUBERNETES and EXECUTOR_USE_FIRECRACKER cannot both be enabled",
+			},
+			{
+				name: "Missing persistence volume claim",
+				conf: Config{
+					UseKubernetes: true,
+					JobMemory:     "12G",
+				},
+				expectedErr: "EXECUTOR_KUBERNETES_PERSISTENCE_VOLUME_CLAIM_NAME must be set when EXECUTOR_USE_KUBERNETES is enabled",
+			},
+			{
+				name: "Invalid resource request",
+				conf: Config{
+					UseKubernetes:                        true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					KubernetesResourceRequestCPU:         "lots",
+					JobMemory:                            "12G",
+				},
+				expectedErr: `invalid Kubernetes quantity provided for EXECUTOR_KUBERNETES_RESOURCE_REQUEST_CPU: "lots"`,
+			},
+			{
+				name: "Invalid node selector",
+				conf: Config{
+					UseKubernetes:                        true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					KubernetesNodeSelector:               "pool",
+					JobMemory:                            "12G",
+				},
+				expectedErr: "invalid EXECUTOR_KUBERNETES_NODE_SELECTOR",
+			},
+			{
+				name: "VM prefix with invalid characters",
+				conf: Config{
+					UseKubernetes:                        true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					VMPrefix:                             "Executor_1",
+					JobMemory:                            "12G",
+				},
+				expectedErr: `EXECUTOR_VM_PREFIX "Executor_1" cannot be used in Kubernetes pod names`,
+			},
+			{
+				name: "VM prefix too long",
+				conf: Config{
+					UseKubernetes:                        true,
+					KubernetesPersistenceVolumeClaimName: "sg-executor-pvc",
+					VMPrefix:                             strings.Repeat("a", 30),
+					JobMemory:                            "12G",
+				},
+				expectedErr: "cannot be used in Kubernetes pod names: must be no more than 63 characters",
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				test.conf.FrontendURL = "https://sourcegraph.example.com"
+				test.conf.QueueName = "batches"
+				if test.conf.VMPrefix == "" {
+					test.conf.VMPrefix = "executor"
+				}
+
+				err := test.conf.Validate()
+				if test.expectedErr == "" {
+					if err != nil {
+						t.Errorf("Unexpected error returned: %v", err)
+					}
+				} else if err == nil || !strings.Contains(err.Error(), test.expectedErr) {
+					t.Errorf("Unexpected error returned: expected '%v', got '%v'", test.expectedErr, err)
+				}
+			})
+		}
+	})
 }
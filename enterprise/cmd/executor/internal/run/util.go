@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/sourcegraph/log"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/apiclient/queue"
@@ -63,6 +64,7 @@ func apiWorkerOptions(c *config.Config, queueTelemetryOptions queue.TelemetryOpt
 		RunnerOptions: runner.Options{
 			DockerOptions:      dockerOptions(c),
 			FirecrackerOptions: firecrackerOptions(c),
+			KubernetesOptions:  kubernetesOptions(c),
 		},
 		GitServicePath: "/.executors/git",
 		QueueOptions:   queueOptions(c, queueTelemetryOptions),
@@ -117,6 +119,42 @@ func firecrackerOptions(c *config.Config) runner.FirecrackerOptions {
 	}
 }
 
+// kubernetesOptions builds the Kubernetes options from c, which must have been validated.
+func kubernetesOptions(c *config.Config) command.KubernetesOptions {
+	// The job resource options are only validated as Kubernetes quantities when
+	// Kubernetes is used.
+	if !c.UseKubernetes {
+		return command.KubernetesOptions{}
+	}
+
+	nodeSelector, _ := config.ParseKubernetesNodeSelector(c.KubernetesNodeSelector)
+
+	var limit, request command.KubernetesResource
+	if c.JobNumCPUs != 0 {
+		limit.CPU = *resource.NewQuantity(int64(c.JobNumCPUs), resource.DecimalSI)
+	}
+	if c.JobMemory != "" && c.JobMemory != "0" {
+		limit.Memory = resource.MustParse(c.JobMemory)
+	}
+	if c.KubernetesResourceRequestCPU != "" {
+		request.CPU = resource.MustParse(c.KubernetesResourceRequestCPU)
+	}
+	if c.KubernetesResourceRequestMemory != "" {
+		request.Memory = resource.MustParse(c.KubernetesResourceRequestMemory)
+	}
+
+	return command.KubernetesOptions{
+		Enabled:                    true,
+		ConfigPath:                 c.KubernetesConfigPath,
+		Namespace:                  c.KubernetesNamespace,
+		PersistenceVolumeClaimName: c.KubernetesPersistenceVolumeClaimName,
+		VolumeMountPath:            c.KubernetesVolumeMountPath,
+		NodeSelector:               nodeSelector,
+		ResourceLimit:              limit,
+		ResourceRequest:            request,
+	}
+}
+
 func resourceOptions(c *config.Config) command.ResourceOptions {
 	return command.ResourceOptions{
 		NumCPUs:             c.JobNumCPUs,
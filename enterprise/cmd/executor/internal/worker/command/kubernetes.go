@@ -0,0 +1,278 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/sourcegraph/log"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// KubernetesJobMountPath is the path inside the pod where the job workspace is mounted.
+	KubernetesJobMountPath = "/data"
+	// KubernetesVolumeName is the name of the pod volume holding the job workspaces.
+	KubernetesVolumeName = "sg-executor-job-volume"
+	// KubernetesContainerName is the name of the container running the step.
+	KubernetesContainerName = "sg-executor-job"
+	// KubernetesExecutorLabel is set on every pod created by an executor to the name of the runner.
+	KubernetesExecutorLabel = "sourcegraph.com/executor-runner"
+)
+
+// KubernetesOptions are the options that are specific to running jobs as Kubernetes pods.
+type KubernetesOptions struct {
+	Enabled bool
+	// ConfigPath is the path to a kubeconfig file. When empty, the in-cluster configuration is used.
+	ConfigPath string
+	// Namespace is the namespace the pods of steps are created in.
+	Namespace string
+	// PersistenceVolumeClaimName is the name of the PVC holding the job workspaces. It is mounted by both the
+	// executor and the pods of steps.
+	PersistenceVolumeClaimName string
+	// VolumeMountPath is the path the PVC is mounted at in the executor. Workspaces are created below it.
+	VolumeMountPath string
+	// NodeSelector constrains the nodes the pods of steps are scheduled on.
+	NodeSelector map[string]string
+	// ResourceLimit is the maximum amount of CPU and memory a step can use.
+	ResourceLimit KubernetesResource
+	// ResourceRequest is the amount of CPU and memory requested for a step.
+	ResourceRequest KubernetesResource
+}
+
+// KubernetesResource is the CPU and memory of a pod resource request or limit. A zero quantity is not set.
+type KubernetesResource struct {
+	CPU    resource.Quantity
+	Memory resource.Quantity
+}
+
+func (r KubernetesResource) resourceList() corev1.ResourceList {
+	list := corev1.ResourceList{}
+	if !r.CPU.IsZero() {
+		list[corev1.ResourceCPU] = r.CPU
+	}
+	if !r.Memory.IsZero() {
+		list[corev1.ResourceMemory] = r.Memory
+	}
+	return list
+}
+
+// KubernetesCommand creates and observes the pods that run the steps of a job.
+type KubernetesCommand struct {
+	Logger     log.Logger
+	Clientset  kubernetes.Interface
+	Operations *Operations
+}
+
+// CreatePod creates the given pod in the given namespace.
+func (c *KubernetesCommand) CreatePod(ctx context.Context, namespace string, pod *corev1.Pod) (_ *corev1.Pod, err error) {
+	ctx, _, endObservation := c.Operations.KubernetesCreatePod.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	c.Logger.Info("Creating pod", log.String("namespace", namespace), log.String("name", pod.Name), log.String("image", pod.Spec.Containers[0].Image))
+	return c.Clientset.CoreV1().Pods(namespace).Create(ctx, pod, metav1.CreateOptions{})
+}
+
+// DeletePod deletes the pod with the given name. Pods that no longer exist are ignored.
+func (c *KubernetesCommand) DeletePod(ctx context.Context, namespace, name string) (err error) {
+	ctx, _, endObservation := c.Operations.KubernetesDeletePod.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	propagation := metav1.DeletePropagationBackground
+	err = c.Clientset.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// ReadLogs streams the logs of the step container of the given pod into the log entry until the container
+// exits. Kubernetes does not keep stdout and stderr apart, so all lines are prefixed with "stdout".
+func (c *KubernetesCommand) ReadLogs(ctx context.Context, namespace, name string, logEntry LogEntry) (err error) {
+	ctx, _, endObservation := c.Operations.KubernetesReadLogs.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	stream, err := c.Clientset.CoreV1().Pods(namespace).GetLogs(name, &corev1.PodLogOptions{
+		Container: KubernetesContainerName,
+		Follow:    true,
+	}).Stream(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "streaming logs of pod %q", name)
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 4*1024), maxBuffer)
+	for scanner.Scan() {
+		if _, err := fmt.Fprintf(logEntry, "stdout: %s\n", scanner.Text()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// WaitForPodToStart blocks until the step container of the given pod runs or has terminated.
+func (c *KubernetesCommand) WaitForPodToStart(ctx context.Context, namespace, name string) (err error) {
+	ctx, _, endObservation := c.Operations.KubernetesWaitForPod.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	_, err = c.waitForPod(ctx, namespace, name, func(pod *corev1.Pod) (bool, error) {
+		if pod.Status.Phase != corev1.PodPending {
+			return true, nil
+		}
+		return false, podStartError(pod)
+	})
+	return err
+}
+
+// WaitForPodToComplete blocks until the given pod has terminated and returns the exit code of the step
+// container.
+func (c *KubernetesCommand) WaitForPodToComplete(ctx context.Context, namespace, name string) (exitCode int, err error) {
+	ctx, _, endObservation := c.Operations.KubernetesWaitForPod.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	pod, err := c.waitForPod(ctx, namespace, name, func(pod *corev1.Pod) (bool, error) {
+		return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return podExitCode(pod), nil
+}
+
+// waitForPod watches the given pod until done returns true or an error.
+func (c *KubernetesCommand) waitForPod(ctx context.Context, namespace, name string, done func(*corev1.Pod) (bool, error)) (*corev1.Pod, error) {
+	pods := c.Clientset.CoreV1().Pods(namespace)
+
+	// Start watching before getting the current state of the pod, so no update can be missed.
+	watcher, err := pods.Watch(ctx, metav1.ListOptions{FieldSelector: "metadata.name=" + name})
+	if err != nil {
+		return nil, errors.Wrapf(err, "watching pod %q", name)
+	}
+	defer watcher.Stop()
+
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting pod %q", name)
+	}
+	if ok, err := done(pod); err != nil || ok {
+		return pod, err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil, errors.Newf("watch of pod %q closed unexpectedly", name)
+			}
+			if event.Type == watch.Deleted {
+				return nil, errors.Newf("pod %q was deleted", name)
+			}
+			pod, isPod := event.Object.(*corev1.Pod)
+			if !isPod || pod.Name != name {
+				continue
+			}
+			if ok, err := done(pod); err != nil || ok {
+				return pod, err
+			}
+		}
+	}
+}
+
+// podStartErrorReasons are the reasons of a waiting container that will not resolve by waiting longer.
+var podStartErrorReasons = map[string]struct{}{
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+	"CreateContainerConfigError": {},
+}
+
+func podStartError(pod *corev1.Pod) error {
+	for _, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil {
+			if _, ok := podStartErrorReasons[waiting.Reason]; ok {
+				return errors.Newf("pod %q failed to start: %s: %s", pod.Name, waiting.Reason, waiting.Message)
+			}
+		}
+	}
+	return nil
+}
+
+func podExitCode(pod *corev1.Pod) int {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == KubernetesContainerName && status.State.Terminated != nil {
+			return int(status.State.Terminated.ExitCode)
+		}
+	}
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return 0
+	}
+	return 1
+}
+
+// NewKubernetesPod builds the pod running the given step. workspaceSubPath is the path of the job workspace
+// relative to the root of the persistent volume claim, and is mounted at KubernetesJobMountPath.
+func NewKubernetesPod(
+	name string,
+	runnerName string,
+	image string,
+	scriptPath string,
+	workspaceSubPath string,
+	spec Spec,
+	options KubernetesOptions,
+) *corev1.Pod {
+	env := make([]corev1.EnvVar, 0, len(spec.Env))
+	for _, e := range spec.Env {
+		key, value, _ := strings.Cut(e, "=")
+		env = append(env, corev1.EnvVar{Name: key, Value: value})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				KubernetesExecutorLabel: runnerName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			NodeSelector:  options.NodeSelector,
+			Containers: []corev1.Container{{
+				Name:            KubernetesContainerName,
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{"/bin/sh", filepath.Join(KubernetesJobMountPath, ScriptsPath, scriptPath)},
+				WorkingDir:      filepath.Join(KubernetesJobMountPath, spec.Dir),
+				Env:             env,
+				Resources: corev1.ResourceRequirements{
+					Limits:   options.ResourceLimit.resourceList(),
+					Requests: options.ResourceRequest.resourceList(),
+				},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      KubernetesVolumeName,
+					MountPath: KubernetesJobMountPath,
+					SubPath:   workspaceSubPath,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: KubernetesVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: options.PersistenceVolumeClaimName,
+					},
+				},
+			}},
+		},
+	}
+}
@@ -0,0 +1,185 @@
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestNewKubernetesPod(t *testing.T) {
+	options := command.KubernetesOptions{
+		PersistenceVolumeClaimName: "sg-executor-pvc",
+		NodeSelector:               map[string]string{"pool": "executors"},
+		ResourceLimit: command.KubernetesResource{
+			CPU:    resource.MustParse("4"),
+			Memory: resource.MustParse("12Gi"),
+		},
+		ResourceRequest: command.KubernetesResource{
+			Memory: resource.MustParse("1Gi"),
+		},
+	}
+	spec := command.Spec{
+		Key:     "some-key",
+		Command: []string{"some", "command"},
+		Dir:     "some/dir",
+		Env:     []string{"FOO=BAR", "EMPTY="},
+	}
+
+	pod := command.NewKubernetesPod("executor-1-0", "executor-1", "some-image", "some/path", "workspace-1", spec, options)
+
+	assert.Equal(t, "executor-1-0", pod.Name)
+	assert.Equal(t, map[string]string{command.KubernetesExecutorLabel: "executor-1"}, pod.Labels)
+	assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Equal(t, options.NodeSelector, pod.Spec.NodeSelector)
+
+	require.Len(t, pod.Spec.Containers, 1)
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, command.KubernetesContainerName, container.Name)
+	assert.Equal(t, "some-image", container.Image)
+	assert.Equal(t, []string{"/bin/sh", "/data/.sourcegraph-executor/some/path"}, container.Command)
+	assert.Equal(t, "/data/some/dir", container.WorkingDir)
+	assert.Equal(t, []corev1.EnvVar{{Name: "FOO", Value: "BAR"}, {Name: "EMPTY", Value: ""}}, container.Env)
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("4"),
+		corev1.ResourceMemory: resource.MustParse("12Gi"),
+	}, container.Resources.Limits)
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}, container.Resources.Requests)
+	assert.Equal(t, []corev1.VolumeMount{{
+		Name:      command.KubernetesVolumeName,
+		MountPath: "/data",
+		SubPath:   "workspace-1",
+	}}, container.VolumeMounts)
+
+	require.Len(t, pod.Spec.Volumes, 1)
+	require.NotNil(t, pod.Spec.Volumes[0].PersistentVolumeClaim)
+	assert.Equal(t, "sg-executor-pvc", pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+}
+
+func TestKubernetesCommand_WaitForPodToComplete(t *testing.T) {
+	tests := []struct {
+		name             string
+		status           corev1.PodStatus
+		expectedExitCode int
+	}{
+		{
+			name:             "Succeeded",
+			status:           corev1.PodStatus{Phase: corev1.PodSucceeded},
+			expectedExitCode: 0,
+		},
+		{
+			name: "Failed with exit code",
+			status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  command.KubernetesContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 42}},
+				}},
+			},
+			expectedExitCode: 42,
+		},
+		{
+			name:             "Failed without container status",
+			status:           corev1.PodStatus{Phase: corev1.PodFailed},
+			expectedExitCode: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "default"},
+				Status:     test.status,
+			})
+			cmd := newKubernetesCommand(t, clientset)
+
+			require.NoError(t, cmd.WaitForPodToStart(context.Background(), "default", "my-pod"))
+			exitCode, err := cmd.WaitForPodToComplete(context.Background(), "default", "my-pod")
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedExitCode, exitCode)
+		})
+	}
+}
+
+func TestKubernetesCommand_WaitForPodToStart_ImagePullError(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "default"},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: command.KubernetesContainerName,
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: "image not found",
+				}},
+			}},
+		},
+	})
+	cmd := newKubernetesCommand(t, clientset)
+
+	err := cmd.WaitForPodToStart(context.Background(), "default", "my-pod")
+	assert.EqualError(t, err, `pod "my-pod" failed to start: ImagePullBackOff: image not found`)
+}
+
+func TestKubernetesCommand_WaitForPodToStart_Canceled(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "default"},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	})
+	cmd := newKubernetesCommand(t, clientset)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := cmd.WaitForPodToStart(ctx, "default", "my-pod")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestKubernetesCommand_DeletePod(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "default"},
+	})
+	cmd := newKubernetesCommand(t, clientset)
+
+	require.NoError(t, cmd.DeletePod(context.Background(), "default", "my-pod"))
+	pods, err := clientset.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, pods.Items)
+
+	// Deleting a pod that no longer exists is not an error.
+	require.NoError(t, cmd.DeletePod(context.Background(), "default", "my-pod"))
+}
+
+func TestKubernetesCommand_ReadLogs(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-pod", Namespace: "default"},
+	})
+	cmd := newKubernetesCommand(t, clientset)
+
+	var logs bytes.Buffer
+	logEntry := command.NewMockLogEntry()
+	logEntry.WriteFunc.SetDefaultHook(logs.Write)
+
+	require.NoError(t, cmd.ReadLogs(context.Background(), "default", "my-pod", logEntry))
+	// The fake clientset always streams the same logs.
+	assert.Equal(t, "stdout: fake logs\n", logs.String())
+}
+
+func newKubernetesCommand(t *testing.T, clientset *fake.Clientset) *command.KubernetesCommand {
+	return &command.KubernetesCommand{
+		Logger:     logtest.Scoped(t),
+		Clientset:  clientset,
+		Operations: command.NewOperations(&observation.TestContext),
+	}
+}
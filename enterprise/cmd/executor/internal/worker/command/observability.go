@@ -23,6 +23,11 @@ type Operations struct {
 	TeardownFirecrackerRemove    *observation.Operation
 	Exec                         *observation.Operation
 
+	KubernetesCreatePod  *observation.Operation
+	KubernetesDeletePod  *observation.Operation
+	KubernetesReadLogs   *observation.Operation
+	KubernetesWaitForPod *observation.Operation
+
 	RunLockWaitTotal prometheus.Counter
 	RunLockHeldTotal prometheus.Counter
 }
@@ -73,6 +78,11 @@ func NewOperations(observationCtx *observation.Context) *Operations {
 		TeardownFirecrackerRemove:    op("teardown.firecracker.remove"),
 		Exec:                         op("exec"),
 
+		KubernetesCreatePod:  op("kubernetes.pod.create"),
+		KubernetesDeletePod:  op("kubernetes.pod.delete"),
+		KubernetesReadLogs:   op("kubernetes.pod.logs"),
+		KubernetesWaitForPod: op("kubernetes.pod.wait"),
+
 		RunLockWaitTotal: runLockWaitTotal,
 		RunLockHeldTotal: runLockHeldTotal,
 	}
@@ -114,6 +114,14 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 	}
 	defer ws.Remove(ctx, h.options.RunnerOptions.FirecrackerOptions.KeepWorkspaces)
 
+	// Upload the artifacts once the runner is torn down. This is done even if a
+	// command failed, so that the artifacts of the commands that ran are kept.
+	defer func() {
+		if uploadErr := ws.UploadArtifacts(context.Background(), job); uploadErr != nil {
+			err = errors.Append(err, uploadErr)
+		}
+	}()
+
 	// Before we setup a VM (and after we teardown), mark the name as in-use so that
 	// the janitor process cleaning up orphaned VMs doesn't try to stop/remove the one
 	// we're using for the current job.
@@ -123,7 +131,7 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, job types.Job)
 
 	// Create the runner that will actually run the commands.
 	logger.Info("Setting up runner")
-	runtimeRunner, err := h.jobRuntime.NewRunner(ctx, commandLogger, runtime.RunnerOptions{Path: ws.Path(), DockerAuthConfig: job.DockerAuthConfig})
+	runtimeRunner, err := h.jobRuntime.NewRunner(ctx, commandLogger, runtime.RunnerOptions{Name: name, Path: ws.Path(), DockerAuthConfig: job.DockerAuthConfig})
 	if err != nil {
 		return errors.Wrap(err, "creating runtime runner")
 	}
@@ -186,7 +194,7 @@ func union(a, b map[string]string) map[string]string {
 
 // Handle clones the target code into a temporary directory, invokes the target indexer in a
 // fresh docker container, and uploads the results to the external frontend API.
-func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger command.Logger, job types.Job) error {
+func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger command.Logger, job types.Job) (err error) {
 	// Create a working directory for this job which will be removed once the job completes.
 	// If a repository is supplied as part of the job configuration, it will be cloned into
 	// the working directory.
@@ -198,6 +206,14 @@ func (h *handler) handle(ctx context.Context, logger log.Logger, commandLogger c
 	}
 	defer ws.Remove(ctx, h.options.RunnerOptions.FirecrackerOptions.KeepWorkspaces)
 
+	// Upload the artifacts once the VM is torn down. This is done even if a step
+	// failed, so that the artifacts of the steps that ran are kept.
+	defer func() {
+		if uploadErr := ws.UploadArtifacts(context.Background(), job); uploadErr != nil {
+			err = errors.Append(err, uploadErr)
+		}
+	}()
+
 	// Before we setup a VM (and after we teardown), mark the name as in-use so that
 	// the janitor process cleaning up orphaned VMs doesn't try to stop/remove the one
 	// we're using for the current job.
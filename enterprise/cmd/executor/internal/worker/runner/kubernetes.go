@@ -0,0 +1,160 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type kubernetesRunner struct {
+	cmd            *command.KubernetesCommand
+	name           string
+	dir            string
+	internalLogger log.Logger
+	commandLogger  command.Logger
+	options        command.KubernetesOptions
+	// workspaceSubPath is the path of dir relative to the volume mount path.
+	workspaceSubPath string
+
+	mu sync.Mutex
+	// numPods is the number of pods created so far, used to name the pod of the next step.
+	numPods int
+	// pods are the names of the pods that have been created but not deleted yet.
+	pods map[string]struct{}
+}
+
+var _ Runner = &kubernetesRunner{}
+
+// NewKubernetesRunner creates a new runner that runs every step in its own Kubernetes pod. The workspace at dir
+// must be on the persistent volume claim mounted at options.VolumeMountPath, so that the pods can mount it too.
+func NewKubernetesRunner(
+	cmd *command.KubernetesCommand,
+	logger command.Logger,
+	name string,
+	dir string,
+	options command.KubernetesOptions,
+) Runner {
+	return &kubernetesRunner{
+		cmd:            cmd,
+		name:           name,
+		dir:            dir,
+		internalLogger: log.Scoped("kubernetes-runner", ""),
+		commandLogger:  logger,
+		options:        options,
+		pods:           map[string]struct{}{},
+	}
+}
+
+func (r *kubernetesRunner) Setup(ctx context.Context) error {
+	rel, err := filepath.Rel(r.options.VolumeMountPath, r.dir)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, "../") {
+		return errors.Newf("workspace %q is not on the volume mounted at %q", r.dir, r.options.VolumeMountPath)
+	}
+	r.workspaceSubPath = rel
+
+	return nil
+}
+
+func (r *kubernetesRunner) TempDir() string {
+	return ""
+}
+
+// Teardown deletes the pods that were not deleted by Run, such as when the executor was interrupted.
+func (r *kubernetesRunner) Teardown(ctx context.Context) error {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.pods))
+	for name := range r.pods {
+		names = append(names, name)
+	}
+	r.mu.Unlock()
+
+	var errs error
+	for _, name := range names {
+		if err := r.deletePod(ctx, name); err != nil {
+			errs = errors.Append(errs, err)
+		}
+	}
+	return errs
+}
+
+func (r *kubernetesRunner) Run(ctx context.Context, spec Spec) error {
+	pod := command.NewKubernetesPod(
+		r.nextPodName(),
+		r.name,
+		spec.Image,
+		spec.ScriptPath,
+		r.workspaceSubPath,
+		spec.CommandSpec,
+		r.options,
+	)
+
+	if _, err := r.cmd.CreatePod(ctx, r.options.Namespace, pod); err != nil {
+		return errors.Wrap(err, "creating pod")
+	}
+	r.mu.Lock()
+	r.pods[pod.Name] = struct{}{}
+	r.mu.Unlock()
+	defer func() {
+		// Perform this outside of the task execution context, so that the pod is also
+		// deleted when the job is canceled or times out.
+		if err := r.deletePod(context.Background(), pod.Name); err != nil {
+			r.internalLogger.Error("Failed to delete pod", log.String("name", pod.Name), log.Error(err))
+		}
+	}()
+
+	logEntry := r.commandLogger.LogEntry(spec.CommandSpec.Key, pod.Spec.Containers[0].Command)
+	defer logEntry.Close()
+
+	exitCode, err := r.runPod(ctx, pod.Name, logEntry)
+	logEntry.Finalize(exitCode)
+	if err != nil {
+		return err
+	}
+	if exitCode != 0 {
+		return errors.Newf("command failed with exit code %d", exitCode)
+	}
+	return nil
+}
+
+// runPod streams the logs of the pod with the given name into the log entry, and returns the exit code of the
+// step once the pod has terminated.
+func (r *kubernetesRunner) runPod(ctx context.Context, name string, logEntry command.LogEntry) (int, error) {
+	if err := r.cmd.WaitForPodToStart(ctx, r.options.Namespace, name); err != nil {
+		return -1, errors.Wrap(err, "waiting for pod to start")
+	}
+	if err := r.cmd.ReadLogs(ctx, r.options.Namespace, name, logEntry); err != nil {
+		return -1, errors.Wrap(err, "reading pod logs")
+	}
+	exitCode, err := r.cmd.WaitForPodToComplete(ctx, r.options.Namespace, name)
+	if err != nil {
+		return -1, errors.Wrap(err, "waiting for pod to complete")
+	}
+	return exitCode, nil
+}
+
+func (r *kubernetesRunner) nextPodName() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := fmt.Sprintf("%s-%d", r.name, r.numPods)
+	r.numPods++
+	return name
+}
+
+func (r *kubernetesRunner) deletePod(ctx context.Context, name string) error {
+	if err := r.cmd.DeletePod(ctx, r.options.Namespace, name); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	delete(r.pods, name)
+	r.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,116 @@
+package runner_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestKubernetesRunner_Setup(t *testing.T) {
+	options := command.KubernetesOptions{VolumeMountPath: "/data"}
+
+	r := runner.NewKubernetesRunner(nil, nil, "executor-1", "/data/workspace-1", options)
+	require.NoError(t, r.Setup(context.Background()))
+
+	r = runner.NewKubernetesRunner(nil, nil, "executor-1", "/tmp/workspace-1", options)
+	assert.EqualError(t, r.Setup(context.Background()), `workspace "/tmp/workspace-1" is not on the volume mounted at "/data"`)
+}
+
+func TestKubernetesRunner_Run(t *testing.T) {
+	tests := []struct {
+		name        string
+		exitCode    int32
+		expectedErr string
+	}{
+		{
+			name: "Success",
+		},
+		{
+			name:        "Failure",
+			exitCode:    2,
+			expectedErr: "command failed with exit code 2",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			// The fake clientset does not run pods, so complete them as soon as they are created.
+			clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				pod := action.(k8stesting.CreateAction).GetObject().(*corev1.Pod)
+				pod.Status = terminatedPodStatus(test.exitCode)
+				return false, nil, nil
+			})
+
+			options := command.KubernetesOptions{Namespace: "executors", VolumeMountPath: "/data"}
+			kubeCmd := &command.KubernetesCommand{
+				Logger:     logtest.Scoped(t),
+				Clientset:  clientset,
+				Operations: command.NewOperations(&observation.TestContext),
+			}
+
+			var logs bytes.Buffer
+			logEntry := runner.NewMockLogEntry()
+			logEntry.WriteFunc.SetDefaultHook(logs.Write)
+			logger := runner.NewMockLogger()
+			logger.LogEntryFunc.SetDefaultReturn(logEntry)
+
+			r := runner.NewKubernetesRunner(kubeCmd, logger, "executor-1", "/data/workspace-1", options)
+			ctx := context.Background()
+			require.NoError(t, r.Setup(ctx))
+
+			err := r.Run(ctx, runner.Spec{
+				CommandSpec: command.Spec{Key: "step.kubernetes.0", Dir: "."},
+				Image:       "alpine",
+				ScriptPath:  "0.sh",
+			})
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Len(t, logger.LogEntryFunc.History(), 1)
+			assert.Equal(t, "step.kubernetes.0", logger.LogEntryFunc.History()[0].Arg0)
+			assert.Equal(t, "stdout: fake logs\n", logs.String())
+			require.Len(t, logEntry.FinalizeFunc.History(), 1)
+			assert.Equal(t, int(test.exitCode), logEntry.FinalizeFunc.History()[0].Arg0)
+			require.Len(t, logEntry.CloseFunc.History(), 1)
+
+			// The pod is deleted once the step has completed.
+			pods, err := clientset.CoreV1().Pods("executors").List(ctx, metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, pods.Items)
+
+			require.NoError(t, r.Teardown(ctx))
+		})
+	}
+}
+
+func terminatedPodStatus(exitCode int32) corev1.PodStatus {
+	phase := corev1.PodSucceeded
+	if exitCode != 0 {
+		phase = corev1.PodFailed
+	}
+	return corev1.PodStatus{
+		Phase: phase,
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name: command.KubernetesContainerName,
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+			},
+		}},
+	}
+}
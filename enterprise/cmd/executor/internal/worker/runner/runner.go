@@ -39,6 +39,7 @@ type Spec struct {
 type Options struct {
 	DockerOptions      command.DockerOptions
 	FirecrackerOptions FirecrackerOptions
+	KubernetesOptions  command.KubernetesOptions
 }
 
 // NewRunner creates a new runner with the given options.
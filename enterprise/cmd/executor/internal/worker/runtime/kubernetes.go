@@ -0,0 +1,95 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/workspace"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type kubernetesRuntime struct {
+	cmd          command.Command
+	kubeCmd      *command.KubernetesCommand
+	operations   *command.Operations
+	filesStore   workspace.FilesStore
+	cloneOptions workspace.CloneOptions
+	options      command.KubernetesOptions
+}
+
+var _ Runtime = &kubernetesRuntime{}
+
+func (r *kubernetesRuntime) Name() Name {
+	return NameKubernetes
+}
+
+func (r *kubernetesRuntime) PrepareWorkspace(ctx context.Context, logger command.Logger, job types.Job) (workspace.Workspace, error) {
+	return workspace.NewKubernetesWorkspace(
+		ctx,
+		r.filesStore,
+		job,
+		r.cmd,
+		logger,
+		r.cloneOptions,
+		r.options.VolumeMountPath,
+		r.operations,
+	)
+}
+
+func (r *kubernetesRuntime) NewRunner(ctx context.Context, logger command.Logger, options RunnerOptions) (runner.Runner, error) {
+	run := runner.NewKubernetesRunner(r.kubeCmd, logger, options.Name, options.Path, r.options)
+	if err := run.Setup(ctx); err != nil {
+		return nil, errors.Wrap(err, "failed to setup kubernetes runner")
+	}
+	return run, nil
+}
+
+func (r *kubernetesRuntime) NewRunnerSpecs(ws workspace.Workspace, steps []types.DockerStep) ([]runner.Spec, error) {
+	runnerSpecs := make([]runner.Spec, len(steps))
+	for i, step := range steps {
+		var key string
+		if len(step.Key) != 0 {
+			key = fmt.Sprintf("step.docker.%s", step.Key)
+		} else {
+			key = fmt.Sprintf("step.docker.%d", i)
+		}
+
+		runnerSpecs[i] = runner.Spec{
+			CommandSpec: command.Spec{
+				Key:       key,
+				Command:   nil,
+				Dir:       step.Dir,
+				Env:       step.Env,
+				Operation: r.operations.Exec,
+			},
+			Image:      step.Image,
+			ScriptPath: ws.ScriptFilenames()[i],
+		}
+	}
+
+	return runnerSpecs, nil
+}
+
+// newKubernetesClientset creates a clientset from the kubeconfig file at configPath, or
+// from the in-cluster configuration if configPath is empty.
+func newKubernetesClientset(configPath string) (kubernetes.Interface, error) {
+	var restConfig *rest.Config
+	var err error
+	if configPath != "" {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", configPath)
+	} else {
+		restConfig, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create kubernetes client config")
+	}
+
+	return kubernetes.NewForConfig(restConfig)
+}
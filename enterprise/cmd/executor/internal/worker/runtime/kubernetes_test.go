@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/runner"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestKubernetesRuntime_Name(t *testing.T) {
+	r := kubernetesRuntime{}
+	assert.Equal(t, "kubernetes", string(r.Name()))
+}
+
+func TestKubernetesRuntime_NewRunnerSpecs(t *testing.T) {
+	operations := command.NewOperations(&observation.TestContext)
+
+	tests := []struct {
+		name           string
+		steps          []types.DockerStep
+		mockFunc       func(ws *MockWorkspace)
+		expected       []runner.Spec
+		assertMockFunc func(t *testing.T, ws *MockWorkspace)
+	}{
+		{
+			name:     "No steps",
+			steps:    []types.DockerStep{},
+			expected: []runner.Spec{},
+			assertMockFunc: func(t *testing.T, ws *MockWorkspace) {
+				require.Len(t, ws.ScriptFilenamesFunc.History(), 0)
+			},
+		},
+		{
+			name: "Multiple steps",
+			steps: []types.DockerStep{
+				{
+					Key:      "key-1",
+					Image:    "my-image",
+					Commands: []string{"echo", "hello"},
+					Dir:      ".",
+					Env:      []string{"FOO=bar"},
+				},
+				{
+					Image:    "my-image",
+					Commands: []string{"echo", "hello"},
+					Dir:      "subdir",
+				},
+			},
+			mockFunc: func(ws *MockWorkspace) {
+				ws.ScriptFilenamesFunc.SetDefaultReturn([]string{"script1.sh", "script2.sh"})
+			},
+			expected: []runner.Spec{
+				{
+					CommandSpec: command.Spec{
+						Key:       "step.docker.key-1",
+						Command:   []string(nil),
+						Dir:       ".",
+						Env:       []string{"FOO=bar"},
+						Operation: operations.Exec,
+					},
+					Image:      "my-image",
+					ScriptPath: "script1.sh",
+				},
+				{
+					CommandSpec: command.Spec{
+						Key:       "step.docker.1",
+						Command:   []string(nil),
+						Dir:       "subdir",
+						Operation: operations.Exec,
+					},
+					Image:      "my-image",
+					ScriptPath: "script2.sh",
+				},
+			},
+			assertMockFunc: func(t *testing.T, ws *MockWorkspace) {
+				require.Len(t, ws.ScriptFilenamesFunc.History(), 2)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ws := NewMockWorkspace()
+
+			if test.mockFunc != nil {
+				test.mockFunc(ws)
+			}
+
+			r := &kubernetesRuntime{operations: operations}
+			actual, err := r.NewRunnerSpecs(ws, test.steps)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+
+			test.assertMockFunc(t, ws)
+		})
+	}
+}
+
+func TestKubernetesRuntime_NewRunnerSpecsKeys(t *testing.T) {
+	operations := command.NewOperations(&observation.TestContext)
+	steps := []types.DockerStep{{Key: "key-1", Image: "my-image"}, {Image: "my-image"}}
+
+	ws := NewMockWorkspace()
+	ws.ScriptFilenamesFunc.SetDefaultReturn([]string{"script1.sh", "script2.sh"})
+
+	// The log entries of steps are keyed the same way in all runtimes, so that
+	// consumers of the execution logs can tell the steps apart.
+	dockerSpecs, err := (&dockerRuntime{operations: operations}).NewRunnerSpecs(ws, steps)
+	require.NoError(t, err)
+	kubernetesSpecs, err := (&kubernetesRuntime{operations: operations}).NewRunnerSpecs(ws, steps)
+	require.NoError(t, err)
+
+	require.Len(t, kubernetesSpecs, len(dockerSpecs))
+	for i := range dockerSpecs {
+		assert.Equal(t, dockerSpecs[i].CommandSpec.Key, kubernetesSpecs[i].CommandSpec.Key)
+	}
+	assert.Equal(t, "step.docker.key-1", kubernetesSpecs[0].CommandSpec.Key)
+	assert.Equal(t, "step.docker.1", kubernetesSpecs[1].CommandSpec.Key)
+}
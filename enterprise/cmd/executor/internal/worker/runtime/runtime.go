@@ -54,6 +54,27 @@ func New(
 		}, nil
 	}
 
+	if runnerOpts.KubernetesOptions.Enabled {
+		clientset, err := newKubernetesClientset(runnerOpts.KubernetesOptions.ConfigPath)
+		if err != nil {
+			logger.Error("runtime 'kubernetes' is not supported: failed to create kubernetes client", log.Error(err))
+			return nil, err
+		}
+		logger.Info("using runtime 'kubernetes'")
+		return &kubernetesRuntime{
+			cmd: cmd,
+			kubeCmd: &command.KubernetesCommand{
+				Logger:     log.Scoped("executor-worker.kubernetes-command", "kubernetes pod execution"),
+				Clientset:  clientset,
+				Operations: ops,
+			},
+			operations:   ops,
+			filesStore:   filesStore,
+			cloneOptions: cloneOpts,
+			options:      runnerOpts.KubernetesOptions,
+		}, nil
+	}
+
 	if runnerOpts.FirecrackerOptions.Enabled {
 		// We explicitly want a Firecracker runtime. So validation must pass.
 		if err := util.ValidateFirecrackerTools(runner); err != nil {
@@ -114,4 +135,5 @@ const (
 	NameDocker      Name = "docker"
 	NameFirecracker Name = "firecracker"
 	NameShell       Name = "shell"
+	NameKubernetes  Name = "kubernetes"
 )
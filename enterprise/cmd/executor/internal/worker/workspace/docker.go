@@ -14,6 +14,7 @@ type dockerWorkspace struct {
 	path            string
 	scriptFilenames []string
 	workspaceDir    string
+	filesStore      FilesStore
 	logger          command.Logger
 }
 
@@ -33,8 +34,23 @@ func NewDockerWorkspace(
 		return nil, err
 	}
 
+	return newHostWorkspace(ctx, workspaceDir, filesStore, job, cmd, logger, cloneOpts, operations)
+}
+
+// newHostWorkspace clones the repo and puts the script files into workspaceDir, a
+// path on the host. workspaceDir is removed if that fails.
+func newHostWorkspace(
+	ctx context.Context,
+	workspaceDir string,
+	filesStore FilesStore,
+	job types.Job,
+	cmd command.Command,
+	logger command.Logger,
+	cloneOpts CloneOptions,
+	operations *command.Operations,
+) (Workspace, error) {
 	if job.RepositoryName != "" {
-		if err = cloneRepo(ctx, workspaceDir, job, cmd, logger, cloneOpts, operations); err != nil {
+		if err := cloneRepo(ctx, workspaceDir, job, cmd, logger, cloneOpts, operations); err != nil {
 			_ = os.RemoveAll(workspaceDir)
 			return nil, err
 		}
@@ -50,6 +66,7 @@ func NewDockerWorkspace(
 		path:            workspaceDir,
 		scriptFilenames: scriptPaths,
 		workspaceDir:    workspaceDir,
+		filesStore:      filesStore,
 		logger:          logger,
 	}, nil
 }
@@ -73,6 +90,10 @@ func (w dockerWorkspace) ScriptFilenames() []string {
 	return w.scriptFilenames
 }
 
+func (w dockerWorkspace) UploadArtifacts(ctx context.Context, job types.Job) error {
+	return uploadArtifacts(ctx, w.filesStore, job, w.workspaceDir, w.logger)
+}
+
 func (w dockerWorkspace) Remove(ctx context.Context, keepWorkspace bool) {
 	handle := w.logger.LogEntry("teardown.fs", nil)
 	defer func() {
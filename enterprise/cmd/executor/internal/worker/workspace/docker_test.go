@@ -497,6 +497,52 @@ func TestNewDockerWorkspace(t *testing.T) {
 	}
 }
 
+func TestDockerWorkspace_UploadArtifacts(t *testing.T) {
+	operations := command.NewOperations(&observation.TestContext)
+
+	filesStore := workspace.NewMockFilesStore()
+	uploaded := map[string]string{}
+	filesStore.UploadFunc.SetDefaultHook(func(_ context.Context, _ types.Job, bucket string, key string, content io.Reader) error {
+		b, err := io.ReadAll(content)
+		if err != nil {
+			return err
+		}
+		uploaded[bucket+"/"+key] = string(b)
+		return nil
+	})
+	logger := workspace.NewMockLogger()
+	logger.LogEntryFunc.SetDefaultReturn(workspace.NewMockLogEntry())
+
+	job := types.Job{
+		ID:                 42,
+		ArtifactsDirectory: "artifacts",
+		ArtifactsBucket:    "my-artifacts",
+	}
+	ws, err := workspace.NewDockerWorkspace(context.Background(), filesStore, job, workspace.NewMockCommand(), logger, workspace.CloneOptions{}, operations)
+	require.NoError(t, err)
+	t.Cleanup(func() { ws.Remove(context.Background(), false) })
+
+	// No artifacts directory.
+	require.NoError(t, ws.UploadArtifacts(context.Background(), job))
+	assert.Empty(t, uploaded)
+
+	secret := path.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secret, []byte("hunter2"), os.ModePerm))
+
+	dir := path.Join(ws.Path(), "artifacts")
+	require.NoError(t, os.MkdirAll(path.Join(dir, "subdir"), os.ModePerm))
+	require.NoError(t, os.WriteFile(path.Join(dir, "abc"), []byte("hello"), os.ModePerm))
+	require.NoError(t, os.Symlink(secret, path.Join(dir, "def")))
+
+	require.NoError(t, ws.UploadArtifacts(context.Background(), job))
+	assert.Equal(t, map[string]string{"my-artifacts/abc": "hello"}, uploaded)
+
+	// Symlinked artifacts directory.
+	require.NoError(t, os.RemoveAll(dir))
+	require.NoError(t, os.Symlink(path.Dir(secret), dir))
+	assert.EqualError(t, ws.UploadArtifacts(context.Background(), job), `artifacts directory "artifacts" is not a directory`)
+}
+
 var expectedGitEnv = []string{"GIT_TERMINAL_PROMPT=0", "GIT_LFS_SKIP_SMUDGE=1"}
 
 func toDockerStepScript(commands ...string) string {
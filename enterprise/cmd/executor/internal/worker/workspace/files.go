@@ -21,6 +21,8 @@ type FilesStore interface {
 	Exists(ctx context.Context, job types.Job, bucket string, key string) (bool, error)
 	// Get retrieves the file.
 	Get(ctx context.Context, job types.Job, bucket string, key string) (io.ReadCloser, error)
+	// Upload uploads the file.
+	Upload(ctx context.Context, job types.Job, bucket string, key string, content io.Reader) error
 }
 
 func prepareScripts(
@@ -186,3 +188,74 @@ func writeFiles(ctx context.Context, store FilesStore, job types.Job, workspaceF
 
 	return nil
 }
+
+// uploadArtifacts uploads the files in the artifacts directory of the job to the
+// artifacts bucket of the files store, keyed by their file names.
+func uploadArtifacts(ctx context.Context, store FilesStore, job types.Job, workspaceDir string, logger command.Logger) (err error) {
+	if job.ArtifactsDirectory == "" {
+		return nil
+	}
+
+	dir, err := filepath.Abs(filepath.Join(workspaceDir, job.ArtifactsDirectory))
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(dir, workspaceDir) {
+		return errors.Errorf("refusing to read outside of working directory")
+	}
+
+	// 🚨 SECURITY: The directory and its files are written by the job, so we don't
+	// follow symlinks, which could point to files on the host.
+	fi, err := os.Lstat(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	if !fi.IsDir() {
+		return errors.Errorf("artifacts directory %q is not a directory", job.ArtifactsDirectory)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	handle := logger.LogEntry("teardown.artifacts", nil)
+	defer func() {
+		if err == nil {
+			handle.Finalize(0)
+		} else {
+			handle.Finalize(1)
+		}
+
+		_ = handle.Close()
+	}()
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		start := time.Now()
+		if err := uploadFile(ctx, store, job, filepath.Join(dir, entry.Name()), entry.Name()); err != nil {
+			return errors.Wrapf(err, "failed to upload artifact %s", entry.Name())
+		}
+		handle.Write([]byte(fmt.Sprintf("Uploaded %s in %s\n", entry.Name(), time.Since(start))))
+	}
+
+	return nil
+}
+
+func uploadFile(ctx context.Context, store FilesStore, job types.Job, path string, key string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return store.Upload(ctx, job, job.ArtifactsBucket, key, f)
+}
@@ -22,6 +22,7 @@ type firecrackerWorkspace struct {
 	scriptFilenames []string
 	blockDeviceFile string
 	blockDevice     string
+	filesStore      FilesStore
 	logger          command.Logger
 }
 
@@ -81,6 +82,7 @@ func NewFirecrackerWorkspace(
 		scriptFilenames: scriptPaths,
 		blockDeviceFile: blockDeviceFile,
 		blockDevice:     blockDevice,
+		filesStore:      filesStore,
 		logger:          logger,
 	}, err
 }
@@ -192,6 +194,40 @@ func (w firecrackerWorkspace) ScriptFilenames() []string {
 	return w.scriptFilenames
 }
 
+func (w firecrackerWorkspace) UploadArtifacts(ctx context.Context, job types.Job) (err error) {
+	if job.ArtifactsDirectory == "" {
+		return nil
+	}
+
+	// The VM is torn down at this point, so we can mount the workspace volume on
+	// the host again to read the artifacts from it.
+	handle := w.logger.LogEntry("teardown.fs.mount", nil)
+	defer func() {
+		if err == nil {
+			handle.Finalize(0)
+		} else {
+			handle.Finalize(1)
+		}
+		handle.Close()
+	}()
+
+	mountDir, err := mountLoopDevice(ctx, w.cmdRunner, w.blockDevice, handle)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := syscall.Unmount(mountDir, 0); err2 != nil {
+			err = errors.Append(err, err2)
+			return
+		}
+		if err2 := os.RemoveAll(mountDir); err2 != nil {
+			err = errors.Append(err, err2)
+		}
+	}()
+
+	return uploadArtifacts(ctx, w.filesStore, job, mountDir, w.logger)
+}
+
 func (w firecrackerWorkspace) Remove(ctx context.Context, keepWorkspace bool) {
 	handle := w.logger.LogEntry("teardown.fs", nil)
 	defer func() {
@@ -0,0 +1,35 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/executor/internal/worker/command"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewKubernetesWorkspace creates a new workspace for Kubernetes-based execution. The
+// workspace is set up below mountPath, where the persistent volume that is shared with
+// the pods running the steps of the job is mounted.
+func NewKubernetesWorkspace(
+	ctx context.Context,
+	filesStore FilesStore,
+	job types.Job,
+	cmd command.Command,
+	logger command.Logger,
+	cloneOpts CloneOptions,
+	mountPath string,
+	operations *command.Operations,
+) (Workspace, error) {
+	if err := os.MkdirAll(mountPath, os.ModePerm); err != nil {
+		return nil, errors.Wrap(err, "creating volume mount path")
+	}
+	workspaceDir, err := os.MkdirTemp(mountPath, "workspace-"+strconv.Itoa(job.ID)+"-*")
+	if err != nil {
+		return nil, err
+	}
+
+	return newHostWorkspace(ctx, workspaceDir, filesStore, job, cmd, logger, cloneOpts, operations)
+}
@@ -1,6 +1,10 @@
 package workspace
 
-import "context"
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/executor/types"
+)
 
 // CloneOptions holds the options for cloning a workspace.
 type CloneOptions struct {
@@ -17,6 +21,10 @@ type Workspace interface {
 	Path() string
 	// ScriptFilenames holds the ordered set of script filenames to be invoked.
 	ScriptFilenames() []string
+	// UploadArtifacts uploads the files in the artifacts directory of the job to
+	// the files store. It must only be called once no commands run in the
+	// workspace anymore.
+	UploadArtifacts(ctx context.Context, job types.Job) error
 	// Remove cleans up the workspace post execution. If keep workspace is true,
 	// the implementation will only clean up additional resources, while keeping
 	// the workspace contents on disk for debugging purposes.
@@ -0,0 +1,218 @@
+package ldap
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+var (
+	// errInvalidCredentials is returned when no user matches the given username, or when the
+	// password of the user is wrong. Both cases are reported the same way to avoid revealing
+	// which usernames exist.
+	errInvalidCredentials = errors.New("invalid username or password")
+
+	// errNotAllowed is returned when the user does not match the group filter of the provider.
+	errNotAllowed = errors.New("user is not allowed to sign in")
+
+	// errMissingAccountID is returned when the entry of the user has no value for the account ID
+	// attribute of the provider.
+	errMissingAccountID = errors.New("missing account ID attribute")
+)
+
+// connectionTimeout is the maximum duration of any single operation against the LDAP server.
+const connectionTimeout = 10 * time.Second
+
+// userInfo is the information about a user read from their directory entry.
+type userInfo struct {
+	dn          string
+	accountID   string
+	username    string
+	email       string
+	displayName string
+}
+
+// authenticate verifies the given credentials against the LDAP server of the provider and
+// returns the information about the user from their directory entry.
+//
+// It first looks up the entry of the user with userSearchFilter, bound as the configured service
+// account (or anonymously), and then binds as that entry with the given password. If a group
+// filter is configured, the entry must additionally match it.
+//
+// 🚨 SECURITY: The returned error is errInvalidCredentials or errNotAllowed if the user must not be
+// signed in. Other errors indicate a problem talking to the LDAP server.
+func authenticate(pc *schema.LDAPAuthProvider, username, password string) (*userInfo, error) {
+	// 🚨 SECURITY: Many LDAP servers treat a simple bind with an empty password as an
+	// unauthenticated bind that succeeds regardless of the DN, so we must never attempt one.
+	if username == "" || password == "" {
+		return nil, errInvalidCredentials
+	}
+
+	conn, err := dial(pc)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := bindServiceAccount(conn, pc); err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: The username must be escaped to prevent LDAP filter injection.
+	filter := strings.ReplaceAll(pc.UserSearchFilter, usernamePlaceholder, goldap.EscapeFilter(username))
+	result, err := conn.Search(goldap.NewSearchRequest(
+		pc.UserSearchBaseDN,
+		goldap.ScopeWholeSubtree,
+		goldap.NeverDerefAliases,
+		0,
+		int(connectionTimeout/time.Second),
+		false,
+		filter,
+		userAttributes(pc),
+		nil,
+	))
+	if err != nil {
+		return nil, errors.Wrap(err, "searching for user")
+	}
+	switch len(result.Entries) {
+	case 0:
+		return nil, errInvalidCredentials
+	case 1:
+	default:
+		return nil, errors.Errorf("userSearchFilter matched %d entries for a single username", len(result.Entries))
+	}
+	entry := result.Entries[0]
+
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, errInvalidCredentials
+		}
+		return nil, errors.Wrap(err, "binding as user")
+	}
+
+	if pc.GroupFilter != "" {
+		// Check group membership as the service account, because users are not necessarily
+		// allowed to read their own group memberships.
+		if err := bindServiceAccount(conn, pc); err != nil {
+			return nil, err
+		}
+
+		result, err := conn.Search(goldap.NewSearchRequest(
+			entry.DN,
+			goldap.ScopeBaseObject,
+			goldap.NeverDerefAliases,
+			1,
+			int(connectionTimeout/time.Second),
+			false,
+			pc.GroupFilter,
+			[]string{"1.1"}, // no attributes
+			nil,
+		))
+		if err != nil {
+			return nil, errors.Wrap(err, "checking group filter")
+		}
+		if len(result.Entries) == 0 {
+			return nil, errNotAllowed
+		}
+	}
+
+	accountID := entry.DN
+	if pc.AccountIDAttribute != "" {
+		accountID = encodeAccountID(entry.GetRawAttributeValue(pc.AccountIDAttribute))
+		if accountID == "" {
+			return nil, errors.Wrapf(errMissingAccountID, "no %q attribute in entry %q", pc.AccountIDAttribute, entry.DN)
+		}
+	}
+
+	info := &userInfo{
+		dn:          entry.DN,
+		accountID:   accountID,
+		username:    entry.GetAttributeValue(pc.UsernameAttribute),
+		email:       entry.GetAttributeValue(pc.EmailAttribute),
+		displayName: entry.GetAttributeValue(pc.DisplayNameAttribute),
+	}
+	if info.username == "" {
+		info.username = username
+	}
+	return info, nil
+}
+
+// userAttributes returns the attributes of the user entry read by authenticate.
+func userAttributes(pc *schema.LDAPAuthProvider) []string {
+	attributes := []string{pc.UsernameAttribute, pc.EmailAttribute, pc.DisplayNameAttribute}
+	if pc.AccountIDAttribute != "" {
+		attributes = append(attributes, pc.AccountIDAttribute)
+	}
+	return attributes
+}
+
+// encodeAccountID returns the account ID for the given value of the account ID attribute. Binary
+// values, such as the objectGUID of Active Directory, are hex-encoded.
+func encodeAccountID(value []byte) string {
+	if utf8.Valid(value) {
+		return string(value)
+	}
+	return hex.EncodeToString(value)
+}
+
+// dial connects to the LDAP server of the provider, upgrading the connection to TLS if StartTLS
+// is enabled.
+func dial(pc *schema.LDAPAuthProvider) (*goldap.Conn, error) {
+	u, err := url.Parse(pc.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing url")
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: pc.InsecureSkipVerify,
+	}
+	if pc.Certificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(pc.Certificate)) {
+			return nil, errors.New("invalid certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	conn, err := goldap.DialURL(
+		pc.Url,
+		goldap.DialWithDialer(&net.Dialer{Timeout: connectionTimeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "connecting to LDAP server")
+	}
+	conn.SetTimeout(connectionTimeout)
+
+	if pc.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrap(err, "starting TLS")
+		}
+	}
+
+	return conn, nil
+}
+
+// bindServiceAccount binds the connection as the service account of the provider. Without a
+// service account, the connection is bound anonymously.
+func bindServiceAccount(conn *goldap.Conn, pc *schema.LDAPAuthProvider) error {
+	var err error
+	if pc.BindDN == "" {
+		err = conn.UnauthenticatedBind("")
+	} else {
+		err = conn.Bind(pc.BindDN, pc.BindPassword)
+	}
+	return errors.Wrap(err, "binding as service account")
+}
@@ -0,0 +1,334 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const (
+	testServiceDN       = "cn=admin,dc=example,dc=com"
+	testPeopleDN        = "ou=people,dc=example,dc=com"
+	testAliceDN         = "uid=alice,ou=people,dc=example,dc=com"
+	testBobDN           = "uid=bob,ou=people,dc=example,dc=com"
+	testEngineersDN     = "cn=engineering,ou=groups,dc=example,dc=com"
+	testAlicePassword   = "alice-secret"
+	testServicePassword = "admin-secret"
+	testAliceEntryUUID  = "9b5c2a1e-6f0e-4d3a-8c1b-2f4e7d9a0b3c"
+)
+
+var testEntries = []ldapEntry{
+	{
+		dn:       testServiceDN,
+		password: testServicePassword,
+		attrs:    map[string][]string{"cn": {"admin"}},
+	},
+	{
+		dn:       testAliceDN,
+		password: testAlicePassword,
+		attrs: map[string][]string{
+			"uid":            {"alice"},
+			"sAMAccountName": {"asmith"},
+			"mail":           {"alice@example.com"},
+			"cn":             {"Alice Smith"},
+			"displayName":    {"Alice S."},
+			"memberOf":       {testEngineersDN},
+			"entryUUID":      {testAliceEntryUUID},
+			"objectGUID":     {"\x1e\x2a\x5c\x9b\x0e\x6f\x3a\x4d\x8c\x1b\x2f\x4e\x7d\x9a\x0b\xfc"},
+		},
+	},
+	{
+		dn:       testBobDN,
+		password: "bob-secret",
+		attrs: map[string][]string{
+			"uid":      {"bob"},
+			"mail":     {"bob@example.com"},
+			"cn":       {"Bob Jones"},
+			"memberOf": {"cn=sales,ou=groups,dc=example,dc=com"},
+		},
+	},
+}
+
+func TestAuthenticate(t *testing.T) {
+	server := newLDAPServer(t, modePlain, testEntries...)
+
+	newConfig := func(modify func(*schema.LDAPAuthProvider)) *schema.LDAPAuthProvider {
+		pc := &schema.LDAPAuthProvider{
+			Type:             providerType,
+			Url:              server.url,
+			BindDN:           testServiceDN,
+			BindPassword:     testServicePassword,
+			UserSearchBaseDN: testPeopleDN,
+		}
+		if modify != nil {
+			modify(pc)
+		}
+		return withConfigDefaults(pc)
+	}
+
+	testCases := []struct {
+		name     string
+		config   *schema.LDAPAuthProvider
+		username string
+		password string
+		wantInfo *userInfo
+		wantErr  error
+	}{
+		{
+			name:     "valid credentials",
+			config:   newConfig(nil),
+			username: "alice",
+			password: testAlicePassword,
+			wantInfo: &userInfo{dn: testAliceDN, accountID: testAliceDN, username: "alice", email: "alice@example.com", displayName: "Alice Smith"},
+		},
+		{
+			name: "attribute mapping",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.UserSearchFilter = "(sAMAccountName={username})"
+				pc.UsernameAttribute = "sAMAccountName"
+				pc.DisplayNameAttribute = "displayName"
+			}),
+			username: "asmith",
+			password: testAlicePassword,
+			wantInfo: &userInfo{dn: testAliceDN, accountID: testAliceDN, username: "asmith", email: "alice@example.com", displayName: "Alice S."},
+		},
+		{
+			name: "account ID attribute",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.AccountIDAttribute = "entryUUID"
+			}),
+			username: "alice",
+			password: testAlicePassword,
+			wantInfo: &userInfo{dn: testAliceDN, accountID: testAliceEntryUUID, username: "alice", email: "alice@example.com", displayName: "Alice Smith"},
+		},
+		{
+			name: "binary account ID attribute",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.AccountIDAttribute = "objectGUID"
+			}),
+			username: "alice",
+			password: testAlicePassword,
+			wantInfo: &userInfo{dn: testAliceDN, accountID: "1e2a5c9b0e6f3a4d8c1b2f4e7d9a0bfc", username: "alice", email: "alice@example.com", displayName: "Alice Smith"},
+		},
+		{
+			name: "missing account ID attribute",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.AccountIDAttribute = "nsUniqueId"
+			}),
+			username: "alice",
+			password: testAlicePassword,
+			wantErr:  errMissingAccountID,
+		},
+		{
+			name:     "wrong password",
+			config:   newConfig(nil),
+			username: "alice",
+			password: "wrong",
+			wantErr:  errInvalidCredentials,
+		},
+		{
+			name:     "unknown user",
+			config:   newConfig(nil),
+			username: "carol",
+			password: testAlicePassword,
+			wantErr:  errInvalidCredentials,
+		},
+		{
+			name:     "empty password",
+			config:   newConfig(nil),
+			username: "alice",
+			password: "",
+			wantErr:  errInvalidCredentials,
+		},
+		{
+			name:     "filter injection",
+			config:   newConfig(nil),
+			username: "*",
+			password: testAlicePassword,
+			wantErr:  errInvalidCredentials,
+		},
+		{
+			name: "member of allowed group",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.GroupFilter = "(memberOf=" + testEngineersDN + ")"
+			}),
+			username: "alice",
+			password: testAlicePassword,
+			wantInfo: &userInfo{dn: testAliceDN, accountID: testAliceDN, username: "alice", email: "alice@example.com", displayName: "Alice Smith"},
+		},
+		{
+			name: "not member of allowed group",
+			config: newConfig(func(pc *schema.LDAPAuthProvider) {
+				pc.GroupFilter = "(memberOf=" + testEngineersDN + ")"
+			}),
+			username: "bob",
+			password: "bob-secret",
+			wantErr:  errNotAllowed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			info, err := authenticate(testCase.config, testCase.username, testCase.password)
+			if testCase.wantErr != nil {
+				if !errors.Is(err, testCase.wantErr) {
+					t.Fatalf("unexpected error. want=%q have=%v", testCase.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(testCase.wantInfo, info, cmp.AllowUnexported(userInfo{})); diff != "" {
+				t.Errorf("unexpected user info (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAuthenticateBinds(t *testing.T) {
+	server := newLDAPServer(t, modePlain, testEntries...)
+	pc := withConfigDefaults(&schema.LDAPAuthProvider{
+		Type:             providerType,
+		Url:              server.url,
+		BindDN:           testServiceDN,
+		BindPassword:     testServicePassword,
+		UserSearchBaseDN: testPeopleDN,
+		GroupFilter:      "(memberOf=" + testEngineersDN + ")",
+	})
+
+	if _, err := authenticate(pc, "alice", testAlicePassword); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The group filter is checked as the service account after binding as the user.
+	if diff := cmp.Diff([]string{testServiceDN, testAliceDN, testServiceDN}, server.bindDNs()); diff != "" {
+		t.Errorf("unexpected binds (-want +got):\n%s", diff)
+	}
+
+	// No bind is attempted without a password.
+	if _, err := authenticate(pc, "alice", ""); !errors.Is(err, errInvalidCredentials) {
+		t.Fatalf("unexpected error. want=%q have=%v", errInvalidCredentials, err)
+	}
+	if n := len(server.bindDNs()); n != 3 {
+		t.Errorf("unexpected number of binds. want=%d have=%d", 3, n)
+	}
+}
+
+func TestAuthenticateServiceAccountError(t *testing.T) {
+	server := newLDAPServer(t, modePlain, testEntries...)
+
+	testCases := map[string]*schema.LDAPAuthProvider{
+		"wrong service account password": {
+			Url:          server.url,
+			BindDN:       testServiceDN,
+			BindPassword: "wrong",
+		},
+		"anonymous search refused": {
+			Url: server.url,
+		},
+	}
+
+	for name, pc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			pc.Type = providerType
+			pc.UserSearchBaseDN = testPeopleDN
+
+			_, err := authenticate(withConfigDefaults(pc), "alice", testAlicePassword)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			// A misconfigured service account must not be reported as a user error.
+			if errors.Is(err, errInvalidCredentials) || errors.Is(err, errNotAllowed) {
+				t.Errorf("unexpected user error: %s", err)
+			}
+		})
+	}
+}
+
+func TestAuthenticateTLS(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mode    ldapServerMode
+		modify  func(pc *schema.LDAPAuthProvider, server *ldapServer)
+		wantErr bool
+	}{
+		{
+			name: "ldaps",
+			mode: modeLDAPS,
+			modify: func(pc *schema.LDAPAuthProvider, server *ldapServer) {
+				pc.Certificate = server.certificate
+			},
+		},
+		{
+			name:    "ldaps with untrusted certificate",
+			mode:    modeLDAPS,
+			wantErr: true,
+		},
+		{
+			name: "ldaps skipping verification",
+			mode: modeLDAPS,
+			modify: func(pc *schema.LDAPAuthProvider, server *ldapServer) {
+				pc.InsecureSkipVerify = true
+			},
+		},
+		{
+			name: "StartTLS",
+			mode: modePlain,
+			modify: func(pc *schema.LDAPAuthProvider, server *ldapServer) {
+				pc.StartTLS = true
+				pc.Certificate = server.certificate
+			},
+		},
+		{
+			name: "StartTLS with untrusted certificate",
+			mode: modePlain,
+			modify: func(pc *schema.LDAPAuthProvider, server *ldapServer) {
+				pc.StartTLS = true
+			},
+			wantErr: true,
+		},
+		{
+			name: "StartTLS not supported by server",
+			mode: modeRejectTLS,
+			modify: func(pc *schema.LDAPAuthProvider, server *ldapServer) {
+				pc.StartTLS = true
+				pc.Certificate = server.certificate
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := newLDAPServer(t, testCase.mode, testEntries...)
+			pc := &schema.LDAPAuthProvider{
+				Type:             providerType,
+				Url:              server.url,
+				BindDN:           testServiceDN,
+				BindPassword:     testServicePassword,
+				UserSearchBaseDN: testPeopleDN,
+			}
+			if testCase.modify != nil {
+				testCase.modify(pc, server)
+			}
+
+			info, err := authenticate(withConfigDefaults(pc), "alice", testAlicePassword)
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if info.dn != testAliceDN {
+				t.Errorf("unexpected dn. want=%q have=%q", testAliceDN, info.dn)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package ldap
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const pkgName = "ldap"
+
+func Init() {
+	conf.ContributeValidator(func(cfg conftypes.SiteConfigQuerier) conf.Problems {
+		_, problems := parseConfig(cfg)
+		return problems
+	})
+	conf.ContributeWarning(configWarnings)
+
+	logger := log.Scoped(pkgName, "LDAP config watch")
+	go func() {
+		conf.Watch(func() {
+			ps, _ := parseConfig(conf.Get())
+			if len(ps) == 0 {
+				providers.Update(pkgName, nil)
+				return
+			}
+
+			if err := licensing.Check(licensing.FeatureSSO); err != nil {
+				logger.Error("Check license for SSO (LDAP)", log.Error(err))
+				providers.Update(pkgName, nil)
+				return
+			}
+			providers.Update(pkgName, ps)
+		})
+	}()
+}
+
+// parseConfig returns a provider for each LDAP auth provider in site config, along with the
+// problems found in their configuration. Providers whose URL is already used by a previous
+// provider are dropped.
+func parseConfig(cfg conftypes.SiteConfigQuerier) (ps []providers.Provider, problems conf.Problems) {
+	seen := make(map[string]struct{})
+	for _, pr := range cfg.SiteConfig().AuthProviders {
+		if pr.Ldap == nil {
+			continue
+		}
+
+		if _, ok := seen[pr.Ldap.Url]; ok {
+			problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("Cannot have more than one LDAP auth provider with url %q", pr.Ldap.Url)))
+			continue
+		}
+		seen[pr.Ldap.Url] = struct{}{}
+
+		problems = append(problems, validateProvider(pr.Ldap)...)
+		ps = append(ps, &provider{config: *withConfigDefaults(pr.Ldap)})
+	}
+
+	return ps, problems
+}
+
+func validateProvider(pc *schema.LDAPAuthProvider) (problems conf.Problems) {
+	if pc.StartTLS && strings.HasPrefix(pc.Url, "ldaps://") {
+		problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("LDAP auth provider with url %q: startTLS cannot be used with the ldaps scheme", pc.Url)))
+	}
+	if pc.Certificate != "" && !x509.NewCertPool().AppendCertsFromPEM([]byte(pc.Certificate)) {
+		problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("LDAP auth provider with url %q: certificate is not a valid PEM-encoded certificate", pc.Url)))
+	}
+	if pc.BindDN != "" && pc.BindPassword == "" {
+		problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("LDAP auth provider with url %q: bindPassword must be set when bindDN is set", pc.Url)))
+	}
+	if pc.UserSearchFilter != "" && !strings.Contains(pc.UserSearchFilter, usernamePlaceholder) {
+		problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("LDAP auth provider with url %q: userSearchFilter must contain the %s placeholder", pc.Url, usernamePlaceholder)))
+	}
+	return problems
+}
+
+// configWarnings returns warnings about LDAP auth providers that work, but are insecure.
+func configWarnings(cfg conftypes.SiteConfigQuerier) (problems conf.Problems) {
+	for _, pr := range cfg.SiteConfig().AuthProviders {
+		if pr.Ldap == nil {
+			continue
+		}
+		if strings.HasPrefix(pr.Ldap.Url, "ldap://") && !pr.Ldap.StartTLS {
+			problems = append(problems, conf.NewSiteProblem(fmt.Sprintf("LDAP auth provider with url %q sends passwords in plain text. Use the ldaps scheme or enable startTLS.", pr.Ldap.Url)))
+		}
+	}
+	return problems
+}
+
+// usernamePlaceholder is replaced with the escaped username in userSearchFilter.
+const usernamePlaceholder = "{username}"
+
+func withConfigDefaults(pc *schema.LDAPAuthProvider) *schema.LDAPAuthProvider {
+	tmp := *pc
+	if tmp.UserSearchFilter == "" {
+		tmp.UserSearchFilter = "(uid=" + usernamePlaceholder + ")"
+	}
+	if tmp.UsernameAttribute == "" {
+		tmp.UsernameAttribute = "uid"
+	}
+	if tmp.EmailAttribute == "" {
+		tmp.EmailAttribute = "mail"
+	}
+	if tmp.DisplayNameAttribute == "" {
+		tmp.DisplayNameAttribute = "cn"
+	}
+	return &tmp
+}
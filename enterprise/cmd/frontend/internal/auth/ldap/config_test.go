@@ -0,0 +1,140 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestParseConfig(t *testing.T) {
+	testCases := map[string]struct {
+		providers    []schema.AuthProviders
+		wantIDs      []string
+		wantProblems []string
+	}{
+		"no configs": {},
+		"single config": {
+			providers: []schema.AuthProviders{
+				{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://ldap.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+			},
+			wantIDs: []string{"ldap://ldap.example.com"},
+		},
+		"duplicate url": {
+			providers: []schema.AuthProviders{
+				{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://ldap.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+				{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://ldap.example.com", UserSearchBaseDN: "dc=example,dc=org"}},
+			},
+			wantIDs:      []string{"ldap://ldap.example.com"},
+			wantProblems: []string{`Cannot have more than one LDAP auth provider with url "ldap://ldap.example.com"`},
+		},
+		"different urls": {
+			providers: []schema.AuthProviders{
+				{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://ldap.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+				{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldaps://ad.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+			},
+			wantIDs: []string{"ldap://ldap.example.com", "ldaps://ad.example.com"},
+		},
+		"invalid settings": {
+			providers: []schema.AuthProviders{
+				{Ldap: &schema.LDAPAuthProvider{
+					Type:             providerType,
+					Url:              "ldaps://ad.example.com",
+					StartTLS:         true,
+					Certificate:      "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----\n",
+					BindDN:           "cn=admin,dc=example,dc=com",
+					UserSearchBaseDN: "dc=example,dc=com",
+					UserSearchFilter: "(uid=alice)",
+				}},
+			},
+			wantIDs: []string{"ldaps://ad.example.com"},
+			wantProblems: []string{
+				`LDAP auth provider with url "ldaps://ad.example.com": startTLS cannot be used with the ldaps scheme`,
+				`LDAP auth provider with url "ldaps://ad.example.com": certificate is not a valid PEM-encoded certificate`,
+				`LDAP auth provider with url "ldaps://ad.example.com": bindPassword must be set when bindDN is set`,
+				`LDAP auth provider with url "ldaps://ad.example.com": userSearchFilter must contain the {username} placeholder`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ps, problems := parseConfig(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				AuthProviders: testCase.providers,
+			}})
+
+			var ids []string
+			for _, p := range ps {
+				ids = append(ids, p.ConfigID().ID)
+			}
+			if diff := cmp.Diff(testCase.wantIDs, ids); diff != "" {
+				t.Errorf("unexpected providers (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(testCase.wantProblems, problems.Messages()); diff != "" {
+				t.Errorf("unexpected problems (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithConfigDefaults(t *testing.T) {
+	pc := withConfigDefaults(&schema.LDAPAuthProvider{
+		Type:              providerType,
+		Url:               "ldaps://ad.example.com",
+		UserSearchBaseDN:  "dc=example,dc=com",
+		UsernameAttribute: "sAMAccountName",
+	})
+
+	want := &schema.LDAPAuthProvider{
+		Type:                 providerType,
+		Url:                  "ldaps://ad.example.com",
+		UserSearchBaseDN:     "dc=example,dc=com",
+		UserSearchFilter:     "(uid={username})",
+		UsernameAttribute:    "sAMAccountName",
+		EmailAttribute:       "mail",
+		DisplayNameAttribute: "cn",
+	}
+	if diff := cmp.Diff(want, pc); diff != "" {
+		t.Errorf("unexpected config (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetProvider(t *testing.T) {
+	a := &provider{config: schema.LDAPAuthProvider{Url: "ldap://a.example.com"}}
+	b := &provider{config: schema.LDAPAuthProvider{Url: "ldap://b.example.com"}}
+
+	providers.MockProviders = []providers.Provider{a}
+	defer func() { providers.MockProviders = nil }()
+
+	// The only LDAP provider is used regardless of the ID.
+	if p := getProvider(""); p != a {
+		t.Errorf("expected single provider to be returned")
+	}
+
+	providers.MockProviders = []providers.Provider{a, b}
+	if p := getProvider("ldap://b.example.com"); p != b {
+		t.Errorf("expected provider with matching ID to be returned")
+	}
+	if p := getProvider(""); p != nil {
+		t.Errorf("expected no provider to be returned with multiple providers and no ID")
+	}
+}
+
+func TestConfigWarnings(t *testing.T) {
+	warnings := configWarnings(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		AuthProviders: []schema.AuthProviders{
+			{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://plain.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+			{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldap://starttls.example.com", StartTLS: true, UserSearchBaseDN: "dc=example,dc=com"}},
+			{Ldap: &schema.LDAPAuthProvider{Type: providerType, Url: "ldaps://ldaps.example.com", UserSearchBaseDN: "dc=example,dc=com"}},
+			{Builtin: &schema.BuiltinAuthProvider{Type: "builtin"}},
+		},
+	}})
+
+	want := []string{`LDAP auth provider with url "ldap://plain.example.com" sends passwords in plain text. Use the ldaps scheme or enable startTLS.`}
+	if diff := cmp.Diff(want, warnings.Messages()); diff != "" {
+		t.Errorf("unexpected warnings (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,3 @@
+// Package ldap implements an authentication provider that signs in users with their username and
+// password by binding to an LDAP directory server, such as OpenLDAP or Active Directory.
+package ldap
@@ -0,0 +1,237 @@
+package ldap
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/session"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/userpasswd"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const authPrefix = auth.AuthURLPrefix + "/ldap"
+
+// Middleware handles the sign-in endpoints of the LDAP auth providers. Unlike redirect-based SSO
+// providers, LDAP needs the password of the user, so the users sign in with a form served by the
+// app. API requests are passed through unchanged.
+//
+// Failed sign-in attempts count towards the same account lockout as the builtin auth provider.
+func Middleware(db database.DB) *auth.Middleware {
+	return middleware(db, userpasswd.NewLockoutStoreFromConf(conf.AuthLockout()))
+}
+
+func middleware(db database.DB, lockoutStore userpasswd.LockoutStore) *auth.Middleware {
+	logger := log.Scoped(pkgName, "LDAP authentication middleware")
+	return &auth.Middleware{
+		API: func(next http.Handler) http.Handler {
+			return next
+		},
+		App: func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !strings.HasPrefix(r.URL.Path, authPrefix+"/") {
+					next.ServeHTTP(w, r)
+					return
+				}
+				loginHandler(logger, db, lockoutStore, w, r)
+			})
+		},
+	}
+}
+
+// getProvider looks up the registered LDAP auth provider with the given ID. If there is only a
+// single LDAP auth provider, it is returned regardless of the ID.
+func getProvider(pcID string) *provider {
+	p, _ := providers.GetProviderByConfigID(providers.ConfigID{Type: providerType, ID: pcID}).(*provider)
+	if p != nil {
+		return p
+	}
+
+	for _, ap := range providers.Providers() {
+		if ap.Config().Ldap != nil {
+			if p != nil {
+				return nil // multiple LDAP providers, can't use this special case
+			}
+			p = ap.(*provider)
+		}
+	}
+
+	return p
+}
+
+func loginHandler(logger log.Logger, db database.DB, lockoutStore userpasswd.LockoutStore, w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != authPrefix+"/login" {
+		http.Error(w, "", http.StatusNotFound)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "", http.StatusBadRequest)
+		return
+	}
+	form := loginForm{
+		ProviderID: r.Form.Get("pc"),
+		ReturnTo:   auth.SafeRedirectURL(r.Form.Get("returnTo")),
+	}
+
+	p := getProvider(form.ProviderID)
+	if p == nil {
+		logger.Error("No LDAP auth provider found with ID", log.String("id", form.ProviderID))
+		http.Error(w, "Misconfigured LDAP auth provider", http.StatusInternalServerError)
+		return
+	}
+	form.ProviderID = p.config.Url
+	form.DisplayName = p.CachedInfo().DisplayName
+
+	switch r.Method {
+	case http.MethodGet:
+		form.render(w, http.StatusOK)
+		return
+	case http.MethodPost:
+	default:
+		http.Error(w, "", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// 🚨 SECURITY: The form must have been submitted from a page of this instance, otherwise
+	// another site could sign its visitors in as a user of its choice.
+	if !isSameOrigin(r) {
+		http.Error(w, "Cross-origin sign-in requests are not allowed.", http.StatusForbidden)
+		return
+	}
+
+	form.Username = r.PostForm.Get("username")
+
+	// 🚨 SECURITY: Failed attempts are counted against the Sourcegraph user with the same
+	// username, so that guessing the password of an existing user locks them out just like
+	// with the builtin auth provider.
+	var userID int32
+	if username, err := auth.NormalizeUsername(form.Username); err == nil {
+		if user, err := db.Users().GetByUsername(r.Context(), username); err == nil {
+			userID = user.ID
+		}
+	}
+	if userID != 0 {
+		if reason, locked := lockoutStore.IsLockedOut(userID); locked {
+			form.Error = fmt.Sprintf("Account has been locked out due to %q.", reason)
+			form.render(w, http.StatusUnprocessableEntity)
+			return
+		}
+	}
+
+	info, err := authenticate(&p.config, form.Username, r.PostForm.Get("password"))
+	switch {
+	case errors.Is(err, errInvalidCredentials):
+		if userID != 0 {
+			lockoutStore.IncreaseFailedAttempt(userID)
+		}
+		form.Error = "Invalid username or password."
+		form.render(w, http.StatusUnauthorized)
+		return
+	case errors.Is(err, errNotAllowed):
+		logger.Warn("LDAP-authenticated user does not match the group filter", log.String("username", form.Username))
+		form.Error = "You are not allowed to sign in. Ask a site admin for access."
+		form.render(w, http.StatusForbidden)
+		return
+	case err != nil:
+		logger.Error("Error authenticating LDAP user", log.String("url", p.config.Url), log.Error(err))
+		form.Error = "Unexpected error contacting the directory server. Ask a site admin to check the server \"frontend\" logs for \"Error authenticating LDAP user\"."
+		form.render(w, http.StatusInternalServerError)
+		return
+	}
+
+	actor, safeErrMsg, err := getOrCreateUser(r.Context(), db, p, info)
+	if err != nil {
+		logger.Error("Error looking up LDAP-authenticated user", log.String("dn", info.dn), log.String("userErr", safeErrMsg), log.Error(err))
+		http.Error(w, safeErrMsg, http.StatusInternalServerError)
+		return
+	}
+
+	// 🚨 SECURITY: The directory entry may be linked to another user than the one looked up by
+	// username, which must not be able to sign in while locked out either.
+	if reason, locked := lockoutStore.IsLockedOut(actor.UID); locked {
+		form.Error = fmt.Sprintf("Account has been locked out due to %q.", reason)
+		form.render(w, http.StatusUnprocessableEntity)
+		return
+	}
+	lockoutStore.Reset(actor.UID)
+
+	user, err := db.Users().GetByID(r.Context(), actor.UID)
+	if err != nil {
+		logger.Error("Error retrieving LDAP-authenticated user from database", log.Error(err))
+		http.Error(w, "Failed to retrieve user: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Use the default session expiry.
+	var exp time.Duration
+	if err := session.SetActor(w, r, actor, exp, user.CreatedAt); err != nil {
+		logger.Error("Error setting LDAP-authenticated actor in session", log.Error(err))
+		http.Error(w, "Error starting LDAP-authenticated session. Try signing in again.", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, form.ReturnTo, http.StatusFound)
+}
+
+// isSameOrigin reports whether the request was sent by a page of this Sourcegraph instance, based
+// on its Origin header, or its Referer header if the browser sent no Origin.
+func isSameOrigin(r *http.Request) bool {
+	externalURL, err := url.Parse(conf.ExternalURL())
+	if err != nil || externalURL.Host == "" {
+		return false
+	}
+
+	if origin := r.Header.Get("Origin"); origin != "" {
+		return origin == externalURL.Scheme+"://"+externalURL.Host
+	}
+	referer, err := url.Parse(r.Referer())
+	if err != nil {
+		return false
+	}
+	return referer.Scheme == externalURL.Scheme && referer.Host == externalURL.Host
+}
+
+// loginForm is the data of the LDAP sign-in form.
+type loginForm struct {
+	ProviderID  string
+	DisplayName string
+	ReturnTo    string
+	Username    string
+	Error       string
+}
+
+func (f *loginForm) render(w http.ResponseWriter, status int) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_ = loginTemplate.Execute(w, f)
+}
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+	<meta charset="utf-8">
+	<title>Sign in with {{.DisplayName}} - Sourcegraph</title>
+</head>
+<body>
+	<h1>Sign in with {{.DisplayName}}</h1>
+	{{if .Error}}<p role="alert">{{.Error}}</p>{{end}}
+	<form method="post">
+		<input type="hidden" name="pc" value="{{.ProviderID}}">
+		<input type="hidden" name="returnTo" value="{{.ReturnTo}}">
+		<p><label>Username <input type="text" name="username" value="{{.Username}}" autocomplete="username" required autofocus></label></p>
+		<p><label>Password <input type="password" name="password" autocomplete="current-password" required></label></p>
+		<p><button type="submit">Sign in</button></p>
+	</form>
+</body>
+</html>
+`))
@@ -0,0 +1,306 @@
+package ldap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/session"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/external/userpasswd"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestMiddleware(t *testing.T) {
+	server := newLDAPServer(t, modePlain, testEntries...)
+
+	p := &provider{config: *withConfigDefaults(&schema.LDAPAuthProvider{
+		Type:             providerType,
+		Url:              server.url,
+		BindDN:           testServiceDN,
+		BindPassword:     testServicePassword,
+		UserSearchBaseDN: testPeopleDN,
+		GroupFilter:      "(memberOf=" + testEngineersDN + ")",
+	})}
+	providers.MockProviders = []providers.Provider{p}
+	defer func() { providers.MockProviders = nil }()
+
+	cleanup := session.ResetMockSessionStore(t)
+	defer cleanup()
+
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{ExternalURL: "http://example.com"}})
+	defer conf.Mock(nil)
+
+	const mockedUserID = 123
+	var gotOps []auth.GetAndSaveUserOp
+	auth.MockGetAndSaveUser = func(ctx context.Context, op auth.GetAndSaveUserOp) (userID int32, safeErrMsg string, err error) {
+		gotOps = append(gotOps, op)
+		return mockedUserID, "", nil
+	}
+	defer func() { auth.MockGetAndSaveUser = nil }()
+
+	users := database.NewStrictMockUserStore()
+	users.GetByIDFunc.SetDefaultHook(func(ctx context.Context, id int32) (*types.User, error) {
+		return &types.User{ID: id, CreatedAt: time.Now()}, nil
+	})
+	users.GetByUsernameFunc.SetDefaultHook(func(ctx context.Context, username string) (*types.User, error) {
+		if username == "alice" {
+			return &types.User{ID: mockedUserID, Username: username}, nil
+		}
+		return nil, database.NewUserNotFoundError(0)
+	})
+	db := database.NewStrictMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+
+	lockoutStore := newFakeLockoutStore()
+	handler := middleware(db, lockoutStore).App(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("next"))
+	}))
+
+	doRequestFrom := func(origin, method, urlStr string, form url.Values) *http.Response {
+		var req *http.Request
+		if form != nil {
+			req = httptest.NewRequest(method, urlStr, strings.NewReader(form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if origin != "" {
+				req.Header.Set("Origin", origin)
+			}
+		} else {
+			req = httptest.NewRequest(method, urlStr, nil)
+		}
+		respRecorder := httptest.NewRecorder()
+		handler.ServeHTTP(respRecorder, req)
+		return respRecorder.Result()
+	}
+	doRequest := func(method, urlStr string, form url.Values) *http.Response {
+		return doRequestFrom("http://example.com", method, urlStr, form)
+	}
+
+	t.Run("other paths are passed through", func(t *testing.T) {
+		resp := doRequest("GET", "http://example.com/search", nil)
+		if want := http.StatusOK; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+	})
+
+	t.Run("sign-in form", func(t *testing.T) {
+		resp := doRequest("GET", "http://example.com/.auth/ldap/login?returnTo=%2Fsearch", nil)
+		if want := http.StatusOK; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if got := resp.Header.Get("Content-Type"); !strings.HasPrefix(got, "text/html") {
+			t.Errorf("unexpected content type %q", got)
+		}
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		resp := doRequest("GET", "http://example.com/.auth/ldap/other", nil)
+		if want := http.StatusNotFound; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+	})
+
+	t.Run("cross-origin request", func(t *testing.T) {
+		for _, origin := range []string{"https://evil.example.org", "null"} {
+			resp := doRequestFrom(origin, "POST", "http://example.com/.auth/ldap/login", url.Values{
+				"username": {"alice"},
+				"password": {testAlicePassword},
+			})
+			if want := http.StatusForbidden; resp.StatusCode != want {
+				t.Errorf("unexpected status code for origin %q. want=%d have=%d", origin, want, resp.StatusCode)
+			}
+			if len(resp.Cookies()) != 0 {
+				t.Errorf("unexpected session cookie")
+			}
+		}
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		resp := doRequest("POST", "http://example.com/.auth/ldap/login", url.Values{
+			"username": {"alice"},
+			"password": {"wrong"},
+		})
+		if want := http.StatusUnauthorized; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if len(resp.Cookies()) != 0 {
+			t.Errorf("unexpected session cookie")
+		}
+		if have := lockoutStore.failedAttempts[mockedUserID]; have != 1 {
+			t.Errorf("unexpected number of failed attempts. want=%d have=%d", 1, have)
+		}
+	})
+
+	t.Run("locked out", func(t *testing.T) {
+		lockoutStore.lockouts[mockedUserID] = "too many failed attempts"
+		defer delete(lockoutStore.lockouts, mockedUserID)
+
+		bindsBefore := len(server.bindDNs())
+		resp := doRequest("POST", "http://example.com/.auth/ldap/login", url.Values{
+			"username": {"alice"},
+			"password": {testAlicePassword},
+		})
+		if want := http.StatusUnprocessableEntity; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if len(resp.Cookies()) != 0 {
+			t.Errorf("unexpected session cookie")
+		}
+		if n := len(server.bindDNs()); n != bindsBefore {
+			t.Errorf("unexpected binds while locked out. want=%d have=%d", bindsBefore, n)
+		}
+	})
+
+	t.Run("not allowed by group filter", func(t *testing.T) {
+		resp := doRequest("POST", "http://example.com/.auth/ldap/login", url.Values{
+			"username": {"bob"},
+			"password": {"bob-secret"},
+		})
+		if want := http.StatusForbidden; resp.StatusCode != want {
+			t.Errorf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if len(resp.Cookies()) != 0 {
+			t.Errorf("unexpected session cookie")
+		}
+	})
+
+	t.Run("valid credentials", func(t *testing.T) {
+		gotOps = nil
+		resp := doRequest("POST", "http://example.com/.auth/ldap/login", url.Values{
+			"pc":       {server.url},
+			"returnTo": {"/search?q=foo"},
+			"username": {"alice"},
+			"password": {testAlicePassword},
+		})
+		if want := http.StatusFound; resp.StatusCode != want {
+			t.Fatalf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if got, want := resp.Header.Get("Location"), "/search?q=foo"; got != want {
+			t.Errorf("unexpected redirect. want=%q have=%q", want, got)
+		}
+		if len(resp.Cookies()) == 0 {
+			t.Errorf("expected session cookie")
+		}
+
+		if len(gotOps) != 1 {
+			t.Fatalf("unexpected number of calls to GetAndSaveUser. want=%d have=%d", 1, len(gotOps))
+		}
+		op := gotOps[0]
+		wantSpec := extsvc.AccountSpec{
+			ServiceType: providerType,
+			ServiceID:   server.url,
+			AccountID:   testAliceDN,
+		}
+		if diff := cmp.Diff(wantSpec, op.ExternalAccount); diff != "" {
+			t.Errorf("unexpected external account (-want +got):\n%s", diff)
+		}
+		wantProps := database.NewUser{
+			Username:        "alice",
+			Email:           "alice@example.com",
+			EmailIsVerified: true,
+			DisplayName:     "Alice Smith",
+		}
+		if diff := cmp.Diff(wantProps, op.UserProps); diff != "" {
+			t.Errorf("unexpected user props (-want +got):\n%s", diff)
+		}
+		if !op.CreateIfNotExist {
+			t.Errorf("expected user to be created if it does not exist")
+		}
+		if have := lockoutStore.failedAttempts[mockedUserID]; have != 0 {
+			t.Errorf("expected failed attempts to be reset, have %d", have)
+		}
+	})
+
+	t.Run("account ID attribute", func(t *testing.T) {
+		p.config.AccountIDAttribute = "entryUUID"
+		defer func() { p.config.AccountIDAttribute = "" }()
+
+		gotOps = nil
+		resp := doRequest("POST", "http://example.com/.auth/ldap/login", url.Values{
+			"username": {"alice"},
+			"password": {testAlicePassword},
+		})
+		if want := http.StatusFound; resp.StatusCode != want {
+			t.Fatalf("unexpected status code. want=%d have=%d", want, resp.StatusCode)
+		}
+		if len(gotOps) != 1 {
+			t.Fatalf("unexpected number of calls to GetAndSaveUser. want=%d have=%d", 1, len(gotOps))
+		}
+		if have, want := gotOps[0].ExternalAccount.AccountID, testAliceEntryUUID; have != want {
+			t.Errorf("unexpected account ID. want=%q have=%q", want, have)
+		}
+	})
+}
+
+func TestIsSameOrigin(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{ExternalURL: "https://sourcegraph.example.com"}})
+	defer conf.Mock(nil)
+
+	testCases := map[string]struct {
+		origin  string
+		referer string
+		want    bool
+	}{
+		"same origin":          {origin: "https://sourcegraph.example.com", want: true},
+		"other origin":         {origin: "https://evil.example.com"},
+		"other scheme":         {origin: "http://sourcegraph.example.com"},
+		"opaque origin":        {origin: "null", referer: "https://sourcegraph.example.com/sign-in"},
+		"same-origin referer":  {referer: "https://sourcegraph.example.com/.auth/ldap/login", want: true},
+		"cross-origin referer": {referer: "https://evil.example.com/sourcegraph.example.com"},
+		"no origin or referer": {},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "https://sourcegraph.example.com/.auth/ldap/login", nil)
+			if testCase.origin != "" {
+				req.Header.Set("Origin", testCase.origin)
+			}
+			if testCase.referer != "" {
+				req.Header.Set("Referer", testCase.referer)
+			}
+			if have := isSameOrigin(req); have != testCase.want {
+				t.Errorf("unexpected result. want=%v have=%v", testCase.want, have)
+			}
+		})
+	}
+}
+
+// fakeLockoutStore is an in-memory userpasswd.LockoutStore that never locks out by itself.
+type fakeLockoutStore struct {
+	userpasswd.LockoutStore
+	lockouts       map[int32]string
+	failedAttempts map[int32]int
+}
+
+func newFakeLockoutStore() *fakeLockoutStore {
+	return &fakeLockoutStore{
+		lockouts:       map[int32]string{},
+		failedAttempts: map[int32]int{},
+	}
+}
+
+func (s *fakeLockoutStore) IsLockedOut(userID int32) (string, bool) {
+	reason, locked := s.lockouts[userID]
+	return reason, locked
+}
+
+func (s *fakeLockoutStore) IncreaseFailedAttempt(userID int32) {
+	s.failedAttempts[userID]++
+}
+
+func (s *fakeLockoutStore) Reset(userID int32) {
+	delete(s.lockouts, userID)
+	delete(s.failedAttempts, userID)
+}
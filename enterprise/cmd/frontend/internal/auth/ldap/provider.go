@@ -0,0 +1,71 @@
+package ldap
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth/providers"
+	"github.com/sourcegraph/sourcegraph/internal/encryption"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+const providerType = "ldap"
+
+type provider struct {
+	config schema.LDAPAuthProvider
+}
+
+// ConfigID implements providers.Provider.
+func (p *provider) ConfigID() providers.ConfigID {
+	return providers.ConfigID{
+		Type: providerType,
+		ID:   p.config.Url,
+	}
+}
+
+// Config implements providers.Provider.
+func (p *provider) Config() schema.AuthProviders {
+	return schema.AuthProviders{Ldap: &p.config}
+}
+
+// Refresh implements providers.Provider.
+func (p *provider) Refresh(context.Context) error { return nil }
+
+// CachedInfo implements providers.Provider.
+func (p *provider) CachedInfo() *providers.Info {
+	displayName := p.config.DisplayName
+	if displayName == "" {
+		displayName = "LDAP"
+	}
+	return &providers.Info{
+		ServiceID:   p.config.Url,
+		DisplayName: displayName,
+		AuthenticationURL: (&url.URL{
+			Path:     path.Join(auth.AuthURLPrefix, "ldap", "login"),
+			RawQuery: url.Values{"pc": []string{p.config.Url}}.Encode(),
+		}).String(),
+	}
+}
+
+func (p *provider) ExternalAccountInfo(ctx context.Context, account extsvc.Account) (*extsvc.PublicAccountData, error) {
+	if account.Data == nil {
+		return nil, nil
+	}
+
+	data, err := encryption.DecryptJSON[accountData](ctx, account.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := data.DisplayName
+	if displayName == "" {
+		displayName = data.Username
+	}
+	return &extsvc.PublicAccountData{
+		DisplayName: &displayName,
+		Login:       &data.Username,
+	}, nil
+}
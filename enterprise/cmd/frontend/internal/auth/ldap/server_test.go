@@ -0,0 +1,336 @@
+package ldap
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+)
+
+// ldapServerMode is the transport security used by ldapServer.
+type ldapServerMode int
+
+const (
+	modePlain     ldapServerMode = iota // plain TCP, StartTLS is supported
+	modeLDAPS                           // TLS from the start
+	modeRejectTLS                       // plain TCP, StartTLS is rejected
+)
+
+// ldapEntry is an entry in the directory of ldapServer.
+type ldapEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// ldapServer is an in-process LDAP server implementing just enough of the protocol for the
+// tests: simple binds, searches with and, or, not, equality and presence filters, and StartTLS.
+// Anonymous searches are refused.
+type ldapServer struct {
+	t        *testing.T
+	listener net.Listener
+	entries  []ldapEntry
+
+	// url is the URL to connect to the server.
+	url string
+	// certificate is the PEM-encoded self-signed certificate of the server.
+	certificate string
+
+	mu    sync.Mutex
+	binds []string // the DNs of all bind requests, in order
+}
+
+func newLDAPServer(t *testing.T, mode ldapServerMode, entries ...ldapEntry) *ldapServer {
+	t.Helper()
+
+	tlsConfig, certificate := newTestTLSConfig(t)
+
+	var (
+		listener net.Listener
+		err      error
+		scheme   = "ldap"
+	)
+	if mode == modeLDAPS {
+		listener, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+		scheme = "ldaps"
+	} else {
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &ldapServer{
+		t:           t,
+		listener:    listener,
+		entries:     entries,
+		url:         scheme + "://" + listener.Addr().String(),
+		certificate: certificate,
+	}
+
+	var wg sync.WaitGroup
+	t.Cleanup(func() {
+		listener.Close()
+		wg.Wait()
+	})
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.serve(conn, mode, tlsConfig)
+			}()
+		}
+	}()
+
+	return s
+}
+
+// bindDNs returns the DNs of all bind requests received by the server.
+func (s *ldapServer) bindDNs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.binds...)
+}
+
+func (s *ldapServer) serve(conn net.Conn, mode ldapServerMode, tlsConfig *tls.Config) {
+	defer func() { conn.Close() }()
+
+	var boundDN string
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case goldap.ApplicationBindRequest:
+			dn := op.Children[1].Value.(string)
+			password := op.Children[2].Data.String()
+
+			s.mu.Lock()
+			s.binds = append(s.binds, dn)
+			s.mu.Unlock()
+
+			var code uint16 = goldap.LDAPResultInvalidCredentials
+			if dn == "" && password == "" {
+				code = goldap.LDAPResultSuccess
+			} else if entry := s.lookup(dn); entry != nil && password != "" && entry.password == password {
+				code = goldap.LDAPResultSuccess
+			}
+			if code == goldap.LDAPResultSuccess {
+				boundDN = dn
+			} else {
+				boundDN = ""
+			}
+			s.write(conn, newLDAPResult(messageID, goldap.ApplicationBindResponse, code))
+
+		case goldap.ApplicationSearchRequest:
+			if boundDN == "" {
+				s.write(conn, newLDAPResult(messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultInsufficientAccessRights))
+				continue
+			}
+
+			baseDN := op.Children[0].Value.(string)
+			scope := op.Children[1].Value.(int64)
+			filter := op.Children[6]
+			var attributes []string
+			for _, attribute := range op.Children[7].Children {
+				attributes = append(attributes, attribute.Value.(string))
+			}
+
+			for _, entry := range s.entries {
+				if !inScope(entry.dn, baseDN, scope) || !matchFilter(entry, filter) {
+					continue
+				}
+				s.write(conn, newSearchResultEntry(messageID, entry, attributes))
+			}
+			s.write(conn, newLDAPResult(messageID, goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess))
+
+		case goldap.ApplicationExtendedRequest:
+			if op.Children[0].Data.String() != "1.3.6.1.4.1.1466.20037" || mode != modePlain {
+				s.write(conn, newLDAPResult(messageID, goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError))
+				continue
+			}
+			s.write(conn, newLDAPResult(messageID, goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess))
+
+			tlsConn := tls.Server(conn, tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+
+		case goldap.ApplicationUnbindRequest:
+			return
+
+		default:
+			s.t.Errorf("unexpected LDAP operation %d", op.Tag)
+			return
+		}
+	}
+}
+
+func (s *ldapServer) lookup(dn string) *ldapEntry {
+	for i := range s.entries {
+		if strings.EqualFold(s.entries[i].dn, dn) {
+			return &s.entries[i]
+		}
+	}
+	return nil
+}
+
+func (s *ldapServer) write(conn net.Conn, packet *ber.Packet) {
+	if _, err := conn.Write(packet.Bytes()); err != nil {
+		s.t.Logf("failed to write LDAP response: %s", err)
+	}
+}
+
+func inScope(dn, baseDN string, scope int64) bool {
+	dn, baseDN = strings.ToLower(dn), strings.ToLower(baseDN)
+	if scope == goldap.ScopeBaseObject {
+		return dn == baseDN
+	}
+	return dn == baseDN || strings.HasSuffix(dn, ","+baseDN)
+}
+
+func matchFilter(entry ldapEntry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(entry, child) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if matchFilter(entry, child) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return !matchFilter(entry, filter.Children[0])
+	case goldap.FilterEqualityMatch:
+		for _, value := range attributeValues(entry, filter.Children[0].Value.(string)) {
+			if strings.EqualFold(value, filter.Children[1].Value.(string)) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(attributeValues(entry, filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func attributeValues(entry ldapEntry, name string) []string {
+	for attr, values := range entry.attrs {
+		if strings.EqualFold(attr, name) {
+			return values
+		}
+	}
+	return nil
+}
+
+func newLDAPResult(messageID int64, tag ber.Tag, code uint16) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), "Result Code"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return newLDAPMessage(messageID, op)
+}
+
+func newSearchResultEntry(messageID int64, entry ldapEntry, attributes []string) *ber.Packet {
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for name, values := range entry.attrs {
+		if !requested(name, attributes) {
+			continue
+		}
+
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attr.AppendChild(set)
+		attrs.AppendChild(attr)
+	}
+
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "Object Name"))
+	op.AppendChild(attrs)
+	return newLDAPMessage(messageID, op)
+}
+
+func requested(name string, attributes []string) bool {
+	if len(attributes) == 0 {
+		return true
+	}
+	for _, attribute := range attributes {
+		if strings.EqualFold(attribute, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func newLDAPMessage(messageID int64, op *ber.Packet) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+	return packet
+}
+
+// newTestTLSConfig returns a TLS server config with a self-signed certificate for 127.0.0.1,
+// along with that certificate in PEM format.
+func newTestTLSConfig(t *testing.T) (*tls.Config, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ldap-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
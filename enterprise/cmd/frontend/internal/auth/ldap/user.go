@@ -0,0 +1,67 @@
+package ldap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/auth"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// accountData is the data stored with the external account of an LDAP-authenticated user.
+type accountData struct {
+	DN          string `json:"dn"`
+	Username    string `json:"username"`
+	Email       string `json:"email,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// getOrCreateUser gets or creates a user account based on the directory entry of the user. It
+// returns the authenticated actor if successful; otherwise it returns a friendly error message
+// (safeErrMsg) that is safe to display to users, and a non-nil err with lower-level error details.
+func getOrCreateUser(ctx context.Context, db database.DB, p *provider, info *userInfo) (_ *actor.Actor, safeErrMsg string, err error) {
+	if info.email == "" {
+		return nil, "Your directory entry has no email address. Ask a site admin for help.", errors.Errorf("no %q attribute in entry %q", p.config.EmailAttribute, info.dn)
+	}
+
+	serializedData, err := json.Marshal(accountData{
+		DN:          info.dn,
+		Username:    info.username,
+		Email:       info.email,
+		DisplayName: info.displayName,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	username, err := auth.NormalizeUsername(info.username)
+	if err != nil {
+		return nil, fmt.Sprintf("Error normalizing the username %q. See https://docs.sourcegraph.com/admin/auth/#username-normalization.", info.username), err
+	}
+
+	userID, safeErrMsg, err := auth.GetAndSaveUser(ctx, db, auth.GetAndSaveUserOp{
+		UserProps: database.NewUser{
+			Username:        username,
+			Email:           info.email,
+			EmailIsVerified: true, // emails in the directory are managed by its admins
+			DisplayName:     info.displayName,
+		},
+		ExternalAccount: extsvc.AccountSpec{
+			ServiceType: providerType,
+			ServiceID:   p.config.Url,
+			AccountID:   info.accountID,
+		},
+		ExternalAccountData: extsvc.AccountData{
+			Data: extsvc.NewUnencryptedData(serializedData),
+		},
+		CreateIfNotExist: p.config.AllowSignup == nil || *p.config.AllowSignup,
+	})
+	if err != nil {
+		return nil, safeErrMsg, err
+	}
+	return actor.FromUser(userID), "", nil
+}
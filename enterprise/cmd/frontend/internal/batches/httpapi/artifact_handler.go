@@ -0,0 +1,190 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+
+	"github.com/go-enry/go-enry/v2/regex"
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/opentracing/opentracing-go/log"
+	sglog "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/artifacts"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ArtifactHandler handles uploading and downloading the step artifacts of
+// workspaces.
+type ArtifactHandler struct {
+	logger        sglog.Logger
+	db            database.DB
+	store         ArtifactsStore
+	artifactStore uploadstore.Store
+	operations    *Operations
+}
+
+type ArtifactsStore interface {
+	GetBatchSpecWorkspace(context.Context, store.GetBatchSpecWorkspaceOpts) (*btypes.BatchSpecWorkspace, error)
+	GetBatchSpecWorkspaceArtifact(context.Context, store.GetBatchSpecWorkspaceArtifactOpts) (*btypes.BatchSpecWorkspaceArtifact, error)
+}
+
+// NewArtifactHandler creates a new ArtifactHandler.
+func NewArtifactHandler(db database.DB, store ArtifactsStore, artifactStore uploadstore.Store, operations *Operations) *ArtifactHandler {
+	return &ArtifactHandler{
+		logger:        sglog.Scoped("ArtifactHandler", "Batch Changes step artifact REST API handler"),
+		db:            db,
+		store:         store,
+		artifactStore: artifactStore,
+		operations:    operations,
+	}
+}
+
+// Get retrieves the content of the artifact.
+func (h *ArtifactHandler) Get() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		artifact, content, statusCode, err := h.get(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+		defer content.Close()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": path.Base(artifact.Path)}))
+		w.WriteHeader(statusCode)
+
+		if _, err := io.Copy(w, content); err != nil {
+			h.logger.Error("failed to write payload to client", sglog.Error(err))
+		}
+	})
+}
+
+func (h *ArtifactHandler) get(r *http.Request) (_ *btypes.BatchSpecWorkspaceArtifact, _ io.ReadCloser, statusCode int, err error) {
+	ctx, _, endObservation := h.operations.getArtifact.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{LogFields: []log.Field{
+			log.Int("statusCode", statusCode),
+		}})
+	}()
+
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return nil, nil, http.StatusUnauthorized, errors.New("not authenticated")
+	}
+
+	if err := enterprise.BatchChangesEnabledForUser(ctx, h.db); err != nil {
+		return nil, nil, http.StatusForbidden, err
+	}
+
+	var workspaceID int64
+	if err := relay.UnmarshalSpec(graphql.ID(mux.Vars(r)["workspace"]), &workspaceID); err != nil || workspaceID == 0 {
+		return nil, nil, http.StatusBadRequest, errors.New("invalid workspace ID")
+	}
+	name := mux.Vars(r)["name"]
+	if name == "" {
+		return nil, nil, http.StatusBadRequest, errors.New("artifact name not provided")
+	}
+
+	workspace, err := h.store.GetBatchSpecWorkspace(ctx, store.GetBatchSpecWorkspaceOpts{ID: workspaceID})
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, nil, http.StatusNotFound, errors.New("workspace does not exist")
+		}
+		return nil, nil, http.StatusInternalServerError, errors.Wrap(err, "retrieving workspace")
+	}
+
+	// 🚨 SECURITY: Artifacts can contain content of the repository, so only
+	// users who can access the repository of the workspace can download them.
+	if _, err := h.db.Repos().Get(ctx, workspace.RepoID); err != nil {
+		if errcode.IsNotFound(err) {
+			return nil, nil, http.StatusNotFound, errors.New("workspace does not exist")
+		}
+		return nil, nil, http.StatusInternalServerError, errors.Wrap(err, "retrieving repository")
+	}
+
+	artifact, err := h.store.GetBatchSpecWorkspaceArtifact(ctx, store.GetBatchSpecWorkspaceArtifactOpts{
+		BatchSpecWorkspaceID: workspace.ID,
+		Name:                 name,
+	})
+	if err != nil {
+		if errors.Is(err, store.ErrNoResults) {
+			return nil, nil, http.StatusNotFound, errors.New("artifact does not exist")
+		}
+		return nil, nil, http.StatusInternalServerError, errors.Wrap(err, "retrieving artifact")
+	}
+
+	content, err := h.artifactStore.Get(ctx, artifact.ObjectKey)
+	if err != nil {
+		return nil, nil, http.StatusInternalServerError, errors.Wrap(err, "retrieving artifact content")
+	}
+
+	return artifact, content, http.StatusOK, nil
+}
+
+// maxArtifactSize is the maximum size of a single artifact in bytes.
+const maxArtifactSize = 1 << 20 // 1MB
+
+var artifactKeyRegex = regex.MustCompile(`^[0-9a-f]{64}$`)
+
+// Upload stores the content of an artifact that was uploaded by an executor.
+func (h *ArtifactHandler) Upload() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxArtifactSize)
+		statusCode, err := h.upload(r)
+		if err != nil {
+			http.Error(w, err.Error(), statusCode)
+			return
+		}
+
+		w.WriteHeader(statusCode)
+	})
+}
+
+func (h *ArtifactHandler) upload(r *http.Request) (statusCode int, err error) {
+	ctx, _, endObservation := h.operations.uploadArtifact.With(r.Context(), &err, observation.Args{})
+	defer func() {
+		endObservation(1, observation.Args{LogFields: []log.Field{
+			log.Int("statusCode", statusCode),
+		}})
+	}()
+
+	key := mux.Vars(r)["key"]
+	if !artifactKeyRegex.MatchString(key) {
+		return http.StatusBadRequest, errors.New("invalid artifact key")
+	}
+
+	content, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return http.StatusBadRequest, errors.New("artifact exceeds 1MB limit")
+		}
+		return http.StatusInternalServerError, errors.Wrap(err, "reading artifact")
+	}
+
+	// 🚨 SECURITY: Artifacts are stored content-addressed and shared between
+	// workspaces, so a job must not be able to store other content under a key.
+	if execution.ArtifactKey(content) != key {
+		return http.StatusBadRequest, errors.New("artifact key does not match the content")
+	}
+
+	if _, err := h.artifactStore.Upload(ctx, artifacts.ObjectKey(key), bytes.NewReader(content)); err != nil {
+		return http.StatusInternalServerError, errors.Wrap(err, "uploading artifact")
+	}
+
+	return http.StatusOK, nil
+}
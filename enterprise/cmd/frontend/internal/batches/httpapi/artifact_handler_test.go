@@ -0,0 +1,289 @@
+package httpapi_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/batches/httpapi"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	uploadstoremocks "github.com/sourcegraph/sourcegraph/internal/uploadstore/mocks"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestArtifactHandler_Get(t *testing.T) {
+	const visibleRepoID, hiddenRepoID = api.RepoID(1), api.RepoID(2)
+	workspaceID := string(relay.MarshalID("BatchSpecWorkspace", int64(42)))
+	path := fmt.Sprintf("/files/batch-changes/workspaces/%s/artifacts/report", workspaceID)
+
+	repos := database.NewMockRepoStore()
+	repos.GetFunc.SetDefaultHook(func(_ context.Context, id api.RepoID) (*types.Repo, error) {
+		if id == visibleRepoID {
+			return &types.Repo{ID: id}, nil
+		}
+		return nil, &database.RepoNotFoundErr{ID: id}
+	})
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultHook(func(ctx context.Context) (*types.User, error) {
+		return &types.User{ID: actor.FromContext(ctx).UID}, nil
+	})
+	db := database.NewMockDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.UsersFunc.SetDefaultReturn(users)
+
+	artifactStore := uploadstoremocks.NewMockStore()
+	artifactStore.GetFunc.SetDefaultHook(func(_ context.Context, key string) (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader("content of " + key)), nil
+	})
+
+	artifact := &btypes.BatchSpecWorkspaceArtifact{
+		BatchSpecWorkspaceID: 42,
+		Name:                 "report",
+		Path:                 "out/report.json",
+		ObjectKey:            "batches/artifacts/abc",
+	}
+
+	tests := []struct {
+		name             string
+		path             string
+		userID           int32
+		restrictToAdmins bool
+		mockInvokes      func(mockStore *mockArtifactsStore)
+
+		expectedStatusCode   int
+		expectedResponseBody string
+	}{
+		{
+			name:   "Get artifact",
+			path:   path,
+			userID: 1,
+			mockInvokes: func(mockStore *mockArtifactsStore) {
+				mockStore.
+					On("GetBatchSpecWorkspace", mock.Anything, store.GetBatchSpecWorkspaceOpts{ID: 42}).
+					Return(&btypes.BatchSpecWorkspace{ID: 42, RepoID: visibleRepoID}, nil).
+					Once()
+				mockStore.
+					On("GetBatchSpecWorkspaceArtifact", mock.Anything, store.GetBatchSpecWorkspaceArtifactOpts{BatchSpecWorkspaceID: 42, Name: "report"}).
+					Return(artifact, nil).
+					Once()
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseBody: "content of batches/artifacts/abc",
+		},
+		{
+			name:                 "Unauthenticated",
+			path:                 path,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseBody: "not authenticated\n",
+		},
+		{
+			name:                 "Batch changes restricted to admins",
+			path:                 path,
+			userID:               1,
+			restrictToAdmins:     true,
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseBody: enterprise.ErrBatchChangesDisabledForUser{}.Error() + "\n",
+		},
+		{
+			name:                 "Invalid workspace ID",
+			path:                 "/files/batch-changes/workspaces/foo/artifacts/report",
+			userID:               1,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid workspace ID\n",
+		},
+		{
+			name:   "Repository not accessible",
+			path:   path,
+			userID: 1,
+			mockInvokes: func(mockStore *mockArtifactsStore) {
+				mockStore.
+					On("GetBatchSpecWorkspace", mock.Anything, store.GetBatchSpecWorkspaceOpts{ID: 42}).
+					Return(&btypes.BatchSpecWorkspace{ID: 42, RepoID: hiddenRepoID}, nil).
+					Once()
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "workspace does not exist\n",
+		},
+		{
+			name:   "Artifact does not exist",
+			path:   path,
+			userID: 1,
+			mockInvokes: func(mockStore *mockArtifactsStore) {
+				mockStore.
+					On("GetBatchSpecWorkspace", mock.Anything, store.GetBatchSpecWorkspaceOpts{ID: 42}).
+					Return(&btypes.BatchSpecWorkspace{ID: 42, RepoID: visibleRepoID}, nil).
+					Once()
+				mockStore.
+					On("GetBatchSpecWorkspaceArtifact", mock.Anything, store.GetBatchSpecWorkspaceArtifactOpts{BatchSpecWorkspaceID: 42, Name: "report"}).
+					Return(nil, store.ErrNoResults).
+					Once()
+			},
+			expectedStatusCode:   http.StatusNotFound,
+			expectedResponseBody: "artifact does not exist\n",
+		},
+		{
+			name:   "Failed to get workspace",
+			path:   path,
+			userID: 1,
+			mockInvokes: func(mockStore *mockArtifactsStore) {
+				mockStore.
+					On("GetBatchSpecWorkspace", mock.Anything, store.GetBatchSpecWorkspaceOpts{ID: 42}).
+					Return(nil, errors.New("failed")).
+					Once()
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseBody: "retrieving workspace: failed\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+				BatchChangesRestrictToAdmins: &test.restrictToAdmins,
+			}})
+			t.Cleanup(func() { conf.Mock(nil) })
+
+			mockStore := new(mockArtifactsStore)
+			handler := httpapi.NewArtifactHandler(db, mockStore, artifactStore, httpapi.NewOperations(&observation.TestContext))
+
+			r := httptest.NewRequest(http.MethodGet, test.path, nil)
+			if test.userID != 0 {
+				r = r.WithContext(actor.WithActor(r.Context(), actor.FromUser(test.userID)))
+			}
+			w := httptest.NewRecorder()
+
+			if test.mockInvokes != nil {
+				test.mockInvokes(mockStore)
+			}
+
+			router := mux.NewRouter()
+			router.Methods(http.MethodGet).Path("/files/batch-changes/workspaces/{workspace}/artifacts/{name}").Handler(handler.Get())
+			router.ServeHTTP(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+			assert.Equal(t, test.expectedStatusCode, res.StatusCode)
+
+			responseBody, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponseBody, string(responseBody))
+
+			mockStore.AssertExpectations(t)
+		})
+	}
+}
+
+func TestArtifactHandler_Upload(t *testing.T) {
+	content := "# Report\n"
+	key := execution.ArtifactKey([]byte(content))
+
+	tests := []struct {
+		name    string
+		key     string
+		content string
+
+		expectedStatusCode   int
+		expectedResponseBody string
+		expectedObjectKey    string
+	}{
+		{
+			name:               "Upload artifact",
+			key:                key,
+			content:            content,
+			expectedStatusCode: http.StatusOK,
+			expectedObjectKey:  "batches/artifacts/" + key,
+		},
+		{
+			name:                 "Invalid key",
+			key:                  "foo",
+			content:              content,
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "invalid artifact key\n",
+		},
+		{
+			name:                 "Key does not match content",
+			key:                  key,
+			content:              "other content",
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "artifact key does not match the content\n",
+		},
+		{
+			name:                 "Artifact too large",
+			key:                  key,
+			content:              strings.Repeat("a", 1<<20+1),
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseBody: "artifact exceeds 1MB limit\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			artifactStore := uploadstoremocks.NewMockStore()
+			handler := httpapi.NewArtifactHandler(database.NewMockDB(), new(mockArtifactsStore), artifactStore, httpapi.NewOperations(&observation.TestContext))
+
+			r := httptest.NewRequest(http.MethodPost, "/artifacts/"+test.key, strings.NewReader(test.content))
+			w := httptest.NewRecorder()
+
+			router := mux.NewRouter()
+			router.Methods(http.MethodPost).Path("/artifacts/{key}").Handler(handler.Upload())
+			router.ServeHTTP(w, r)
+
+			res := w.Result()
+			defer res.Body.Close()
+			assert.Equal(t, test.expectedStatusCode, res.StatusCode)
+
+			responseBody, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedResponseBody, string(responseBody))
+
+			if test.expectedObjectKey == "" {
+				assert.Empty(t, artifactStore.UploadFunc.History())
+				return
+			}
+			require.Len(t, artifactStore.UploadFunc.History(), 1)
+			assert.Equal(t, test.expectedObjectKey, artifactStore.UploadFunc.History()[0].Arg1)
+		})
+	}
+}
+
+type mockArtifactsStore struct {
+	mock.Mock
+}
+
+func (m *mockArtifactsStore) GetBatchSpecWorkspace(ctx context.Context, opts store.GetBatchSpecWorkspaceOpts) (*btypes.BatchSpecWorkspace, error) {
+	args := m.Called(ctx, opts)
+	var obj *btypes.BatchSpecWorkspace
+	if args.Get(0) != nil {
+		obj = args.Get(0).(*btypes.BatchSpecWorkspace)
+	}
+	return obj, args.Error(1)
+}
+
+func (m *mockArtifactsStore) GetBatchSpecWorkspaceArtifact(ctx context.Context, opts store.GetBatchSpecWorkspaceArtifactOpts) (*btypes.BatchSpecWorkspaceArtifact, error) {
+	args := m.Called(ctx, opts)
+	var obj *btypes.BatchSpecWorkspaceArtifact
+	if args.Get(0) != nil {
+		obj = args.Get(0).(*btypes.BatchSpecWorkspaceArtifact)
+	}
+	return obj, args.Error(1)
+}
@@ -11,6 +11,9 @@ type Operations struct {
 	get    *observation.Operation
 	exists *observation.Operation
 	upload *observation.Operation
+
+	getArtifact    *observation.Operation
+	uploadArtifact *observation.Operation
 }
 
 func NewOperations(observationCtx *observation.Context) *Operations {
@@ -33,5 +36,8 @@ func NewOperations(observationCtx *observation.Context) *Operations {
 		get:    op("get"),
 		exists: op("exists"),
 		upload: op("upload"),
+
+		getArtifact:    op("getArtifact"),
+		uploadArtifact: op("uploadArtifact"),
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/batches/httpapi"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/batches/resolvers"
 	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/batches/webhooks"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/artifacts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/window"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel"
@@ -20,6 +21,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
 
+// LoadConfig loads the configuration of the upload store that step artifacts
+// are stored in.
+func LoadConfig() {
+	artifacts.UploadStoreConfigInst.Load()
+}
+
 // Init initializes the given enterpriseServices to include the required
 // resolvers for Batch Changes and sets up webhook handlers for changeset
 // events.
@@ -59,5 +66,13 @@ func Init(
 	enterpriseServices.BatchesChangesFileExistsHandler = fileHandler.Exists()
 	enterpriseServices.BatchesChangesFileUploadHandler = fileHandler.Upload()
 
+	artifactStore, err := artifacts.InitUploadStore()
+	if err != nil {
+		return err
+	}
+	artifactHandler := httpapi.NewArtifactHandler(db, bstore, artifactStore, operations)
+	enterpriseServices.BatchesChangesArtifactGetHandler = artifactHandler.Get()
+	enterpriseServices.BatchesChangesArtifactUploadHandler = artifactHandler.Upload()
+
 	return nil
 }
@@ -93,6 +93,27 @@ type BatchChange struct {
 	DiffStat                DiffStat
 	BulkOperations          BulkOperationConnection
 	BatchSpecs              BatchSpecConnection
+	Schedule                *BatchChangeSchedule
+	ScheduledRuns           BatchChangeScheduledRunConnection
+}
+
+type BatchChangeSchedule struct {
+	Schedule  string
+	NextRunAt string
+}
+
+type BatchChangeScheduledRun struct {
+	State          string
+	BatchSpec      *BatchSpec
+	FailureMessage *string
+	StartedAt      string
+	FinishedAt     string
+}
+
+type BatchChangeScheduledRunConnection struct {
+	Nodes      []BatchChangeScheduledRun
+	TotalCount int
+	PageInfo   PageInfo
 }
 
 type BatchChangeConnection struct {
@@ -354,3 +354,39 @@ func (r *batchChangeResolver) BatchSpecs(
 
 	return &batchSpecConnectionResolver{store: r.store, opts: opts}, nil
 }
+
+func (r *batchChangeResolver) Schedule(ctx context.Context) (graphqlbackend.BatchChangeScheduleResolver, error) {
+	schedule, err := r.store.GetBatchChangeSchedule(ctx, r.batchChange.ID)
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &batchChangeScheduleResolver{schedule: schedule}, nil
+}
+
+func (r *batchChangeResolver) ScheduledRuns(
+	ctx context.Context,
+	args *graphqlbackend.ListBatchChangeScheduledRunsArgs,
+) (graphqlbackend.BatchChangeScheduledRunConnectionResolver, error) {
+	if err := validateFirstParamDefaults(args.First); err != nil {
+		return nil, err
+	}
+	opts := store.ListBatchChangeScheduledRunsOpts{
+		BatchChangeID: r.batchChange.ID,
+		LimitOpts: store.LimitOpts{
+			Limit: int(args.First),
+		},
+	}
+
+	if args.After != nil {
+		id, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cursor = int64(id)
+	}
+
+	return &batchChangeScheduledRunConnectionResolver{store: r.store, gitserverClient: r.gitserverClient, opts: opts}, nil
+}
@@ -0,0 +1,127 @@
+package resolvers
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+)
+
+var _ graphqlbackend.BatchChangeScheduleResolver = &batchChangeScheduleResolver{}
+
+type batchChangeScheduleResolver struct {
+	schedule *btypes.BatchChangeSchedule
+}
+
+func (r *batchChangeScheduleResolver) Schedule() string {
+	return r.schedule.Schedule
+}
+
+func (r *batchChangeScheduleResolver) NextRunAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.schedule.NextRunAt}
+}
+
+var _ graphqlbackend.BatchChangeScheduledRunResolver = &batchChangeScheduledRunResolver{}
+
+type batchChangeScheduledRunResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+
+	run *btypes.BatchChangeScheduledRun
+}
+
+func (r *batchChangeScheduledRunResolver) State() string {
+	return r.run.State.ToGraphQL()
+}
+
+func (r *batchChangeScheduledRunResolver) BatchSpec(ctx context.Context) (graphqlbackend.BatchSpecResolver, error) {
+	if r.run.BatchSpecID == 0 {
+		return nil, nil
+	}
+
+	spec, err := r.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: r.run.BatchSpecID})
+	if err != nil {
+		if err == store.ErrNoResults {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Like in the batch specs connection of a batch change, batch specs
+	// created from raw are only visible to their creator and site-admins.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.store.DatabaseDB()); err != nil && spec.UserID != actor.FromContext(ctx).UID {
+		return nil, nil
+	}
+
+	return &batchSpecResolver{store: r.store, gitserverClient: r.gitserverClient, batchSpec: spec}, nil
+}
+
+func (r *batchChangeScheduledRunResolver) FailureMessage() *string {
+	return r.run.FailureMessage
+}
+
+func (r *batchChangeScheduledRunResolver) StartedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.run.StartedAt}
+}
+
+func (r *batchChangeScheduledRunResolver) FinishedAt() *gqlutil.DateTime {
+	return gqlutil.FromTime(r.run.FinishedAt)
+}
+
+var _ graphqlbackend.BatchChangeScheduledRunConnectionResolver = &batchChangeScheduledRunConnectionResolver{}
+
+type batchChangeScheduledRunConnectionResolver struct {
+	store           *store.Store
+	gitserverClient gitserver.Client
+	opts            store.ListBatchChangeScheduledRunsOpts
+
+	// Cache results because they are used by multiple fields.
+	once sync.Once
+	runs []*btypes.BatchChangeScheduledRun
+	next int64
+	err  error
+}
+
+func (r *batchChangeScheduledRunConnectionResolver) Nodes(ctx context.Context) ([]graphqlbackend.BatchChangeScheduledRunResolver, error) {
+	runs, _, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.BatchChangeScheduledRunResolver, 0, len(runs))
+	for _, run := range runs {
+		resolvers = append(resolvers, &batchChangeScheduledRunResolver{store: r.store, gitserverClient: r.gitserverClient, run: run})
+	}
+	return resolvers, nil
+}
+
+func (r *batchChangeScheduledRunConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	count, err := r.store.CountBatchChangeScheduledRuns(ctx, r.opts)
+	return int32(count), err
+}
+
+func (r *batchChangeScheduledRunConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	_, next, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if next != 0 {
+		return graphqlutil.NextPageCursor(strconv.Itoa(int(next))), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
+
+func (r *batchChangeScheduledRunConnectionResolver) compute(ctx context.Context) ([]*btypes.BatchChangeScheduledRun, int64, error) {
+	r.once.Do(func() {
+		r.runs, r.next, r.err = r.store.ListBatchChangeScheduledRuns(ctx, r.opts)
+	})
+	return r.runs, r.next, r.err
+}
@@ -171,6 +171,7 @@ func (r *batchSpecWorkspaceResolver) computeStepResolvers() ([]graphqlbackend.Ba
 			if cachedResult, ok := r.workspace.StepCacheResult(idx + 1); ok {
 				resolver.skipped = true
 				resolver.cachedResult = cachedResult.Value
+				resolver.sharedCachedResultFound = cachedResult.Shared
 			} else if r.execution != nil {
 				e, ok := findExecutionLogEntry(r.execution, fmt.Sprintf("step.docker.step.%d.post", idx))
 				if ok {
@@ -252,6 +253,8 @@ func (r *batchSpecWorkspaceResolver) computeStepResolvers() ([]graphqlbackend.Ba
 		// See if we have a cache result for this step.
 		if cachedResult, ok := r.workspace.StepCacheResult(idx + 1); ok {
 			resolver.cachedResult = cachedResult.Value
+			si.CachedResultFound = true
+			si.SharedCachedResultFound = cachedResult.Shared
 		}
 
 		resolvers = append(resolvers, resolver)
@@ -526,6 +529,28 @@ func (r *batchSpecWorkspaceResolver) Executor(ctx context.Context) (*graphqlback
 	return graphqlbackend.NewExecutorResolver(e), nil
 }
 
+func (r *batchSpecWorkspaceResolver) Artifacts(ctx context.Context) ([]graphqlbackend.BatchSpecWorkspaceArtifactResolver, error) {
+	// Artifacts can contain content of the repository, so they're only
+	// returned for visible workspaces.
+	if _, ok := r.ToHiddenBatchSpecWorkspace(); ok {
+		return []graphqlbackend.BatchSpecWorkspaceArtifactResolver{}, nil
+	}
+
+	artifacts, err := r.store.ListBatchSpecWorkspaceArtifacts(ctx, store.ListBatchSpecWorkspaceArtifactsOpts{
+		BatchSpecWorkspaceIDs: []int64{r.workspace.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]graphqlbackend.BatchSpecWorkspaceArtifactResolver, 0, len(artifacts))
+	for _, a := range artifacts {
+		resolvers = append(resolvers, &batchSpecWorkspaceArtifactResolver{artifact: a})
+	}
+
+	return resolvers, nil
+}
+
 type batchSpecWorkspaceStagesResolver struct {
 	store     *store.Store
 	execution *btypes.BatchSpecWorkspaceExecutionJob
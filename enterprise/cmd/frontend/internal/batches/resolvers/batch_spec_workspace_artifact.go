@@ -0,0 +1,39 @@
+package resolvers
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+var _ graphqlbackend.BatchSpecWorkspaceArtifactResolver = &batchSpecWorkspaceArtifactResolver{}
+
+type batchSpecWorkspaceArtifactResolver struct {
+	artifact *btypes.BatchSpecWorkspaceArtifact
+}
+
+func (r *batchSpecWorkspaceArtifactResolver) Name() string {
+	return r.artifact.Name
+}
+
+func (r *batchSpecWorkspaceArtifactResolver) Path() string {
+	return r.artifact.Path
+}
+
+func (r *batchSpecWorkspaceArtifactResolver) Step() int32 {
+	return int32(r.artifact.StepIndex + 1)
+}
+
+func (r *batchSpecWorkspaceArtifactResolver) ByteSize() graphqlbackend.BigInt {
+	return graphqlbackend.BigInt(r.artifact.ByteSize)
+}
+
+func (r *batchSpecWorkspaceArtifactResolver) DownloadURL() string {
+	return fmt.Sprintf(
+		"/.api/files/batch-changes/workspaces/%s/artifacts/%s",
+		marshalBatchSpecWorkspaceID(r.artifact.BatchSpecWorkspaceID),
+		url.PathEscape(r.artifact.Name),
+	)
+}
@@ -55,6 +55,10 @@ func (r *batchSpecWorkspaceStepV1Resolver) CachedResultFound() bool {
 	return r.stepInfo.StartedAt.IsZero() && r.cachedResult != nil
 }
 
+func (r *batchSpecWorkspaceStepV1Resolver) SharedCachedResultFound() bool {
+	return r.CachedResultFound() && r.stepInfo.SharedCachedResultFound
+}
+
 func (r *batchSpecWorkspaceStepV1Resolver) Skipped() bool {
 	return r.CachedResultFound() || r.stepInfo.Skipped
 }
@@ -181,8 +185,9 @@ type batchSpecWorkspaceStepV2Resolver struct {
 	logEntry      executor.ExecutionLogEntry
 	logEntryFound bool
 
-	cachedResult      *execution.AfterStepResult
-	cachedResultFound bool
+	cachedResult            *execution.AfterStepResult
+	cachedResultFound       bool
+	sharedCachedResultFound bool
 }
 
 var _ graphqlbackend.BatchSpecWorkspaceStepResolver = &batchSpecWorkspaceStepV2Resolver{}
@@ -211,6 +216,10 @@ func (r *batchSpecWorkspaceStepV2Resolver) CachedResultFound() bool {
 	return r.cachedResultFound
 }
 
+func (r *batchSpecWorkspaceStepV2Resolver) SharedCachedResultFound() bool {
+	return r.sharedCachedResultFound
+}
+
 func (r *batchSpecWorkspaceStepV2Resolver) Skipped() bool {
 	return r.CachedResultFound() || r.skipped
 }
@@ -1615,6 +1615,129 @@ func TestMoveBatchChange(t *testing.T) {
 	})
 }
 
+func TestSetBatchChangeSchedule(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	user := bt.CreateTestUser(t, db, false)
+	userID := user.ID
+	assignBatchChangesWritePermissionToUser(ctx, t, db, userID)
+
+	otherUser := bt.CreateTestUser(t, db, false)
+	assignBatchChangesWritePermissionToUser(ctx, t, db, otherUser.ID)
+
+	bstore := store.New(db, &observation.TestContext, nil)
+
+	batchSpec := &btypes.BatchSpec{
+		RawSpec:         bt.TestRawBatchSpec,
+		UserID:          userID,
+		NamespaceUserID: userID,
+	}
+	if err := bstore.CreateBatchSpec(ctx, batchSpec); err != nil {
+		t.Fatal(err)
+	}
+
+	batchChange := &btypes.BatchChange{
+		BatchSpecID:     batchSpec.ID,
+		Name:            "scheduled",
+		CreatorID:       userID,
+		LastApplierID:   userID,
+		LastAppliedAt:   time.Now(),
+		NamespaceUserID: userID,
+	}
+	if err := bstore.CreateBatchChange(ctx, batchChange); err != nil {
+		t.Fatal(err)
+	}
+
+	run := &btypes.BatchChangeScheduledRun{
+		BatchChangeID: batchChange.ID,
+		BatchSpecID:   batchSpec.ID,
+		State:         btypes.BatchChangeScheduledRunStateUnchanged,
+		FinishedAt:    time.Now(),
+	}
+	if err := bstore.CreateBatchChangeScheduledRun(ctx, run); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Resolver{store: bstore}
+	s, err := newSchema(db, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := map[string]any{
+		"batchChange": string(bgql.MarshalBatchChangeID(batchChange.ID)),
+		"schedule":    "0 3 * * 1",
+	}
+
+	t.Run("not the last applier", func(t *testing.T) {
+		var response struct{ SetBatchChangeSchedule apitest.BatchChange }
+		actorCtx := actor.WithActor(ctx, actor.FromUser(otherUser.ID))
+		errs := apitest.Exec(actorCtx, t, s, input, &response, mutationSetBatchChangeSchedule)
+		if errs == nil {
+			t.Fatal("expected error")
+		}
+	})
+
+	t.Run("last applier", func(t *testing.T) {
+		var response struct{ SetBatchChangeSchedule apitest.BatchChange }
+		actorCtx := actor.WithActor(ctx, actor.FromUser(userID))
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationSetBatchChangeSchedule)
+
+		have := response.SetBatchChangeSchedule
+		if have.Schedule == nil {
+			t.Fatal("expected schedule to be set")
+		}
+		if diff := cmp.Diff(input["schedule"], have.Schedule.Schedule); diff != "" {
+			t.Fatalf("unexpected schedule (-want +got):\n%s", diff)
+		}
+
+		wantRuns := apitest.BatchChangeScheduledRunConnection{
+			Nodes: []apitest.BatchChangeScheduledRun{{
+				State:      "UNCHANGED",
+				BatchSpec:  &apitest.BatchSpec{ID: string(marshalBatchSpecRandID(batchSpec.RandID))},
+				StartedAt:  run.StartedAt.Format(time.RFC3339),
+				FinishedAt: run.FinishedAt.Format(time.RFC3339),
+			}},
+			TotalCount: 1,
+		}
+		if diff := cmp.Diff(wantRuns, have.ScheduledRuns); diff != "" {
+			t.Fatalf("unexpected runs (-want +got):\n%s", diff)
+		}
+
+		// Remove the schedule again.
+		input["schedule"] = nil
+		apitest.MustExec(actorCtx, t, s, input, &response, mutationSetBatchChangeSchedule)
+		if response.SetBatchChangeSchedule.Schedule != nil {
+			t.Fatalf("expected schedule to be removed, got %+v", response.SetBatchChangeSchedule.Schedule)
+		}
+	})
+}
+
+const mutationSetBatchChangeSchedule = `
+mutation($batchChange: ID!, $schedule: String){
+  setBatchChangeSchedule(batchChange: $batchChange, schedule: $schedule) {
+    id
+    schedule { schedule, nextRunAt }
+    scheduledRuns {
+      nodes {
+        state
+        batchSpec { id }
+        failureMessage
+        startedAt
+        finishedAt
+      }
+      totalCount
+    }
+  }
+}
+`
+
 const mutationMoveBatchChange = `
 fragment u on User { id, databaseID, siteAdmin }
 fragment o on Org  { id, name }
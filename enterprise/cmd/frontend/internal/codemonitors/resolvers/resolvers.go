@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/graph-gophers/graphql-go"
@@ -21,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 // NewResolver returns a new Resolver that uses the given database
@@ -124,6 +126,14 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		return nil, err
 	}
 
+	mode := edb.QueryTriggerModeCommit
+	if args.Trigger.Mode != nil {
+		mode, err = queryTriggerMode(*args.Trigger.Mode)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Start transaction.
 	var newMonitor *edb.Monitor
 	err = r.withTransact(ctx, func(tx *Resolver) error {
@@ -144,12 +154,24 @@ func (r *Resolver) CreateCodeMonitor(ctx context.Context, args *graphqlbackend.C
 		}
 
 		// Create trigger.
-		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query)
+		_, err = tx.db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, args.Trigger.Query, mode)
 		if err != nil {
 			return err
 		}
 
-		if featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
+		if mode == edb.QueryTriggerModeContent {
+			settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, tx.db)
+			if err != nil {
+				return err
+			}
+
+			// Snapshot the lines currently matched so that only later changes
+			// trigger the monitor.
+			err = r.snapshotContent(ctx, tx.db.CodeMonitors(), args.Trigger.Query, m.ID, settings)
+			if err != nil {
+				return err
+			}
+		} else if featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
 			settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, tx.db)
 			if err != nil {
 				return err
@@ -299,15 +321,24 @@ func (r *Resolver) createActions(ctx context.Context, monitorID int64, args []*g
 			if err != nil {
 				return err
 			}
+		case a.HTTPAction != nil:
+			httpActionArgs, err := httpActionArgs(a.HTTPAction)
+			if err != nil {
+				return err
+			}
+			_, err = r.db.CodeMonitors().CreateHTTPAction(ctx, monitorID, httpActionArgs)
+			if err != nil {
+				return err
+			}
 		default:
-			return errors.New("exactly one of Email, Webhook, or SlackWebhook must be set")
+			return errors.New("exactly one of Email, Webhook, SlackWebhook, or HTTPAction must be set")
 		}
 	}
 	return nil
 }
 
 func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []graphql.ID) error {
-	var email, webhook, slackWebhook []int64
+	var email, webhook, slackWebhook, httpAction []int64
 	for _, id := range ids {
 		var intID int64
 		err := relay.UnmarshalSpec(id, &intID)
@@ -322,8 +353,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 			webhook = append(webhook, intID)
 		case monitorActionSlackWebhookKind:
 			slackWebhook = append(slackWebhook, intID)
+		case monitorActionHTTPActionKind:
+			httpAction = append(httpAction, intID)
 		default:
-			return errors.New("action IDs must be exactly one of email, webhook, or slack webhook")
+			return errors.New("action IDs must be exactly one of email, webhook, slack webhook, or HTTP action")
 		}
 	}
 
@@ -339,6 +372,10 @@ func (r *Resolver) deleteActions(ctx context.Context, monitorID int64, ids []gra
 		return err
 	}
 
+	if err := r.db.CodeMonitors().DeleteHTTPActions(ctx, monitorID, httpAction...); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -450,7 +487,11 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	if err != nil {
 		return nil, err
 	}
-	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions))
+	httpActions, err := r.db.CodeMonitors().ListHTTPActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]graphql.ID, 0, len(emailActions)+len(webhookActions)+len(slackWebhookActions)+len(httpActions))
 	for _, emailAction := range emailActions {
 		ids = append(ids, (&monitorEmail{EmailAction: emailAction}).ID())
 	}
@@ -460,6 +501,9 @@ func (r *Resolver) actionIDsForMonitorIDInt64(ctx context.Context, monitorID int
 	for _, slackWebhookAction := range slackWebhookActions {
 		ids = append(ids, (&monitorSlackWebhook{SlackWebhookAction: slackWebhookAction}).ID())
 	}
+	for _, httpAction := range httpActions {
+		ids = append(ids, (&monitorHTTPAction{HTTPAction: httpAction}).ID())
+	}
 	return ids, nil
 }
 
@@ -504,6 +548,16 @@ func splitActionIDs(args *graphqlbackend.UpdateCodeMonitorArgs, actionIDs []grap
 			}
 			toUpdateActions = append(toUpdateActions, a)
 			delete(aMap, *a.SlackWebhook.Id)
+		case a.HTTPAction != nil:
+			if a.HTTPAction.Id == nil {
+				toCreate = append(toCreate, &graphqlbackend.CreateActionArgs{HTTPAction: a.HTTPAction.Update})
+				continue
+			}
+			if _, ok := aMap[*a.HTTPAction.Id]; !ok {
+				return nil, nil, errors.Errorf("unknown ID=%s for action", *a.HTTPAction.Id)
+			}
+			toUpdateActions = append(toUpdateActions, a)
+			delete(aMap, *a.HTTPAction.Id)
 		}
 	}
 
@@ -541,15 +595,38 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 		return nil, err
 	}
 
-	if featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
-		currentTrigger, err := r.db.CodeMonitors().GetQueryTriggerForMonitor(ctx, monitorID)
+	currentTrigger, err := r.db.CodeMonitors().GetQueryTriggerForMonitor(ctx, monitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	mode := currentTrigger.Mode
+	if args.Trigger.Update.Mode != nil {
+		mode, err = queryTriggerMode(*args.Trigger.Update.Mode)
 		if err != nil {
 			return nil, err
 		}
+	}
+	triggerChanged := currentTrigger.QueryString != args.Trigger.Update.Query || currentTrigger.Mode != mode
+
+	if mode == edb.QueryTriggerModeContent {
+		// When the query is changed, take a new snapshot of the lines that are
+		// currently matched so we know where to start.
+		if triggerChanged {
+			settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, r.db)
+			if err != nil {
+				return nil, err
+			}
 
+			err = r.snapshotContent(ctx, r.db.CodeMonitors(), args.Trigger.Update.Query, monitorID, settings)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else if featureflag.FromContext(ctx).GetBoolOr("cc-repo-aware-monitors", true) {
 		// When the query is changed, take a new snapshot of the commits that currently
 		// exist so we know where to start.
-		if currentTrigger.QueryString != args.Trigger.Update.Query {
+		if triggerChanged {
 			settings, err := graphqlbackend.DecodedViewerFinalSettings(ctx, r.db)
 			if err != nil {
 				return nil, err
@@ -565,7 +642,7 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 	}
 
 	// Update trigger.
-	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query)
+	err = r.db.CodeMonitors().UpdateQueryTrigger(ctx, triggerID, args.Trigger.Update.Query, mode)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +665,10 @@ func (r *Resolver) updateCodeMonitor(ctx context.Context, args *graphqlbackend.U
 				return nil, err
 			}
 			err = r.updateSlackWebhookAction(ctx, *action.SlackWebhook)
+		case action.HTTPAction != nil:
+			err = r.updateHTTPAction(ctx, *action.HTTPAction)
 		default:
-			err = errors.New("action must be one of email, webhook, or slack webhook")
+			err = errors.New("action must be one of email, webhook, slack webhook, or HTTP action")
 		}
 		if err != nil {
 			return nil, err
@@ -645,6 +724,32 @@ func (r *Resolver) updateSlackWebhookAction(ctx context.Context, args graphqlbac
 	return err
 }
 
+func (r *Resolver) updateHTTPAction(ctx context.Context, args graphqlbackend.EditActionHTTPActionArgs) error {
+	var id int64
+	err := relay.UnmarshalSpec(*args.Id, &id)
+	if err != nil {
+		return err
+	}
+
+	httpActionArgs, err := httpActionArgs(args.Update)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.CodeMonitors().UpdateHTTPAction(ctx, id, httpActionArgs)
+	return err
+}
+
+// snapshotContent saves the lines currently matched by the query of a content
+// mode monitor to store. The search itself doesn't run in the transaction store
+// may be part of, because transactions cannot be used concurrently.
+func (r *Resolver) snapshotContent(ctx context.Context, store edb.CodeMonitorStore, query string, monitorID int64, settings *schema.Settings) error {
+	snapshot, err := codemonitors.SearchContent(ctx, r.logger, r.db, r.enterpriseJobs, query, settings)
+	if err != nil {
+		return err
+	}
+	return store.SetContentSnapshot(ctx, monitorID, snapshot)
+}
+
 func (r *Resolver) withTransact(ctx context.Context, f func(*Resolver) error) error {
 	return r.db.WithTransact(ctx, func(tx database.DB) error {
 		return f(&Resolver{
@@ -729,12 +834,26 @@ const (
 	monitorActionEmailKind             = "CodeMonitorActionEmail"
 	monitorActionWebhookKind           = "CodeMonitorActionWebhook"
 	monitorActionSlackWebhookKind      = "CodeMonitorActionSlackWebhook"
+	monitorActionHTTPActionKind        = "CodeMonitorActionHTTPAction"
 	monitorActionEmailEventKind        = "CodeMonitorActionEmailEvent"
 	monitorActionWebhookEventKind      = "CodeMonitorActionWebhookEvent"
 	monitorActionSlackWebhookEventKind = "CodeMonitorActionSlackWebhookEvent"
 	monitorActionEmailRecipientKind    = "CodeMonitorActionEmailRecipient"
 )
 
+// queryTriggerMode maps the GraphQL mode of a query trigger to the mode stored
+// in the database.
+func queryTriggerMode(mode string) (edb.QueryTriggerMode, error) {
+	switch mode {
+	case "COMMIT":
+		return edb.QueryTriggerModeCommit, nil
+	case "CONTENT":
+		return edb.QueryTriggerModeContent, nil
+	default:
+		return "", errors.Errorf("unknown query trigger mode %q", mode)
+	}
+}
+
 func unmarshalMonitorID(id graphql.ID) (int64, error) {
 	if kind := relay.UnmarshalKind(id); kind != MonitorKind {
 		return 0, errors.Errorf("expected graphql ID kind %s, got %s", MonitorKind, kind)
@@ -824,7 +943,12 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 		return nil, err
 	}
 
-	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws))
+	has, err := r.db.CodeMonitors().ListHTTPActions(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]graphqlbackend.MonitorAction, 0, len(es)+len(ws)+len(sws)+len(has))
 	for _, e := range es {
 		actions = append(actions, &action{
 			email: &monitorEmail{
@@ -852,6 +976,15 @@ func (r *Resolver) actionConnectionResolverWithTriggerID(ctx context.Context, tr
 			},
 		})
 	}
+	for _, ha := range has {
+		actions = append(actions, &action{
+			httpAction: &monitorHTTPAction{
+				Resolver:       r,
+				HTTPAction:     ha,
+				triggerEventID: triggerEventID,
+			},
+		})
+	}
 
 	totalCount := len(actions)
 	if args.After != nil {
@@ -893,6 +1026,10 @@ func (q *monitorQuery) Query() string {
 	return q.QueryString
 }
 
+func (q *monitorQuery) Mode() string {
+	return strings.ToUpper(string(q.QueryTrigger.Mode))
+}
+
 func (q *monitorQuery) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorTriggerEventConnectionResolver, error) {
 	after, err := unmarshalAfter(args.After)
 	if err != nil {
@@ -980,6 +1117,7 @@ func (m *monitorTriggerEvent) ResultCount() int32 {
 	for _, cm := range m.TriggerJob.SearchResults {
 		count += cm.ResultCount()
 	}
+	count += len(m.TriggerJob.ContentChanges)
 	return int32(count)
 }
 
@@ -1028,6 +1166,7 @@ type action struct {
 	email        graphqlbackend.MonitorEmailResolver
 	webhook      graphqlbackend.MonitorWebhookResolver
 	slackWebhook graphqlbackend.MonitorSlackWebhookResolver
+	httpAction   graphqlbackend.MonitorHTTPActionResolver
 }
 
 func (a *action) ID() graphql.ID {
@@ -1038,6 +1177,8 @@ func (a *action) ID() graphql.ID {
 		return a.webhook.ID()
 	case a.slackWebhook != nil:
 		return a.slackWebhook.ID()
+	case a.httpAction != nil:
+		return a.httpAction.ID()
 	default:
 		panic("action must have a type")
 	}
@@ -1055,6 +1196,10 @@ func (a *action) ToMonitorSlackWebhook() (graphqlbackend.MonitorSlackWebhookReso
 	return a.slackWebhook, a.slackWebhook != nil
 }
 
+func (a *action) ToMonitorHTTPAction() (graphqlbackend.MonitorHTTPActionResolver, bool) {
+	return a.httpAction, a.httpAction != nil
+}
+
 // Email
 type monitorEmail struct {
 	*Resolver
@@ -1270,6 +1415,82 @@ func (m *monitorSlackWebhook) Events(ctx context.Context, args *graphqlbackend.L
 	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
 }
 
+type monitorHTTPAction struct {
+	*Resolver
+	*edb.HTTPAction
+
+	// If triggerEventID == nil, all events of this action will be returned.
+	// Otherwise, only those events of this action which are related to the specified
+	// trigger event will be returned.
+	triggerEventID *int32
+}
+
+func (m *monitorHTTPAction) ID() graphql.ID {
+	return relay.MarshalID(monitorActionHTTPActionKind, m.HTTPAction.ID)
+}
+
+func (m *monitorHTTPAction) Enabled() bool {
+	return m.HTTPAction.Enabled
+}
+
+func (m *monitorHTTPAction) IncludeResults() bool {
+	return m.HTTPAction.IncludeResults
+}
+
+func (m *monitorHTTPAction) URL() string {
+	return m.HTTPAction.URL
+}
+
+func (m *monitorHTTPAction) Preset() string {
+	return strings.ToUpper(string(m.HTTPAction.Preset))
+}
+
+func (m *monitorHTTPAction) PayloadTemplate() string {
+	return m.HTTPAction.PayloadTemplate
+}
+
+func (m *monitorHTTPAction) RoutingKey() string {
+	return m.HTTPAction.RoutingKey
+}
+
+func (m *monitorHTTPAction) MaxRetries() int32 {
+	return m.HTTPAction.MaxRetries
+}
+
+func (m *monitorHTTPAction) RetryBackoffSeconds() int32 {
+	return int32(m.HTTPAction.RetryBackoff / time.Second)
+}
+
+func (m *monitorHTTPAction) Events(ctx context.Context, args *graphqlbackend.ListEventsArgs) (graphqlbackend.MonitorActionEventConnectionResolver, error) {
+	after, err := unmarshalAfter(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	ajs, err := m.db.CodeMonitors().ListActionJobs(ctx, edb.ListActionJobsOpts{
+		HTTPActionID:   intPtr(int(m.HTTPAction.ID)),
+		TriggerEventID: m.triggerEventID,
+		First:          intPtr(int(args.First)),
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totalCount, err := m.db.CodeMonitors().CountActionJobs(ctx, edb.ListActionJobsOpts{
+		HTTPActionID:   intPtr(int(m.HTTPAction.ID)),
+		TriggerEventID: m.triggerEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]graphqlbackend.MonitorActionEventResolver, len(ajs))
+	for i, aj := range ajs {
+		events[i] = &monitorActionEvent{Resolver: m.Resolver, ActionJob: aj}
+	}
+	return &monitorActionEventConnection{events: events, totalCount: int32(totalCount)}, nil
+}
+
 func intPtr(i int) *int { return &i }
 func intPtrToInt64Ptr(i *int) *int64 {
 	if i == nil {
@@ -1363,3 +1584,61 @@ func validateSlackURL(urlString string) error {
 	}
 	return nil
 }
+
+const (
+	maxHTTPActionRetries             = 10
+	maxHTTPActionRetryBackoffSeconds = 30
+)
+
+// httpActionArgs validates the GraphQL input of an HTTP action, and maps it to
+// the arguments stored in the database.
+func httpActionArgs(args *graphqlbackend.CreateActionHTTPActionArgs) (*edb.HTTPActionArgs, error) {
+	a := &edb.HTTPActionArgs{
+		Enabled:         args.Enabled,
+		IncludeResults:  args.IncludeResults,
+		URL:             args.URL,
+		PayloadTemplate: args.PayloadTemplate,
+		RoutingKey:      args.RoutingKey,
+		MaxRetries:      args.MaxRetries,
+		RetryBackoff:    time.Duration(args.RetryBackoffSeconds) * time.Second,
+	}
+
+	switch args.Preset {
+	case "", "CUSTOM":
+		a.Preset = edb.HTTPActionPresetCustom
+		if strings.TrimSpace(args.PayloadTemplate) == "" {
+			return nil, errors.New("HTTP actions without a preset must set a payload template")
+		}
+		if err := background.ValidateHTTPActionTemplate(args.PayloadTemplate); err != nil {
+			return nil, errors.Wrap(err, "invalid payload template")
+		}
+	case "TEAMS":
+		a.Preset = edb.HTTPActionPresetTeams
+	case "PAGERDUTY":
+		a.Preset = edb.HTTPActionPresetPagerDuty
+		if args.RoutingKey == "" {
+			return nil, errors.New("PagerDuty HTTP actions must set a routing key")
+		}
+	default:
+		return nil, errors.Errorf("unknown HTTP action preset %q", args.Preset)
+	}
+
+	// PagerDuty actions default to the PagerDuty Events API.
+	if a.URL != "" || a.Preset != edb.HTTPActionPresetPagerDuty {
+		u, err := url.Parse(a.URL)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme != "https" && u.Scheme != "http" || u.Host == "" {
+			return nil, errors.New("HTTP action URL must be an absolute http or https URL")
+		}
+	}
+
+	if args.MaxRetries < 0 || args.MaxRetries > maxHTTPActionRetries {
+		return nil, errors.Errorf("HTTP action max retries must be between 0 and %d", maxHTTPActionRetries)
+	}
+	if args.RetryBackoffSeconds < 0 || args.RetryBackoffSeconds > maxHTTPActionRetryBackoffSeconds {
+		return nil, errors.Errorf("HTTP action retry backoff must be between 0 and %d seconds", maxHTTPActionRetryBackoffSeconds)
+	}
+	return a, nil
+}
@@ -1323,3 +1323,32 @@ func TestValidateSlackURL(t *testing.T) {
 		require.Error(t, validateSlackURL(url))
 	}
 }
+
+func TestHTTPActionArgs(t *testing.T) {
+	valid := []*graphqlbackend.CreateActionHTTPActionArgs{
+		{URL: "https://oncall.example.com/hooks", Preset: "CUSTOM", PayloadTemplate: `{"text": {{ json .Summary }}}`, MaxRetries: 3, RetryBackoffSeconds: 10},
+		{URL: "https://example.webhook.office.com/webhookb2/abc", Preset: "TEAMS"},
+		{Preset: "PAGERDUTY", RoutingKey: "routing-key"},
+	}
+
+	for _, args := range valid {
+		_, err := httpActionArgs(args)
+		require.NoError(t, err)
+	}
+
+	invalid := []*graphqlbackend.CreateActionHTTPActionArgs{
+		{URL: "https://oncall.example.com/hooks", Preset: "CUSTOM"},
+		{URL: "https://oncall.example.com/hooks", Preset: "CUSTOM", PayloadTemplate: `{{ .Summary`},
+		{URL: "oncall.example.com/hooks", Preset: "TEAMS"},
+		{Preset: "TEAMS"},
+		{Preset: "PAGERDUTY"},
+		{URL: "https://example.webhook.office.com/webhookb2/abc", Preset: "OPSGENIE"},
+		{URL: "https://example.webhook.office.com/webhookb2/abc", Preset: "TEAMS", MaxRetries: 11},
+		{URL: "https://example.webhook.office.com/webhookb2/abc", Preset: "TEAMS", RetryBackoffSeconds: -1},
+	}
+
+	for _, args := range invalid {
+		_, err := httpActionArgs(args)
+		require.Error(t, err)
+	}
+}
@@ -28,5 +28,8 @@ func Init(
 	enterpriseServices.NewComputeStreamHandler = func() http.Handler {
 		return streaming.NewComputeStreamHandler(logger, db, enterpriseServices.EnterpriseSearchJobs)
 	}
+	enterpriseServices.NewComputeExportHandler = func() http.Handler {
+		return streaming.NewComputeExportHandler(logger, db, enterpriseServices.EnterpriseSearchJobs)
+	}
 	return nil
 }
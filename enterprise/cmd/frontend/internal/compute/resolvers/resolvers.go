@@ -3,6 +3,7 @@ package resolvers
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/inconshreveable/log15"
 	"github.com/sourcegraph/log"
@@ -169,6 +170,11 @@ func toComputeResultResolver(result compute.Result, repoResolver *gql.Repository
 		return &computeResultResolver{result: toComputeMatchContextResolver(r, repoResolver, path, commit)}
 	case *compute.Text:
 		return &computeResultResolver{result: toComputeTextResolver(r, repoResolver, path, commit)}
+	case *compute.AggregateValues:
+		// The GraphQL API returns results per match, so the values are not
+		// grouped. Grouped values are returned by the export endpoint.
+		t := &compute.Text{Value: strings.Join(r.Values, "\n"), Kind: "aggregate"}
+		return &computeResultResolver{result: toComputeTextResolver(t, repoResolver, path, commit)}
 	default:
 		panic(fmt.Sprintf("unsupported compute result %T", r))
 	}
@@ -0,0 +1,120 @@
+package streaming
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/compute"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// NewComputeExportHandler is an http handler which runs a compute query to
+// completion, and responds with all its results as a single CSV or JSON
+// document.
+func NewComputeExportHandler(logger log.Logger, db database.DB, enterpriseJobs jobutil.EnterpriseJobs) http.Handler {
+	return &exportHandler{
+		logger:         logger,
+		db:             db,
+		enterpriseJobs: enterpriseJobs,
+	}
+}
+
+type exportHandler struct {
+	logger         log.Logger
+	db             database.DB
+	enterpriseJobs jobutil.EnterpriseJobs
+}
+
+const (
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), maxRequestDuration)
+	defer cancel()
+
+	args, err := parseExportURLQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tr, ctx := trace.New(ctx, "compute.ServeExport", args.Query)
+	defer func() {
+		tr.SetError(err)
+		tr.Finish()
+	}()
+
+	computeQuery, err := compute.Parse(args.Query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	searchQuery, err := computeQuery.ToSearchQuery()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	exporter := compute.NewExporter(computeQuery.Command)
+	events, getResults := NewComputeStream(ctx, h.logger, h.db, h.enterpriseJobs, searchQuery, computeQuery.Command)
+	for event := range events {
+		for _, result := range event.Results {
+			exporter.Add(result)
+		}
+	}
+	if _, err = getResults(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Unlike the streaming endpoint, incomplete data can't be flagged in the
+	// document, so running out of time is an error.
+	if err = ctx.Err(); err != nil {
+		http.Error(w, "compute query did not complete in time: "+err.Error(), http.StatusGatewayTimeout)
+		return
+	}
+
+	switch args.Format {
+	case exportFormatCSV:
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="compute.csv"`)
+		err = exporter.WriteCSV(w)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		err = exporter.WriteJSON(w)
+	}
+	if err != nil {
+		h.logger.Warn("failed to write compute export", log.Error(err))
+	}
+}
+
+type exportArgs struct {
+	Query  string
+	Format string
+}
+
+func parseExportURLQuery(q url.Values) (*exportArgs, error) {
+	a := exportArgs{
+		Query:  q.Get("q"),
+		Format: q.Get("format"),
+	}
+	if a.Query == "" {
+		return nil, errors.New("no query found")
+	}
+	switch a.Format {
+	case "":
+		a.Format = exportFormatJSON
+	case exportFormatCSV, exportFormatJSON:
+	default:
+		return nil, errors.Errorf("format must be %q or %q, got %q", exportFormatCSV, exportFormatJSON, a.Format)
+	}
+	return &a, nil
+}
@@ -0,0 +1,75 @@
+package deadletter
+
+import (
+	"context"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/enterprise"
+	"github.com/sourcegraph/sourcegraph/enterprise/cmd/frontend/internal/deadletter/resolvers"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/autoindexing"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codeintel/uploads"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/codemonitors/background"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/contextdetection"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/background/queryrunner"
+	"github.com/sourcegraph/sourcegraph/internal/conf/conftypes"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/repos"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/deadletter"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// Init initializes the given enterpriseServices to include the resolvers for inspecting
+// and retrying the failed records of background worker queues.
+func Init(
+	ctx context.Context,
+	observationCtx *observation.Context,
+	db database.DB,
+	_ codeintel.Services,
+	_ conftypes.UnifiedWatchable,
+	enterpriseServices *enterprise.Services,
+) error {
+	// Only queues whose failed records can be requeued, canceled, and deleted without
+	// further bookkeeping are registered here. The batch changes reconciler is left out,
+	// as its records are the changesets themselves, and so are the code insights
+	// backfill and retention queues, which live in the code insights database.
+	svc := deadletter.NewService(
+		observationCtx,
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), autoindexing.IndexWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), autoindexing.DependencySyncingJobWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), autoindexing.DependencyIndexingJobWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), uploads.UploadWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), repo.RepoEmbeddingJobWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), contextdetection.ContextDetectionEmbeddingJobWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), store.BatchSpecResolutionWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), store.BatchSpecWorkspaceExecutionWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), store.BulkOperationWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), background.TriggerJobsWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), background.ActionJobsWorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), queryrunner.WorkerStoreOptions),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), repos.SyncWorkerStoreOptions),
+
+		// The stores of the following queues are built in the packages of the services
+		// running their workers, which cannot be imported here. A failed record store only
+		// needs the name and table of a queue.
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), dbworkerstore.Options[*database.PermissionSyncJob]{
+			// Shared by the repo and user permissions sync workers.
+			Name:      "permissions_sync_job_worker_store",
+			TableName: "permission_sync_jobs",
+		}),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), dbworkerstore.Options[*types.BitbucketProjectPermissionJob]{
+			Name:      "explicit_permissions_bitbucket_projects_jobs_store",
+			TableName: "explicit_permissions_bitbucket_projects_jobs",
+		}),
+		dbworkerstore.NewFailedRecordStore(observationCtx, db.Handle(), dbworkerstore.Options[*types.OutboundWebhookJob]{
+			Name:      "outbound_webhooks_worker_store",
+			TableName: "outbound_webhook_jobs",
+		}),
+	)
+
+	enterpriseServices.DeadLetterQueuesResolver = resolvers.New(db, svc)
+	return nil
+}
@@ -0,0 +1,244 @@
+package resolvers
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	gql "github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend/graphqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/deadletter"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const defaultFailedRecordsPageSize = 50
+
+type Resolver struct {
+	db  database.DB
+	svc *deadletter.Service
+}
+
+func New(db database.DB, svc *deadletter.Service) gql.DeadLetterQueuesResolver {
+	return &Resolver{db: db, svc: svc}
+}
+
+func (r *Resolver) DeadLetterQueues(ctx context.Context) ([]gql.DeadLetterQueueResolver, error) {
+	// 🚨 SECURITY: Only site admins may inspect failed worker records.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	var resolvers []gql.DeadLetterQueueResolver
+	for _, name := range r.svc.Queues() {
+		resolvers = append(resolvers, &deadLetterQueueResolver{db: r.db, svc: r.svc, name: name})
+	}
+
+	return resolvers, nil
+}
+
+func (r *Resolver) DeadLetterQueue(ctx context.Context, args *gql.DeadLetterQueueArgs) (gql.DeadLetterQueueResolver, error) {
+	// 🚨 SECURITY: Only site admins may inspect failed worker records.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return nil, err
+	}
+
+	if !r.svc.HasQueue(args.Name) {
+		return nil, nil
+	}
+
+	return &deadLetterQueueResolver{db: r.db, svc: r.svc, name: args.Name}, nil
+}
+
+func (r *Resolver) RequeueFailedWorkerRecords(ctx context.Context, args *gql.BulkFailedWorkerRecordsArgs) (int32, error) {
+	return r.updateFailedWorkerRecords(ctx, args, r.svc.Requeue)
+}
+
+func (r *Resolver) CancelFailedWorkerRecords(ctx context.Context, args *gql.BulkFailedWorkerRecordsArgs) (int32, error) {
+	return r.updateFailedWorkerRecords(ctx, args, r.svc.Cancel)
+}
+
+func (r *Resolver) PurgeFailedWorkerRecords(ctx context.Context, args *gql.BulkFailedWorkerRecordsArgs) (int32, error) {
+	return r.updateFailedWorkerRecords(ctx, args, r.svc.Purge)
+}
+
+func (r *Resolver) updateFailedWorkerRecords(
+	ctx context.Context,
+	args *gql.BulkFailedWorkerRecordsArgs,
+	update func(ctx context.Context, queue string, filter dbworkerstore.FailedRecordFilter) (int, error),
+) (int32, error) {
+	// 🚨 SECURITY: Only site admins may update failed worker records.
+	if err := auth.CheckCurrentUserIsSiteAdmin(ctx, r.db); err != nil {
+		return 0, err
+	}
+
+	count, err := update(ctx, args.Queue, toFailedRecordFilter(args.Filter))
+	if err != nil {
+		return 0, err
+	}
+
+	return int32(count), nil
+}
+
+type deadLetterQueueResolver struct {
+	db   database.DB
+	svc  *deadletter.Service
+	name string
+}
+
+func (r *deadLetterQueueResolver) Name() string {
+	return r.name
+}
+
+func (r *deadLetterQueueResolver) FailedRecords(ctx context.Context, args *gql.ListFailedWorkerRecordsArgs) (gql.FailedWorkerRecordConnectionResolver, error) {
+	opts := dbworkerstore.ListFailedRecordsOptions{
+		FailedRecordFilter: toFailedRecordFilter(args.Filter),
+		Limit:              defaultFailedRecordsPageSize,
+	}
+	if args.First != nil {
+		opts.Limit = int(*args.First)
+	}
+	if opts.Limit <= 0 {
+		return nil, errors.New("first must be positive")
+	}
+	if args.After != nil {
+		offset, err := strconv.Atoi(*args.After)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid cursor")
+		}
+		opts.Offset = offset
+	}
+
+	return &failedWorkerRecordConnectionResolver{
+		db:    r.db,
+		svc:   r.svc,
+		queue: r.name,
+		opts:  opts,
+	}, nil
+}
+
+type failedWorkerRecordConnectionResolver struct {
+	db    database.DB
+	svc   *deadletter.Service
+	queue string
+	opts  dbworkerstore.ListFailedRecordsOptions
+
+	// cache results because they are used by multiple fields
+	once       sync.Once
+	records    []dbworkerstore.FailedRecord
+	totalCount int
+	err        error
+}
+
+func (r *failedWorkerRecordConnectionResolver) Nodes(ctx context.Context) ([]gql.FailedWorkerRecordResolver, error) {
+	records, _, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]gql.FailedWorkerRecordResolver, 0, len(records))
+	for _, record := range records {
+		resolvers = append(resolvers, &failedWorkerRecordResolver{db: r.db, queue: r.queue, record: record})
+	}
+
+	return resolvers, nil
+}
+
+func (r *failedWorkerRecordConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	_, totalCount, err := r.compute(ctx)
+	return int32(totalCount), err
+}
+
+func (r *failedWorkerRecordConnectionResolver) PageInfo(ctx context.Context) (*graphqlutil.PageInfo, error) {
+	records, totalCount, err := r.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if next := r.opts.Offset + len(records); next < totalCount {
+		return graphqlutil.NextPageCursor(strconv.Itoa(next)), nil
+	}
+	return graphqlutil.HasNextPage(false), nil
+}
+
+func (r *failedWorkerRecordConnectionResolver) compute(ctx context.Context) ([]dbworkerstore.FailedRecord, int, error) {
+	r.once.Do(func() {
+		r.records, r.totalCount, r.err = r.svc.ListFailed(ctx, r.queue, r.opts)
+	})
+	return r.records, r.totalCount, r.err
+}
+
+type failedWorkerRecordResolver struct {
+	db     database.DB
+	queue  string
+	record dbworkerstore.FailedRecord
+}
+
+func (r *failedWorkerRecordResolver) RecordID() int32 {
+	return int32(r.record.ID)
+}
+
+func (r *failedWorkerRecordResolver) Queue() string {
+	return r.queue
+}
+
+func (r *failedWorkerRecordResolver) FailureMessage() *string {
+	return r.record.FailureMessage
+}
+
+func (r *failedWorkerRecordResolver) QueuedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.record.QueuedAt}
+}
+
+func (r *failedWorkerRecordResolver) StartedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.record.StartedAt)
+}
+
+func (r *failedWorkerRecordResolver) FailedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.record.FinishedAt)
+}
+
+func (r *failedWorkerRecordResolver) NumResets() int32 {
+	return int32(r.record.NumResets)
+}
+
+func (r *failedWorkerRecordResolver) NumFailures() int32 {
+	return int32(r.record.NumFailures)
+}
+
+func (r *failedWorkerRecordResolver) ExecutionLogs() []gql.ExecutionLogEntryResolver {
+	resolvers := make([]gql.ExecutionLogEntryResolver, 0, len(r.record.ExecutionLogs))
+	for _, entry := range r.record.ExecutionLogs {
+		resolvers = append(resolvers, gql.NewExecutionLogEntryResolver(r.db, entry))
+	}
+
+	return resolvers
+}
+
+func toFailedRecordFilter(filter *gql.FailedWorkerRecordsFilter) dbworkerstore.FailedRecordFilter {
+	if filter == nil {
+		return dbworkerstore.FailedRecordFilter{}
+	}
+
+	var opts dbworkerstore.FailedRecordFilter
+	if filter.IDs != nil {
+		opts.IDs = make([]int, 0, len(*filter.IDs))
+		for _, id := range *filter.IDs {
+			opts.IDs = append(opts.IDs, int(id))
+		}
+	}
+	if filter.FailureMessage != nil {
+		opts.FailureMessage = *filter.FailureMessage
+	}
+	if filter.FailedBefore != nil {
+		opts.FailedBefore = &filter.FailedBefore.Time
+	}
+	if filter.FailedAfter != nil {
+		opts.FailedAfter = &filter.FailedAfter.Time
+	}
+
+	return opts
+}
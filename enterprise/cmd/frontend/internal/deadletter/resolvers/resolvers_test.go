@@ -0,0 +1,247 @@
+package resolvers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/deadletter"
+	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+func TestDeadLetterQueues(t *testing.T) {
+	indexes := newMockStore("indexes")
+	indexes.ListFailedFunc.SetDefaultReturn([]dbworkerstore.FailedRecord{
+		{
+			ID:             42,
+			FailureMessage: strPtr("timeout"),
+			QueuedAt:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			FinishedAt:     timePtr(time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC)),
+			NumFailures:    3,
+			ExecutionLogs: []executor.ExecutionLogEntry{
+				{Key: "step.0", Command: []string{"true"}, StartTime: time.Date(2023, 1, 1, 0, 30, 0, 0, time.UTC), Out: "stdout: failed\n"},
+			},
+		},
+	}, 3, nil)
+
+	schema := mustParseGraphQLSchema(t, siteAdminDB(true), indexes, newMockStore("embeddings"))
+
+	graphqlbackend.RunTests(t, []*graphqlbackend.Test{
+		{
+			Schema: schema,
+			Query: `
+				{
+					deadLetterQueues { name }
+				}
+			`,
+			ExpectedResult: `
+				{
+					"deadLetterQueues": [{"name": "embeddings"}, {"name": "indexes"}]
+				}
+			`,
+		},
+		{
+			Schema: schema,
+			Query: `
+				{
+					deadLetterQueue(name: "unknown") { name }
+				}
+			`,
+			ExpectedResult: `
+				{
+					"deadLetterQueue": null
+				}
+			`,
+		},
+		{
+			Schema: schema,
+			Query: `
+				{
+					deadLetterQueue(name: "indexes") {
+						failedRecords(first: 1, after: "1", filter: {failureMessage: "timeout", failedBefore: "2023-01-02T00:00:00Z"}) {
+							nodes {
+								recordID
+								queue
+								failureMessage
+								queuedAt
+								startedAt
+								failedAt
+								numFailures
+								executionLogs { key out }
+							}
+							totalCount
+							pageInfo { hasNextPage endCursor }
+						}
+					}
+				}
+			`,
+			ExpectedResult: `
+				{
+					"deadLetterQueue": {
+						"failedRecords": {
+							"nodes": [
+								{
+									"recordID": 42,
+									"queue": "indexes",
+									"failureMessage": "timeout",
+									"queuedAt": "2023-01-01T00:00:00Z",
+									"startedAt": null,
+									"failedAt": "2023-01-01T01:00:00Z",
+									"numFailures": 3,
+									"executionLogs": [{"key": "step.0", "out": "stdout: failed\n"}]
+								}
+							],
+							"totalCount": 3,
+							"pageInfo": {"hasNextPage": true, "endCursor": "2"}
+						}
+					}
+				}
+			`,
+		},
+	})
+
+	history := indexes.ListFailedFunc.History()
+	if len(history) != 1 {
+		t.Fatalf("unexpected number of calls. want=%d have=%d", 1, len(history))
+	}
+	expectedOpts := dbworkerstore.ListFailedRecordsOptions{
+		FailedRecordFilter: dbworkerstore.FailedRecordFilter{
+			FailureMessage: "timeout",
+			FailedBefore:   timePtr(time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+		Limit:  1,
+		Offset: 1,
+	}
+	if diff := cmp.Diff(expectedOpts, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+}
+
+func TestBulkFailedWorkerRecords(t *testing.T) {
+	indexes := newMockStore("indexes")
+	indexes.RequeueFailedFunc.SetDefaultReturn(2, nil)
+	indexes.CancelFailedFunc.SetDefaultReturn(3, nil)
+	indexes.PurgeFailedFunc.SetDefaultReturn(4, nil)
+
+	schema := mustParseGraphQLSchema(t, siteAdminDB(true), indexes)
+
+	graphqlbackend.RunTest(t, &graphqlbackend.Test{
+		Schema: schema,
+		Query: `
+			mutation {
+				requeued: requeueFailedWorkerRecords(queue: "indexes", filter: {ids: [1, 2]})
+				canceled: cancelFailedWorkerRecords(queue: "indexes", filter: {failureMessage: "OOM"})
+				purged: purgeFailedWorkerRecords(queue: "indexes")
+			}
+		`,
+		ExpectedResult: `
+			{
+				"requeued": 2,
+				"canceled": 3,
+				"purged": 4
+			}
+		`,
+	})
+
+	if history := indexes.RequeueFailedFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of requeue calls. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff(dbworkerstore.FailedRecordFilter{IDs: []int{1, 2}}, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected requeue filter (-want +got):\n%s", diff)
+	}
+	if history := indexes.CancelFailedFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of cancel calls. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff(dbworkerstore.FailedRecordFilter{FailureMessage: "OOM"}, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected cancel filter (-want +got):\n%s", diff)
+	}
+	if history := indexes.PurgeFailedFunc.History(); len(history) != 1 {
+		t.Errorf("unexpected number of purge calls. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff(dbworkerstore.FailedRecordFilter{}, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected purge filter (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeadLetterQueuesNonSiteAdmin(t *testing.T) {
+	indexes := newMockStore("indexes")
+	schema := mustParseGraphQLSchema(t, siteAdminDB(false), indexes)
+
+	graphqlbackend.RunTests(t, []*graphqlbackend.Test{
+		{
+			Schema: schema,
+			Query: `
+				{
+					deadLetterQueues { name }
+				}
+			`,
+			ExpectedResult: `null`,
+			ExpectedErrors: []*gqlerrors.QueryError{
+				{
+					Message: auth.ErrMustBeSiteAdmin.Error(),
+					Path:    []any{"deadLetterQueues"},
+				},
+			},
+		},
+		{
+			Schema: schema,
+			Query: `
+				mutation {
+					purgeFailedWorkerRecords(queue: "indexes")
+				}
+			`,
+			ExpectedResult: `null`,
+			ExpectedErrors: []*gqlerrors.QueryError{
+				{
+					Message: auth.ErrMustBeSiteAdmin.Error(),
+					Path:    []any{"purgeFailedWorkerRecords"},
+				},
+			},
+		},
+	})
+
+	if len(indexes.PurgeFailedFunc.History()) != 0 {
+		t.Errorf("unexpected purge of failed records")
+	}
+}
+
+func mustParseGraphQLSchema(t *testing.T, db database.DB, stores ...dbworkerstore.FailedRecordStore) *graphql.Schema {
+	t.Helper()
+
+	svc := deadletter.NewService(&observation.TestContext, stores...)
+	schema, err := graphqlbackend.NewSchemaWithDeadLetterQueuesResolver(db, New(db, svc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return schema
+}
+
+func siteAdminDB(siteAdmin bool) database.DB {
+	users := database.NewMockUserStore()
+	users.GetByCurrentAuthUserFunc.SetDefaultReturn(&types.User{SiteAdmin: siteAdmin}, nil)
+
+	db := database.NewMockDB()
+	db.UsersFunc.SetDefaultReturn(users)
+	return db
+}
+
+func newMockStore(name string) *MockFailedRecordStore {
+	mockStore := NewMockFailedRecordStore()
+	mockStore.NameFunc.SetDefaultReturn(name)
+	return mockStore
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -149,6 +149,10 @@ func (r *repoEmbeddingJobResolver) Cancel() bool {
 	return r.job.Cancel
 }
 
+func (r *repoEmbeddingJobResolver) IsIncremental() bool {
+	return r.job.IsIncremental
+}
+
 func (r *repoEmbeddingJobResolver) compute(ctx context.Context) (*graphqlbackend.RepositoryResolver, error) {
 	r.once.Do(func() {
 		repo, err := r.db.Repos().Get(ctx, r.job.RepoID)
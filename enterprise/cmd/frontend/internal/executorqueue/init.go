@@ -22,6 +22,7 @@ func Init(
 	codeintelUploadHandler := enterpriseServices.NewCodeIntelUploadHandler(false)
 	batchesWorkspaceFileGetHandler := enterpriseServices.BatchesChangesFileGetHandler
 	batchesWorkspaceFileExistsHandler := enterpriseServices.BatchesChangesFileGetHandler
+	batchesArtifactUploadHandler := enterpriseServices.BatchesChangesArtifactUploadHandler
 
 	accessToken := func() string {
 		if deploy.IsApp() {
@@ -40,6 +41,7 @@ func Init(
 		codeintelUploadHandler,
 		batchesWorkspaceFileGetHandler,
 		batchesWorkspaceFileExistsHandler,
+		batchesArtifactUploadHandler,
 	)
 
 	enterpriseServices.NewExecutorProxyHandler = queueHandler
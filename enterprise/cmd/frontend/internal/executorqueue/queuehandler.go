@@ -31,6 +31,7 @@ func newExecutorQueuesHandler(
 	uploadHandler http.Handler,
 	batchesWorkspaceFileGetHandler http.Handler,
 	batchesWorkspaceFileExistsHandler http.Handler,
+	batchesArtifactUploadHandler http.Handler,
 ) func() http.Handler {
 	metricsStore := metricsstore.NewDistributedStore("executors:")
 	executorStore := db.Executors()
@@ -107,6 +108,7 @@ func newExecutorQueuesHandler(
 		batchChangesRouter := filesRouter.PathPrefix("/batch-changes").Subrouter()
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodGet).Handler(batchesWorkspaceFileGetHandler)
 		batchChangesRouter.Path("/{spec}/{file}").Methods(http.MethodHead).Handler(batchesWorkspaceFileExistsHandler)
+		batchChangesRouter.Path("/artifacts/{key}").Methods(http.MethodPost).Handler(batchesArtifactUploadHandler)
 		// The files route are treated as an internal actor and require the executor access token to authenticate.
 		filesRouter.Use(withInternalActor, jobAuthMiddleware(logger, routeFiles, jobTokenStore, executorStore))
 
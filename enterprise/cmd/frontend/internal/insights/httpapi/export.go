@@ -5,7 +5,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -49,43 +51,89 @@ func NewExportHandler(db database.DB, insightsDB edb.InsightsDB) *ExportHandler
 	}
 }
 
+// The formats code insights data can be exported in. The zip archive containing a
+// CSV file is the default, for backwards compatibility.
+const (
+	exportFormatZip  = "zip"
+	exportFormatCSV  = "csv"
+	exportFormatJSON = "json"
+)
+
 func (h *ExportHandler) ExportFunc() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		id := mux.Vars(r)["id"]
 
-		archive, err := h.exportCodeInsightData(r.Context(), id)
+		format, err := parseExportFormat(r.URL.Query().Get("format"))
 		if err != nil {
-			if errors.Is(err, notFoundError) {
-				http.Error(w, err.Error(), http.StatusNotFound)
-			} else if errors.Is(err, authenticationError) {
-				http.Error(w, err.Error(), http.StatusUnauthorized)
-			} else if errors.Is(err, invalidLicenseError) {
-				http.Error(w, err.Error(), http.StatusForbidden)
-			} else {
-				http.Error(w, fmt.Sprintf("failed to export data: %v", err), http.StatusInternalServerError)
-			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-		w.Header().Set("Content-Type", "application/zip")
-		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.zip\"", archive.name))
 
-		_, err = w.Write(archive.data)
+		export, err := h.exportCodeInsightData(r.Context(), id)
+		if err != nil {
+			writeInsightsError(w, "failed to export data", err)
+			return
+		}
+
+		switch format {
+		case exportFormatCSV:
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.csv\"", export.name))
+			err = writeExportCSV(w, export.points)
+		case exportFormatJSON:
+			w.Header().Set("Content-Type", "application/json")
+			err = writeExportJSON(w, export.points)
+		default:
+			var archive []byte
+			archive, err = zipExport(export)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to export data: %v", err), http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("Content-Type", "application/zip")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s.zip\"", export.name))
+			_, err = w.Write(archive)
+		}
 		if err != nil {
 			http.Error(w, fmt.Sprintf("failed to write data: %v", err), http.StatusInternalServerError)
 		}
 	}
 }
 
-type codeInsightsDataArchive struct {
-	name string
-	data []byte
+func parseExportFormat(format string) (string, error) {
+	switch format {
+	case "":
+		return exportFormatZip, nil
+	case exportFormatZip, exportFormatCSV, exportFormatJSON:
+		return format, nil
+	default:
+		return "", errors.Errorf("format must be %q, %q or %q, got %q", exportFormatZip, exportFormatCSV, exportFormatJSON, format)
+	}
+}
+
+// writeInsightsError responds with the status code matching err.
+func writeInsightsError(w http.ResponseWriter, msg string, err error) {
+	if errors.Is(err, notFoundError) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+	} else if errors.Is(err, authenticationError) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	} else if errors.Is(err, invalidLicenseError) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+	} else {
+		http.Error(w, fmt.Sprintf("%s: %v", msg, err), http.StatusInternalServerError)
+	}
+}
+
+type codeInsightsDataExport struct {
+	name   string
+	points []store.SeriesPointForExport
 }
 
 var notFoundError = errors.New("insight not found")
 var authenticationError = errors.New("authentication error")
 var invalidLicenseError = errors.New("invalid license for code insights")
 
-func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*codeInsightsDataArchive, error) {
+func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*codeInsightsDataExport, error) {
 	currentActor := actor.FromContext(ctx)
 	if !currentActor.IsAuthenticated() {
 		return nil, authenticationError
@@ -142,7 +190,7 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 		includeRepo(*visibleViewSeries[0].DefaultFilterIncludeRepoRegex)
 	}
 	if visibleViewSeries[0].DefaultFilterExcludeRepoRegex != nil {
-		includeRepo(*visibleViewSeries[0].DefaultFilterExcludeRepoRegex)
+		excludeRepo(*visibleViewSeries[0].DefaultFilterExcludeRepoRegex)
 	}
 
 	inc, exc, err := h.searchContextHandler.UnwrapSearchContexts(ctx, visibleViewSeries[0].DefaultFilterSearchContexts)
@@ -152,19 +200,42 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 	includeRepo(inc...)
 	excludeRepo(exc...)
 
-	var buf bytes.Buffer
-	zw := zip.NewWriter(&buf)
-
 	timestamp := time.Now().Format(time.RFC3339)
 	escapedInsightViewTitle := regexp.MustCompile(`\W+`).ReplaceAllString(visibleViewSeries[0].Title, "-")
 	name := fmt.Sprintf("%s-%s", escapedInsightViewTitle, timestamp)
 
-	dataFile, err := zw.Create(fmt.Sprintf("%s.csv", name))
+	opts.InsightViewUniqueID = insightViewId
+	dataPoints, err := h.seriesStore.GetAllDataForInsightViewID(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch all data for insight")
+	}
+
+	return &codeInsightsDataExport{
+		name:   name,
+		points: dataPoints,
+	}, nil
+}
+
+// zipExport returns a zip archive containing the export as a single CSV file.
+func zipExport(export *codeInsightsDataExport) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	dataFile, err := zw.Create(fmt.Sprintf("%s.csv", export.name))
 	if err != nil {
 		return nil, err
 	}
+	if err := writeExportCSV(dataFile, export.points); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
 
-	dataWriter := csv.NewWriter(dataFile)
+func writeExportCSV(w io.Writer, points []store.SeriesPointForExport) error {
+	dataWriter := csv.NewWriter(w)
 
 	// this needs to be the same number of elements as the number of columns in store.GetAllDataForInsightViewID
 	dataPoint := []string{
@@ -178,16 +249,10 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 	}
 
 	if err := dataWriter.Write(dataPoint); err != nil {
-		return nil, errors.Wrap(err, "failed to write csv header")
+		return errors.Wrap(err, "failed to write csv header")
 	}
 
-	opts.InsightViewUniqueID = insightViewId
-	dataPoints, err := h.seriesStore.GetAllDataForInsightViewID(ctx, opts)
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to fetch all data for insight")
-	}
-
-	for _, d := range dataPoints {
+	for _, d := range points {
 		dataPoint[0] = d.InsightViewTitle
 		dataPoint[1] = d.SeriesLabel
 		dataPoint[2] = d.SeriesQuery
@@ -197,18 +262,37 @@ func (h *ExportHandler) exportCodeInsightData(ctx context.Context, id string) (*
 		dataPoint[6] = emptyStringIfNil(d.Capture)
 
 		if err := dataWriter.Write(dataPoint); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	dataWriter.Flush()
+	return dataWriter.Error()
+}
 
-	if err := zw.Close(); err != nil {
-		return nil, err
+type exportedPoint struct {
+	Title         string    `json:"title"`
+	Label         string    `json:"label"`
+	Query         string    `json:"query"`
+	RecordingTime time.Time `json:"recordingTime"`
+	Repository    *string   `json:"repository"`
+	Value         int       `json:"value"`
+	Capture       *string   `json:"capture"`
+}
+
+func writeExportJSON(w io.Writer, points []store.SeriesPointForExport) error {
+	exported := make([]exportedPoint, 0, len(points))
+	for _, d := range points {
+		exported = append(exported, exportedPoint{
+			Title:         d.InsightViewTitle,
+			Label:         d.SeriesLabel,
+			Query:         d.SeriesQuery,
+			RecordingTime: d.RecordingTime.UTC(),
+			Repository:    d.RepoName,
+			Value:         d.Value,
+			Capture:       d.Capture,
+		})
 	}
-	return &codeInsightsDataArchive{
-		name: name,
-		data: buf.Bytes(),
-	}, nil
+	return json.NewEncoder(w).Encode(exported)
 }
 
 func emptyStringIfNil(s *string) string {
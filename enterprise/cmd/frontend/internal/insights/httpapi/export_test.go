@@ -0,0 +1,114 @@
+package httpapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	for input, want := range map[string]string{
+		"":     exportFormatZip,
+		"zip":  exportFormatZip,
+		"csv":  exportFormatCSV,
+		"json": exportFormatJSON,
+	} {
+		got, err := parseExportFormat(input)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", input, err)
+		}
+		if got != want {
+			t.Errorf("expected format %q for %q, got %q", want, input, got)
+		}
+	}
+
+	if _, err := parseExportFormat("xlsx"); err == nil {
+		t.Error("expected error for unknown format")
+	}
+}
+
+func testExportPoints() []store.SeriesPointForExport {
+	repo := "github.com/sourcegraph/sourcegraph"
+	capture := "Kotlin"
+	recordingTime := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	return []store.SeriesPointForExport{
+		{
+			InsightViewTitle: "Migration",
+			SeriesLabel:      "Java",
+			SeriesQuery:      "lang:java",
+			RecordingTime:    recordingTime,
+			RepoName:         &repo,
+			Value:            12,
+		},
+		{
+			InsightViewTitle: "Migration",
+			SeriesLabel:      "Kotlin",
+			SeriesQuery:      "",
+			RecordingTime:    recordingTime,
+			Value:            0,
+			Capture:          &capture,
+		},
+	}
+}
+
+func TestWriteExportCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeExportCSV(&buf, testExportPoints()); err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect(`title,label,query,recording_time,repository,value,capture
+Migration,Java,lang:java,2023-04-01 00:00:00 +0000 UTC,github.com/sourcegraph/sourcegraph,12,
+Migration,Kotlin,,2023-04-01 00:00:00 +0000 UTC,,0,Kotlin
+`).Equal(t, buf.String())
+}
+
+func TestWriteExportJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeExportJSON(&buf, testExportPoints()); err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect(`[{"title":"Migration","label":"Java","query":"lang:java","recordingTime":"2023-04-01T00:00:00Z","repository":"github.com/sourcegraph/sourcegraph","value":12,"capture":null},{"title":"Migration","label":"Kotlin","query":"","recordingTime":"2023-04-01T00:00:00Z","repository":null,"value":0,"capture":"Kotlin"}]
+`).Equal(t, buf.String())
+
+	buf.Reset()
+	if err := writeExportJSON(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	autogold.Expect("[]\n").Equal(t, buf.String())
+}
+
+func TestZipExport(t *testing.T) {
+	archive, err := zipExport(&codeInsightsDataExport{name: "Migration-2023", points: testExportPoints()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != "Migration-2023.csv" {
+		t.Fatalf("unexpected archive content: %v", zr.File)
+	}
+	f, err := zr.File[0].Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want bytes.Buffer
+	if err := writeExportCSV(&want, testExportPoints()); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want.String() {
+		t.Errorf("unexpected CSV in archive:\n%s", data)
+	}
+}
@@ -0,0 +1,132 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/licensing"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// MetricsHandler exposes the latest value of the code insights series visible to the
+// requesting user in the Prometheus text format, so they can be scraped into existing
+// dashboards.
+type MetricsHandler struct {
+	seriesStore  *store.Store
+	permStore    *store.InsightPermStore
+	insightStore *store.InsightStore
+}
+
+func NewMetricsHandler(db database.DB, insightsDB edb.InsightsDB) *MetricsHandler {
+	insightPermStore := store.NewInsightPermissionStore(db)
+
+	return &MetricsHandler{
+		seriesStore:  store.New(insightsDB, insightPermStore),
+		permStore:    insightPermStore,
+		insightStore: store.NewInsightStore(insightsDB),
+	}
+}
+
+func (h *MetricsHandler) MetricsFunc() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !conf.Get().InsightsMetricsEndpoint {
+			http.Error(w, "the code insights metrics endpoint is disabled, set insights.metricsEndpoint in the site configuration to enable it", http.StatusNotFound)
+			return
+		}
+
+		registry, err := h.collectMetrics(r.Context())
+		if err != nil {
+			writeInsightsError(w, "failed to collect metrics", err)
+			return
+		}
+		promhttp.HandlerFor(registry, promhttp.HandlerOpts{}).ServeHTTP(w, r)
+	}
+}
+
+var seriesMetricLabels = []string{"insight_view_id", "insight_title", "series_id", "series_label", "capture"}
+
+func (h *MetricsHandler) collectMetrics(ctx context.Context) (*prometheus.Registry, error) {
+	if !actor.FromContext(ctx).IsAuthenticated() {
+		return nil, authenticationError
+	}
+	userID, orgIDs, err := h.permStore.GetUserPermissions(ctx)
+	if err != nil {
+		return nil, authenticationError
+	}
+	if licenseError := licensing.Check(licensing.FeatureCodeInsights); licenseError != nil {
+		return nil, invalidLicenseError
+	}
+
+	// 🚨 SECURITY: only the insights visible to the user are returned here, and repository
+	// permissions are enforced when loading their values.
+	notFrozen := false
+	viewSeries, err := h.insightStore.GetAll(ctx, store.InsightQueryArgs{
+		UserID:   userID,
+		OrgID:    orgIDs,
+		IsFrozen: &notFrozen,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching insights")
+	}
+
+	seriesIDs := make([]string, 0, len(viewSeries))
+	seen := make(map[string]struct{}, len(viewSeries))
+	for _, vs := range viewSeries {
+		if _, ok := seen[vs.SeriesID]; ok {
+			continue
+		}
+		seen[vs.SeriesID] = struct{}{}
+		seriesIDs = append(seriesIDs, vs.SeriesID)
+	}
+	latestValues, err := h.seriesStore.GetLatestSeriesValues(ctx, seriesIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "fetching latest series values")
+	}
+
+	registry := prometheus.NewRegistry()
+	values := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "src_insights_series_value",
+		Help: "The value of a code insight series at its latest recording time.",
+	}, seriesMetricLabels)
+	recordingTimes := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "src_insights_series_recording_timestamp_seconds",
+		Help: "The latest recording time of a code insight series, as a Unix timestamp.",
+	}, seriesMetricLabels)
+	registry.MustRegister(values, recordingTimes)
+
+	setSeriesGauges(values, recordingTimes, viewSeries, latestValues)
+	return registry, nil
+}
+
+// setSeriesGauges sets the gauges of every series attached to the given views.
+func setSeriesGauges(values, recordingTimes *prometheus.GaugeVec, viewSeries []types.InsightViewSeries, latestValues []store.LatestSeriesValue) {
+	bySeries := make(map[string][]store.LatestSeriesValue)
+	for _, v := range latestValues {
+		bySeries[v.SeriesID] = append(bySeries[v.SeriesID], v)
+	}
+
+	for _, vs := range viewSeries {
+		viewID := string(relay.MarshalID("insight_view", vs.UniqueID))
+		for _, v := range bySeries[vs.SeriesID] {
+			labels := prometheus.Labels{
+				"insight_view_id": viewID,
+				"insight_title":   vs.Title,
+				"series_id":       vs.SeriesID,
+				"series_label":    vs.Label,
+				"capture":         emptyStringIfNil(v.Capture),
+			}
+			values.With(labels).Set(v.Value)
+			recordingTimes.With(labels).Set(float64(v.RecordingTime.Unix()))
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package httpapi
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+)
+
+func TestSetSeriesGauges(t *testing.T) {
+	values := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "src_insights_series_value", Help: "value"}, seriesMetricLabels)
+	recordingTimes := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "src_insights_series_recording_timestamp_seconds", Help: "time"}, seriesMetricLabels)
+
+	kotlin := "Kotlin"
+	recordingTime := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	setSeriesGauges(values, recordingTimes,
+		[]types.InsightViewSeries{
+			{UniqueID: "view1", Title: "Migration", SeriesID: "series1", Label: "Java"},
+			{UniqueID: "view1", Title: "Migration", SeriesID: "series2", Label: "Languages"},
+			{UniqueID: "view2", Title: "No data", SeriesID: "series3", Label: "Empty"},
+		},
+		[]store.LatestSeriesValue{
+			{SeriesID: "series1", RecordingTime: recordingTime, Value: 12},
+			{SeriesID: "series2", Capture: &kotlin, RecordingTime: recordingTime, Value: 40},
+		},
+	)
+
+	expected := `
+# HELP src_insights_series_value value
+# TYPE src_insights_series_value gauge
+src_insights_series_value{capture="",insight_title="Migration",insight_view_id="aW5zaWdodF92aWV3OiJ2aWV3MSI=",series_id="series1",series_label="Java"} 12
+src_insights_series_value{capture="Kotlin",insight_title="Migration",insight_view_id="aW5zaWdodF92aWV3OiJ2aWV3MSI=",series_id="series2",series_label="Languages"} 40
+`
+	if err := testutil.CollectAndCompare(values, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+	if got := testutil.CollectAndCount(recordingTimes); got != 2 {
+		t.Errorf("expected 2 recording times, got %d", got)
+	}
+}
@@ -0,0 +1,148 @@
+package resolvers
+
+import (
+	"context"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/graphqlbackend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/alerts"
+	insightsstore "github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/auth"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const insightSeriesAlertRuleKind = "InsightSeriesAlertRule"
+
+var _ graphqlbackend.InsightSeriesAlertRuleResolver = &insightSeriesAlertRuleResolver{}
+
+func (r *Resolver) InsightSeriesAlertRules(ctx context.Context, args *graphqlbackend.InsightSeriesAlertRulesArgs) ([]graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	actr := actor.FromContext(ctx)
+	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+		return nil, err
+	}
+
+	rules, err := r.alertRuleStore.GetAlertRules(ctx, insightsstore.AlertRuleQueryArgs{SeriesID: args.SeriesId})
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]graphqlbackend.InsightSeriesAlertRuleResolver, 0, len(rules))
+	for _, rule := range rules {
+		resolvers = append(resolvers, &insightSeriesAlertRuleResolver{rule: rule, seriesID: args.SeriesId})
+	}
+	return resolvers, nil
+}
+
+func (r *Resolver) CreateInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.CreateInsightSeriesAlertRuleArgs) (graphqlbackend.InsightSeriesAlertRuleResolver, error) {
+	actr := actor.FromContext(ctx)
+	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+		return nil, err
+	}
+
+	series, err := r.dataSeriesStore.GetDataSeries(ctx, insightsstore.GetDataSeriesArgs{SeriesID: args.Input.SeriesId})
+	if err != nil {
+		return nil, err
+	}
+	if len(series) == 0 {
+		return nil, errors.Newf("unable to fetch series with series_id: %v", args.Input.SeriesId)
+	}
+	if series[0].JustInTime {
+		return nil, errors.New("alert rules are only supported for series recorded in the background")
+	}
+
+	rule := types.InsightSeriesAlertRule{
+		InsightSeriesID: series[0].ID,
+		Kind:            types.AlertRuleKind(args.Input.Kind),
+		Comparator:      types.AlertRuleComparator(args.Input.Comparator),
+		Value:           args.Input.Value,
+		WindowSize:      1,
+	}
+	if args.Input.WindowSize != nil {
+		rule.WindowSize = int(*args.Input.WindowSize)
+	}
+	if args.Input.EmailRecipients != nil {
+		rule.EmailRecipients = *args.Input.EmailRecipients
+	}
+	if err := alerts.ValidateRule(rule); err != nil {
+		return nil, err
+	}
+
+	created, err := r.alertRuleStore.CreateAlertRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return &insightSeriesAlertRuleResolver{rule: created, seriesID: args.Input.SeriesId}, nil
+}
+
+func (r *Resolver) DeleteInsightSeriesAlertRule(ctx context.Context, args *graphqlbackend.DeleteInsightSeriesAlertRuleArgs) (*graphqlbackend.EmptyResponse, error) {
+	actr := actor.FromContext(ctx)
+	if err := auth.CheckUserIsSiteAdmin(ctx, r.postgresDB, actr.UID); err != nil {
+		return nil, err
+	}
+
+	var id int
+	if err := relay.UnmarshalSpec(args.Id, &id); err != nil {
+		return nil, errors.Wrap(err, "error unmarshalling the alert rule id")
+	}
+	if err := r.alertRuleStore.DeleteAlertRule(ctx, id); err != nil {
+		return nil, err
+	}
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
+type insightSeriesAlertRuleResolver struct {
+	rule     types.InsightSeriesAlertRule
+	seriesID string
+}
+
+func (r *insightSeriesAlertRuleResolver) ID() graphql.ID {
+	return relay.MarshalID(insightSeriesAlertRuleKind, r.rule.ID)
+}
+
+func (r *insightSeriesAlertRuleResolver) SeriesId() string {
+	return r.seriesID
+}
+
+func (r *insightSeriesAlertRuleResolver) Kind() string {
+	return string(r.rule.Kind)
+}
+
+func (r *insightSeriesAlertRuleResolver) Comparator() string {
+	return string(r.rule.Comparator)
+}
+
+func (r *insightSeriesAlertRuleResolver) Value() float64 {
+	return r.rule.Value
+}
+
+func (r *insightSeriesAlertRuleResolver) WindowSize() int32 {
+	return int32(r.rule.WindowSize)
+}
+
+func (r *insightSeriesAlertRuleResolver) EmailRecipients() []string {
+	return r.rule.EmailRecipients
+}
+
+func (r *insightSeriesAlertRuleResolver) State() string {
+	return string(r.rule.State)
+}
+
+func (r *insightSeriesAlertRuleResolver) LastValue() *float64 {
+	return r.rule.LastValue
+}
+
+func (r *insightSeriesAlertRuleResolver) LastEvaluatedAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.rule.LastEvaluatedAt)
+}
+
+func (r *insightSeriesAlertRuleResolver) LastFiredAt() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.rule.LastFiredAt)
+}
+
+func (r *insightSeriesAlertRuleResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.rule.CreatedAt}
+}
@@ -338,6 +338,10 @@ func (s *searchInsightDataSeriesDefinitionResolver) GeneratedFromCaptureGroups()
 	return s.series.GeneratedFromCaptureGroups, nil
 }
 
+func (s *searchInsightDataSeriesDefinitionResolver) LanguageComposition() (bool, error) {
+	return s.series.GenerationMethod == types.LanguageComposition, nil
+}
+
 func (s *searchInsightDataSeriesDefinitionResolver) GroupBy() (*string, error) {
 	if s.series.GroupBy != nil {
 		groupBy := strings.ToUpper(*s.series.GroupBy)
@@ -624,6 +628,10 @@ func isCaptureGroupSeries(generatedFromCaptureGroups *bool) bool {
 	return *generatedFromCaptureGroups
 }
 
+func isLanguageCompositionSeries(languageComposition *bool) bool {
+	return languageComposition != nil && *languageComposition
+}
+
 func updateCaptureGroupInsight(ctx context.Context, input graphqlbackend.LineChartSearchInsightDataSeriesInput, existingSeries []types.InsightViewSeries, view types.InsightView, tx *store.InsightStore, seriesFillStrategy fillSeriesStrategy) error {
 	if len(existingSeries) == 0 {
 		// This should not happen, but if we somehow have no existing series for an insight, create one.
@@ -731,6 +739,9 @@ func existingSeriesHasChanged(new graphqlbackend.LineChartSearchInsightDataSerie
 			return true
 		}
 	}
+	if isLanguageCompositionSeries(new.LanguageComposition) != (existing.GenerationMethod == types.LanguageComposition) {
+		return true
+	}
 	return emptyIfNil(new.GroupBy) != emptyIfNil(existing.GroupBy)
 }
 
@@ -1273,12 +1284,16 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	var foundSeries bool
 	var err error
 	var dynamic bool
+	languageComposition := isLanguageCompositionSeries(series.LanguageComposition)
 	// Validate the query before creating anything; we don't want faulty insights running pointlessly.
-	if series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil {
+	// Language composition series don't run a search query, so there is nothing to validate for them.
+	switch {
+	case languageComposition:
+	case series.GroupBy != nil || series.GeneratedFromCaptureGroups != nil:
 		if _, err := querybuilder.ParseComputeQuery(series.Query); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
-	} else {
+	default:
 		if _, err := querybuilder.ParseQuery(series.Query, "literal"); err != nil {
 			return errors.Wrap(err, "query validation")
 		}
@@ -1287,6 +1302,10 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	if series.GeneratedFromCaptureGroups != nil {
 		dynamic = *series.GeneratedFromCaptureGroups
 	}
+	if languageComposition {
+		// Every language is recorded as a capture, so the series expands the same way capture group series do.
+		dynamic = true
+	}
 
 	groupBy := lowercaseGroupBy(series.GroupBy)
 	var nextRecordingAfter time.Time
@@ -1301,7 +1320,7 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 	// Don't try to match on non-global series, since they are always replaced
 	// Also don't try to match on series that use repo criteria
 	// TODO: Reconsider matching on criteria based series. If so the edit case would need work to ensure other insights remain the same.
-	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil {
+	if len(series.RepositoryScope.Repositories) == 0 && series.RepositoryScope.RepositoryCriteria == nil && !languageComposition {
 		matchingSeries, foundSeries, err = tx.FindMatchingSeries(ctx, store.MatchSeriesArgs{
 			Query:                     series.Query,
 			StepIntervalUnit:          series.TimeScope.StepInterval.Unit,
@@ -1357,6 +1376,9 @@ func createAndAttachSeries(ctx context.Context, tx *store.InsightStore, startSer
 }
 
 func searchGenerationMethod(series graphqlbackend.LineChartSearchInsightDataSeriesInput) types.GenerationMethod {
+	if isLanguageCompositionSeries(series.LanguageComposition) {
+		return types.LanguageComposition
+	}
 	if series.GeneratedFromCaptureGroups != nil && *series.GeneratedFromCaptureGroups {
 		if series.GroupBy != nil {
 			return types.MappingCompute
@@ -1585,6 +1607,17 @@ func isValidSeriesInput(seriesInput graphqlbackend.LineChartSearchInsightDataSer
 	if !repoListSpecified && seriesInput.GroupBy != nil {
 		return errors.New("group by series require a list of repositories to be specified.")
 	}
+	if isLanguageCompositionSeries(seriesInput.LanguageComposition) {
+		if !repoListSpecified && !repoCriteriaSpecified {
+			return errors.New("language composition series require a list of repositories or repository criteria to be specified")
+		}
+		if seriesInput.GroupBy != nil || isCaptureGroupSeries(seriesInput.GeneratedFromCaptureGroups) {
+			return errors.New("language composition series can not be grouped or generated from capture groups")
+		}
+		if strings.TrimSpace(seriesInput.Query) != "" {
+			return errors.New("language composition series do not support a search query, use the repository scope instead")
+		}
+	}
 
 	if repoCriteriaSpecified {
 		plan, err := querybuilder.ParseQuery(*seriesInput.RepositoryScope.RepositoryCriteria, "literal")
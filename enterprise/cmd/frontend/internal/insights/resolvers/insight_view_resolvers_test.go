@@ -485,3 +485,76 @@ func TestInsightRepoScopeResolver(t *testing.T) {
 	}
 
 }
+
+func TestIsValidSeriesInputLanguageComposition(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	strPtr := func(s string) *string { return &s }
+	timeScope := &graphqlbackend.TimeScopeInput{StepInterval: &graphqlbackend.TimeIntervalStepInput{Unit: "MONTH", Value: 1}}
+
+	testCases := []struct {
+		name    string
+		input   graphqlbackend.LineChartSearchInsightDataSeriesInput
+		wantErr string
+	}{
+		{
+			name: "repository list",
+			input: graphqlbackend.LineChartSearchInsightDataSeriesInput{
+				RepositoryScope:     &graphqlbackend.RepositoryScopeInput{Repositories: []string{"github.com/sourcegraph/sourcegraph"}},
+				TimeScope:           timeScope,
+				LanguageComposition: boolPtr(true),
+			},
+		},
+		{
+			name: "repository criteria",
+			input: graphqlbackend.LineChartSearchInsightDataSeriesInput{
+				RepositoryScope:     &graphqlbackend.RepositoryScopeInput{RepositoryCriteria: strPtr("repo:sourcegraph")},
+				TimeScope:           timeScope,
+				LanguageComposition: boolPtr(true),
+			},
+		},
+		{
+			name: "all repositories",
+			input: graphqlbackend.LineChartSearchInsightDataSeriesInput{
+				RepositoryScope:     &graphqlbackend.RepositoryScopeInput{},
+				TimeScope:           timeScope,
+				LanguageComposition: boolPtr(true),
+			},
+			wantErr: "language composition series require a list of repositories or repository criteria to be specified",
+		},
+		{
+			name: "capture groups",
+			input: graphqlbackend.LineChartSearchInsightDataSeriesInput{
+				RepositoryScope:            &graphqlbackend.RepositoryScopeInput{Repositories: []string{"github.com/sourcegraph/sourcegraph"}},
+				TimeScope:                  timeScope,
+				GeneratedFromCaptureGroups: boolPtr(true),
+				LanguageComposition:        boolPtr(true),
+			},
+			wantErr: "language composition series can not be grouped or generated from capture groups",
+		},
+		{
+			name: "search query",
+			input: graphqlbackend.LineChartSearchInsightDataSeriesInput{
+				Query:               "lang:java",
+				RepositoryScope:     &graphqlbackend.RepositoryScopeInput{Repositories: []string{"github.com/sourcegraph/sourcegraph"}},
+				TimeScope:           timeScope,
+				LanguageComposition: boolPtr(true),
+			},
+			wantErr: "language composition series do not support a search query, use the repository scope instead",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := isValidSeriesInput(tc.input)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.wantErr {
+				t.Fatalf("expected error %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
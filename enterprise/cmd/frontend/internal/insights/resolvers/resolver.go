@@ -34,6 +34,7 @@ type baseInsightResolver struct {
 	insightStore    *store.InsightStore
 	timeSeriesStore *store.Store
 	dashboardStore  *store.DBDashboardStore
+	alertRuleStore  *store.AlertRuleStore
 	workerBaseStore *basestore.Store
 	scheduler       *scheduler.Scheduler
 
@@ -53,6 +54,7 @@ func WithBase(insightsDB edb.InsightsDB, primaryDB database.DB, clock func() tim
 		insightStore:    insightStore,
 		timeSeriesStore: timeSeriesStore,
 		dashboardStore:  dashboardStore,
+		alertRuleStore:  store.NewAlertRuleStore(insightsDB),
 		workerBaseStore: workerBaseStore,
 		scheduler:       insightsScheduler,
 		insightsDB:      insightsDB,
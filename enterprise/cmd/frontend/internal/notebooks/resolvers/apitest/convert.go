@@ -30,6 +30,10 @@ func BlockToAPIResponse(block notebooks.NotebookBlock) NotebookBlock {
 			SymbolContainerName: block.SymbolInput.SymbolContainerName,
 			SymbolKind:          block.SymbolInput.SymbolKind,
 		}}
+	case notebooks.NotebookComputeBlockType:
+		return NotebookBlock{Typename: "ComputeBlock", ID: block.ID, ComputeInput: block.ComputeInput.Text}
+	case notebooks.NotebookInsightBlockType:
+		return NotebookBlock{Typename: "InsightBlock", ID: block.ID, InsightInput: block.InsightInput.SeriesID}
 	}
 	panic("unknown block type")
 }
@@ -75,6 +79,10 @@ func BlockToAPIInput(block notebooks.NotebookBlock) graphqlbackend.CreateNoteboo
 			SymbolContainerName: block.SymbolInput.SymbolContainerName,
 			SymbolKind:          block.SymbolInput.SymbolKind,
 		}}
+	case notebooks.NotebookComputeBlockType:
+		return graphqlbackend.CreateNotebookBlockInputArgs{ID: block.ID, Type: graphqlbackend.NotebookComputeBlockType, ComputeInput: &block.ComputeInput.Text}
+	case notebooks.NotebookInsightBlockType:
+		return graphqlbackend.CreateNotebookBlockInputArgs{ID: block.ID, Type: graphqlbackend.NotebookInsightBlockType, InsightInput: &block.InsightInput.SeriesID}
 	}
 	panic("unknown block type")
 }
@@ -23,6 +23,8 @@ type NotebookBlock struct {
 	QueryInput    string
 	FileInput     FileInput
 	SymbolInput   SymbolInput
+	ComputeInput  string
+	InsightInput  string
 }
 
 type FileInput struct {
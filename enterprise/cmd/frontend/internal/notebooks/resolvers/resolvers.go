@@ -108,6 +108,18 @@ func convertNotebookBlockInput(inputBlock graphqlbackend.CreateNotebookBlockInpu
 			SymbolContainerName: inputBlock.SymbolInput.SymbolContainerName,
 			SymbolKind:          inputBlock.SymbolInput.SymbolKind,
 		}
+	case graphqlbackend.NotebookComputeBlockType:
+		if inputBlock.ComputeInput == nil {
+			return nil, errors.Errorf("compute block with id %s is missing input", inputBlock.ID)
+		}
+		block.Type = notebooks.NotebookComputeBlockType
+		block.ComputeInput = &notebooks.NotebookComputeBlockInput{Text: *inputBlock.ComputeInput}
+	case graphqlbackend.NotebookInsightBlockType:
+		if inputBlock.InsightInput == nil {
+			return nil, errors.Errorf("insight block with id %s is missing input", inputBlock.ID)
+		}
+		block.Type = notebooks.NotebookInsightBlockType
+		block.InsightInput = &notebooks.NotebookInsightBlockInput{SeriesID: *inputBlock.InsightInput}
 	default:
 		return nil, errors.Newf("invalid block type: %s", inputBlock.Type)
 	}
@@ -513,6 +525,20 @@ func (r *notebookBlockResolver) ToSymbolBlock() (graphqlbackend.SymbolBlockResol
 	return nil, false
 }
 
+func (r *notebookBlockResolver) ToComputeBlock() (graphqlbackend.ComputeBlockResolver, bool) {
+	if r.block.Type == notebooks.NotebookComputeBlockType {
+		return &computeBlockResolver{r.block}, true
+	}
+	return nil, false
+}
+
+func (r *notebookBlockResolver) ToInsightBlock() (graphqlbackend.InsightBlockResolver, bool) {
+	if r.block.Type == notebooks.NotebookInsightBlockType {
+		return &insightBlockResolver{r.block}, true
+	}
+	return nil, false
+}
+
 type markdownBlockResolver struct {
 	// block.type == NotebookMarkdownBlockType
 	block notebooks.NotebookBlock
@@ -631,3 +657,29 @@ func (r *symbolBlockInputResolver) SymbolContainerName() string {
 func (r *symbolBlockInputResolver) SymbolKind() string {
 	return r.input.SymbolKind
 }
+
+type computeBlockResolver struct {
+	// block.type == NotebookComputeBlockType
+	block notebooks.NotebookBlock
+}
+
+func (r *computeBlockResolver) ID() string {
+	return r.block.ID
+}
+
+func (r *computeBlockResolver) ComputeInput() string {
+	return r.block.ComputeInput.Text
+}
+
+type insightBlockResolver struct {
+	// block.type == NotebookInsightBlockType
+	block notebooks.NotebookBlock
+}
+
+func (r *insightBlockResolver) ID() string {
+	return r.block.ID
+}
+
+func (r *insightBlockResolver) InsightInput() string {
+	return r.block.InsightInput.SeriesID
+}
@@ -73,6 +73,16 @@ const notebookFields = `
 				symbolKind
 			}
 		}
+		... on ComputeBlock {
+			__typename
+			id
+			computeInput
+		}
+		... on InsightBlock {
+			__typename
+			id
+			insightInput
+		}
 	}
 `
 
@@ -145,6 +155,8 @@ func notebookFixture(creatorID int32, namespaceUserID int32, namespaceOrgID int3
 			SymbolContainerName: "container",
 			SymbolKind:          "FUNCTION",
 		}},
+		{ID: "5", Type: notebooks.NotebookComputeBlockType, ComputeInput: &notebooks.NotebookComputeBlockInput{Text: `content:output(go (\d+\.\d+) -> $1)`}},
+		{ID: "6", Type: notebooks.NotebookInsightBlockType, InsightInput: &notebooks.NotebookInsightBlockInput{SeriesID: "2MHIU8WbNNlvYtPELnVHIY3qmLO"}},
 	}
 	return &notebooks.Notebook{Title: "Notebook Title", Blocks: blocks, Public: public, CreatorUserID: creatorID, UpdaterUserID: creatorID, NamespaceUserID: namespaceUserID, NamespaceOrgID: namespaceOrgID}
 }
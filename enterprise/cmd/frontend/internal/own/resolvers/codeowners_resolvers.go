@@ -33,6 +33,7 @@ import (
 var (
 	_ graphqlbackend.CodeownersIngestedFileResolver           = &codeownersIngestedFileResolver{}
 	_ graphqlbackend.CodeownersIngestedFileConnectionResolver = &codeownersIngestedFileConnectionResolver{}
+	_ graphqlbackend.CodeownersRepoPatternResolver            = &codeownersRepoPatternResolver{}
 )
 
 func (r *ownResolver) AddCodeownersFile(ctx context.Context, args *graphqlbackend.CodeownersFileArgs) (graphqlbackend.CodeownersIngestedFileResolver, error) {
@@ -50,10 +51,15 @@ func (r *ownResolver) AddCodeownersFile(ctx context.Context, args *graphqlbacken
 	if err != nil {
 		return nil, err
 	}
+	mode, err := parseCodeownersMode(args.Input.Mode)
+	if err != nil {
+		return nil, err
+	}
 	codeownersFile := &types.CodeownersFile{
 		RepoID:   repo.ID,
 		Contents: args.Input.FileContents,
 		Proto:    proto,
+		Mode:     mode,
 	}
 
 	if err := r.db.Codeowners().CreateCodeownersFile(ctx, codeownersFile); err != nil {
@@ -83,10 +89,15 @@ func (r *ownResolver) UpdateCodeownersFile(ctx context.Context, args *graphqlbac
 	if err != nil {
 		return nil, err
 	}
+	mode, err := parseCodeownersMode(args.Input.Mode)
+	if err != nil {
+		return nil, err
+	}
 	codeownersFile := &types.CodeownersFile{
 		RepoID:   repo.ID,
 		Contents: args.Input.FileContents,
 		Proto:    proto,
+		Mode:     mode,
 	}
 	if err := r.db.Codeowners().UpdateCodeownersFile(ctx, codeownersFile); err != nil {
 		return nil, errors.Wrap(err, "could not update codeowners file")
@@ -100,6 +111,20 @@ func (r *ownResolver) UpdateCodeownersFile(ctx context.Context, args *graphqlbac
 	}, nil
 }
 
+// parseCodeownersMode converts the given GraphQL CodeownersMode enum value. A
+// nil value defaults to overriding.
+func parseCodeownersMode(mode *string) (types.CodeownersMode, error) {
+	if mode == nil {
+		return types.CodeownersModeOverride, nil
+	}
+	switch m := types.CodeownersMode(strings.ToLower(*mode)); m {
+	case types.CodeownersModeOverride, types.CodeownersModeMerge:
+		return m, nil
+	default:
+		return "", errors.Newf("unknown codeowners mode %q", *mode)
+	}
+}
+
 func parseInputString(fileContents string) (*codeownerspb.File, error) {
 	fileReader := strings.NewReader(fileContents)
 	file, err := codeowners.Parse(fileReader)
@@ -157,6 +182,81 @@ func (r *ownResolver) DeleteCodeownersFiles(ctx context.Context, args *graphqlba
 	return &graphqlbackend.EmptyResponse{}, nil
 }
 
+func (r *ownResolver) AddCodeownersRepoPattern(ctx context.Context, args *graphqlbackend.CodeownersRepoPatternArgs) (graphqlbackend.CodeownersRepoPatternResolver, error) {
+	if err := isIngestionAvailable(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.viewerCanAdminister(ctx); err != nil {
+		return nil, err
+	}
+	pattern, err := parseRepoPatternInput(args.Input)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.Codeowners().CreateCodeownersRepoPattern(ctx, pattern); err != nil {
+		return nil, errors.Wrap(err, "could not ingest codeowners file")
+	}
+	r.logBackendEvent(ctx, "own:ingestedCodeownersRepoPattern:added")
+	return &codeownersRepoPatternResolver{pattern: pattern}, nil
+}
+
+func (r *ownResolver) UpdateCodeownersRepoPattern(ctx context.Context, args *graphqlbackend.CodeownersRepoPatternArgs) (graphqlbackend.CodeownersRepoPatternResolver, error) {
+	if err := isIngestionAvailable(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.viewerCanAdminister(ctx); err != nil {
+		return nil, err
+	}
+	pattern, err := parseRepoPatternInput(args.Input)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.Codeowners().UpdateCodeownersRepoPattern(ctx, pattern); err != nil {
+		return nil, errors.Wrap(err, "could not update codeowners file")
+	}
+	r.logBackendEvent(ctx, "own:ingestedCodeownersRepoPattern:updated")
+	return &codeownersRepoPatternResolver{pattern: pattern}, nil
+}
+
+func parseRepoPatternInput(input graphqlbackend.CodeownersRepoPatternInput) (*types.CodeownersRepoPattern, error) {
+	if strings.TrimSpace(input.RepoPattern) == "" {
+		return nil, errors.New("repoPattern cannot be empty")
+	}
+	proto, err := parseInputString(input.FileContents)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := parseCodeownersMode(input.Mode)
+	if err != nil {
+		return nil, err
+	}
+	return &types.CodeownersRepoPattern{
+		RepoPattern: input.RepoPattern,
+		Contents:    input.FileContents,
+		Proto:       proto,
+		Mode:        mode,
+	}, nil
+}
+
+func (r *ownResolver) DeleteCodeownersRepoPatterns(ctx context.Context, args *graphqlbackend.DeleteCodeownersRepoPatternsArgs) (*graphqlbackend.EmptyResponse, error) {
+	if err := isIngestionAvailable(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.viewerCanAdminister(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(args.RepoPatterns) == 0 {
+		return nil, errors.New("at least one repository pattern must be given")
+	}
+
+	if err := r.db.Codeowners().DeleteCodeownersRepoPatterns(ctx, args.RepoPatterns...); err != nil {
+		return nil, errors.Wrapf(err, "could not delete codeowners file for repo patterns")
+	}
+	r.logBackendEvent(ctx, "own:ingestedCodeownersRepoPattern:deleted")
+	return &graphqlbackend.EmptyResponse{}, nil
+}
+
 func (r *ownResolver) logBackendEvent(ctx context.Context, eventName string) {
 	a := actor.FromContext(ctx)
 	if a.IsAuthenticated() && !a.IsMockUser() {
@@ -226,6 +326,43 @@ func (r *ownResolver) RepoIngestedCodeowners(ctx context.Context, repoID api.Rep
 	}, nil
 }
 
+func (r *ownResolver) CodeownersRepoPatterns(ctx context.Context) ([]graphqlbackend.CodeownersRepoPatternResolver, error) {
+	if err := isIngestionAvailable(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.viewerCanAdminister(ctx); err != nil {
+		return nil, err
+	}
+	patterns, err := r.db.Codeowners().ListCodeownersRepoPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]graphqlbackend.CodeownersRepoPatternResolver, 0, len(patterns))
+	for _, p := range patterns {
+		resolvers = append(resolvers, &codeownersRepoPatternResolver{pattern: p})
+	}
+	return resolvers, nil
+}
+
+func (r *ownResolver) codeownersRepoPatternByID(ctx context.Context, id int32) (graphqlbackend.CodeownersRepoPatternResolver, error) {
+	if err := isIngestionAvailable(ctx); err != nil {
+		return nil, err
+	}
+	if err := r.viewerCanAdminister(ctx); err != nil {
+		return nil, err
+	}
+	patterns, err := r.db.Codeowners().ListCodeownersRepoPatterns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range patterns {
+		if p.ID == id {
+			return &codeownersRepoPatternResolver{pattern: p}, nil
+		}
+	}
+	return nil, nil
+}
+
 type codeownersIngestedFileResolver struct {
 	gitserver      gitserver.Client
 	db             edb.EnterpriseDB
@@ -247,6 +384,10 @@ func (r *codeownersIngestedFileResolver) Repository() *graphqlbackend.Repository
 	return graphqlbackend.NewRepositoryResolver(r.db, r.gitserver, r.repository)
 }
 
+func (r *codeownersIngestedFileResolver) Mode() string {
+	return codeownersModeEnum(r.codeownersFile.Mode)
+}
+
 func (r *codeownersIngestedFileResolver) CreatedAt() gqlutil.DateTime {
 	return gqlutil.DateTime{Time: r.codeownersFile.CreatedAt}
 }
@@ -255,6 +396,44 @@ func (r *codeownersIngestedFileResolver) UpdatedAt() gqlutil.DateTime {
 	return gqlutil.DateTime{Time: r.codeownersFile.UpdatedAt}
 }
 
+// codeownersModeEnum returns the GraphQL CodeownersMode enum value of the given mode.
+func codeownersModeEnum(mode types.CodeownersMode) string {
+	if mode == "" {
+		mode = types.CodeownersModeOverride
+	}
+	return strings.ToUpper(string(mode))
+}
+
+type codeownersRepoPatternResolver struct {
+	pattern *types.CodeownersRepoPattern
+}
+
+const codeownersRepoPatternKind = "CodeownersRepoPattern"
+
+func (r *codeownersRepoPatternResolver) ID() graphql.ID {
+	return relay.MarshalID(codeownersRepoPatternKind, r.pattern.ID)
+}
+
+func (r *codeownersRepoPatternResolver) RepoPattern() string {
+	return r.pattern.RepoPattern
+}
+
+func (r *codeownersRepoPatternResolver) Contents() string {
+	return r.pattern.Contents
+}
+
+func (r *codeownersRepoPatternResolver) Mode() string {
+	return codeownersModeEnum(r.pattern.Mode)
+}
+
+func (r *codeownersRepoPatternResolver) CreatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.pattern.CreatedAt}
+}
+
+func (r *codeownersRepoPatternResolver) UpdatedAt() gqlutil.DateTime {
+	return gqlutil.DateTime{Time: r.pattern.UpdatedAt}
+}
+
 type codeownersIngestedFileConnectionResolver struct {
 	codeownersStore edb.CodeownersStore
 
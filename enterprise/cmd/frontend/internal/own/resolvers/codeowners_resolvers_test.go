@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/graph-gophers/graphql-go/errors"
@@ -64,6 +65,30 @@ func TestCodeownersIngestionGuarding(t *testing.T) {
 			alwaysNil
 		 }
 		}`,
+		"addCodeownersRepoPattern": `
+		mutation addRepoPattern {
+		  addCodeownersRepoPattern(input: {fileContents: "* @admin", repoPattern: "github.com/sourcegraph/*", mode: MERGE}) {
+			id
+		  }
+		}`,
+		"updateCodeownersRepoPattern": `
+		mutation updateRepoPattern {
+		  updateCodeownersRepoPattern(input: {fileContents: "* @admin", repoPattern: "github.com/sourcegraph/*"}) {
+			id
+		  }
+		}`,
+		"deleteCodeownersRepoPatterns": `
+		mutation deleteRepoPatterns {
+		 deleteCodeownersRepoPatterns(repoPatterns: ["github.com/sourcegraph/*"]) {
+			alwaysNil
+		 }
+		}`,
+		"codeownersRepoPatterns": `
+		query repoPatterns {
+		 codeownersRepoPatterns {
+			id
+		 }
+		}`,
 		"codeownersIngestedFiles": `
 		query files {
 		 codeownersIngestedFiles(first:1) {
@@ -127,12 +152,12 @@ func TestCodeownersIngestionGuarding(t *testing.T) {
 func nullOrAlwaysNil(t *testing.T, endpoint string) string {
 	t.Helper()
 	expectedResult := `null`
-	if endpoint == "deleteCodeownersFiles" {
-		expectedResult = `
+	if endpoint == "deleteCodeownersFiles" || endpoint == "deleteCodeownersRepoPatterns" {
+		expectedResult = fmt.Sprintf(`
 					{
-						"deleteCodeownersFiles": null
+						%q: null
 					}
-				`
+				`, endpoint)
 	}
 	return expectedResult
 }
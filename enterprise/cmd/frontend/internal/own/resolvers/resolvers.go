@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/graph-gophers/graphql-go"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -18,10 +19,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/featureflag"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gqlutil"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -58,14 +61,15 @@ func (r *ownResolver) ownService() own.Service {
 	return r.ownServiceFn()
 }
 
-func ownerText(o *codeownerspb.Owner) string {
-	if o == nil {
-		return ""
-	}
-	if o.Handle != "" {
-		return o.Handle
+// ruleListsOwner returns true if the resolved owner was resolved from one of
+// the owners of the rule.
+func ruleListsOwner(rule *codeownerspb.Rule, ro codeowners.ResolvedOwner) bool {
+	for _, o := range rule.GetOwner() {
+		if o.GetHandle()+o.GetEmail() == ro.Identifier() {
+			return true
+		}
 	}
-	return o.Email
+	return false
 }
 
 func (r *ownResolver) GitBlobOwnership(
@@ -84,49 +88,75 @@ func (r *ownResolver) GitBlobOwnership(
 	repoID, repoName := repo.IDInt32(), repo.RepoName()
 	commitID := api.CommitID(blob.Commit().OID())
 	ownService := r.ownService()
-	rs, err := ownService.RulesetForRepo(ctx, repoName, repoID, commitID)
-	if err != nil {
-		return nil, err
+	var (
+		rs     *codeowners.Ruleset
+		rules  []*codeownerspb.Rule
+		owners []*codeownerspb.Owner
+	)
+	if includeReason(args.Reasons, codeownersFileEntryReason) {
+		rs, err = ownService.RulesetForRepo(ctx, repoName, repoID, commitID)
+		if err != nil {
+			return nil, err
+		}
+		// Every section of the CODEOWNERS file is evaluated separately, so several
+		// rules can match.
+		if rs != nil {
+			rules = rs.MatchSections(blob.Path())
+			owners = rs.MatchOwners(blob.Path())
+		}
 	}
-	// No ruleset found.
-	if rs == nil {
-		return &ownershipConnectionResolver{db: r.db}, nil
+	var signals []*owntypes.HistorySignal
+	if includeReason(args.Reasons, gitHistoryOwnershipSignalReason) {
+		signals, err = ownService.HistorySignalsForPath(ctx, repoID, blob.Path())
+		if err != nil {
+			return nil, err
+		}
 	}
-	rule := rs.Match(blob.Path())
 	// No match found.
-	if rule == nil {
+	if len(owners) == 0 && len(signals) == 0 {
 		return &ownershipConnectionResolver{db: r.db}, nil
 	}
-	owners := rule.GetOwner()
-	sort.Slice(owners, func(i, j int) bool {
-		iText := ownerText(owners[i])
-		jText := ownerText(owners[j])
-		return iText < jText
+	// Owners from CODEOWNERS and from the git history are merged when resolving,
+	// so all of them need to be resolved before paginating.
+	resolvedOwners, err := ownService.ResolveOwnersWithType(ctx, owners, signals)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resolvedOwners, func(i, j int) bool {
+		return resolvedOwners[i].Identifier() < resolvedOwners[j].Identifier()
 	})
-	total := len(owners)
-	for cursor != "" && len(owners) > 0 && ownerText(owners[0]) != cursor {
-		owners = owners[1:]
+	total := len(resolvedOwners)
+	for cursor != "" && len(resolvedOwners) > 0 && resolvedOwners[0].Identifier() != cursor {
+		resolvedOwners = resolvedOwners[1:]
 	}
 	var next *string
-	if args.First != nil && len(owners) > int(*args.First) {
-		cursor := ownerText(owners[*args.First])
+	if args.First != nil && len(resolvedOwners) > int(*args.First) {
+		cursor := resolvedOwners[*args.First].Identifier()
 		next = &cursor
-		owners = owners[:*args.First]
-	}
-	resolvedOwners, err := ownService.ResolveOwnersWithType(ctx, owners)
-	if err != nil {
-		return nil, err
+		resolvedOwners = resolvedOwners[:*args.First]
 	}
 	ownerships := make([]graphqlbackend.OwnershipResolver, 0, len(resolvedOwners))
 	for _, ro := range resolvedOwners {
-		reasons := []graphqlbackend.OwnershipReasonResolver{
-			&codeownersFileEntryResolver{
+		// Every matching rule that lists the owner is a reason for ownership.
+		var reasons []graphqlbackend.OwnershipReasonResolver
+		for _, rule := range rules {
+			if !ruleListsOwner(rule, ro) {
+				continue
+			}
+			reasons = append(reasons, &codeownersFileEntryResolver{
 				db:              r.db,
 				gitserverClient: r.gitserver,
-				source:          rs.GetSource(),
+				source:          rs.GetRuleSource(rule),
 				repo:            blob.Repository(),
 				matchLineNumber: rule.GetLineNumber(),
-			},
+				section:         rs.GetSection(rule.GetSectionName()),
+			})
+		}
+		// So is every signal from the git history that was attached to the owner.
+		if person, ok := ro.(*codeowners.Person); ok {
+			for _, signal := range person.HistorySignals {
+				reasons = append(reasons, &gitHistoryOwnershipSignalResolver{signal: signal})
+			}
 		}
 		ownerships = append(ownerships, &ownershipResolver{
 			db:            r.db,
@@ -143,6 +173,25 @@ func (r *ownResolver) GitBlobOwnership(
 	}, nil
 }
 
+const (
+	codeownersFileEntryReason       = "CODEOWNERS_FILE_ENTRY"
+	gitHistoryOwnershipSignalReason = "GIT_HISTORY_OWNERSHIP_SIGNAL"
+)
+
+// includeReason returns true if ownership for the given reason was requested.
+// All reasons are included if no reasons were given.
+func includeReason(reasons *[]string, reason string) bool {
+	if reasons == nil || len(*reasons) == 0 {
+		return true
+	}
+	for _, r := range *reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *ownResolver) PersonOwnerField(person *graphqlbackend.PersonResolver) string {
 	return "owner"
 }
@@ -165,6 +214,13 @@ func (r *ownResolver) NodeResolvers() map[string]graphqlbackend.NodeByIDFunc {
 			}
 			return r.RepoIngestedCodeowners(ctx, repoID)
 		},
+		codeownersRepoPatternKind: func(ctx context.Context, id graphql.ID) (graphqlbackend.Node, error) {
+			var patternID int32
+			if err := relay.UnmarshalSpec(id, &patternID); err != nil {
+				return nil, errors.Wrap(err, "could not unmarshal codeowners repo pattern ID")
+			}
+			return r.codeownersRepoPatternByID(ctx, patternID)
+		},
 	}
 }
 
@@ -242,6 +298,7 @@ type codeownersFileEntryResolver struct {
 	db              edb.EnterpriseDB
 	source          codeowners.RulesetSource
 	matchLineNumber int32
+	section         *codeownerspb.Section
 	repo            *graphqlbackend.RepositoryResolver
 	gitserverClient gitserver.Client
 }
@@ -250,6 +307,10 @@ func (r *codeownersFileEntryResolver) ToCodeownersFileEntry() (graphqlbackend.Co
 	return r, true
 }
 
+func (r *codeownersFileEntryResolver) ToGitHistoryOwnershipSignal() (graphqlbackend.GitHistoryOwnershipSignalResolver, bool) {
+	return nil, false
+}
+
 func (r *codeownersFileEntryResolver) Title(_ context.Context) (string, error) {
 	return "CODEOWNERS", nil
 }
@@ -273,6 +334,17 @@ func (r *codeownersFileEntryResolver) CodeownersFile(ctx context.Context) (graph
 		}, graphqlbackend.VirtualFileResolverOptions{
 			URL: fmt.Sprintf("%s/-/own", r.repo.URL()),
 		}), nil
+	case codeowners.RepoPatternRulesetSource:
+		// Files for repo name patterns are not tied to a single repository, so
+		// the virtual file has no URL.
+		stat := graphqlbackend.CreateFileInfo("CODEOWNERS", false)
+		return graphqlbackend.NewVirtualFileResolver(stat, func(ctx context.Context) (string, error) {
+			p, err := r.db.Codeowners().GetCodeownersRepoPattern(ctx, src.RepoPattern)
+			if err != nil {
+				return "", err
+			}
+			return p.Contents, nil
+		}, graphqlbackend.VirtualFileResolverOptions{}), nil
 	case codeowners.GitRulesetSource:
 		// For committed, we can return a GitTreeEntry, as it implements File2.
 		c := graphqlbackend.NewGitCommitResolver(r.db, r.gitserverClient, r.repo, src.Commit, nil)
@@ -286,6 +358,84 @@ func (r *codeownersFileEntryResolver) RuleLineMatch(_ context.Context) (int32, e
 	return r.matchLineNumber, nil
 }
 
+func (r *codeownersFileEntryResolver) Section(_ context.Context) (graphqlbackend.CodeownersSectionResolver, error) {
+	// Rules that are not part of any section have no section.
+	if r.section == nil {
+		return nil, nil
+	}
+	return &codeownersSectionResolver{section: r.section}, nil
+}
+
+type codeownersSectionResolver struct {
+	section *codeownerspb.Section
+}
+
+func (r *codeownersSectionResolver) Name() string {
+	return r.section.GetName()
+}
+
+func (r *codeownersSectionResolver) Optional() bool {
+	return r.section.GetOptional()
+}
+
+func (r *codeownersSectionResolver) ApprovalsRequired() int32 {
+	// A single approval is required, unless specified otherwise.
+	if a := r.section.GetApprovals(); a > 0 {
+		return a
+	}
+	return 1
+}
+
+func (r *codeownersSectionResolver) LineNumber() int32 {
+	return r.section.GetLineNumber()
+}
+
+type gitHistoryOwnershipSignalResolver struct {
+	signal *owntypes.HistorySignal
+}
+
+func (r *gitHistoryOwnershipSignalResolver) ToCodeownersFileEntry() (graphqlbackend.CodeownersFileEntryResolver, bool) {
+	return nil, false
+}
+
+func (r *gitHistoryOwnershipSignalResolver) ToGitHistoryOwnershipSignal() (graphqlbackend.GitHistoryOwnershipSignalResolver, bool) {
+	return r, true
+}
+
+func (r *gitHistoryOwnershipSignalResolver) Title(_ context.Context) (string, error) {
+	return "Git history", nil
+}
+
+func (r *gitHistoryOwnershipSignalResolver) Description(_ context.Context) (string, error) {
+	var parts []string
+	if n := r.signal.RecentCommitCount; n > 0 {
+		commits := "commits"
+		if n == 1 {
+			commits = "commit"
+		}
+		parts = append(parts, fmt.Sprintf("authored %d recent %s to this file", n, commits))
+	}
+	if share := r.signal.BlameLineShare; share > 0 {
+		parts = append(parts, fmt.Sprintf("last changed %.0f%% of its lines", share*100))
+	}
+	if len(parts) == 0 {
+		return "Owner has contributed to this file.", nil
+	}
+	return fmt.Sprintf("Owner %s.", strings.Join(parts, " and ")), nil
+}
+
+func (r *gitHistoryOwnershipSignalResolver) RecentCommitCount() int32 {
+	return r.signal.RecentCommitCount
+}
+
+func (r *gitHistoryOwnershipSignalResolver) LastCommitDate() *gqlutil.DateTime {
+	return gqlutil.DateTimeOrNil(r.signal.LastCommitAt)
+}
+
+func (r *gitHistoryOwnershipSignalResolver) BlameLineShare() float64 {
+	return r.signal.BlameLineShare
+}
+
 func areOwnEndpointsAvailable(ctx context.Context) error {
 	if !featureflag.FromContext(ctx).GetBoolOr("search-ownership", false) {
 		return errors.New("own is not available yet")
@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/graph-gophers/graphql-go/relay"
@@ -30,6 +31,7 @@ import (
 
 	enterprisedb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 )
 
 // userCtx returns a context where give user ID identifies logged in user.
@@ -41,7 +43,8 @@ func userCtx(userID int32) context.Context {
 
 // fakeOwnService returns given owners file and resolves owners to UnknownOwner.
 type fakeOwnService struct {
-	Ruleset *codeowners.Ruleset
+	Ruleset        *codeowners.Ruleset
+	HistorySignals map[string][]*owntypes.HistorySignal
 }
 
 func (s fakeOwnService) RulesetForRepo(context.Context, api.RepoName, api.RepoID, api.CommitID) (*codeowners.Ruleset, error) {
@@ -51,7 +54,7 @@ func (s fakeOwnService) RulesetForRepo(context.Context, api.RepoName, api.RepoID
 // ResolverOwnersWithType here behaves in line with production
 // OwnService implementation in case handle/email cannot be associated
 // with anything - defaults to a Person with a nil person entity.
-func (s fakeOwnService) ResolveOwnersWithType(_ context.Context, owners []*codeownerspb.Owner) ([]codeowners.ResolvedOwner, error) {
+func (s fakeOwnService) ResolveOwnersWithType(_ context.Context, owners []*codeownerspb.Owner, signals []*owntypes.HistorySignal) ([]codeowners.ResolvedOwner, error) {
 	var resolved []codeowners.ResolvedOwner
 	for _, o := range owners {
 		resolved = append(resolved, &codeowners.Person{
@@ -59,9 +62,34 @@ func (s fakeOwnService) ResolveOwnersWithType(_ context.Context, owners []*codeo
 			Email:  o.Email,
 		})
 	}
+signals:
+	for _, signal := range signals {
+		for _, ro := range resolved {
+			if p := ro.(*codeowners.Person); p.Email == signal.AuthorEmail {
+				p.HistorySignals = append(p.HistorySignals, signal)
+				continue signals
+			}
+		}
+		resolved = append(resolved, &codeowners.Person{
+			Email:          signal.AuthorEmail,
+			HistorySignals: []*owntypes.HistorySignal{signal},
+		})
+	}
 	return resolved, nil
 }
 
+func (s fakeOwnService) HistorySignalsForPath(_ context.Context, _ api.RepoID, path string) ([]*owntypes.HistorySignal, error) {
+	return s.HistorySignals[path], nil
+}
+
+func (s fakeOwnService) HistorySignalsForPaths(_ context.Context, _ api.RepoID, paths []string) (map[string][]*owntypes.HistorySignal, error) {
+	signals := make(map[string][]*owntypes.HistorySignal, len(paths))
+	for _, path := range paths {
+		signals[path] = s.HistorySignals[path]
+	}
+	return signals, nil
+}
+
 // fakeGitServer is a limited gitserver.Client that returns a file for every Stat call.
 type fakeGitserver struct {
 	gitserver.Client
@@ -312,6 +340,330 @@ func TestBlobOwnershipPanelQueryIngested(t *testing.T) {
 	})
 }
 
+// TestBlobOwnershipSections checks that owners from all matching sections of
+// a CODEOWNERS file are returned, along with the sections that yielded them.
+func TestBlobOwnershipSections(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fs := fakedb.New()
+	db := database.NewMockDB()
+	fs.Wire(db)
+	repoID := api.RepoID(1)
+	own := fakeOwnService{
+		Ruleset: codeowners.NewRuleset(
+			codeowners.IngestedRulesetSource{ID: int32(repoID)},
+			&codeownerspb.File{
+				Rule: []*codeownerspb.Rule{
+					{
+						Pattern: "*.js",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "js-owner"},
+						},
+						LineNumber: 1,
+					},
+					{
+						Pattern:     "foo/",
+						SectionName: "docs",
+						Owner: []*codeownerspb.Owner{
+							{Handle: "docs-owner"},
+							{Handle: "js-owner"},
+						},
+						LineNumber: 4,
+					},
+				},
+				Section: []*codeownerspb.Section{
+					{
+						Name:       "docs",
+						Optional:   true,
+						Approvals:  2,
+						LineNumber: 3,
+					},
+				},
+			}),
+	}
+	ctx := userCtx(fs.AddUser(types.User{SiteAdmin: true}))
+	ctx = featureflag.WithFlags(ctx, featureflag.NewMemoryStore(map[string]bool{"search-ownership": true}, nil, nil))
+	repos := database.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, nil, graphqlbackend.OptionalResolver{OwnResolver: resolvers.NewWithService(db, git, own, logger)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	graphqlbackend.RunTest(t, &graphqlbackend.Test{
+		Schema:  schema,
+		Context: ctx,
+		Query: `
+			fragment CodeownersFileEntryFields on CodeownersFileEntry {
+				ruleLineMatch
+				section {
+					name
+					optional
+					approvalsRequired
+					lineNumber
+				}
+			}
+
+			query FetchOwnership($repo: ID!, $revision: String!, $currentPath: String!) {
+				node(id: $repo) {
+					... on Repository {
+						commit(rev: $revision) {
+							blob(path: $currentPath) {
+								ownership {
+									totalCount
+									nodes {
+										owner {
+											... on Person {
+												displayName
+											}
+										}
+										reasons {
+											...CodeownersFileEntryFields
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}`,
+		ExpectedResult: `{
+			"node": {
+				"commit": {
+					"blob": {
+						"ownership": {
+							"totalCount": 2,
+							"nodes": [
+								{
+									"owner": {
+										"displayName": "docs-owner"
+									},
+									"reasons": [
+										{
+											"ruleLineMatch": 4,
+											"section": {
+												"name": "docs",
+												"optional": true,
+												"approvalsRequired": 2,
+												"lineNumber": 3
+											}
+										}
+									]
+								},
+								{
+									"owner": {
+										"displayName": "js-owner"
+									},
+									"reasons": [
+										{
+											"ruleLineMatch": 1,
+											"section": null
+										},
+										{
+											"ruleLineMatch": 4,
+											"section": {
+												"name": "docs",
+												"optional": true,
+												"approvalsRequired": 2,
+												"lineNumber": 3
+											}
+										}
+									]
+								}
+							]
+						}
+					}
+				}
+			}
+		}`,
+		Variables: map[string]any{
+			"repo":        string(relay.MarshalID("Repository", repoID)),
+			"revision":    "revision",
+			"currentPath": "foo/bar.js",
+		},
+	})
+}
+
+func TestBlobOwnershipHistorySignals(t *testing.T) {
+	logger := logtest.Scoped(t)
+	fs := fakedb.New()
+	db := database.NewMockDB()
+	fs.Wire(db)
+	repoID := api.RepoID(1)
+	lastCommitAt := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	own := fakeOwnService{
+		Ruleset: codeowners.NewRuleset(
+			codeowners.IngestedRulesetSource{ID: int32(repoID)},
+			&codeownerspb.File{
+				Rule: []*codeownerspb.Rule{
+					{
+						Pattern: "*.js",
+						Owner: []*codeownerspb.Owner{
+							{Email: "js-owner@example.com"},
+						},
+						LineNumber: 1,
+					},
+				},
+			}),
+		HistorySignals: map[string][]*owntypes.HistorySignal{
+			"foo/bar.js": {
+				{
+					RepoID:            repoID,
+					Path:              "foo/bar.js",
+					AuthorEmail:       "contributor@example.com",
+					RecentCommitCount: 3,
+					LastCommitAt:      &lastCommitAt,
+					BlameLineShare:    0.75,
+				},
+				{
+					RepoID:         repoID,
+					Path:           "foo/bar.js",
+					AuthorEmail:    "js-owner@example.com",
+					BlameLineShare: 0.25,
+				},
+			},
+		},
+	}
+	ctx := userCtx(fs.AddUser(types.User{SiteAdmin: true}))
+	ctx = featureflag.WithFlags(ctx, featureflag.NewMemoryStore(map[string]bool{"search-ownership": true}, nil, nil))
+	repos := database.NewMockRepoStore()
+	db.ReposFunc.SetDefaultReturn(repos)
+	repos.GetFunc.SetDefaultReturn(&types.Repo{ID: repoID, Name: "github.com/sourcegraph/own"}, nil)
+	backend.Mocks.Repos.ResolveRev = func(_ context.Context, repo *types.Repo, rev string) (api.CommitID, error) {
+		return "deadbeef", nil
+	}
+	git := fakeGitserver{}
+	schema, err := graphqlbackend.NewSchema(db, git, nil, graphqlbackend.OptionalResolver{OwnResolver: resolvers.NewWithService(db, git, own, logger)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := `
+		query FetchOwnership($repo: ID!, $revision: String!, $currentPath: String!, $reasons: [OwnershipReasonType!]) {
+			node(id: $repo) {
+				... on Repository {
+					commit(rev: $revision) {
+						blob(path: $currentPath) {
+							ownership(reasons: $reasons) {
+								totalCount
+								nodes {
+									owner {
+										... on Person {
+											email
+										}
+									}
+									reasons {
+										__typename
+										... on GitHistoryOwnershipSignal {
+											description
+											recentCommitCount
+											lastCommitDate
+											blameLineShare
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+	variables := func(reasons ...any) map[string]any {
+		v := map[string]any{
+			"repo":        string(relay.MarshalID("Repository", repoID)),
+			"revision":    "revision",
+			"currentPath": "foo/bar.js",
+		}
+		if len(reasons) > 0 {
+			v["reasons"] = reasons
+		}
+		return v
+	}
+	graphqlbackend.RunTests(t, []*graphqlbackend.Test{
+		{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 2,
+								"nodes": [
+									{
+										"owner": {
+											"email": "contributor@example.com"
+										},
+										"reasons": [
+											{
+												"__typename": "GitHistoryOwnershipSignal",
+												"description": "Owner authored 3 recent commits to this file and last changed 75% of its lines.",
+												"recentCommitCount": 3,
+												"lastCommitDate": "2023-04-01T12:00:00Z",
+												"blameLineShare": 0.75
+											}
+										]
+									},
+									{
+										"owner": {
+											"email": "js-owner@example.com"
+										},
+										"reasons": [
+											{
+												"__typename": "CodeownersFileEntry"
+											},
+											{
+												"__typename": "GitHistoryOwnershipSignal",
+												"description": "Owner last changed 25% of its lines.",
+												"recentCommitCount": 0,
+												"lastCommitDate": null,
+												"blameLineShare": 0.25
+											}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			Variables: variables(),
+		},
+		{
+			Schema:  schema,
+			Context: ctx,
+			Query:   query,
+			ExpectedResult: `{
+				"node": {
+					"commit": {
+						"blob": {
+							"ownership": {
+								"totalCount": 1,
+								"nodes": [
+									{
+										"owner": {
+											"email": "js-owner@example.com"
+										},
+										"reasons": [
+											{
+												"__typename": "CodeownersFileEntry"
+											}
+										]
+									}
+								]
+							}
+						}
+					}
+				}
+			}`,
+			Variables: variables("CODEOWNERS_FILE_ENTRY"),
+		},
+	})
+}
+
 func TestBlobOwnershipPanelQueryTeamResolved(t *testing.T) {
 	logger := logtest.Scoped(t)
 	repo := &types.Repo{Name: "repo-name", ID: 42}
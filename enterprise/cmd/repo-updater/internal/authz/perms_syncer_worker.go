@@ -12,7 +12,9 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -72,14 +74,14 @@ func (h *permsSyncerWorker) Handle(ctx context.Context, _ log.Logger, record *da
 		log.Int("priority", int(record.Priority)),
 	)
 
-	return h.handlePermsSync(ctx, reqType, reqID, record.ID, record.NoPerms, record.InvalidateCaches)
+	return h.handlePermsSync(ctx, reqType, reqID, record.ID, record.NumFailures, record.NoPerms, record.InvalidateCaches)
 }
 
 // handlePermsSync is effectively a sync version of `perms_syncer.syncPerms`
 // which calls `perms_syncer.syncUserPerms` or `perms_syncer.syncRepoPerms`
 // depending on a request type and logs/adds metrics of sync statistics
 // afterwards.
-func (h *permsSyncerWorker) handlePermsSync(ctx context.Context, reqType requestType, reqID int32, recordID int, noPerms, invalidateCaches bool) error {
+func (h *permsSyncerWorker) handlePermsSync(ctx context.Context, reqType requestType, reqID int32, recordID, numFailures int, noPerms, invalidateCaches bool) error {
 	var err error
 	var result *database.SetPermissionsResult
 	var providerStates database.CodeHostStatusesSet
@@ -115,9 +117,47 @@ func (h *permsSyncerWorker) handlePermsSync(ctx context.Context, reqType request
 		h.logger.Error(fmt.Sprintf("failed to save permissions sync job(%d) results", recordID), log.Error(saveErr))
 	}
 
+	// Failed jobs that are going to be retried are not finished yet, so the
+	// failure webhook is only sent once the job reaches the failed state.
+	if err == nil || isTerminalFailure(err, numFailures) {
+		h.enqueueWebhook(ctx, reqType, reqID, recordID, result, err)
+	}
+
 	return err
 }
 
+// isTerminalFailure returns true if the worker marks a job that failed with the
+// given error as failed instead of errored, which mirrors the conditions used by
+// workerutil and the dbworker store.
+func isTerminalFailure(err error, numFailures int) bool {
+	return errcode.IsNonRetryable(err) || numFailures+1 >= syncJobMaxNumRetries
+}
+
+// enqueueWebhook sends the outbound webhook for a finished permissions sync job.
+func (h *permsSyncerWorker) enqueueWebhook(ctx context.Context, reqType requestType, reqID int32, recordID int, result *database.SetPermissionsResult, syncErr error) {
+	payload := events.PermissionsSync{JobID: recordID}
+	if reqType == requestTypeRepo {
+		payload.RepositoryID = api.RepoID(reqID)
+	} else {
+		payload.UserID = reqID
+	}
+	if result != nil {
+		payload.Added = result.Added
+		payload.Removed = result.Removed
+		payload.Found = result.Found
+	}
+	if syncErr != nil {
+		payload.Error = syncErr.Error()
+	}
+
+	events.Enqueue(ctx, h.logger, h.jobsStore, payload.EventType(), payload)
+}
+
+// syncJobMaxNumRetries is the number of times a failed permissions sync job is
+// retried before it is marked as failed. Jobs are not retried, as a new one is
+// scheduled for the next sync anyway.
+const syncJobMaxNumRetries = 0
+
 func MakeStore(observationCtx *observation.Context, dbHandle basestore.TransactableHandle, syncType syncType) dbworkerstore.Store[*database.PermissionSyncJob] {
 	name := "repo_permissions_sync_job_worker_store"
 	if syncType == SyncTypeUser {
@@ -135,6 +175,7 @@ func MakeStore(observationCtx *observation.Context, dbHandle basestore.Transacta
 		// 3. job_id: 1(old) > 2(enqueued after 1)
 		OrderByExpression: sqlf.Sprintf("permission_sync_jobs.priority DESC, permission_sync_jobs.process_after ASC NULLS FIRST, permission_sync_jobs.id ASC"),
 		MaxNumResets:      5,
+		MaxNumRetries:     syncJobMaxNumRetries,
 		StalledMaxAge:     time.Second * 30,
 	})
 }
@@ -15,17 +15,27 @@ var maxCacheEntriesSize = env.MustGetInt(
 	"Maximum size of the batch_spec_execution_cache_entries.value column. Value is megabytes.",
 )
 
+var maxSharedCacheEntriesSize = env.MustGetInt(
+	"SRC_BATCH_CHANGES_MAX_SHARED_CACHE_SIZE_MB",
+	5000,
+	"Maximum size of the batch_spec_execution_shared_cache_entries.value column. Value is megabytes.",
+)
+
 const cacheCleanInterval = 1 * time.Hour
 
 func NewCacheEntryCleaner(ctx context.Context, s *store.Store) goroutine.BackgroundRoutine {
 	maxSizeByte := int64(maxCacheEntriesSize * 1024 * 1024)
+	maxSharedSizeByte := int64(maxSharedCacheEntriesSize * 1024 * 1024)
 
 	return goroutine.NewPeriodicGoroutine(
 		ctx,
 		"batchchanges.cache-cleaner", "cleaning up LRU batch spec execution cache entries",
 		cacheCleanInterval,
 		goroutine.HandlerFunc(func(ctx context.Context) error {
-			return s.CleanBatchSpecExecutionCacheEntries(ctx, maxSizeByte)
+			if err := s.CleanBatchSpecExecutionCacheEntries(ctx, maxSizeByte); err != nil {
+				return err
+			}
+			return s.CleanBatchSpecExecutionSharedCacheEntries(ctx, maxSharedSizeByte)
 		}),
 	)
 }
@@ -35,6 +35,7 @@ func (j *schedulerJob) Routines(_ context.Context, observationCtx *observation.C
 
 	routines := []goroutine.BackgroundRoutine{
 		scheduler.NewScheduler(workCtx, bstore),
+		scheduler.NewBatchChangeScheduler(workCtx, bstore),
 	}
 
 	return routines, nil
@@ -48,8 +48,9 @@ func (r *batchSpecWorkspaceCreator) HandlerFunc() workerutil.HandlerFunc[*btypes
 }
 
 type stepCacheKey struct {
-	index int
-	key   string
+	index     int
+	key       string
+	sharedKey string
 }
 
 type workspaceCacheKey struct {
@@ -117,6 +118,7 @@ func (r *batchSpecWorkspaceCreator) process(
 	// Collect all cache keys so we can look them up in a single query.
 	cacheKeyWorkspaces := make([]workspaceCacheKey, 0, len(workspaces))
 	allStepCacheKeys := make([]string, 0, len(workspaces))
+	allSharedStepCacheKeys := make([]string, 0, len(workspaces))
 	// load the mounts from the DB up front to avoid duplicate calls with no difference in data
 	mounts, err := listBatchSpecMounts(ctx, r.store, spec.ID)
 	if err != nil {
@@ -164,6 +166,7 @@ func (r *batchSpecWorkspaceCreator) process(
 		}
 
 		stepCacheKeys := make([]stepCacheKey, 0, len(spec.Spec.Steps))
+		workspace.SharedStepCacheKeys = make(map[int]string, len(spec.Spec.Steps))
 		// Generate cache keys for all the steps.
 		for i := 0; i < len(spec.Spec.Steps); i++ {
 			if _, ok := skippedSteps[i]; ok {
@@ -189,8 +192,17 @@ func (r *batchSpecWorkspaceCreator) process(
 				return err
 			}
 
-			stepCacheKeys = append(stepCacheKeys, stepCacheKey{index: i, key: rawStepKey})
+			// The shared key doesn't depend on the batch change, so that the
+			// step results can be reused by other batch changes, too.
+			sharedStepKey, err := key.SharedKey()
+			if err != nil {
+				return err
+			}
+
+			stepCacheKeys = append(stepCacheKeys, stepCacheKey{index: i, key: rawStepKey, sharedKey: sharedStepKey})
 			allStepCacheKeys = append(allStepCacheKeys, rawStepKey)
+			allSharedStepCacheKeys = append(allSharedStepCacheKeys, sharedStepKey)
+			workspace.SharedStepCacheKeys[i+1] = sharedStepKey
 		}
 
 		cacheKeyWorkspaces = append(cacheKeyWorkspaces, workspaceCacheKey{
@@ -215,11 +227,28 @@ func (r *batchSpecWorkspaceCreator) process(
 		}
 	}
 
+	// Entries in the shared cache are only returned for repositories the user
+	// has access to, since ctx is the user's.
+	sharedStepEntriesByCacheKey := make(map[string]*btypes.BatchSpecExecutionSharedCacheEntry, len(allSharedStepCacheKeys))
+	if len(allSharedStepCacheKeys) > 0 {
+		entries, err := r.store.ListBatchSpecExecutionSharedCacheEntries(ctx, store.ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: allSharedStepCacheKeys,
+		})
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			sharedStepEntriesByCacheKey[entry.Key] = entry
+		}
+	}
+
 	// All changeset specs to be created.
 	cs := []*btypes.ChangesetSpec{}
 	// Collect all IDs of used cache entries to mark them as recently used later.
 	usedCacheEntries := []int64{}
+	usedSharedCacheEntries := []int64{}
 	changesetsByWorkspace := make(map[*btypes.BatchSpecWorkspace][]*btypes.ChangesetSpec)
+	artifactsByWorkspace := make(map[*btypes.BatchSpecWorkspace]map[string]execution.Artifact)
 
 	changesetAuthor, err := author.GetChangesetAuthorForUser(ctx, database.UsersWith(r.logger, r.store), spec.UserID)
 	if err != nil {
@@ -240,6 +269,17 @@ func (r *batchSpecWorkspaceCreator) process(
 
 				// Mark the cache entry as used.
 				usedCacheEntries = append(usedCacheEntries, c.ID)
+			} else if c, ok := sharedStepEntriesByCacheKey[ck.sharedKey]; ok {
+				// Fall back to a result another batch change or user produced
+				// for the same steps.
+				var res execution.AfterStepResult
+				if err := json.Unmarshal([]byte(c.Value), &res); err != nil {
+					return err
+				}
+				workspace.dbWorkspace.SetStepCacheResult(idx+1, btypes.StepCacheResult{Key: key, Value: &res, Shared: true})
+
+				// Mark the shared cache entry as used.
+				usedSharedCacheEntries = append(usedSharedCacheEntries, c.ID)
 			} else {
 				// Only add cache entries up until we don't have the cache entry
 				// for the previous step anymore.
@@ -301,6 +341,9 @@ func (r *batchSpecWorkspaceCreator) process(
 
 		cs = append(cs, specs...)
 		changesetsByWorkspace[workspace.dbWorkspace] = specs
+		if len(res.Value.Artifacts) > 0 {
+			artifactsByWorkspace[workspace.dbWorkspace] = res.Value.Artifacts
+		}
 	}
 
 	// If there are "importChangesets" statements in the spec we evaluate
@@ -321,6 +364,9 @@ func (r *batchSpecWorkspaceCreator) process(
 	if err := tx.MarkUsedBatchSpecExecutionCacheEntries(ctx, usedCacheEntries); err != nil {
 		return err
 	}
+	if err := tx.MarkUsedBatchSpecExecutionSharedCacheEntries(ctx, usedSharedCacheEntries); err != nil {
+		return err
+	}
 
 	if err = tx.CreateChangesetSpec(ctx, cs...); err != nil {
 		return err
@@ -333,7 +379,22 @@ func (r *batchSpecWorkspaceCreator) process(
 		}
 	}
 
-	return tx.CreateBatchSpecWorkspace(ctx, ws...)
+	if err := tx.CreateBatchSpecWorkspace(ctx, ws...); err != nil {
+		return err
+	}
+
+	// Record the artifacts of the cached results. Their content was uploaded
+	// when the steps were executed, and artifacts are stored content-addressed,
+	// so the workspaces can reference the same objects.
+	for workspace, artifacts := range artifactsByWorkspace {
+		for name, a := range artifacts {
+			if err := tx.UpsertBatchSpecWorkspaceArtifact(ctx, btypes.NewBatchSpecWorkspaceArtifact(workspace.ID, name, a)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 func listBatchSpecMounts(ctx context.Context, s *store.Store, batchSpecID int64) ([]*btypes.BatchSpecWorkspaceFile, error) {
@@ -3,7 +3,9 @@ package workers
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -351,6 +353,94 @@ func TestBatchSpecWorkspaceCreatorProcess_Caching(t *testing.T) {
 		}
 	})
 
+	t.Run("shared cache entry of other batch change", func(t *testing.T) {
+		workspace := buildWorkspace("shared-cache-entry")
+
+		otherUser := bt.CreateTestUser(t, db, false)
+		otherBatchSpec := createBatchSpec(t, false, strings.Replace(bt.TestRawBatchSpecYAML, "my-unique-name", "my-other-name", 1))
+		sharedKey, err := cache.KeyForWorkspace(
+			&template.BatchChangeAttributes{
+				Name:        otherBatchSpec.Spec.Name,
+				Description: otherBatchSpec.Spec.Description,
+			},
+			batcheslib.Repository{
+				ID:          string(relay.MarshalID("Repository", workspace.Repo.ID)),
+				Name:        string(workspace.Repo.Name),
+				BaseRef:     workspace.Branch,
+				BaseRev:     string(workspace.Commit),
+				FileMatches: workspace.FileMatches,
+			},
+			workspace.Path,
+			[]string{fmt.Sprintf("FOO=%s", secretValue)},
+			workspace.OnlyFetchWorkspace,
+			otherBatchSpec.Spec.Steps,
+			executionResult.StepIndex,
+			&remoteFileMetadataRetriever{},
+		).SharedKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := json.Marshal(executionResult)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry := &btypes.BatchSpecExecutionSharedCacheEntry{
+			RepoID: workspace.Repo.ID,
+			UserID: otherUser.ID,
+			Key:    sharedKey,
+			Value:  string(value),
+		}
+		if err := s.CreateBatchSpecExecutionSharedCacheEntry(context.Background(), entry); err != nil {
+			t.Fatal(err)
+		}
+
+		batchSpec := createBatchSpec(t, false, bt.TestRawBatchSpecYAML)
+
+		resolver := &dummyWorkspaceResolver{workspaces: []*service.RepoWorkspace{workspace}}
+		job := &btypes.BatchSpecResolutionJob{BatchSpecID: batchSpec.ID}
+		if err := creator.process(userCtx, resolver.DummyBuilder, job); err != nil {
+			t.Fatalf("proces failed: %s", err)
+		}
+
+		have, _, err := s.ListBatchSpecWorkspaces(context.Background(), store.ListBatchSpecWorkspacesOpts{BatchSpecID: batchSpec.ID})
+		if err != nil {
+			t.Fatalf("listing workspaces failed: %s", err)
+		}
+		if len(have) != 1 {
+			t.Fatalf("wrong number of workspaces: %d", len(have))
+		}
+
+		if !have[0].CachedResultFound {
+			t.Fatal("cached result not found")
+		}
+		if diff := cmp.Diff(map[int]string{1: sharedKey}, have[0].SharedStepCacheKeys); diff != "" {
+			t.Fatalf("wrong shared step cache keys: %s", diff)
+		}
+		result, ok := have[0].StepCacheResult(1)
+		if !ok {
+			t.Fatal("step cache result not found")
+		}
+		if !result.Shared {
+			t.Fatal("step cache result not marked as shared")
+		}
+		if diff := cmp.Diff(executionResult, result.Value); diff != "" {
+			t.Fatalf("wrong step cache result: %s", diff)
+		}
+
+		reloadedEntries, err := s.ListBatchSpecExecutionSharedCacheEntries(context.Background(), store.ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: []string{sharedKey},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reloadedEntries) != 1 {
+			t.Fatal("shared cache entry not found")
+		}
+		if !reloadedEntries[0].LastUsedAt.Equal(now) {
+			t.Fatalf("shared cache entry LastUsedAt not updated. want=%s, have=%s", now, reloadedEntries[0].LastUsedAt)
+		}
+	})
+
 	t.Run("secret value changed", func(t *testing.T) {
 		workspace := buildWorkspace("secret-value-changed")
 
@@ -1034,7 +1124,7 @@ func assertWorkspacesEqual(t *testing.T, have, want []*btypes.BatchSpecWorkspace
 	t.Helper()
 
 	opts := []cmp.Option{
-		cmpopts.IgnoreFields(btypes.BatchSpecWorkspace{}, "ID", "CreatedAt", "UpdatedAt"),
+		cmpopts.IgnoreFields(btypes.BatchSpecWorkspace{}, "ID", "CreatedAt", "UpdatedAt", "SharedStepCacheKeys"),
 		cmpopts.IgnoreUnexported(bytes.Buffer{}),
 	}
 	if diff := cmp.Diff(want, have, opts...); diff != "" {
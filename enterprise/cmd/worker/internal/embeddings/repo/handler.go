@@ -2,6 +2,8 @@ package repo
 
 import (
 	"context"
+	"io"
+	"runtime"
 
 	"github.com/sourcegraph/log"
 
@@ -14,10 +16,13 @@ import (
 	repoembeddingsbg "github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/background/repo"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/split"
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 type handler struct {
@@ -40,6 +45,10 @@ const (
 	embeddingChunkEarlySplitTokensThreshold = embeddingChunkTokensThreshold - 32
 )
 
+// approximateIndexMinRowsToSplit is the minimum number of rows that are split among
+// workers when building approximate indexes.
+const approximateIndexMinRowsToSplit = 1000
+
 var splitOptions = split.SplitOptions{
 	NoSplitTokensThreshold:         embedEntireFileTokensThreshold,
 	ChunkTokensThreshold:           embeddingChunkTokensThreshold,
@@ -56,45 +65,209 @@ func (h *handler) Handle(ctx context.Context, logger log.Logger, record *repoemb
 		return err
 	}
 
-	files, err := h.gitserverClient.ListFiles(ctx, nil, repo.Name, record.Revision, matchEverythingRegexp)
-	if err != nil {
-		return err
+	embeddingsClient := embed.NewEmbeddingsClient()
+
+	config := conf.Get().Embeddings
+	excludedGlobPatterns := embed.GetDefaultExcludedFilePathPatterns()
+	excludedGlobPatterns = append(excludedGlobPatterns, embed.CompileGlobPatterns(config.ExcludedFilePathPatterns)...)
+
+	readFile := func(fileName string) ([]byte, error) {
+		return h.gitserverClient.ReadFile(ctx, nil, repo.Name, record.Revision, fileName)
 	}
 
-	validFiles := []string{}
-	for _, file := range files {
-		stat, err := h.gitserverClient.Stat(ctx, nil, repo.Name, record.Revision, file)
+	var repoEmbeddingIndex *embeddings.RepoEmbeddingIndex
+	previousIndex := h.getPreviousRepoEmbeddingIndex(ctx, logger, repo.Name, record.Revision, config, embeddingsClient)
+	isIncremental := false
+	if previousIndex != nil {
+		changedFiles, removedFiles, err := h.diffFiles(ctx, repo.Name, previousIndex.Revision, record.Revision)
+		if err != nil {
+			// The previous revision might not exist anymore, e.g. after a force push.
+			logger.Warn("failed to diff against the previously embedded revision, embedding the whole repository", log.String("previousRevision", string(previousIndex.Revision)), log.Error(err))
+		} else {
+			isIncremental = true
+			repoEmbeddingIndex, err = embed.EmbedRepoIncrementally(
+				ctx,
+				previousIndex,
+				record.Revision,
+				changedFiles,
+				removedFiles,
+				excludedGlobPatterns,
+				embeddingsClient,
+				splitOptions,
+				readFile,
+				getDocumentRanks,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !isIncremental {
+		validFiles, err := h.listValidFiles(ctx, repo.Name, record.Revision)
 		if err != nil {
 			return err
 		}
 
-		if !stat.IsDir() && stat.Size() <= MAX_FILE_SIZE {
-			validFiles = append(validFiles, file)
+		repoEmbeddingIndex, err = embed.EmbedRepo(
+			ctx,
+			repo.Name,
+			record.Revision,
+			validFiles,
+			excludedGlobPatterns,
+			embeddingsClient,
+			splitOptions,
+			readFile,
+			getDocumentRanks,
+		)
+		if err != nil {
+			return err
 		}
 	}
 
-	embeddingsClient := embed.NewEmbeddingsClient()
+	if opts, ok := embeddings.ApproximateSearchOptionsFromConfig(config); ok {
+		workerOptions := embeddings.WorkerOptions{NumWorkers: runtime.GOMAXPROCS(0), MinRowsToSplit: approximateIndexMinRowsToSplit}
+		repoEmbeddingIndex.CodeIndex.BuildApproximateIndex(opts, workerOptions)
+		repoEmbeddingIndex.TextIndex.BuildApproximateIndex(opts, workerOptions)
+	}
 
-	config := conf.Get().Embeddings
-	excludedGlobPatterns := embed.GetDefaultExcludedFilePathPatterns()
-	excludedGlobPatterns = append(excludedGlobPatterns, embed.CompileGlobPatterns(config.ExcludedFilePathPatterns)...)
+	if config.QuantizeIndexes {
+		repoEmbeddingIndex.CodeIndex.Quantize()
+		repoEmbeddingIndex.TextIndex.Quantize()
+	}
 
-	repoEmbeddingIndex, err := embed.EmbedRepo(
-		ctx,
-		repo.Name,
-		record.Revision,
-		validFiles,
-		excludedGlobPatterns,
-		embeddingsClient,
-		splitOptions,
-		func(fileName string) ([]byte, error) {
-			return h.gitserverClient.ReadFile(ctx, nil, repo.Name, record.Revision, fileName)
-		},
-		getDocumentRanks,
-	)
-	if err != nil {
+	if err := embeddings.UploadRepoEmbeddingIndex(ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repo.Name)), repoEmbeddingIndex); err != nil {
 		return err
 	}
 
-	return embeddings.UploadRepoEmbeddingIndex(ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repo.Name)), repoEmbeddingIndex)
+	if isIncremental {
+		if err := repoembeddingsbg.NewRepoEmbeddingJobsStore(h.db).MarkRepoEmbeddingJobIncremental(ctx, record.ID); err != nil {
+			return err
+		}
+	}
+
+	events.Enqueue(ctx, logger, h.db, events.EmbeddingsJobCompleted, events.EmbeddingsJob{
+		JobID:          record.ID,
+		RepositoryID:   repo.ID,
+		RepositoryName: repo.Name,
+		Revision:       record.Revision,
+		Incremental:    isIncremental,
+	})
+	return nil
+}
+
+// getPreviousRepoEmbeddingIndex returns the current embedding index of the
+// repository if it can be updated incrementally to the given revision, and nil
+// otherwise.
+func (h *handler) getPreviousRepoEmbeddingIndex(
+	ctx context.Context,
+	logger log.Logger,
+	repoName api.RepoName,
+	revision api.CommitID,
+	config *schema.Embeddings,
+	embeddingsClient embed.EmbeddingsClient,
+) *embeddings.RepoEmbeddingIndex {
+	if config.Incremental != nil && !*config.Incremental {
+		return nil
+	}
+
+	previousIndex, err := embeddings.DownloadRepoEmbeddingIndex(ctx, h.uploadStore, string(embeddings.GetRepoEmbeddingIndexName(repoName)))
+	if err != nil {
+		// The repository was not embedded before.
+		logger.Debug("no previous repo embedding index", log.Error(err))
+		return nil
+	}
+
+	if previousIndex.Revision == "" || previousIndex.Revision == revision {
+		return nil
+	}
+
+	// The embeddings of the previous index were created with a different model.
+	dimensions, err := embeddingsClient.GetDimensions()
+	if err != nil || previousIndex.CodeIndex.ColumnDimension != dimensions || previousIndex.TextIndex.ColumnDimension != dimensions {
+		return nil
+	}
+
+	// Quantization is lossy, so quantized indexes are only updated while
+	// quantization is enabled.
+	if !config.QuantizeIndexes && (previousIndex.CodeIndex.IsQuantized() || previousIndex.TextIndex.IsQuantized()) {
+		return nil
+	}
+
+	return previousIndex
+}
+
+// diffFiles returns the files that were added or modified between the two
+// revisions and are valid for embedding, and the files that were removed or
+// are no longer valid for embedding.
+func (h *handler) diffFiles(ctx context.Context, repoName api.RepoName, base, head api.CommitID) (changedFiles, removedFiles []string, err error) {
+	iter, err := h.gitserverClient.Diff(ctx, nil, gitserver.DiffOptions{
+		Repo:      repoName,
+		Base:      string(base),
+		Head:      string(head),
+		RangeType: "..",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	for {
+		fileDiff, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		if fileDiff.OrigName != devNullPath && fileDiff.OrigName != fileDiff.NewName {
+			removedFiles = append(removedFiles, fileDiff.OrigName)
+		}
+		if fileDiff.NewName == devNullPath {
+			continue
+		}
+
+		valid, err := h.isValidFile(ctx, repoName, head, fileDiff.NewName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if valid {
+			changedFiles = append(changedFiles, fileDiff.NewName)
+		} else {
+			removedFiles = append(removedFiles, fileDiff.NewName)
+		}
+	}
+
+	return changedFiles, removedFiles, nil
+}
+
+// devNullPath is the file name git diff uses for the missing side of added and
+// removed files.
+const devNullPath = "/dev/null"
+
+func (h *handler) listValidFiles(ctx context.Context, repoName api.RepoName, revision api.CommitID) ([]string, error) {
+	files, err := h.gitserverClient.ListFiles(ctx, nil, repoName, revision, matchEverythingRegexp)
+	if err != nil {
+		return nil, err
+	}
+
+	validFiles := []string{}
+	for _, file := range files {
+		valid, err := h.isValidFile(ctx, repoName, revision, file)
+		if err != nil {
+			return nil, err
+		}
+		if valid {
+			validFiles = append(validFiles, file)
+		}
+	}
+	return validFiles, nil
+}
+
+func (h *handler) isValidFile(ctx context.Context, repoName api.RepoName, revision api.CommitID, file string) (bool, error) {
+	stat, err := h.gitserverClient.Stat(ctx, nil, repoName, revision, file)
+	if err != nil {
+		return false, err
+	}
+	return !stat.IsDir() && stat.Size() <= MAX_FILE_SIZE, nil
 }
@@ -0,0 +1,174 @@
+package own
+
+import (
+	"context"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// devNullPath is the file name git diff uses for the missing side of added and
+// removed files.
+const devNullPath = "/dev/null"
+
+type pathAuthor struct {
+	path  string
+	email string
+}
+
+type pathAuthorStats struct {
+	name         string
+	commits      int32
+	lastCommitAt time.Time
+	blamedLines  int
+}
+
+// computeHistorySignals computes the ownership signals of all the files of the
+// given repository at the given commit:
+//
+//   - The recent contributors of a file are the authors of the commits changing
+//     it within the configured window, among the most recent commits.
+//   - The line share of an author is the share of lines of a file they last
+//     changed, according to git blame. Blame is only computed for the files that
+//     changed most often within the window.
+func computeHistorySignals(ctx context.Context, client gitserver.Client, repo api.RepoName, head api.CommitID, config schema.OwnHistorySignals, now time.Time) ([]*types.HistorySignal, error) {
+	stats := map[pathAuthor]*pathAuthorStats{}
+	statsFor := func(path, email, name string) *pathAuthorStats {
+		key := pathAuthor{path: path, email: strings.ToLower(email)}
+		s, ok := stats[key]
+		if !ok {
+			s = &pathAuthorStats{name: name}
+			stats[key] = s
+		}
+		return s
+	}
+
+	commits, err := client.Commits(ctx, nil, repo, gitserver.CommitsOptions{
+		Range: string(head),
+		After: now.AddDate(0, 0, -config.RecentContributorsWindowDays).Format(time.RFC3339),
+		N:     uint(config.MaxCommitsPerRepo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]int{}
+	for _, commit := range commits {
+		// Merge commits don't author changes on their own, and root commits have
+		// no parent to diff against.
+		if len(commit.Parents) != 1 || commit.Author.Email == "" {
+			continue
+		}
+		paths, err := changedPaths(ctx, client, repo, commit.Parents[0], commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			changes[path]++
+			s := statsFor(path, commit.Author.Email, commit.Author.Name)
+			s.commits++
+			if commit.Author.Date.After(s.lastCommitAt) {
+				s.lastCommitAt = commit.Author.Date
+			}
+		}
+	}
+
+	// The files that changed most often are the most relevant to blame.
+	paths := make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if changes[paths[i]] != changes[paths[j]] {
+			return changes[paths[i]] > changes[paths[j]]
+		}
+		return paths[i] < paths[j]
+	})
+	if len(paths) > config.MaxBlamedFilesPerRepo {
+		paths = paths[:config.MaxBlamedFilesPerRepo]
+	}
+	totalLines := map[string]int{}
+	for _, path := range paths {
+		// Files changed within the window might have been removed since.
+		if _, err := client.Stat(ctx, nil, repo, head, path); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		hunks, err := client.BlameFile(ctx, nil, repo, path, &gitserver.BlameOptions{NewestCommit: head})
+		if err != nil {
+			return nil, err
+		}
+		for _, hunk := range hunks {
+			if hunk.Author.Email == "" {
+				continue
+			}
+			lines := hunk.EndLine - hunk.StartLine
+			totalLines[path] += lines
+			statsFor(path, hunk.Author.Email, hunk.Author.Name).blamedLines += lines
+		}
+	}
+
+	signals := make([]*types.HistorySignal, 0, len(stats))
+	for key, s := range stats {
+		signal := &types.HistorySignal{
+			Path:              key.path,
+			AuthorName:        s.name,
+			AuthorEmail:       key.email,
+			RecentCommitCount: s.commits,
+		}
+		if !s.lastCommitAt.IsZero() {
+			lastCommitAt := s.lastCommitAt
+			signal.LastCommitAt = &lastCommitAt
+		}
+		if total := totalLines[key.path]; total > 0 {
+			signal.BlameLineShare = float64(s.blamedLines) / float64(total)
+		}
+		signals = append(signals, signal)
+	}
+	sort.Slice(signals, func(i, j int) bool {
+		if signals[i].Path != signals[j].Path {
+			return signals[i].Path < signals[j].Path
+		}
+		return signals[i].AuthorEmail < signals[j].AuthorEmail
+	})
+	return signals, nil
+}
+
+// changedPaths returns the paths of the files that were added or modified by
+// the head commit.
+func changedPaths(ctx context.Context, client gitserver.Client, repo api.RepoName, base, head api.CommitID) ([]string, error) {
+	iter, err := client.Diff(ctx, nil, gitserver.DiffOptions{
+		Repo:      repo,
+		Base:      string(base),
+		Head:      string(head),
+		RangeType: "..",
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var paths []string
+	for {
+		fileDiff, err := iter.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if fileDiff.NewName == devNullPath {
+			continue
+		}
+		paths = append(paths, fileDiff.NewName)
+	}
+	return paths, nil
+}
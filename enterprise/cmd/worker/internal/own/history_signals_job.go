@@ -0,0 +1,144 @@
+package own
+
+import (
+	"context"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/worker/job"
+	workerdb "github.com/sourcegraph/sourcegraph/cmd/worker/shared/init/db"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/env"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+var _ job.Job = (*historySignalsJob)(nil)
+
+// historySignalsJob is a worker responsible for computing the ownership signals
+// derived from the git history of repositories.
+type historySignalsJob struct{}
+
+func NewOwnHistorySignalsJob() job.Job {
+	return &historySignalsJob{}
+}
+
+func (j *historySignalsJob) Description() string {
+	return "Computes ownership signals from the git history of repositories."
+}
+
+func (j *historySignalsJob) Config() []env.Config {
+	return nil
+}
+
+func (j *historySignalsJob) Routines(_ context.Context, observationCtx *observation.Context) ([]goroutine.BackgroundRoutine, error) {
+	db, err := workerdb.InitDB(observationCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "init DB")
+	}
+
+	return []goroutine.BackgroundRoutine{
+		goroutine.NewPeriodicGoroutine(
+			context.Background(),
+			"own.history-signals-indexer",
+			"computes ownership signals from the git history of repositories",
+			time.Minute,
+			&historySignalsHandler{
+				db:              edb.NewEnterpriseDB(db),
+				gitserverClient: gitserver.NewClient(),
+				logger:          observationCtx.Logger.Scoped("own-history-signals", "computes ownership signals from git history"),
+			},
+		),
+	}, nil
+}
+
+// reposPerRun is the maximum number of repositories for which signals are
+// computed by a single run of the handler.
+const reposPerRun = 10
+
+var _ goroutine.Handler = (*historySignalsHandler)(nil)
+
+type historySignalsHandler struct {
+	db              edb.EnterpriseDB
+	gitserverClient gitserver.Client
+	logger          log.Logger
+}
+
+// Handle computes the history signals of the repositories for which they were
+// never computed, or were computed longer than the refresh interval ago.
+func (h *historySignalsHandler) Handle(ctx context.Context) error {
+	c := conf.Get().OwnHistorySignals
+	if c == nil || !c.Enabled {
+		return nil
+	}
+	config := historySignalsConfigWithDefaults(*c)
+
+	repos, err := h.db.OwnSignals().ListReposForHistorySignals(ctx, time.Now().Add(-time.Duration(config.RefreshIntervalHours)*time.Hour), reposPerRun)
+	if err != nil {
+		return errors.Wrap(err, "listing repos")
+	}
+
+	var errs error
+	for _, repoName := range repos {
+		repo, err := h.db.Repos().GetByName(ctx, repoName)
+		if err != nil {
+			errs = errors.Append(errs, errors.Wrapf(err, "getting repo %q", repoName))
+			continue
+		}
+		if err := h.handleRepo(ctx, repo, config); err != nil {
+			errs = errors.Append(errs, err)
+			// Record the failure, so that the repo is backed off and doesn't
+			// prevent the signals of other repos from being computed.
+			if err := h.db.OwnSignals().RecordHistorySignalsFailure(ctx, repo.ID); err != nil {
+				errs = errors.Append(errs, errors.Wrapf(err, "recording failure of %q", repoName))
+			}
+		}
+	}
+	return errs
+}
+
+// handleRepo computes and stores the history signals of a single repository.
+func (h *historySignalsHandler) handleRepo(ctx context.Context, repo *itypes.Repo, config schema.OwnHistorySignals) error {
+	_, head, err := h.gitserverClient.GetDefaultBranch(ctx, repo.Name, true)
+	if err != nil {
+		return errors.Wrapf(err, "getting default branch of %q", repo.Name)
+	}
+	var signals []*types.HistorySignal
+	// Empty repositories have no history, so no signals are stored for them.
+	if head != "" {
+		signals, err = computeHistorySignals(ctx, h.gitserverClient, repo.Name, head, config, time.Now())
+		if err != nil {
+			return errors.Wrapf(err, "computing history signals of %q", repo.Name)
+		}
+	}
+	if err := h.db.OwnSignals().ReplaceHistorySignals(ctx, repo.ID, head, signals); err != nil {
+		return errors.Wrapf(err, "storing history signals of %q", repo.Name)
+	}
+	h.logger.Debug("computed history signals", log.String("repo", string(repo.Name)), log.Int("signals", len(signals)))
+	return nil
+}
+
+// historySignalsConfigWithDefaults returns the given configuration, with the
+// defaults of the site configuration schema for all unset values.
+func historySignalsConfigWithDefaults(c schema.OwnHistorySignals) schema.OwnHistorySignals {
+	if c.RecentContributorsWindowDays <= 0 {
+		c.RecentContributorsWindowDays = 90
+	}
+	if c.MaxCommitsPerRepo <= 0 {
+		c.MaxCommitsPerRepo = 1000
+	}
+	if c.MaxBlamedFilesPerRepo <= 0 {
+		c.MaxBlamedFilesPerRepo = 200
+	}
+	if c.RefreshIntervalHours <= 0 {
+		c.RefreshIntervalHours = 24
+	}
+	return c
+}
@@ -0,0 +1,57 @@
+package own
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	itypes "github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestHistorySignalsHandler(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+		OwnHistorySignals: &schema.OwnHistorySignals{Enabled: true},
+	}})
+	t.Cleanup(func() { conf.Mock(nil) })
+
+	repos := database.NewMockRepoStore()
+	repos.GetByNameFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) (*itypes.Repo, error) {
+		ids := map[api.RepoName]api.RepoID{"broken": 1, "empty": 2}
+		return &itypes.Repo{ID: ids[name], Name: name}, nil
+	})
+	signals := edb.NewMockOwnSignalStore()
+	signals.ListReposForHistorySignalsFunc.SetDefaultReturn([]api.RepoName{"broken", "empty"}, nil)
+	db := edb.NewMockEnterpriseDB()
+	db.ReposFunc.SetDefaultReturn(repos)
+	db.OwnSignalsFunc.SetDefaultReturn(signals)
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.GetDefaultBranchFunc.SetDefaultHook(func(_ context.Context, name api.RepoName, _ bool) (string, api.CommitID, error) {
+		if name == "broken" {
+			return "", "", errors.New("boom")
+		}
+		// Empty repos have no default branch.
+		return "", "", nil
+	})
+
+	h := &historySignalsHandler{db: db, gitserverClient: gitserverClient, logger: logtest.Scoped(t)}
+	err := h.Handle(context.Background())
+	require.ErrorContains(t, err, "boom")
+
+	// The failing repo is recorded as such, so that it doesn't starve the
+	// other repos, which are still processed.
+	require.Len(t, signals.RecordHistorySignalsFailureFunc.History(), 1)
+	assert.Equal(t, api.RepoID(1), signals.RecordHistorySignalsFailureFunc.History()[0].Arg1)
+	require.Len(t, signals.ReplaceHistorySignalsFunc.History(), 1)
+	assert.Equal(t, api.RepoID(2), signals.ReplaceHistorySignalsFunc.History()[0].Arg1)
+}
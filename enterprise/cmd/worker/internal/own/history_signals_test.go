@@ -0,0 +1,108 @@
+package own
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/fileutil"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestComputeHistorySignals(t *testing.T) {
+	now := time.Date(2023, 4, 12, 0, 0, 0, 0, time.UTC)
+	alice := gitdomain.Signature{Name: "Alice", Email: "alice@example.com", Date: now.Add(-48 * time.Hour)}
+	bob := gitdomain.Signature{Name: "Bob", Email: "Bob@example.com", Date: now.Add(-24 * time.Hour)}
+
+	diffs := map[api.CommitID]string{
+		"c2": `diff --git README.md README.md
+index 1..2 100644
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-a
++b
+diff --git old.go old.go
+deleted file mode 100644
+index 1..0
+--- old.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package old
+`,
+		"c3": `diff --git README.md README.md
+index 2..3 100644
+--- README.md
++++ README.md
+@@ -1 +1 @@
+-b
++c
+diff --git main.go main.go
+index 1..2 100644
+--- main.go
++++ main.go
+@@ -1 +1 @@
+-package a
++package main
+`,
+	}
+
+	client := gitserver.NewMockClient()
+	client.CommitsFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, opt gitserver.CommitsOptions) ([]*gitdomain.Commit, error) {
+		if want := now.AddDate(0, 0, -30).Format(time.RFC3339); opt.After != want {
+			t.Errorf("unexpected after: want %q, have %q", want, opt.After)
+		}
+		return []*gitdomain.Commit{
+			{ID: "merge", Author: alice, Parents: []api.CommitID{"c3", "c2"}},
+			{ID: "c3", Author: bob, Parents: []api.CommitID{"c2"}},
+			{ID: "c2", Author: alice, Parents: []api.CommitID{"c1"}},
+			{ID: "c1", Author: alice},
+		}, nil
+	})
+	client.DiffFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, opts gitserver.DiffOptions) (*gitserver.DiffFileIterator, error) {
+		return gitserver.NewDiffFileIterator(io.NopCloser(strings.NewReader(diffs[api.CommitID(opts.Head)]))), nil
+	})
+	client.StatFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, _ api.CommitID, path string) (fs.FileInfo, error) {
+		if path == "main.go" {
+			return nil, &os.PathError{Op: "stat", Path: path, Err: os.ErrNotExist}
+		}
+		return &fileutil.FileInfo{Name_: path}, nil
+	})
+	client.BlameFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, path string, _ *gitserver.BlameOptions) ([]*gitserver.Hunk, error) {
+		if path != "README.md" {
+			t.Errorf("unexpected blame of %q", path)
+		}
+		return []*gitserver.Hunk{
+			{StartLine: 1, EndLine: 4, Author: bob},
+			{StartLine: 4, EndLine: 5, Author: gitdomain.Signature{Name: "Carol", Email: "carol@example.com"}},
+		}, nil
+	})
+
+	config := historySignalsConfigWithDefaults(schema.OwnHistorySignals{RecentContributorsWindowDays: 30})
+	signals, err := computeHistorySignals(context.Background(), client, "github.com/sourcegraph/own", "merge", config, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aliceDate, bobDate := alice.Date, bob.Date
+	want := []*types.HistorySignal{
+		{Path: "README.md", AuthorName: "Alice", AuthorEmail: "alice@example.com", RecentCommitCount: 1, LastCommitAt: &aliceDate},
+		{Path: "README.md", AuthorName: "Bob", AuthorEmail: "bob@example.com", RecentCommitCount: 1, LastCommitAt: &bobDate, BlameLineShare: 0.75},
+		{Path: "README.md", AuthorName: "Carol", AuthorEmail: "carol@example.com", BlameLineShare: 0.25},
+		{Path: "main.go", AuthorName: "Bob", AuthorEmail: "bob@example.com", RecentCommitCount: 1, LastCommitAt: &bobDate},
+	}
+	if diff := cmp.Diff(want, signals); diff != "" {
+		t.Errorf("unexpected signals (-want +got):\n%s", diff)
+	}
+}
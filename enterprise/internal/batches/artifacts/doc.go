@@ -0,0 +1,3 @@
+// Package artifacts contains the upload store that the files steps of batch
+// specs declare as artifacts are stored in.
+package artifacts
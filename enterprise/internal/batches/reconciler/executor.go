@@ -202,6 +202,10 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 		return afterDone, err
 	}
 	opts := buildCommitOpts(e.targetRepo, e.spec, pushConf)
+	rcss, isReviewRefSource := css.(sources.ReviewRefChangesetSource)
+	if isReviewRefSource {
+		rcss.PrepareCommitOpts(e.ch, e.spec, &opts)
+	}
 
 	err = e.pushCommit(ctx, opts)
 	var pce pushCommitError
@@ -214,6 +218,11 @@ func (e *executor) pushChangesetPatch(ctx context.Context, triggerUpdateWebhook
 				return afterDone, errCannotPushToArchivedRepo
 			}
 		}
+		// The same commit has already been pushed to the review ref, so the
+		// changeset is up to date.
+		if isReviewRefSource && rcss.IsNoChangesPushError(pce.CombinedOutput) {
+			err = nil
+		}
 	}
 
 	if triggerUpdateWebhook && err == nil {
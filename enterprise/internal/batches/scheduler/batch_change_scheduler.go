@@ -0,0 +1,444 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/inconshreveable/log15"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/service"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/cron"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/goroutine/recorder"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// BatchChangeScheduler re-executes the current batch spec of batch changes
+// that have a cron schedule, and applies the result whenever the resulting
+// changeset specs differ from the ones currently applied.
+//
+// Each scheduled run goes through three phases: the workspaces of a copy of
+// the current batch spec are resolved again, they are executed server-side,
+// and the batch spec is finally applied. All of this happens on behalf of the
+// user who last applied the batch change.
+type BatchChangeScheduler struct {
+	ctx      context.Context
+	done     chan struct{}
+	store    *store.Store
+	svc      *service.Service
+	jobName  string
+	recorder *recorder.Recorder
+}
+
+var _ recorder.Recordable = &BatchChangeScheduler{}
+
+func NewBatchChangeScheduler(ctx context.Context, bstore *store.Store) *BatchChangeScheduler {
+	return &BatchChangeScheduler{
+		ctx:   ctx,
+		done:  make(chan struct{}),
+		store: bstore,
+		svc:   service.New(bstore),
+	}
+}
+
+// unlimited is a schedule that never has to wait: the scheduled runs are paced
+// by the backoff of the scheduler.
+type unlimited struct{}
+
+func (unlimited) Take() (time.Time, error) { return time.Now(), nil }
+
+func (s *BatchChangeScheduler) Start() {
+	if s.recorder != nil {
+		go s.recorder.LogStart(s)
+	}
+
+	// Most ticks won't have anything to do, so we back off up to a minute,
+	// which is the resolution of cron schedules anyway.
+	backoff := newBackoff(5*time.Second, 2, 1*time.Minute)
+	ticker := newTicker(unlimited{})
+	defer ticker.stop()
+
+	for {
+		select {
+		case delay := <-ticker.C:
+			start := time.Now()
+
+			if err := s.process(); err != nil {
+				if err != store.ErrNoResults {
+					log15.Warn("error processing scheduled batch change runs", "err", err)
+				}
+				delay <- backoff.next()
+			} else {
+				backoff.reset()
+				delay <- time.Duration(0)
+			}
+
+			if s.recorder != nil {
+				go s.recorder.LogRun(s, time.Since(start), nil)
+			}
+
+		case <-s.done:
+			log15.Debug("stopping the batch change re-execution scheduler")
+			return
+		}
+	}
+}
+
+func (s *BatchChangeScheduler) Stop() {
+	if s.recorder != nil {
+		go s.recorder.LogStop(s)
+	}
+	s.done <- struct{}{}
+	close(s.done)
+}
+
+// process starts the next due run, and advances all the runs in progress. If
+// there was nothing to do, store.ErrNoResults is returned.
+func (s *BatchChangeScheduler) process() error {
+	started, err := s.startDueRun(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	advanced, err := s.advanceRuns(s.ctx)
+	if err != nil {
+		return err
+	}
+
+	if !started && !advanced {
+		return store.ErrNoResults
+	}
+	return nil
+}
+
+// startDueRun moves the schedule that is due next to its next run time and
+// starts a run for it, unless the previous run of the batch change is still in
+// progress.
+func (s *BatchChangeScheduler) startDueRun(ctx context.Context) (started bool, err error) {
+	sched, err := s.advanceDueSchedule(ctx)
+	if err != nil || sched == nil {
+		return sched != nil, err
+	}
+
+	return true, s.startRun(ctx, sched.BatchChangeID)
+}
+
+// advanceDueSchedule moves the schedule that is due next to its next run time,
+// and returns it. This is committed on its own, so that the schedule isn't due
+// forever if starting the run fails. If no schedule is due, nil is returned.
+func (s *BatchChangeScheduler) advanceDueSchedule(ctx context.Context) (_ *btypes.BatchChangeSchedule, err error) {
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	now := s.store.Clock()()
+	sched, err := tx.GetNextDueBatchChangeSchedule(ctx, now)
+	if err == store.ErrNoResults {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cs, err := cron.Parse(sched.Schedule)
+	if err != nil {
+		// The schedule is validated when it is set, so this should never
+		// happen. Since the schedule would otherwise be due forever, we drop it.
+		log15.Warn("dropping invalid batch change schedule", "batchChangeID", sched.BatchChangeID, "err", err)
+		return nil, tx.DeleteBatchChangeSchedule(ctx, sched.BatchChangeID)
+	}
+	sched.NextRunAt = cs.Next(now)
+	if err := tx.UpsertBatchChangeSchedule(ctx, sched); err != nil {
+		return nil, err
+	}
+
+	return sched, nil
+}
+
+// startRun starts a scheduled run of the batch change, unless its previous run
+// is still in progress. If the batch spec of the run cannot be created, the run
+// is recorded as failed.
+func (s *BatchChangeScheduler) startRun(ctx context.Context, batchChangeID int64) (err error) {
+	tx, err := s.store.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	unfinished, err := tx.CountBatchChangeScheduledRuns(ctx, store.ListBatchChangeScheduledRunsOpts{
+		BatchChangeID:  batchChangeID,
+		OnlyUnfinished: true,
+	})
+	if err != nil {
+		return err
+	}
+	if unfinished > 0 {
+		log15.Info("skipping scheduled batch change run, previous run still in progress", "batchChangeID", batchChangeID)
+		return nil
+	}
+
+	run := &btypes.BatchChangeScheduledRun{
+		BatchChangeID: batchChangeID,
+		State:         btypes.BatchChangeScheduledRunStateResolving,
+	}
+	if spec, err := s.createBatchSpec(ctx, tx, batchChangeID); err != nil {
+		failRun(run, err, s.store.Clock()())
+	} else {
+		run.BatchSpecID = spec.ID
+	}
+
+	return tx.CreateBatchChangeScheduledRun(ctx, run)
+}
+
+// createBatchSpec creates a new batch spec from the current batch spec of the
+// batch change, and enqueues its workspace resolution. It runs in a savepoint
+// of tx, so that a failing statement doesn't abort tx, and the failed run can
+// still be recorded in it.
+func (s *BatchChangeScheduler) createBatchSpec(ctx context.Context, tx *store.Store, batchChangeID int64) (_ *btypes.BatchSpec, err error) {
+	tx, err = tx.Transact(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	batchChange, err := tx.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: batchChangeID})
+	if err != nil {
+		return nil, err
+	}
+	if batchChange.LastApplierID == 0 {
+		return nil, errors.New("the user who last applied the batch change no longer exists")
+	}
+
+	current, err := tx.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: batchChange.BatchSpecID})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: The batch spec is created on behalf of the last applier of
+	// the batch change, so that their permissions apply when resolving and
+	// executing the workspaces.
+	ctx = actor.WithActor(ctx, actor.FromUser(batchChange.LastApplierID))
+	return s.svc.WithStore(tx).CreateBatchSpecFromRaw(ctx, service.CreateBatchSpecFromRawOpts{
+		RawSpec:          current.RawSpec,
+		NamespaceUserID:  batchChange.NamespaceUserID,
+		NamespaceOrgID:   batchChange.NamespaceOrgID,
+		AllowIgnored:     current.AllowIgnored,
+		AllowUnsupported: current.AllowUnsupported,
+		BatchChange:      batchChange.ID,
+	})
+}
+
+// advanceRuns moves all runs in progress to their next phase, if they are
+// ready to.
+func (s *BatchChangeScheduler) advanceRuns(ctx context.Context) (advanced bool, err error) {
+	runs, _, err := s.store.ListBatchChangeScheduledRuns(ctx, store.ListBatchChangeScheduledRunsOpts{OnlyUnfinished: true})
+	if err != nil {
+		return false, err
+	}
+
+	for _, run := range runs {
+		before := run.State
+		if err := s.advanceRun(ctx, run); err != nil {
+			failRun(run, err, s.store.Clock()())
+		}
+		if run.State == before {
+			continue
+		}
+
+		if err := s.store.UpdateBatchChangeScheduledRun(ctx, run); err != nil {
+			return advanced, err
+		}
+		advanced = true
+	}
+
+	return advanced, nil
+}
+
+func (s *BatchChangeScheduler) advanceRun(ctx context.Context, run *btypes.BatchChangeScheduledRun) error {
+	if run.BatchSpecID == 0 {
+		return errors.New("the batch spec of the run was deleted")
+	}
+
+	spec, err := s.store.GetBatchSpec(ctx, store.GetBatchSpecOpts{ID: run.BatchSpecID})
+	if err != nil {
+		return err
+	}
+
+	// 🚨 SECURITY: Everything happens on behalf of the user who created the
+	// batch spec of the run.
+	ctx = actor.WithActor(ctx, actor.FromUser(spec.UserID))
+
+	switch run.State {
+	case btypes.BatchChangeScheduledRunStateResolving:
+		job, err := s.store.GetBatchSpecResolutionJob(ctx, store.GetBatchSpecResolutionJobOpts{BatchSpecID: spec.ID})
+		if err != nil {
+			return err
+		}
+
+		switch job.State {
+		case btypes.BatchSpecResolutionJobStateFailed:
+			if job.FailureMessage != nil {
+				return errors.Newf("resolving the workspaces failed: %s", *job.FailureMessage)
+			}
+			return errors.New("resolving the workspaces failed")
+
+		case btypes.BatchSpecResolutionJobStateCompleted:
+			if _, err := s.svc.ExecuteBatchSpec(ctx, service.ExecuteBatchSpecOpts{BatchSpecRandID: spec.RandID}); err != nil {
+				return err
+			}
+			run.State = btypes.BatchChangeScheduledRunStateExecuting
+		}
+
+	case btypes.BatchChangeScheduledRunStateExecuting:
+		stats, err := s.svc.LoadBatchSpecStats(ctx, spec)
+		if err != nil {
+			return err
+		}
+
+		state := btypes.ComputeBatchSpecState(spec, stats)
+		if !state.Finished() {
+			return nil
+		}
+		if state != btypes.BatchSpecStateCompleted {
+			return errors.Newf("the execution finished in state %s", state)
+		}
+
+		batchChange, err := s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: run.BatchChangeID})
+		if err != nil {
+			return err
+		}
+
+		changed, err := s.changesetSpecsChanged(ctx, batchChange.BatchSpecID, spec.ID)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			run.State = btypes.BatchChangeScheduledRunStateUnchanged
+			run.FinishedAt = s.store.Clock()()
+			return nil
+		}
+
+		if _, err := s.svc.ApplyBatchChange(ctx, service.ApplyBatchChangeOpts{
+			BatchSpecRandID:     spec.RandID,
+			EnsureBatchChangeID: batchChange.ID,
+		}); err != nil {
+			return err
+		}
+		run.State = btypes.BatchChangeScheduledRunStateApplied
+		run.FinishedAt = s.store.Clock()()
+	}
+
+	return nil
+}
+
+// changesetSpecsChanged returns whether the changeset specs of the two batch
+// specs differ.
+func (s *BatchChangeScheduler) changesetSpecsChanged(ctx context.Context, currentSpecID, newSpecID int64) (bool, error) {
+	current, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{BatchSpecID: currentSpecID})
+	if err != nil {
+		return false, err
+	}
+	next, _, err := s.store.ListChangesetSpecs(ctx, store.ListChangesetSpecsOpts{BatchSpecID: newSpecID})
+	if err != nil {
+		return false, err
+	}
+
+	return !sameChangesetSpecs(current, next), nil
+}
+
+// sameChangesetSpecs returns whether both lists contain changeset specs that
+// would result in the same changesets, regardless of their order.
+//
+// The base revision is deliberately ignored: otherwise every new commit on the
+// base branch would result in applying the batch change again, even if the
+// changes are exactly the same.
+func sameChangesetSpecs(a, b btypes.ChangesetSpecs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, spec := range a {
+		counts[changesetSpecFingerprint(spec)]++
+	}
+	for _, spec := range b {
+		fp := changesetSpecFingerprint(spec)
+		if counts[fp] == 0 {
+			return false
+		}
+		counts[fp]--
+	}
+
+	return true
+}
+
+func changesetSpecFingerprint(spec *btypes.ChangesetSpec) string {
+	fp, _ := json.Marshal(struct {
+		Type              btypes.ChangesetSpecType
+		BaseRepoID        int32
+		ExternalID        string
+		BaseRef           string
+		HeadRef           string
+		Title             string
+		Body              string
+		Published         batcheslib.PublishedValue
+		Diff              []byte
+		CommitMessage     string
+		CommitAuthorName  string
+		CommitAuthorEmail string
+	}{
+		Type:              spec.Type,
+		BaseRepoID:        int32(spec.BaseRepoID),
+		ExternalID:        spec.ExternalID,
+		BaseRef:           spec.BaseRef,
+		HeadRef:           spec.HeadRef,
+		Title:             spec.Title,
+		Body:              spec.Body,
+		Published:         spec.Published,
+		Diff:              spec.Diff,
+		CommitMessage:     spec.CommitMessage,
+		CommitAuthorName:  spec.CommitAuthorName,
+		CommitAuthorEmail: spec.CommitAuthorEmail,
+	})
+	return string(fp)
+}
+
+func failRun(run *btypes.BatchChangeScheduledRun, err error, now time.Time) {
+	msg := err.Error()
+	run.State = btypes.BatchChangeScheduledRunStateFailed
+	run.FailureMessage = &msg
+	run.FinishedAt = now
+}
+
+func (s *BatchChangeScheduler) Name() string {
+	return "batches-batch-change-scheduler"
+}
+
+func (s *BatchChangeScheduler) Type() recorder.RoutineType {
+	return recorder.CustomRoutine
+}
+
+func (s *BatchChangeScheduler) JobName() string {
+	return s.jobName
+}
+
+func (s *BatchChangeScheduler) SetJobName(jobName string) {
+	s.jobName = jobName
+}
+
+func (s *BatchChangeScheduler) Description() string {
+	return "Re-executes batch changes on their cron schedule"
+}
+
+func (s *BatchChangeScheduler) Interval() time.Duration {
+	return 1 * time.Minute // Actually between 5 sec and 1 min, changes dynamically
+}
+
+func (s *BatchChangeScheduler) RegisterRecorder(recorder *recorder.Recorder) {
+	s.recorder = recorder
+}
@@ -0,0 +1,139 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+)
+
+func TestBatchChangeSchedulerStartDueRunFailure(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	logger := logtest.Scoped(t)
+	ctx := actor.WithInternalActor(context.Background())
+	db := database.NewDB(logger, dbtest.NewDB(logger, t))
+
+	now := timeutil.Now()
+	bstore := store.NewWithClock(db, &observation.TestContext, nil, func() time.Time { return now })
+
+	user := bt.CreateTestUser(t, db, false)
+	spec := bt.CreateBatchSpec(t, ctx, bstore, "scheduled", user.ID, 0)
+
+	// Without a last applier the batch spec of the run cannot be created.
+	batchChange := bt.BuildBatchChange(bstore, "scheduled", user.ID, spec.ID)
+	batchChange.LastApplierID = 0
+	if err := bstore.CreateBatchChange(ctx, batchChange); err != nil {
+		t.Fatal(err)
+	}
+	if err := bstore.UpsertBatchChangeSchedule(ctx, &btypes.BatchChangeSchedule{
+		BatchChangeID: batchChange.ID,
+		Schedule:      "0 3 * * *",
+		NextRunAt:     now.Add(-time.Minute),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewBatchChangeScheduler(ctx, bstore)
+	started, err := s.startDueRun(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !started {
+		t.Fatal("expected a run to be started")
+	}
+
+	runs, _, err := bstore.ListBatchChangeScheduledRuns(ctx, store.ListBatchChangeScheduledRunsOpts{BatchChangeID: batchChange.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 1 {
+		t.Fatalf("unexpected number of runs. want=%d have=%d", 1, len(runs))
+	}
+	if have, want := runs[0].State, btypes.BatchChangeScheduledRunStateFailed; have != want {
+		t.Errorf("unexpected run state. want=%s have=%s", want, have)
+	}
+
+	sched, err := bstore.GetBatchChangeSchedule(ctx, batchChange.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sched.NextRunAt.After(now) {
+		t.Errorf("expected the next run to be in the future, have %s", sched.NextRunAt)
+	}
+}
+
+func TestSameChangesetSpecs(t *testing.T) {
+	spec := func(repo int32, title, diff, baseRev string) *btypes.ChangesetSpec {
+		return &btypes.ChangesetSpec{
+			Type:          btypes.ChangesetSpecTypeBranch,
+			BaseRepoID:    api.RepoID(repo),
+			BaseRef:       "refs/heads/main",
+			BaseRev:       baseRev,
+			HeadRef:       "refs/heads/lint-fixes",
+			Title:         title,
+			Diff:          []byte(diff),
+			CommitMessage: "Fix lints",
+			Published:     batcheslib.PublishedValue{Val: true},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		a, b btypes.ChangesetSpecs
+		want bool
+	}{
+		"both empty": {
+			want: true,
+		},
+		"same specs in a different order": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a"), spec(2, "Fix", "diff b", "a")},
+			b:    btypes.ChangesetSpecs{spec(2, "Fix", "diff b", "a"), spec(1, "Fix", "diff a", "a")},
+			want: true,
+		},
+		"only the base revision changed": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a")},
+			b:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "b")},
+			want: true,
+		},
+		"changed diff": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a")},
+			b:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a2", "a")},
+			want: false,
+		},
+		"changed title": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a")},
+			b:    btypes.ChangesetSpecs{spec(1, "Fix all the things", "diff a", "a")},
+			want: false,
+		},
+		"new changeset": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a")},
+			b:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a"), spec(2, "Fix", "diff b", "a")},
+			want: false,
+		},
+		"duplicates are counted": {
+			a:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a"), spec(1, "Fix", "diff a", "a")},
+			b:    btypes.ChangesetSpecs{spec(1, "Fix", "diff a", "a"), spec(2, "Fix", "diff b", "a")},
+			want: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if have := sameChangesetSpecs(tc.a, tc.b); have != tc.want {
+				t.Errorf("unexpected result: have=%v want=%v", have, tc.want)
+			}
+		})
+	}
+}
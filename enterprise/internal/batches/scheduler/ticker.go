@@ -34,10 +34,15 @@ type ticker struct {
 	C chan chan time.Duration
 
 	done     chan struct{}
-	schedule *window.Schedule
+	schedule taker
 }
 
-func newTicker(schedule *window.Schedule) *ticker {
+// taker is implemented by the schedules a ticker can wrap.
+type taker interface {
+	Take() (time.Time, error)
+}
+
+func newTicker(schedule taker) *ticker {
 	t := &ticker{
 		C:        make(chan chan time.Duration),
 		done:     make(chan struct{}),
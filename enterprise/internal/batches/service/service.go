@@ -18,6 +18,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/store"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types/scheduler/cron"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/webhooks"
 	sgactor "github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -82,6 +83,7 @@ type operations struct {
 	getBatchChangeMatchingBatchSpec      *observation.Operation
 	getNewestBatchSpec                   *observation.Operation
 	moveBatchChange                      *observation.Operation
+	setBatchChangeSchedule               *observation.Operation
 	closeBatchChange                     *observation.Operation
 	deleteBatchChange                    *observation.Operation
 	enqueueChangesetSync                 *observation.Operation
@@ -132,6 +134,7 @@ func newOperations(observationCtx *observation.Context) *operations {
 			getBatchChangeMatchingBatchSpec:      op("GetBatchChangeMatchingBatchSpec"),
 			getNewestBatchSpec:                   op("GetNewestBatchSpec"),
 			moveBatchChange:                      op("MoveBatchChange"),
+			setBatchChangeSchedule:               op("SetBatchChangeSchedule"),
 			closeBatchChange:                     op("CloseBatchChange"),
 			deleteBatchChange:                    op("DeleteBatchChange"),
 			enqueueChangesetSync:                 op("EnqueueChangesetSync"),
@@ -972,6 +975,65 @@ func (s *Service) MoveBatchChange(ctx context.Context, opts MoveBatchChangeOpts)
 	return batchChange, tx.UpdateBatchChange(ctx, batchChange)
 }
 
+// ErrScheduleDraftBatchChange is returned by SetBatchChangeSchedule when the
+// batch change has never had a batch spec applied, so there is nothing to
+// re-execute.
+var ErrScheduleDraftBatchChange = errors.New("cannot schedule a batch change that has never been applied")
+
+type SetBatchChangeScheduleOpts struct {
+	BatchChangeID int64
+	// Schedule is the cron expression at which the batch change is
+	// re-executed. An empty schedule removes the existing schedule.
+	Schedule string
+}
+
+// SetBatchChangeSchedule sets or removes the cron schedule at which the
+// current batch spec of the batch change is re-executed server-side.
+func (s *Service) SetBatchChangeSchedule(ctx context.Context, opts SetBatchChangeScheduleOpts) (batchChange *btypes.BatchChange, err error) {
+	ctx, _, endObservation := s.operations.setBatchChangeSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(opts.BatchChangeID)),
+		log.String("schedule", opts.Schedule),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	batchChange, err = s.store.GetBatchChange(ctx, store.GetBatchChangeOpts{ID: opts.BatchChangeID})
+	if err != nil {
+		return nil, err
+	}
+
+	// 🚨 SECURITY: Scheduled runs are executed and applied on behalf of the
+	// last applier of the batch change, so only they (or site-admins) can
+	// schedule it.
+	if err := auth.CheckSiteAdminOrSameUser(ctx, s.store.DatabaseDB(), batchChange.LastApplierID); err != nil {
+		return nil, err
+	}
+	if err := s.CheckNamespaceAccess(ctx, batchChange.NamespaceUserID, batchChange.NamespaceOrgID); err != nil {
+		return nil, err
+	}
+
+	if opts.Schedule == "" {
+		return batchChange, s.store.DeleteBatchChangeSchedule(ctx, batchChange.ID)
+	}
+
+	if batchChange.LastAppliedAt.IsZero() {
+		return nil, ErrScheduleDraftBatchChange
+	}
+	if batchChange.Closed() {
+		return nil, ErrApplyClosedBatchChange
+	}
+
+	cs, err := cron.Parse(opts.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return batchChange, s.store.UpsertBatchChangeSchedule(ctx, &btypes.BatchChangeSchedule{
+		BatchChangeID: batchChange.ID,
+		Schedule:      opts.Schedule,
+		NextRunAt:     cs.Next(s.clock()),
+	})
+}
+
 // CloseBatchChange closes the BatchChange with the given ID if it has not been closed yet.
 func (s *Service) CloseBatchChange(ctx context.Context, id int64, closeChangesets bool) (batchChange *btypes.BatchChange, err error) {
 	ctx, _, endObservation := s.operations.closeBatchChange.With(ctx, &err, observation.Args{})
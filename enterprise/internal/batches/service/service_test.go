@@ -862,6 +862,91 @@ index e5af166..d44c3fc 100644
 		})
 	})
 
+	t.Run("SetBatchChangeSchedule", func(t *testing.T) {
+		createBatchChange := func(t *testing.T, user int32, draft bool) *btypes.BatchChange {
+			t.Helper()
+
+			spec := testBatchSpec(user)
+			if err := s.CreateBatchSpec(ctx, spec); err != nil {
+				t.Fatal(err)
+			}
+
+			batchChange := testBatchChange(user, spec)
+			if draft {
+				batchChange = testDraftBatchChange(user, spec)
+			}
+			if err := s.CreateBatchChange(ctx, batchChange); err != nil {
+				t.Fatal(err)
+			}
+			return batchChange
+		}
+
+		t.Run("set and remove", func(t *testing.T) {
+			batchChange := createBatchChange(t, user.ID, false)
+
+			if _, err := svc.SetBatchChangeSchedule(userCtx, SetBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      "0 3 * * 1",
+			}); err != nil {
+				t.Fatal(err)
+			}
+
+			sched, err := s.GetBatchChangeSchedule(ctx, batchChange.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if have, want := sched.Schedule, "0 3 * * 1"; have != want {
+				t.Fatalf("wrong schedule. want=%q, have=%q", want, have)
+			}
+			if !sched.NextRunAt.After(now) || sched.NextRunAt.Weekday() != time.Monday {
+				t.Fatalf("wrong next run: %s", sched.NextRunAt)
+			}
+
+			if _, err := svc.SetBatchChangeSchedule(userCtx, SetBatchChangeScheduleOpts{BatchChangeID: batchChange.ID}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := s.GetBatchChangeSchedule(ctx, batchChange.ID); err != store.ErrNoResults {
+				t.Fatalf("want schedule to be deleted, but got %v", err)
+			}
+		})
+
+		t.Run("invalid schedule", func(t *testing.T) {
+			batchChange := createBatchChange(t, user.ID, false)
+
+			_, err := svc.SetBatchChangeSchedule(userCtx, SetBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      "every monday",
+			})
+			if err == nil {
+				t.Fatal("unexpected nil error")
+			}
+		})
+
+		t.Run("draft batch change", func(t *testing.T) {
+			batchChange := createBatchChange(t, admin.ID, true)
+
+			_, err := svc.SetBatchChangeSchedule(adminCtx, SetBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      "@daily",
+			})
+			if err != ErrScheduleDraftBatchChange {
+				t.Fatalf("expected %s error but got %v", ErrScheduleDraftBatchChange, err)
+			}
+		})
+
+		t.Run("not the last applier", func(t *testing.T) {
+			batchChange := createBatchChange(t, user.ID, false)
+
+			_, err := svc.SetBatchChangeSchedule(user2Ctx, SetBatchChangeScheduleOpts{
+				BatchChangeID: batchChange.ID,
+				Schedule:      "@daily",
+			})
+			if !errcode.IsUnauthorized(err) {
+				t.Fatalf("expected unauthorized error but got %s", err)
+			}
+		})
+	})
+
 	t.Run("GetBatchChangeMatchingBatchSpec", func(t *testing.T) {
 		batchSpec := bt.CreateBatchSpec(t, ctx, s, "matching-batch-spec", admin.ID, 0)
 
@@ -36,6 +36,22 @@ type ArchivableChangesetSource interface {
 	IsArchivedPushError(output string) bool
 }
 
+// A ReviewRefChangesetSource is a changeset source for a code host on which
+// changesets are created by pushing commits to a review ref of the base
+// branch, such as Gerrit's refs/for/<branch>, instead of by pushing a branch.
+type ReviewRefChangesetSource interface {
+	ChangesetSource
+
+	// PrepareCommitOpts updates the options used to create the commit of the
+	// given changeset, so that pushing it creates or updates the changeset on
+	// the code host.
+	PrepareCommitOpts(ch *btypes.Changeset, spec *btypes.ChangesetSpec, opts *protocol.CreateCommitFromPatchRequest)
+	// IsNoChangesPushError parses the given error output from `git push` to
+	// detect whether the push was rejected because the same commit has
+	// already been pushed.
+	IsNoChangesPushError(output string) bool
+}
+
 // A DraftChangesetSource can create draft changesets and undraft them.
 type DraftChangesetSource interface {
 	ChangesetSource
@@ -0,0 +1,329 @@
+package sources
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// GerritSource is the changeset source for Gerrit. Gerrit has no pull
+// requests: a change is created by pushing a commit with a Change-Id to the
+// review ref of its destination branch, and further pushes of commits with the
+// same Change-Id add patch sets to the change. The title and description of a
+// change are the subject and body of its commit message.
+type GerritSource struct {
+	client *gerrit.Client
+}
+
+var (
+	_ DraftChangesetSource     = GerritSource{}
+	_ ReviewRefChangesetSource = GerritSource{}
+)
+
+func NewGerritSource(ctx context.Context, svc *types.ExternalService, cf *httpcli.Factory) (*GerritSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.GerritConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Wrapf(err, "external service id=%d", svc.ID)
+	}
+
+	if cf == nil {
+		cf = httpcli.ExternalClientFactory
+	}
+
+	cli, err := cf.Doer()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating external client")
+	}
+
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Gerrit URL")
+	}
+
+	client, err := gerrit.NewClient(svc.URN(), u, &gerrit.AccountCredentials{Username: c.Username, Password: c.Password}, cli)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Gerrit client")
+	}
+
+	return &GerritSource{client: client}, nil
+}
+
+// GitserverPushConfig returns an authenticated push config used for pushing
+// commits to the code host.
+func (s GerritSource) GitserverPushConfig(repo *types.Repo) (*protocol.PushConfig, error) {
+	return GitserverPushConfig(repo, s.client.Authenticator())
+}
+
+// WithAuthenticator returns a copy of the original Source configured to use the
+// given authenticator, provided that authenticator type is supported by the
+// code host.
+func (s GerritSource) WithAuthenticator(a auth.Authenticator) (ChangesetSource, error) {
+	switch a.(type) {
+	case *auth.BasicAuth,
+		*auth.BasicAuthWithSSH:
+		break
+
+	default:
+		return nil, newUnsupportedAuthenticatorError("GerritSource", a)
+	}
+
+	return &GerritSource{client: s.client.WithAuthenticator(a)}, nil
+}
+
+// ValidateAuthenticator validates the currently set authenticator is usable.
+// Returns an error, when validating the Authenticator yielded an error.
+func (s GerritSource) ValidateAuthenticator(ctx context.Context) error {
+	_, err := s.client.GetAuthenticatedUserAccount(ctx)
+	return err
+}
+
+// PrepareCommitOpts adds the Change-Id of the changeset to the commit message,
+// and pushes the commit to the review ref of the base branch, with the head
+// branch as the topic of the change.
+func (s GerritSource) PrepareCommitOpts(ch *btypes.Changeset, spec *btypes.ChangesetSpec, opts *protocol.CreateCommitFromPatchRequest) {
+	changeID := gerritChangeID(opts.Repo, ch)
+	opts.CommitInfo.Message = strings.TrimRight(opts.CommitInfo.Message, "\n") + "\n\nChange-Id: " + changeID + "\n"
+
+	pushRef := fmt.Sprintf("refs/for/%s%%topic=%s", gitdomain.AbbreviateRef(spec.BaseRef), gitdomain.AbbreviateRef(spec.HeadRef))
+	opts.PushRef = &pushRef
+}
+
+// IsNoChangesPushError detects the error Gerrit responds with when a commit
+// is pushed again to the same change.
+func (s GerritSource) IsNoChangesPushError(output string) bool {
+	return strings.Contains(output, "(no new changes)")
+}
+
+// LoadChangeset loads the given Changeset from the source and updates it. If
+// the Changeset could not be found on the source, a ChangesetNotFoundError is
+// returned.
+func (s GerritSource) LoadChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting change")
+	}
+
+	return s.setChangesetMetadata(change, cs)
+}
+
+// CreateChangeset will create the Changeset on the source. If it already
+// exists, *Changeset will be populated and the return value will be true.
+//
+// On Gerrit, the change has already been created by pushing its commit, so it
+// only needs to be looked up by its Change-Id. The body of the changeset is
+// posted as a message on the change, since changes have no description other
+// than their commit message.
+func (s GerritSource) CreateChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	change, err := s.findChange(ctx, cs, gitdomain.AbbreviateRef(cs.BaseRef))
+	if err != nil {
+		return false, err
+	}
+	if change == nil {
+		return false, errors.New("no change found for the pushed commit")
+	}
+
+	if cs.Body != "" && !hasChangeMessage(change, cs.Body) {
+		if err := s.client.SetReview(ctx, strconv.Itoa(change.Number), gerrit.ReviewInput{Message: cs.Body}); err != nil {
+			return false, errors.Wrap(err, "posting changeset body")
+		}
+		if change, err = s.client.GetChange(ctx, strconv.Itoa(change.Number)); err != nil {
+			return false, errors.Wrap(err, "getting change")
+		}
+	}
+
+	return false, s.setChangesetMetadata(change, cs)
+}
+
+// CreateDraftChangeset creates the given changeset on the code host in draft
+// mode, which is a work in progress change on Gerrit.
+func (s GerritSource) CreateDraftChangeset(ctx context.Context, cs *Changeset) (bool, error) {
+	exists, err := s.CreateChangeset(ctx, cs)
+	if err != nil {
+		return exists, err
+	}
+
+	change := cs.Metadata.(*gerritbatches.AnnotatedChange)
+	if change.WorkInProgress {
+		return exists, nil
+	}
+	if err := s.client.SetWorkInProgress(ctx, cs.ExternalID); err != nil {
+		return exists, errors.Wrap(err, "marking change as work in progress")
+	}
+
+	return exists, s.LoadChangeset(ctx, cs)
+}
+
+// UndraftChangeset will update the Changeset on the source to be not in draft mode anymore.
+func (s GerritSource) UndraftChangeset(ctx context.Context, cs *Changeset) error {
+	if err := s.client.SetReadyForReview(ctx, cs.ExternalID); err != nil {
+		return errors.Wrap(err, "marking change as ready for review")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// CloseChangeset will close the Changeset on the source, where "close"
+// means the appropriate final state on the codehost (e.g. "abandoned" on
+// Gerrit).
+func (s GerritSource) CloseChangeset(ctx context.Context, cs *Changeset) error {
+	if err := s.client.AbandonChange(ctx, cs.ExternalID); err != nil {
+		return errors.Wrap(err, "abandoning change")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// UpdateChangeset can update Changesets.
+//
+// The title and body of a change are defined by its commit message, which is
+// updated by pushing a new patch set, so only the destination branch has to be
+// updated here.
+func (s GerritSource) UpdateChangeset(ctx context.Context, cs *Changeset) error {
+	change, err := s.client.GetChange(ctx, cs.ExternalID)
+	if err != nil {
+		if errcode.IsNotFound(err) {
+			return ChangesetNotFoundError{Changeset: cs}
+		}
+		return errors.Wrap(err, "getting change")
+	}
+
+	baseBranch := gitdomain.AbbreviateRef(cs.BaseRef)
+	if change.Branch == baseBranch {
+		return s.setChangesetMetadata(change, cs)
+	}
+
+	// If a new patch set has been pushed since the base branch changed, it
+	// created a new change on the new base branch, which replaces the
+	// previous one.
+	moved, err := s.findChange(ctx, cs, baseBranch)
+	if err != nil {
+		return err
+	}
+	if moved != nil {
+		if err := s.client.AbandonChange(ctx, cs.ExternalID); err != nil {
+			return errors.Wrap(err, "abandoning replaced change")
+		}
+		return s.setChangesetMetadata(moved, cs)
+	}
+
+	if err := s.client.MoveChange(ctx, cs.ExternalID, gerrit.MoveChangeInput{DestinationBranch: baseBranch}); err != nil {
+		return errors.Wrap(err, "moving change")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// ReopenChangeset will reopen the Changeset on the source, if it's closed.
+// If not, it's a noop.
+func (s GerritSource) ReopenChangeset(ctx context.Context, cs *Changeset) error {
+	if change, ok := cs.Metadata.(*gerritbatches.AnnotatedChange); ok && change.Status != gerrit.ChangeStatusAbandoned {
+		return nil
+	}
+	if err := s.client.RestoreChange(ctx, cs.ExternalID); err != nil {
+		return errors.Wrap(err, "restoring change")
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// CreateComment posts a comment on the Changeset.
+func (s GerritSource) CreateComment(ctx context.Context, cs *Changeset, comment string) error {
+	return s.client.SetReview(ctx, cs.ExternalID, gerrit.ReviewInput{Message: comment})
+}
+
+// MergeChangeset merges a Changeset on the code host, if in a mergeable state.
+// Gerrit changes are submitted with the submit type configured for the
+// project, so squash is ignored. If the changeset cannot be merged, because it
+// is in an unmergeable state, ChangesetNotMergeableError must be returned.
+func (s GerritSource) MergeChangeset(ctx context.Context, cs *Changeset, squash bool) error {
+	if err := s.client.SubmitChange(ctx, cs.ExternalID); err != nil {
+		if errcode.IsNotFound(err) {
+			return errors.Wrap(err, "submitting change")
+		}
+		return ChangesetNotMergeableError{ErrorMsg: err.Error()}
+	}
+
+	return s.LoadChangeset(ctx, cs)
+}
+
+// findChange returns the change of the given changeset on the given branch, or
+// nil if there is none.
+func (s GerritSource) findChange(ctx context.Context, cs *Changeset, branch string) (*gerrit.Change, error) {
+	project, err := gerritProjectName(cs.TargetRepo)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.client.QueryChanges(ctx, "change:"+gerritChangeID(cs.TargetRepo.Name, cs.Changeset))
+	if err != nil {
+		return nil, errors.Wrap(err, "querying changes")
+	}
+	for _, change := range changes {
+		if change.Project == project && change.Branch == branch {
+			return change, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s GerritSource) setChangesetMetadata(change *gerrit.Change, cs *Changeset) error {
+	if err := cs.SetMetadata(&gerritbatches.AnnotatedChange{
+		Change:      change,
+		CodeHostURL: s.client.URL.String(),
+	}); err != nil {
+		return errors.Wrap(err, "setting changeset metadata")
+	}
+
+	return nil
+}
+
+// gerritChangeID returns the Change-Id of the Gerrit change of the given
+// changeset. It is derived from the repository and the changeset, so that every
+// push of the changeset updates the same change.
+func gerritChangeID(repo api.RepoName, ch *btypes.Changeset) string {
+	sum := sha1.Sum([]byte(fmt.Sprintf("%s:%d", repo, ch.ID)))
+	return "I" + hex.EncodeToString(sum[:])
+}
+
+func gerritProjectName(repo *types.Repo) (string, error) {
+	project, ok := repo.Metadata.(*gerrit.Project)
+	if !ok {
+		return "", errors.Errorf("unexpected metadata type %T for Gerrit repository", repo.Metadata)
+	}
+	// Gerrit encodes slashes in IDs, so need to decode them.
+	return url.PathUnescape(project.ID)
+}
+
+func hasChangeMessage(change *gerrit.Change, message string) bool {
+	for _, m := range change.Messages {
+		if strings.Contains(m.Message, message) {
+			return true
+		}
+	}
+	return false
+}
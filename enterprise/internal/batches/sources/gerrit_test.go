@@ -0,0 +1,278 @@
+package sources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/protocol"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestGerritSource_LoadChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("change not found", func(t *testing.T) {
+		s, _ := mockGerritSource(t, nil)
+		cs, _ := mockGerritChangeset()
+
+		err := s.LoadChangeset(ctx, cs)
+		target := ChangesetNotFoundError{}
+		assert.ErrorAs(t, err, &target)
+		assert.Same(t, target.Changeset, cs)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		change := mockGerritChange()
+		s, _ := mockGerritSource(t, change)
+		cs, _ := mockGerritChangeset()
+
+		require.NoError(t, s.LoadChangeset(ctx, cs))
+		assertChangesetMatchesChange(t, cs, change)
+	})
+}
+
+func TestGerritSource_CreateChangeset(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no change pushed", func(t *testing.T) {
+		s, _ := mockGerritSource(t, nil)
+		cs, _ := mockGerritChangeset()
+
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.Error(t, err)
+	})
+
+	t.Run("change on other branch", func(t *testing.T) {
+		change := mockGerritChange()
+		change.Branch = "other"
+		s, _ := mockGerritSource(t, change)
+		cs, _ := mockGerritChangeset()
+
+		_, err := s.CreateChangeset(ctx, cs)
+		assert.Error(t, err)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		change := mockGerritChange()
+		s, posted := mockGerritSource(t, change)
+		cs, _ := mockGerritChangeset()
+
+		exists, err := s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.False(t, exists)
+		assert.Equal(t, []string{"42/revisions/current/review"}, *posted)
+		assertChangesetMatchesChange(t, cs, change)
+
+		// The body isn't posted again once the change has it.
+		change.Messages = []*gerrit.ChangeMessage{{ID: "1", Message: "Patch Set 1:\n\n" + cs.Body}}
+		*posted = nil
+		_, err = s.CreateChangeset(ctx, cs)
+		require.NoError(t, err)
+		assert.Empty(t, *posted)
+	})
+}
+
+func TestGerritSource_Actions(t *testing.T) {
+	ctx := context.Background()
+
+	for name, tc := range map[string]struct {
+		invoke func(s *GerritSource, cs *Changeset) error
+		want   []string
+	}{
+		"CloseChangeset": {
+			invoke: func(s *GerritSource, cs *Changeset) error { return s.CloseChangeset(ctx, cs) },
+			want:   []string{"42/abandon"},
+		},
+		"ReopenChangeset": {
+			invoke: func(s *GerritSource, cs *Changeset) error { return s.ReopenChangeset(ctx, cs) },
+			want:   []string{"42/restore"},
+		},
+		"UndraftChangeset": {
+			invoke: func(s *GerritSource, cs *Changeset) error { return s.UndraftChangeset(ctx, cs) },
+			want:   []string{"42/ready"},
+		},
+		"MergeChangeset": {
+			invoke: func(s *GerritSource, cs *Changeset) error { return s.MergeChangeset(ctx, cs, true) },
+			want:   []string{"42/submit"},
+		},
+		"CreateComment": {
+			invoke: func(s *GerritSource, cs *Changeset) error { return s.CreateComment(ctx, cs, "comment") },
+			want:   []string{"42/revisions/current/review"},
+		},
+		"UpdateChangeset moves the change": {
+			invoke: func(s *GerritSource, cs *Changeset) error {
+				cs.BaseRef = "refs/heads/other"
+				return s.UpdateChangeset(ctx, cs)
+			},
+			want: []string{"42/move"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s, posted := mockGerritSource(t, mockGerritChange())
+			cs, _ := mockGerritChangeset()
+
+			require.NoError(t, tc.invoke(s, cs))
+			assert.Equal(t, tc.want, *posted)
+		})
+	}
+}
+
+func TestGerritSource_MergeChangeset_NotMergeable(t *testing.T) {
+	change := mockGerritChange()
+	s, _ := mockGerritSource(t, change)
+	cs, _ := mockGerritChangeset()
+	cs.ExternalID = "43"
+
+	err := s.MergeChangeset(context.Background(), cs, false)
+	target := ChangesetNotMergeableError{}
+	assert.ErrorAs(t, err, &target)
+}
+
+func TestGerritSource_PrepareCommitOpts(t *testing.T) {
+	s, _ := mockGerritSource(t, nil)
+	ch := &btypes.Changeset{ID: 1}
+	spec := &btypes.ChangesetSpec{BaseRef: "refs/heads/main", HeadRef: "refs/heads/batch/my-change"}
+	opts := protocol.CreateCommitFromPatchRequest{
+		Repo:       "gerrit.example.com/my/project",
+		CommitInfo: protocol.PatchCommitInfo{Message: "Fix things\n"},
+	}
+
+	s.PrepareCommitOpts(ch, spec, &opts)
+
+	changeID := gerritChangeID("gerrit.example.com/my/project", ch)
+	assert.Len(t, changeID, 41)
+	assert.Equal(t, "Fix things\n\nChange-Id: "+changeID+"\n", opts.CommitInfo.Message)
+	require.NotNil(t, opts.PushRef)
+	assert.Equal(t, "refs/for/main%topic=batch/my-change", *opts.PushRef)
+
+	// The Change-Id is stable across pushes.
+	assert.Equal(t, changeID, gerritChangeID("gerrit.example.com/my/project", ch))
+	assert.NotEqual(t, changeID, gerritChangeID("gerrit.example.com/my/project", &btypes.Changeset{ID: 2}))
+
+	assert.True(t, s.IsNoChangesPushError(" ! [remote rejected] HEAD -> refs/for/main%topic=batch/my-change (no new changes)"))
+	assert.False(t, s.IsNoChangesPushError(" ! [remote rejected] HEAD -> refs/for/main (prohibited by Gerrit)"))
+}
+
+func TestGerritSource_WithAuthenticator(t *testing.T) {
+	s, _ := mockGerritSource(t, nil)
+
+	for name, a := range map[string]auth.Authenticator{
+		"BasicAuth":        &auth.BasicAuth{},
+		"BasicAuthWithSSH": &auth.BasicAuthWithSSH{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			newSource, err := s.WithAuthenticator(a)
+			require.NoError(t, err)
+			assert.Same(t, a, newSource.(*GerritSource).client.Authenticator())
+		})
+	}
+
+	_, err := s.WithAuthenticator(&auth.OAuthBearerToken{})
+	assert.Error(t, err)
+}
+
+func assertChangesetMatchesChange(t *testing.T, cs *Changeset, change *gerrit.Change) {
+	t.Helper()
+
+	meta, ok := cs.Metadata.(*gerritbatches.AnnotatedChange)
+	require.True(t, ok)
+	assert.Equal(t, change.Number, meta.Number)
+	assert.Equal(t, "42", cs.ExternalID)
+	assert.Equal(t, extsvc.TypeGerrit, cs.ExternalServiceType)
+	assert.Equal(t, "refs/heads/batch/my-change", cs.ExternalBranch)
+}
+
+// mockGerritSource returns a source backed by a fake Gerrit server which
+// serves the given change as change 42, and records the changes actions are
+// posted to.
+func mockGerritSource(t *testing.T, change *gerrit.Change) (*GerritSource, *[]string) {
+	t.Helper()
+
+	var posted []string
+	writeJSON := func(w http.ResponseWriter, v any) {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		// Gerrit prefixes JSON responses to prevent XSSI.
+		_, _ = w.Write(append([]byte(")]}'\n"), b...))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/a/changes/", func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.Path[len("/a/changes/"):]
+		switch {
+		case r.Method == http.MethodPost:
+			if path == "43/submit" {
+				http.Error(w, "change is not ready", http.StatusConflict)
+				return
+			}
+			posted = append(posted, path)
+			_, _ = w.Write([]byte(")]}'\n{}"))
+		case path == "":
+			var changes []*gerrit.Change
+			if change != nil {
+				changes = append(changes, change)
+			}
+			writeJSON(w, changes)
+		case path == "42" && change != nil:
+			writeJSON(w, change)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	client, err := gerrit.NewClient("gerrit", u, &gerrit.AccountCredentials{Username: "user", Password: "pass"}, srv.Client())
+	require.NoError(t, err)
+
+	return &GerritSource{client: client}, &posted
+}
+
+// mockGerritChangeset creates a plausible changeset and repo for a Gerrit
+// project.
+func mockGerritChangeset() (*Changeset, *types.Repo) {
+	repo := &types.Repo{
+		Name: api.RepoName("gerrit.example.com/my/project"),
+		// Gerrit project IDs are URL encoded.
+		Metadata: &gerrit.Project{ID: "my%2Fproject", Name: "my/project"},
+	}
+	cs := &Changeset{
+		Title:      "Fix things",
+		Body:       "Created by a batch change.",
+		Changeset:  &btypes.Changeset{ID: 1, ExternalID: "42"},
+		RemoteRepo: repo,
+		TargetRepo: repo,
+		HeadRef:    "refs/heads/batch/my-change",
+		BaseRef:    "refs/heads/main",
+	}
+
+	return cs, repo
+}
+
+func mockGerritChange() *gerrit.Change {
+	return &gerrit.Change{
+		ID:       "my%2Fproject~main~I0123",
+		Project:  "my/project",
+		Branch:   "main",
+		Topic:    "batch/my-change",
+		Subject:  "Fix things",
+		Status:   gerrit.ChangeStatusNew,
+		Number:   42,
+		Messages: []*gerrit.ChangeMessage{},
+	}
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/inconshreveable/log15"
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -51,16 +53,19 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	// Undraft.
 	btypes.ChangesetEventKindGitHubReadyForReview,
 	btypes.ChangesetEventKindGitLabUnmarkWorkInProgress,
+	btypes.ChangesetEventKindGerritChangeReadyForReview,
 
 	// Draft.
 	btypes.ChangesetEventKindGitHubConvertToDraft,
 	btypes.ChangesetEventKindGitLabMarkWorkInProgress,
+	btypes.ChangesetEventKindGerritChangeWorkInProgress,
 
 	// Closed, unmerged.
 	btypes.ChangesetEventKindBitbucketCloudPullRequestRejected,
 	btypes.ChangesetEventKindBitbucketServerDeclined,
 	btypes.ChangesetEventKindGitHubClosed,
 	btypes.ChangesetEventKindGitLabClosed,
+	btypes.ChangesetEventKindGerritChangeAbandoned,
 
 	// Closed, merged.
 	btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled,
@@ -68,11 +73,13 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitHubMerged,
 	btypes.ChangesetEventKindGitLabMerged,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestMerged,
+	btypes.ChangesetEventKindGerritChangeMerged,
 
 	// Reopened
 	btypes.ChangesetEventKindBitbucketServerReopened,
 	btypes.ChangesetEventKindGitHubReopened,
 	btypes.ChangesetEventKindGitLabReopened,
+	btypes.ChangesetEventKindGerritChangeRestored,
 
 	// Reviewed, indeterminate status.
 	btypes.ChangesetEventKindGitHubReviewed,
@@ -85,6 +92,8 @@ var RequiredEventTypesForHistory = []btypes.ChangesetEventKind{
 	btypes.ChangesetEventKindGitLabApproved,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestApproved,
 	btypes.ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+	btypes.ChangesetEventKindGerritChangeApproved,
+	btypes.ChangesetEventKindGerritChangeRejected,
 
 	// Reviewed, not approved.
 	btypes.ChangesetEventKindBitbucketCloudPullRequestChangesRequestRemoved,
@@ -149,7 +158,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 		case btypes.ChangesetEventKindGitHubClosed,
 			btypes.ChangesetEventKindBitbucketServerDeclined,
 			btypes.ChangesetEventKindGitLabClosed,
-			btypes.ChangesetEventKindBitbucketCloudPullRequestRejected:
+			btypes.ChangesetEventKindBitbucketCloudPullRequestRejected,
+			btypes.ChangesetEventKindGerritChangeAbandoned:
 			// Merged and ReadOnly are final states. We can ignore everything after.
 			if currentExtState != btypes.ChangesetExternalStateMerged &&
 				currentExtState != btypes.ChangesetExternalStateReadOnly {
@@ -161,11 +171,13 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 			btypes.ChangesetEventKindBitbucketServerMerged,
 			btypes.ChangesetEventKindGitLabMerged,
 			btypes.ChangesetEventKindBitbucketCloudPullRequestFulfilled,
-			btypes.ChangesetEventKindAzureDevOpsPullRequestMerged:
+			btypes.ChangesetEventKindAzureDevOpsPullRequestMerged,
+			btypes.ChangesetEventKindGerritChangeMerged:
 			currentExtState = btypes.ChangesetExternalStateMerged
 			pushStates(et)
 
-		case btypes.ChangesetEventKindGitLabMarkWorkInProgress:
+		case btypes.ChangesetEventKindGitLabMarkWorkInProgress,
+			btypes.ChangesetEventKindGerritChangeWorkInProgress:
 			isDraft = true
 			// This event only matters when the changeset is open, otherwise a change in the title won't change the overall external state.
 			if currentExtState == btypes.ChangesetExternalStateOpen {
@@ -183,7 +195,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 			}
 
 		case btypes.ChangesetEventKindGitLabUnmarkWorkInProgress,
-			btypes.ChangesetEventKindGitHubReadyForReview:
+			btypes.ChangesetEventKindGitHubReadyForReview,
+			btypes.ChangesetEventKindGerritChangeReadyForReview:
 			isDraft = false
 			// This event only matters when the changeset is open, otherwise a change in the title won't change the overall external state.
 			if currentExtState == btypes.ChangesetExternalStateDraft {
@@ -193,7 +206,8 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 
 		case btypes.ChangesetEventKindGitHubReopened,
 			btypes.ChangesetEventKindBitbucketServerReopened,
-			btypes.ChangesetEventKindGitLabReopened:
+			btypes.ChangesetEventKindGitLabReopened,
+			btypes.ChangesetEventKindGerritChangeRestored:
 			// Merged and ReadOnly are final states. We can ignore everything after.
 			if currentExtState != btypes.ChangesetExternalStateMerged &&
 				currentExtState != btypes.ChangesetExternalStateReadOnly {
@@ -211,7 +225,9 @@ func computeHistory(ch *btypes.Changeset, ce ChangesetEvents) (changesetHistory,
 			btypes.ChangesetEventKindGitLabApproved,
 			btypes.ChangesetEventKindBitbucketCloudApproved,
 			btypes.ChangesetEventKindBitbucketCloudPullRequestApproved,
-			btypes.ChangesetEventKindAzureDevOpsPullRequestApproved:
+			btypes.ChangesetEventKindAzureDevOpsPullRequestApproved,
+			btypes.ChangesetEventKindGerritChangeApproved,
+			btypes.ChangesetEventKindGerritChangeRejected:
 			s, err := e.ReviewState()
 			if err != nil {
 				return nil, err
@@ -341,17 +357,28 @@ func initialExternalState(ch *btypes.Changeset, ce ChangesetEvents) btypes.Chang
 		if m.IsDraft {
 			open = false
 		}
+	case *gerritbatches.AnnotatedChange:
+		if m.WorkInProgress {
+			open = false
+		}
 	default:
 		return btypes.ChangesetExternalStateOpen
 	}
 	// Walk the events backwards, since we need to look from the current time to the past.
 	for i := len(ce) - 1; i >= 0; i-- {
 		e := ce[i]
-		switch e.Metadata.(type) {
+		switch m := e.Metadata.(type) {
 		case *gitlab.UnmarkWorkInProgressEvent, *github.ReadyForReviewEvent:
 			open = false
 		case *gitlab.MarkWorkInProgressEvent, *github.ConvertToDraftEvent:
 			open = true
+		case *gerrit.ChangeMessage:
+			switch m.Tag {
+			case gerrit.ChangeMessageTagSetReadyForReview:
+				open = false
+			case gerrit.ChangeMessageTagSetWorkInProgress:
+				open = true
+			}
 		}
 	}
 	if open {
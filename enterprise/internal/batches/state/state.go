@@ -16,6 +16,7 @@ import (
 	"github.com/sourcegraph/log"
 
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
@@ -24,6 +25,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
@@ -118,6 +120,8 @@ func computeCheckState(c *btypes.Changeset, events ChangesetEvents) btypes.Chang
 		return computeBitbucketCloudBuildState(c.UpdatedAt, m, events)
 	case *azuredevops.AnnotatedPullRequest:
 		return computeAzureDevOpsBuildState(m)
+	case *gerritbatches.AnnotatedChange:
+		return computeGerritBuildState(m)
 	}
 
 	return btypes.ChangesetCheckStateUnknown
@@ -285,6 +289,29 @@ func parseAzureDevOpsBuildState(s adobatches.PullRequestStatusState) btypes.Chan
 	}
 }
 
+// computeGerritBuildState derives the check state from the votes on the
+// Verified label, which is where CI systems report their results on Gerrit.
+func computeGerritBuildState(c *gerritbatches.AnnotatedChange) btypes.ChangesetCheckState {
+	label, ok := c.Labels[gerrit.LabelVerified]
+	if !ok {
+		return btypes.ChangesetCheckStateUnknown
+	}
+
+	states := make([]btypes.ChangesetCheckState, 0, len(label.All))
+	for _, approval := range label.All {
+		switch {
+		case approval.Value < 0:
+			states = append(states, btypes.ChangesetCheckStateFailed)
+		case approval.Value > 0:
+			states = append(states, btypes.ChangesetCheckStatePassed)
+		}
+	}
+	if len(states) == 0 {
+		return btypes.ChangesetCheckStatePending
+	}
+	return combineCheckStates(states)
+}
+
 func computeGitHubCheckState(lastSynced time.Time, pr *github.PullRequest, events []*btypes.ChangesetEvent) btypes.ChangesetCheckState {
 	// We should only consider the latest commit. This could be from a sync or a webhook that
 	// has occurred later
@@ -564,6 +591,21 @@ func computeSingleChangesetExternalState(c *btypes.Changeset) (s btypes.Changese
 		default:
 			return "", errors.Errorf("unknown Azure DevOps pull request state: %s", m.Status)
 		}
+	case *gerritbatches.AnnotatedChange:
+		switch m.Status {
+		case gerrit.ChangeStatusAbandoned:
+			s = btypes.ChangesetExternalStateClosed
+		case gerrit.ChangeStatusMerged:
+			s = btypes.ChangesetExternalStateMerged
+		case gerrit.ChangeStatusNew:
+			if m.WorkInProgress {
+				s = btypes.ChangesetExternalStateDraft
+			} else {
+				s = btypes.ChangesetExternalStateOpen
+			}
+		default:
+			return "", errors.Errorf("unknown Gerrit change status: %s", m.Status)
+		}
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -650,6 +692,17 @@ func computeSingleChangesetReviewState(c *btypes.Changeset) (s btypes.ChangesetR
 				states[btypes.ChangesetReviewStatePending] = true
 			}
 		}
+	case *gerritbatches.AnnotatedChange:
+		for _, approval := range m.Labels[gerrit.LabelCodeReview].All {
+			switch {
+			case approval.Value > 0:
+				states[btypes.ChangesetReviewStateApproved] = true
+			case approval.Value < 0:
+				states[btypes.ChangesetReviewStateChangesRequested] = true
+			default:
+				states[btypes.ChangesetReviewStatePending] = true
+			}
+		}
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -0,0 +1,356 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/opentracing/opentracing-go/log"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchChangeScheduleColumns are used by the schedule related Store methods to
+// query and create batch change schedules.
+var batchChangeScheduleColumns = SQLColumns{
+	"batch_change_schedules.batch_change_id",
+	"batch_change_schedules.schedule",
+	"batch_change_schedules.next_run_at",
+	"batch_change_schedules.created_at",
+	"batch_change_schedules.updated_at",
+}
+
+// batchChangeScheduleInsertColumns is the list of batch_change_schedules
+// columns that are modified in UpsertBatchChangeSchedule.
+var batchChangeScheduleInsertColumns = SQLColumns{
+	"batch_change_id",
+	"schedule",
+	"next_run_at",
+	"created_at",
+	"updated_at",
+}
+
+// UpsertBatchChangeSchedule creates the schedule of a batch change, or
+// replaces the existing one.
+func (s *Store) UpsertBatchChangeSchedule(ctx context.Context, sched *btypes.BatchChangeSchedule) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchChangeSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(sched.BatchChangeID)),
+		log.String("schedule", sched.Schedule),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if sched.CreatedAt.IsZero() {
+		sched.CreatedAt = s.now()
+	}
+	sched.UpdatedAt = s.now()
+
+	q := sqlf.Sprintf(
+		upsertBatchChangeScheduleQueryFmtstr,
+		sqlf.Join(batchChangeScheduleInsertColumns.ToSqlf(), ", "),
+		sched.BatchChangeID,
+		sched.Schedule,
+		sched.NextRunAt,
+		sched.CreatedAt,
+		sched.UpdatedAt,
+		sqlf.Join(batchChangeScheduleColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeSchedule(sched, sc)
+	})
+}
+
+var upsertBatchChangeScheduleQueryFmtstr = `
+INSERT INTO batch_change_schedules (%s)
+VALUES ` + batchChangeScheduleInsertColumns.FmtStr() + `
+ON CONFLICT (batch_change_id)
+DO UPDATE SET
+	schedule = EXCLUDED.schedule,
+	next_run_at = EXCLUDED.next_run_at,
+	updated_at = EXCLUDED.updated_at
+RETURNING %s
+`
+
+// GetBatchChangeSchedule gets the schedule of the given batch change.
+func (s *Store) GetBatchChangeSchedule(ctx context.Context, batchChangeID int64) (sched *btypes.BatchChangeSchedule, err error) {
+	ctx, _, endObservation := s.operations.getBatchChangeSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getBatchChangeScheduleQueryFmtstr,
+		sqlf.Join(batchChangeScheduleColumns.ToSqlf(), ", "),
+		batchChangeID,
+	)
+
+	return s.getBatchChangeSchedule(ctx, q)
+}
+
+var getBatchChangeScheduleQueryFmtstr = `
+SELECT %s FROM batch_change_schedules
+WHERE batch_change_id = %s
+`
+
+// GetNextDueBatchChangeSchedule gets the schedule that has been due for the
+// longest time at the given time, locking it for the rest of the transaction.
+// Schedules of closed batch changes are never due. If no schedule is due,
+// ErrNoResults is returned.
+func (s *Store) GetNextDueBatchChangeSchedule(ctx context.Context, now time.Time) (sched *btypes.BatchChangeSchedule, err error) {
+	ctx, _, endObservation := s.operations.getNextDueBatchChangeSchedule.With(ctx, &err, observation.Args{})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getNextDueBatchChangeScheduleQueryFmtstr,
+		sqlf.Join(batchChangeScheduleColumns.ToSqlf(), ", "),
+		now,
+	)
+
+	return s.getBatchChangeSchedule(ctx, q)
+}
+
+var getNextDueBatchChangeScheduleQueryFmtstr = `
+SELECT %s FROM batch_change_schedules
+JOIN batch_changes ON batch_changes.id = batch_change_schedules.batch_change_id
+WHERE
+	batch_change_schedules.next_run_at <= %s
+	AND
+	batch_changes.closed_at IS NULL
+ORDER BY batch_change_schedules.next_run_at ASC
+LIMIT 1
+FOR UPDATE OF batch_change_schedules SKIP LOCKED
+`
+
+func (s *Store) getBatchChangeSchedule(ctx context.Context, q *sqlf.Query) (*btypes.BatchChangeSchedule, error) {
+	var sched btypes.BatchChangeSchedule
+	err := s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeSchedule(&sched, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if sched.BatchChangeID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &sched, nil
+}
+
+// DeleteBatchChangeSchedule deletes the schedule of the given batch change, if
+// it has one.
+func (s *Store) DeleteBatchChangeSchedule(ctx context.Context, batchChangeID int64) (err error) {
+	ctx, _, endObservation := s.operations.deleteBatchChangeSchedule.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(batchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(deleteBatchChangeScheduleQueryFmtstr, batchChangeID))
+}
+
+var deleteBatchChangeScheduleQueryFmtstr = `
+DELETE FROM batch_change_schedules WHERE batch_change_id = %s
+`
+
+func scanBatchChangeSchedule(sched *btypes.BatchChangeSchedule, s dbutil.Scanner) error {
+	return s.Scan(
+		&sched.BatchChangeID,
+		&sched.Schedule,
+		&sched.NextRunAt,
+		&sched.CreatedAt,
+		&sched.UpdatedAt,
+	)
+}
+
+// batchChangeScheduledRunColumns are used by the scheduled run related Store
+// methods to query and create scheduled runs.
+var batchChangeScheduledRunColumns = SQLColumns{
+	"batch_change_scheduled_runs.id",
+	"batch_change_scheduled_runs.batch_change_id",
+	"batch_change_scheduled_runs.batch_spec_id",
+	"batch_change_scheduled_runs.state",
+	"batch_change_scheduled_runs.failure_message",
+	"batch_change_scheduled_runs.started_at",
+	"batch_change_scheduled_runs.finished_at",
+}
+
+// batchChangeScheduledRunInsertColumns is the list of
+// batch_change_scheduled_runs columns that are modified in
+// CreateBatchChangeScheduledRun and UpdateBatchChangeScheduledRun.
+var batchChangeScheduledRunInsertColumns = SQLColumns{
+	"batch_change_id",
+	"batch_spec_id",
+	"state",
+	"failure_message",
+	"started_at",
+	"finished_at",
+}
+
+// CreateBatchChangeScheduledRun creates the given scheduled run.
+func (s *Store) CreateBatchChangeScheduledRun(ctx context.Context, run *btypes.BatchChangeScheduledRun) (err error) {
+	ctx, _, endObservation := s.operations.createBatchChangeScheduledRun.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(run.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if run.StartedAt.IsZero() {
+		run.StartedAt = s.now()
+	}
+
+	q := sqlf.Sprintf(
+		createBatchChangeScheduledRunQueryFmtstr,
+		sqlf.Join(batchChangeScheduledRunInsertColumns.ToSqlf(), ", "),
+		run.BatchChangeID,
+		dbutil.NullInt64Column(run.BatchSpecID),
+		run.State,
+		run.FailureMessage,
+		run.StartedAt,
+		dbutil.NullTimeColumn(run.FinishedAt),
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeScheduledRun(run, sc)
+	})
+}
+
+var createBatchChangeScheduledRunQueryFmtstr = `
+INSERT INTO batch_change_scheduled_runs (%s)
+VALUES ` + batchChangeScheduledRunInsertColumns.FmtStr() + `
+RETURNING %s
+`
+
+// UpdateBatchChangeScheduledRun updates the given scheduled run.
+func (s *Store) UpdateBatchChangeScheduledRun(ctx context.Context, run *btypes.BatchChangeScheduledRun) (err error) {
+	ctx, _, endObservation := s.operations.updateBatchChangeScheduledRun.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("ID", int(run.ID)),
+		log.String("state", string(run.State)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		updateBatchChangeScheduledRunQueryFmtstr,
+		sqlf.Join(batchChangeScheduledRunInsertColumns.ToSqlf(), ", "),
+		run.BatchChangeID,
+		dbutil.NullInt64Column(run.BatchSpecID),
+		run.State,
+		run.FailureMessage,
+		run.StartedAt,
+		dbutil.NullTimeColumn(run.FinishedAt),
+		run.ID,
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchChangeScheduledRun(run, sc)
+	})
+}
+
+var updateBatchChangeScheduledRunQueryFmtstr = `
+UPDATE batch_change_scheduled_runs
+SET (%s) = ` + batchChangeScheduledRunInsertColumns.FmtStr() + `
+WHERE id = %s
+RETURNING %s
+`
+
+// ListBatchChangeScheduledRunsOpts captures the query options needed for
+// listing scheduled runs.
+type ListBatchChangeScheduledRunsOpts struct {
+	LimitOpts
+	Cursor int64
+
+	BatchChangeID  int64
+	OnlyUnfinished bool
+}
+
+// ListBatchChangeScheduledRuns lists the scheduled runs matching the given
+// options, newest first.
+func (s *Store) ListBatchChangeScheduledRuns(ctx context.Context, opts ListBatchChangeScheduledRunsOpts) (rs []*btypes.BatchChangeScheduledRun, next int64, err error) {
+	ctx, _, endObservation := s.operations.listBatchChangeScheduledRuns.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	preds := listBatchChangeScheduledRunsPreds(opts)
+	if opts.Cursor != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.id <= %s", opts.Cursor))
+	}
+
+	q := sqlf.Sprintf(
+		listBatchChangeScheduledRunsQueryFmtstr+opts.LimitOpts.ToDB(),
+		sqlf.Join(batchChangeScheduledRunColumns.ToSqlf(), ", "),
+		sqlf.Join(preds, "\n AND "),
+	)
+
+	rs = make([]*btypes.BatchChangeScheduledRun, 0, opts.DBLimit())
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var r btypes.BatchChangeScheduledRun
+		if err := scanBatchChangeScheduledRun(&r, sc); err != nil {
+			return err
+		}
+		rs = append(rs, &r)
+		return nil
+	})
+
+	if opts.Limit != 0 && len(rs) == opts.DBLimit() {
+		next = rs[len(rs)-1].ID
+		rs = rs[:len(rs)-1]
+	}
+
+	return rs, next, err
+}
+
+var listBatchChangeScheduledRunsQueryFmtstr = `
+SELECT %s FROM batch_change_scheduled_runs
+WHERE %s
+ORDER BY batch_change_scheduled_runs.id DESC
+`
+
+// CountBatchChangeScheduledRuns returns the number of scheduled runs matching
+// the given options. The cursor and limit are ignored.
+func (s *Store) CountBatchChangeScheduledRuns(ctx context.Context, opts ListBatchChangeScheduledRunsOpts) (count int, err error) {
+	ctx, _, endObservation := s.operations.countBatchChangeScheduledRuns.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchChangeID", int(opts.BatchChangeID)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		countBatchChangeScheduledRunsQueryFmtstr,
+		sqlf.Join(listBatchChangeScheduledRunsPreds(opts), "\n AND "),
+	)
+
+	return s.queryCount(ctx, q)
+}
+
+var countBatchChangeScheduledRunsQueryFmtstr = `
+SELECT COUNT(*) FROM batch_change_scheduled_runs
+WHERE %s
+`
+
+func listBatchChangeScheduledRunsPreds(opts ListBatchChangeScheduledRunsOpts) []*sqlf.Query {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+
+	if opts.BatchChangeID != 0 {
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.batch_change_id = %s", opts.BatchChangeID))
+	}
+
+	if opts.OnlyUnfinished {
+		preds = append(preds, sqlf.Sprintf("batch_change_scheduled_runs.finished_at IS NULL"))
+	}
+
+	return preds
+}
+
+func scanBatchChangeScheduledRun(r *btypes.BatchChangeScheduledRun, s dbutil.Scanner) error {
+	return s.Scan(
+		&r.ID,
+		&r.BatchChangeID,
+		&dbutil.NullInt64{N: &r.BatchSpecID},
+		&r.State,
+		&r.FailureMessage,
+		&r.StartedAt,
+		&dbutil.NullTime{Time: &r.FinishedAt},
+	)
+}
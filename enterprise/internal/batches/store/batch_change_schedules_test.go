@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchChangeSchedules(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	batchChanges := make([]*btypes.BatchChange, 0, 3)
+	for i := 0; i < cap(batchChanges); i++ {
+		bc := &btypes.BatchChange{
+			Name:            fmt.Sprintf("scheduled-%d", i),
+			CreatorID:       int32(i + 50),
+			LastApplierID:   int32(i + 50),
+			LastAppliedAt:   clock.Now(),
+			NamespaceUserID: int32(i + 50),
+			BatchSpecID:     int64(i + 100),
+		}
+		if i == 2 {
+			bc.ClosedAt = clock.Now()
+		}
+		if err := s.CreateBatchChange(ctx, bc); err != nil {
+			t.Fatal(err)
+		}
+		batchChanges = append(batchChanges, bc)
+	}
+
+	schedules := make([]*btypes.BatchChangeSchedule, 0, len(batchChanges))
+	for i, bc := range batchChanges {
+		schedules = append(schedules, &btypes.BatchChangeSchedule{
+			BatchChangeID: bc.ID,
+			Schedule:      "0 3 * * *",
+			NextRunAt:     clock.Now().Add(time.Duration(i-2) * time.Hour),
+		})
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		for _, sched := range schedules {
+			if err := s.UpsertBatchChangeSchedule(ctx, sched); err != nil {
+				t.Fatal(err)
+			}
+			if have, want := sched.CreatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("schedule.CreatedAt is wrong.\n\twant=%s\n\thave=%s", want, have)
+			}
+		}
+
+		t.Run("replaces existing schedule", func(t *testing.T) {
+			schedules[1].Schedule = "@daily"
+			if err := s.UpsertBatchChangeSchedule(ctx, schedules[1]); err != nil {
+				t.Fatal(err)
+			}
+			if have, want := schedules[1].Schedule, "@daily"; have != want {
+				t.Fatalf("wrong schedule. want=%q, have=%q", want, have)
+			}
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		for _, want := range schedules {
+			have, err := s.GetBatchChangeSchedule(ctx, want.BatchChangeID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetBatchChangeSchedule(ctx, 0xdeadbeef)
+			if want := ErrNoResults; have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	t.Run("GetNextDue", func(t *testing.T) {
+		have, err := s.GetNextDueBatchChangeSchedule(ctx, clock.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(schedules[0], have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		t.Run("skips closed batch changes", func(t *testing.T) {
+			_, have := s.GetNextDueBatchChangeSchedule(ctx, clock.Now().Add(-3*time.Hour))
+			if want := ErrNoResults; have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	runs := make([]*btypes.BatchChangeScheduledRun, 0, 3)
+	t.Run("CreateScheduledRun", func(t *testing.T) {
+		for i := 0; i < cap(runs); i++ {
+			run := &btypes.BatchChangeScheduledRun{
+				BatchChangeID: batchChanges[i%2].ID,
+				BatchSpecID:   int64(i + 200),
+				State:         btypes.BatchChangeScheduledRunStateResolving,
+			}
+			if err := s.CreateBatchChangeScheduledRun(ctx, run); err != nil {
+				t.Fatal(err)
+			}
+			if run.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if have, want := run.StartedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("run.StartedAt is wrong.\n\twant=%s\n\thave=%s", want, have)
+			}
+			runs = append(runs, run)
+		}
+	})
+
+	t.Run("UpdateScheduledRun", func(t *testing.T) {
+		msg := "execution failed"
+		runs[0].State = btypes.BatchChangeScheduledRunStateFailed
+		runs[0].FailureMessage = &msg
+		runs[0].FinishedAt = clock.Now()
+		if err := s.UpdateBatchChangeScheduledRun(ctx, runs[0]); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("ListScheduledRuns", func(t *testing.T) {
+		have, _, err := s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{BatchChangeID: batchChanges[0].ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChangeScheduledRun{runs[2], runs[0]}, have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		have, _, err = s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{OnlyUnfinished: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchChangeScheduledRun{runs[2], runs[1]}, have); diff != "" {
+			t.Fatal(diff)
+		}
+
+		t.Run("paginated", func(t *testing.T) {
+			have, next, err := s.ListBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{LimitOpts: LimitOpts{Limit: 2}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff([]*btypes.BatchChangeScheduledRun{runs[2], runs[1]}, have); diff != "" {
+				t.Fatal(diff)
+			}
+			if want := runs[0].ID; next != want {
+				t.Fatalf("wrong next cursor. want=%d, have=%d", want, next)
+			}
+		})
+
+		count, err := s.CountBatchChangeScheduledRuns(ctx, ListBatchChangeScheduledRunsOpts{BatchChangeID: batchChanges[1].ID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count != 1 {
+			t.Fatalf("wrong count. want=1, have=%d", count)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		if err := s.DeleteBatchChangeSchedule(ctx, schedules[0].BatchChangeID); err != nil {
+			t.Fatal(err)
+		}
+		if _, have := s.GetBatchChangeSchedule(ctx, schedules[0].BatchChangeID); have != ErrNoResults {
+			t.Fatalf("have err %v, want %v", have, ErrNoResults)
+		}
+	})
+}
@@ -0,0 +1,234 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// batchSpecExecutionSharedCacheEntryInsertColumns is the list of
+// batch_spec_execution_shared_cache_entries columns that are modified in
+// CreateBatchSpecExecutionSharedCacheEntry.
+var batchSpecExecutionSharedCacheEntryInsertColumns = SQLColumns{
+	"repo_id",
+	"user_id",
+	"key",
+	"value",
+	"version",
+	"last_used_at",
+	"created_at",
+}
+
+// BatchSpecExecutionSharedCacheEntryColumns are used by the shared cache entry
+// related Store methods to query and create shared cache entries.
+var BatchSpecExecutionSharedCacheEntryColumns = SQLColumns{
+	"batch_spec_execution_shared_cache_entries.id",
+	"batch_spec_execution_shared_cache_entries.repo_id",
+	"batch_spec_execution_shared_cache_entries.user_id",
+	"batch_spec_execution_shared_cache_entries.key",
+	"batch_spec_execution_shared_cache_entries.value",
+	"batch_spec_execution_shared_cache_entries.version",
+	"batch_spec_execution_shared_cache_entries.last_used_at",
+	"batch_spec_execution_shared_cache_entries.created_at",
+}
+
+// CreateBatchSpecExecutionSharedCacheEntry creates the given shared cache
+// entry, or replaces the value of the existing entry with the same key.
+func (s *Store) CreateBatchSpecExecutionSharedCacheEntry(ctx context.Context, ce *btypes.BatchSpecExecutionSharedCacheEntry) (err error) {
+	ctx, _, endObservation := s.operations.createBatchSpecExecutionSharedCacheEntry.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("Key", ce.Key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := s.createBatchSpecExecutionSharedCacheEntryQuery(ce)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) (err error) {
+		return scanBatchSpecExecutionSharedCacheEntry(ce, sc)
+	})
+}
+
+func (s *Store) createBatchSpecExecutionSharedCacheEntryQuery(ce *btypes.BatchSpecExecutionSharedCacheEntry) *sqlf.Query {
+	if ce.CreatedAt.IsZero() {
+		ce.CreatedAt = s.now()
+	}
+
+	if ce.Version == 0 {
+		ce.Version = btypes.CurrentCacheVersion
+	}
+
+	lastUsedAt := &ce.LastUsedAt
+	if ce.LastUsedAt.IsZero() {
+		lastUsedAt = nil
+	}
+
+	return sqlf.Sprintf(
+		createBatchSpecExecutionSharedCacheEntryQueryFmtstr,
+		sqlf.Join(batchSpecExecutionSharedCacheEntryInsertColumns.ToSqlf(), ", "),
+		ce.RepoID,
+		dbutil.NullInt32Column(ce.UserID),
+		ce.Key,
+		ce.Value,
+		ce.Version,
+		&dbutil.NullTime{Time: lastUsedAt},
+		ce.CreatedAt,
+		sqlf.Join(BatchSpecExecutionSharedCacheEntryColumns.ToSqlf(), ", "),
+	)
+}
+
+var createBatchSpecExecutionSharedCacheEntryQueryFmtstr = `
+INSERT INTO batch_spec_execution_shared_cache_entries (%s)
+VALUES ` + batchSpecExecutionSharedCacheEntryInsertColumns.FmtStr() + `
+ON CONFLICT ON CONSTRAINT batch_spec_execution_shared_cache_entries_key_unique
+DO UPDATE SET
+	value = EXCLUDED.value,
+	version = EXCLUDED.version,
+	created_at = EXCLUDED.created_at
+RETURNING %s
+`
+
+// ListBatchSpecExecutionSharedCacheEntriesOpts captures the query options
+// needed for getting BatchSpecExecutionSharedCacheEntries.
+type ListBatchSpecExecutionSharedCacheEntriesOpts struct {
+	Keys []string
+}
+
+// ListBatchSpecExecutionSharedCacheEntries gets the shared cache entries with
+// the given keys. Entries of repositories that the actor in ctx cannot access
+// are never returned.
+func (s *Store) ListBatchSpecExecutionSharedCacheEntries(ctx context.Context, opts ListBatchSpecExecutionSharedCacheEntriesOpts) (cs []*btypes.BatchSpecExecutionSharedCacheEntry, err error) {
+	ctx, _, endObservation := s.operations.listBatchSpecExecutionSharedCacheEntries.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("Count", len(opts.Keys)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if len(opts.Keys) == 0 {
+		return nil, errors.New("cannot query shared cache entries without specifying Keys")
+	}
+
+	repoAuthzConds, err := database.AuthzQueryConds(ctx, database.NewDBWith(s.logger, s))
+	if err != nil {
+		return nil, errors.Wrap(err, "ListBatchSpecExecutionSharedCacheEntries generating authz query conds")
+	}
+
+	q := sqlf.Sprintf(
+		listBatchSpecExecutionSharedCacheEntriesQueryFmtstr,
+		sqlf.Join(BatchSpecExecutionSharedCacheEntryColumns.ToSqlf(), ", "),
+		btypes.CurrentCacheVersion,
+		pq.Array(opts.Keys),
+		repoAuthzConds,
+	)
+
+	cs = make([]*btypes.BatchSpecExecutionSharedCacheEntry, 0, len(opts.Keys))
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var c btypes.BatchSpecExecutionSharedCacheEntry
+		if err := scanBatchSpecExecutionSharedCacheEntry(&c, sc); err != nil {
+			return err
+		}
+		cs = append(cs, &c)
+		return nil
+	})
+
+	return cs, err
+}
+
+var listBatchSpecExecutionSharedCacheEntriesQueryFmtstr = `
+SELECT %s FROM batch_spec_execution_shared_cache_entries
+JOIN repo ON repo.id = batch_spec_execution_shared_cache_entries.repo_id
+WHERE
+	-- Only consider records that are in the current cache version.
+	batch_spec_execution_shared_cache_entries.version = %s
+	AND batch_spec_execution_shared_cache_entries.key = ANY (%s)
+	AND repo.deleted_at IS NULL
+	AND %s -- authz query conds
+`
+
+const markUsedBatchSpecExecutionSharedCacheEntriesQueryFmtstr = `
+UPDATE
+	batch_spec_execution_shared_cache_entries
+SET last_used_at = %s
+WHERE
+	batch_spec_execution_shared_cache_entries.id = ANY (%s)
+`
+
+// MarkUsedBatchSpecExecutionSharedCacheEntries updates the LastUsedAt of the
+// given shared cache entries.
+func (s *Store) MarkUsedBatchSpecExecutionSharedCacheEntries(ctx context.Context, ids []int64) (err error) {
+	ctx, _, endObservation := s.operations.markUsedBatchSpecExecutionSharedCacheEntries.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("count", len(ids)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		markUsedBatchSpecExecutionSharedCacheEntriesQueryFmtstr,
+		s.now(),
+		pq.Array(ids),
+	)
+	return s.Exec(ctx, q)
+}
+
+// cleanBatchSpecExecutionSharedEntriesQueryFmtstr works like
+// cleanBatchSpecExecutionEntriesQueryFmtstr, but on the shared cache.
+const cleanBatchSpecExecutionSharedEntriesQueryFmtstr = `
+WITH total_size AS (
+  SELECT sum(octet_length(value)) AS total FROM batch_spec_execution_shared_cache_entries
+),
+candidates AS (
+  SELECT
+    id
+  FROM (
+    SELECT
+      entries.id,
+      entries.created_at,
+      entries.last_used_at,
+      SUM(octet_length(entries.value)) OVER (ORDER BY COALESCE(entries.last_used_at, entries.created_at) ASC, entries.id ASC) AS running_size
+    FROM batch_spec_execution_shared_cache_entries entries
+  ) t
+  WHERE
+    ((SELECT total FROM total_size) - t.running_size) >= %s
+),
+outdated AS (
+	SELECT
+		id
+	FROM batch_spec_execution_shared_cache_entries
+	WHERE
+		version < %s
+),
+ids AS (
+	SELECT id FROM outdated
+	UNION ALL
+	SELECT id FROM candidates
+)
+DELETE FROM batch_spec_execution_shared_cache_entries WHERE id IN (SELECT id FROM ids)
+`
+
+// CleanBatchSpecExecutionSharedCacheEntries evicts the least recently used
+// shared cache entries until the values take up at most maxCacheSize bytes.
+func (s *Store) CleanBatchSpecExecutionSharedCacheEntries(ctx context.Context, maxCacheSize int64) (err error) {
+	ctx, _, endObservation := s.operations.cleanBatchSpecExecutionSharedCacheEntries.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("MaxTableSize", int(maxCacheSize)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return s.Exec(ctx, sqlf.Sprintf(cleanBatchSpecExecutionSharedEntriesQueryFmtstr, maxCacheSize, btypes.CurrentCacheVersion))
+}
+
+func scanBatchSpecExecutionSharedCacheEntry(c *btypes.BatchSpecExecutionSharedCacheEntry, s dbutil.Scanner) error {
+	return s.Scan(
+		&c.ID,
+		&c.RepoID,
+		&dbutil.NullInt32{N: &c.UserID},
+		&c.Key,
+		&c.Value,
+		&c.Version,
+		&dbutil.NullTime{Time: &c.LastUsedAt},
+		&c.CreatedAt,
+	)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchSpecExecutionSharedCacheEntries(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	repos, _ := bt.CreateTestRepos(t, ctx, s.DatabaseDB(), 2)
+	deletedRepo := repos[1]
+	if err := s.DatabaseDB().Repos().Delete(ctx, deletedRepo.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := make([]*btypes.BatchSpecExecutionSharedCacheEntry, 0, 3)
+	for i := 0; i < cap(entries); i++ {
+		entries = append(entries, &btypes.BatchSpecExecutionSharedCacheEntry{
+			RepoID: repos[0].ID,
+			UserID: 900 + int32(i),
+			Key:    fmt.Sprintf("shared-cache-key-%d", i),
+			Value:  fmt.Sprintf("shared-cache-value-%d", i),
+		})
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		for _, entry := range entries {
+			if err := s.CreateBatchSpecExecutionSharedCacheEntry(ctx, entry); err != nil {
+				t.Fatal(err)
+			}
+
+			if entry.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if have, want := entry.CreatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("entry.CreatedAt is wrong.\n\twant=%s\n\thave=%s", want, have)
+			}
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		// Entries are shared, so they're listed regardless of their user.
+		cs, err := s.ListBatchSpecExecutionSharedCacheEntries(ctx, ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: []string{entries[0].Key, entries[2].Key, "unknown-key"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff([]*btypes.BatchSpecExecutionSharedCacheEntry{entries[0], entries[2]}, cs); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("ListDeletedRepo", func(t *testing.T) {
+		entry := &btypes.BatchSpecExecutionSharedCacheEntry{
+			RepoID: deletedRepo.ID,
+			Key:    "deleted-repo-cache-key",
+			Value:  "deleted-repo-cache-value",
+		}
+		if err := s.CreateBatchSpecExecutionSharedCacheEntry(ctx, entry); err != nil {
+			t.Fatal(err)
+		}
+
+		cs, err := s.ListBatchSpecExecutionSharedCacheEntries(ctx, ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: []string{entry.Key},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(cs) != 0 {
+			t.Fatalf("cache entry of deleted repo returned: %+v", cs)
+		}
+	})
+
+	t.Run("CreateWithConflictingKey", func(t *testing.T) {
+		clock.Add(1 * time.Minute)
+
+		keyConflict := &btypes.BatchSpecExecutionSharedCacheEntry{
+			RepoID: entries[0].RepoID,
+			UserID: 9999,
+			Key:    entries[0].Key,
+			Value:  "new value",
+		}
+		if err := s.CreateBatchSpecExecutionSharedCacheEntry(ctx, keyConflict); err != nil {
+			t.Fatal(err)
+		}
+		if keyConflict.ID != entries[0].ID {
+			t.Fatalf("entry not replaced: want ID %d, have %d", entries[0].ID, keyConflict.ID)
+		}
+
+		reloaded, err := s.ListBatchSpecExecutionSharedCacheEntries(ctx, ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: []string{keyConflict.Key},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reloaded) != 1 {
+			t.Fatal("cache entry not found")
+		}
+		if have, want := reloaded[0].Value, "new value"; have != want {
+			t.Fatalf("wrong value. want=%q, have=%q", want, have)
+		}
+		if reloaded[0].CreatedAt.Equal(entries[0].CreatedAt) {
+			t.Fatal("CreatedAt not updated")
+		}
+	})
+
+	t.Run("MarkUsedBatchSpecExecutionSharedCacheEntries", func(t *testing.T) {
+		entry := entries[1]
+		if err := s.MarkUsedBatchSpecExecutionSharedCacheEntries(ctx, []int64{entry.ID}); err != nil {
+			t.Fatal(err)
+		}
+
+		reloaded, err := s.ListBatchSpecExecutionSharedCacheEntries(ctx, ListBatchSpecExecutionSharedCacheEntriesOpts{
+			Keys: []string{entry.Key},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reloaded) != 1 {
+			t.Fatal("cache entry not found")
+		}
+
+		if want, have := clock.Now(), reloaded[0].LastUsedAt; !have.Equal(want) {
+			t.Fatalf("entry.LastUsedAt is wrong.\n\twant=%s\n\thave=%s", want, have)
+		}
+	})
+}
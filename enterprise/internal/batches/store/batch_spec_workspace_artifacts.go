@@ -0,0 +1,178 @@
+package store
+
+import (
+	"context"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/opentracing/opentracing-go/log"
+
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+// batchSpecWorkspaceArtifactInsertColumns is the list of
+// batch_spec_workspace_artifacts columns that are modified in
+// UpsertBatchSpecWorkspaceArtifact.
+var batchSpecWorkspaceArtifactInsertColumns = SQLColumns{
+	"batch_spec_workspace_id",
+	"step_index",
+	"name",
+	"path",
+	"byte_size",
+	"object_key",
+	"created_at",
+}
+
+// BatchSpecWorkspaceArtifactColumns are used by the artifact related Store
+// methods to query and create artifacts.
+var BatchSpecWorkspaceArtifactColumns = SQLColumns{
+	"batch_spec_workspace_artifacts.id",
+	"batch_spec_workspace_artifacts.batch_spec_workspace_id",
+	"batch_spec_workspace_artifacts.step_index",
+	"batch_spec_workspace_artifacts.name",
+	"batch_spec_workspace_artifacts.path",
+	"batch_spec_workspace_artifacts.byte_size",
+	"batch_spec_workspace_artifacts.object_key",
+	"batch_spec_workspace_artifacts.created_at",
+}
+
+// UpsertBatchSpecWorkspaceArtifact creates the given artifact, or replaces the
+// existing artifact with the same name in the workspace.
+func (s *Store) UpsertBatchSpecWorkspaceArtifact(ctx context.Context, a *btypes.BatchSpecWorkspaceArtifact) (err error) {
+	ctx, _, endObservation := s.operations.upsertBatchSpecWorkspaceArtifact.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchSpecWorkspaceID", int(a.BatchSpecWorkspaceID)),
+		log.String("name", a.Name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = s.now()
+	}
+
+	q := sqlf.Sprintf(
+		upsertBatchSpecWorkspaceArtifactQueryFmtstr,
+		sqlf.Join(batchSpecWorkspaceArtifactInsertColumns.ToSqlf(), ", "),
+		a.BatchSpecWorkspaceID,
+		a.StepIndex,
+		a.Name,
+		a.Path,
+		a.ByteSize,
+		a.ObjectKey,
+		a.CreatedAt,
+		sqlf.Join(BatchSpecWorkspaceArtifactColumns.ToSqlf(), ", "),
+	)
+
+	return s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchSpecWorkspaceArtifact(a, sc)
+	})
+}
+
+var upsertBatchSpecWorkspaceArtifactQueryFmtstr = `
+INSERT INTO batch_spec_workspace_artifacts (%s)
+VALUES ` + batchSpecWorkspaceArtifactInsertColumns.FmtStr() + `
+ON CONFLICT ON CONSTRAINT batch_spec_workspace_artifacts_workspace_name_unique
+DO UPDATE SET
+	step_index = EXCLUDED.step_index,
+	path = EXCLUDED.path,
+	byte_size = EXCLUDED.byte_size,
+	object_key = EXCLUDED.object_key,
+	created_at = EXCLUDED.created_at
+RETURNING %s
+`
+
+// GetBatchSpecWorkspaceArtifactOpts captures the query options needed for
+// getting a BatchSpecWorkspaceArtifact.
+type GetBatchSpecWorkspaceArtifactOpts struct {
+	BatchSpecWorkspaceID int64
+	Name                 string
+}
+
+// GetBatchSpecWorkspaceArtifact gets the artifact with the given name of the
+// given workspace.
+func (s *Store) GetBatchSpecWorkspaceArtifact(ctx context.Context, opts GetBatchSpecWorkspaceArtifactOpts) (a *btypes.BatchSpecWorkspaceArtifact, err error) {
+	ctx, _, endObservation := s.operations.getBatchSpecWorkspaceArtifact.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("batchSpecWorkspaceID", int(opts.BatchSpecWorkspaceID)),
+		log.String("name", opts.Name),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		getBatchSpecWorkspaceArtifactQueryFmtstr,
+		sqlf.Join(BatchSpecWorkspaceArtifactColumns.ToSqlf(), ", "),
+		opts.BatchSpecWorkspaceID,
+		opts.Name,
+	)
+
+	var artifact btypes.BatchSpecWorkspaceArtifact
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		return scanBatchSpecWorkspaceArtifact(&artifact, sc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if artifact.ID == 0 {
+		return nil, ErrNoResults
+	}
+
+	return &artifact, nil
+}
+
+var getBatchSpecWorkspaceArtifactQueryFmtstr = `
+SELECT %s FROM batch_spec_workspace_artifacts
+WHERE batch_spec_workspace_id = %s AND name = %s
+LIMIT 1
+`
+
+// ListBatchSpecWorkspaceArtifactsOpts captures the query options needed for
+// listing BatchSpecWorkspaceArtifacts.
+type ListBatchSpecWorkspaceArtifactsOpts struct {
+	BatchSpecWorkspaceIDs []int64
+}
+
+// ListBatchSpecWorkspaceArtifacts lists the artifacts of the given workspaces,
+// ordered by step and name.
+func (s *Store) ListBatchSpecWorkspaceArtifacts(ctx context.Context, opts ListBatchSpecWorkspaceArtifactsOpts) (as []*btypes.BatchSpecWorkspaceArtifact, err error) {
+	ctx, _, endObservation := s.operations.listBatchSpecWorkspaceArtifacts.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.Int("count", len(opts.BatchSpecWorkspaceIDs)),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	q := sqlf.Sprintf(
+		listBatchSpecWorkspaceArtifactsQueryFmtstr,
+		sqlf.Join(BatchSpecWorkspaceArtifactColumns.ToSqlf(), ", "),
+		pq.Array(opts.BatchSpecWorkspaceIDs),
+	)
+
+	err = s.query(ctx, q, func(sc dbutil.Scanner) error {
+		var a btypes.BatchSpecWorkspaceArtifact
+		if err := scanBatchSpecWorkspaceArtifact(&a, sc); err != nil {
+			return err
+		}
+		as = append(as, &a)
+		return nil
+	})
+
+	return as, err
+}
+
+var listBatchSpecWorkspaceArtifactsQueryFmtstr = `
+SELECT %s FROM batch_spec_workspace_artifacts
+WHERE batch_spec_workspace_id = ANY (%s)
+ORDER BY batch_spec_workspace_id ASC, step_index ASC, name ASC
+`
+
+func scanBatchSpecWorkspaceArtifact(a *btypes.BatchSpecWorkspaceArtifact, s dbutil.Scanner) error {
+	return s.Scan(
+		&a.ID,
+		&a.BatchSpecWorkspaceID,
+		&a.StepIndex,
+		&a.Name,
+		&a.Path,
+		&a.ByteSize,
+		&a.ObjectKey,
+		&a.CreatedAt,
+	)
+}
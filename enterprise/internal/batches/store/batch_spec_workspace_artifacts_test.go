@@ -0,0 +1,93 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	bt "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/testing"
+	btypes "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/types"
+)
+
+func testStoreBatchSpecWorkspaceArtifacts(t *testing.T, ctx context.Context, s *Store, clock bt.Clock) {
+	artifacts := make([]*btypes.BatchSpecWorkspaceArtifact, 0, 3)
+	for i := 0; i < cap(artifacts); i++ {
+		artifacts = append(artifacts, &btypes.BatchSpecWorkspaceArtifact{
+			BatchSpecWorkspaceID: int64(456 + i%2),
+			StepIndex:            i,
+			Name:                 fmt.Sprintf("artifact-%d", i),
+			Path:                 fmt.Sprintf("out/artifact-%d.json", i),
+			ByteSize:             int64(100 + i),
+			ObjectKey:            fmt.Sprintf("batches/artifacts/%d", i),
+		})
+	}
+
+	t.Run("Upsert", func(t *testing.T) {
+		for _, a := range artifacts {
+			if err := s.UpsertBatchSpecWorkspaceArtifact(ctx, a); err != nil {
+				t.Fatal(err)
+			}
+
+			if a.ID == 0 {
+				t.Fatal("ID should not be zero")
+			}
+			if have, want := a.CreatedAt, clock.Now(); !have.Equal(want) {
+				t.Fatalf("artifact.CreatedAt is wrong.\n\twant=%s\n\thave=%s", want, have)
+			}
+		}
+
+		t.Run("replaces artifact with same name", func(t *testing.T) {
+			replaced := *artifacts[0]
+			replaced.ID = 0
+			replaced.ObjectKey = "batches/artifacts/replaced"
+			replaced.ByteSize = 42
+
+			if err := s.UpsertBatchSpecWorkspaceArtifact(ctx, &replaced); err != nil {
+				t.Fatal(err)
+			}
+			if have, want := replaced.ID, artifacts[0].ID; have != want {
+				t.Fatalf("wrong ID. want=%d, have=%d", want, have)
+			}
+			artifacts[0] = &replaced
+		})
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		for _, want := range artifacts {
+			have, err := s.GetBatchSpecWorkspaceArtifact(ctx, GetBatchSpecWorkspaceArtifactOpts{
+				BatchSpecWorkspaceID: want.BatchSpecWorkspaceID,
+				Name:                 want.Name,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, have); diff != "" {
+				t.Fatal(diff)
+			}
+		}
+
+		t.Run("NoResults", func(t *testing.T) {
+			_, have := s.GetBatchSpecWorkspaceArtifact(ctx, GetBatchSpecWorkspaceArtifactOpts{
+				BatchSpecWorkspaceID: artifacts[1].BatchSpecWorkspaceID,
+				Name:                 artifacts[0].Name,
+			})
+			if want := ErrNoResults; have != want {
+				t.Fatalf("have err %v, want %v", have, want)
+			}
+		})
+	})
+
+	t.Run("List", func(t *testing.T) {
+		have, err := s.ListBatchSpecWorkspaceArtifacts(ctx, ListBatchSpecWorkspaceArtifactsOpts{
+			BatchSpecWorkspaceIDs: []int64{artifacts[0].BatchSpecWorkspaceID},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff([]*btypes.BatchSpecWorkspaceArtifact{artifacts[0], artifacts[2]}, have); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
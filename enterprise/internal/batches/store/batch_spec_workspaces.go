@@ -37,6 +37,7 @@ var batchSpecWorkspaceInsertColumns = []string{
 	"skipped",
 	"cached_result_found",
 	"step_cache_results",
+	"shared_step_cache_keys",
 
 	"created_at",
 	"updated_at",
@@ -61,6 +62,7 @@ var BatchSpecWorkspaceColums = SQLColumns{
 	"batch_spec_workspaces.skipped",
 	"batch_spec_workspaces.cached_result_found",
 	"batch_spec_workspaces.step_cache_results",
+	"batch_spec_workspaces.shared_step_cache_keys",
 
 	"batch_spec_workspaces.created_at",
 	"batch_spec_workspaces.updated_at",
@@ -102,6 +104,15 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 				return err
 			}
 
+			sharedStepCacheKeys := wj.SharedStepCacheKeys
+			if sharedStepCacheKeys == nil {
+				sharedStepCacheKeys = map[int]string{}
+			}
+			marshaledSharedStepCacheKeys, err := json.Marshal(sharedStepCacheKeys)
+			if err != nil {
+				return err
+			}
+
 			if err := inserter.Insert(
 				ctx,
 				wj.BatchSpecID,
@@ -117,6 +128,7 @@ func (s *Store) CreateBatchSpecWorkspace(ctx context.Context, ws ...*btypes.Batc
 				wj.Skipped,
 				wj.CachedResultFound,
 				marshaledStepCacheResults,
+				marshaledSharedStepCacheKeys,
 				wj.CreatedAt,
 				wj.UpdatedAt,
 			); err != nil {
@@ -504,7 +516,7 @@ func (s *Store) DisableBatchSpecWorkspaceExecutionCache(ctx context.Context, bat
 }
 
 func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) error {
-	var stepCacheResults json.RawMessage
+	var stepCacheResults, sharedStepCacheKeys json.RawMessage
 
 	if err := s.Scan(
 		&wj.ID,
@@ -521,6 +533,7 @@ func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) err
 		&wj.Skipped,
 		&wj.CachedResultFound,
 		&stepCacheResults,
+		&sharedStepCacheKeys,
 		&wj.CreatedAt,
 		&wj.UpdatedAt,
 	); err != nil {
@@ -531,6 +544,10 @@ func scanBatchSpecWorkspace(wj *btypes.BatchSpecWorkspace, s dbutil.Scanner) err
 		return errors.Wrap(err, "scanBatchSpecWorkspace: failed to unmarshal StepCacheResults")
 	}
 
+	if err := json.Unmarshal(sharedStepCacheKeys, &wj.SharedStepCacheKeys); err != nil {
+		return errors.Wrap(err, "scanBatchSpecWorkspace: failed to unmarshal SharedStepCacheKeys")
+	}
+
 	return nil
 }
 
@@ -45,6 +45,9 @@ func TestIntegration(t *testing.T) {
 		t.Run("BatchSpecWorkspaceExecutionJobs", storeTest(db, nil, testStoreBatchSpecWorkspaceExecutionJobs))
 		t.Run("BatchSpecResolutionJobs", storeTest(db, nil, testStoreBatchSpecResolutionJobs))
 		t.Run("BatchSpecExecutionCacheEntries", storeTest(db, nil, testStoreBatchSpecExecutionCacheEntries))
+		t.Run("BatchSpecExecutionSharedCacheEntries", storeTest(db, nil, testStoreBatchSpecExecutionSharedCacheEntries))
+		t.Run("BatchSpecWorkspaceArtifacts", storeTest(db, nil, testStoreBatchSpecWorkspaceArtifacts))
+		t.Run("BatchChangeSchedules", storeTest(db, nil, testStoreBatchChangeSchedules))
 
 		for name, key := range map[string]encryption.Key{
 			"no key":   nil,
@@ -281,6 +281,24 @@ type operations struct {
 	markUsedBatchSpecExecutionCacheEntries *observation.Operation
 	createBatchSpecExecutionCacheEntry     *observation.Operation
 	cleanBatchSpecExecutionCacheEntries    *observation.Operation
+
+	listBatchSpecExecutionSharedCacheEntries     *observation.Operation
+	markUsedBatchSpecExecutionSharedCacheEntries *observation.Operation
+	createBatchSpecExecutionSharedCacheEntry     *observation.Operation
+	cleanBatchSpecExecutionSharedCacheEntries    *observation.Operation
+
+	upsertBatchSpecWorkspaceArtifact *observation.Operation
+	getBatchSpecWorkspaceArtifact    *observation.Operation
+	listBatchSpecWorkspaceArtifacts  *observation.Operation
+
+	upsertBatchChangeSchedule     *observation.Operation
+	getBatchChangeSchedule        *observation.Operation
+	getNextDueBatchChangeSchedule *observation.Operation
+	deleteBatchChangeSchedule     *observation.Operation
+	createBatchChangeScheduledRun *observation.Operation
+	updateBatchChangeScheduledRun *observation.Operation
+	listBatchChangeScheduledRuns  *observation.Operation
+	countBatchChangeScheduledRuns *observation.Operation
 }
 
 var (
@@ -426,6 +444,24 @@ func newOperations(observationCtx *observation.Context) *operations {
 			createBatchSpecExecutionCacheEntry:     op("CreateBatchSpecExecutionCacheEntry"),
 
 			cleanBatchSpecExecutionCacheEntries: op("CleanBatchSpecExecutionCacheEntries"),
+
+			listBatchSpecExecutionSharedCacheEntries:     op("ListBatchSpecExecutionSharedCacheEntries"),
+			markUsedBatchSpecExecutionSharedCacheEntries: op("MarkUsedBatchSpecExecutionSharedCacheEntries"),
+			createBatchSpecExecutionSharedCacheEntry:     op("CreateBatchSpecExecutionSharedCacheEntry"),
+			cleanBatchSpecExecutionSharedCacheEntries:    op("CleanBatchSpecExecutionSharedCacheEntries"),
+
+			upsertBatchSpecWorkspaceArtifact: op("UpsertBatchSpecWorkspaceArtifact"),
+			getBatchSpecWorkspaceArtifact:    op("GetBatchSpecWorkspaceArtifact"),
+			listBatchSpecWorkspaceArtifacts:  op("ListBatchSpecWorkspaceArtifacts"),
+
+			upsertBatchChangeSchedule:     op("UpsertBatchChangeSchedule"),
+			getBatchChangeSchedule:        op("GetBatchChangeSchedule"),
+			getNextDueBatchChangeSchedule: op("GetNextDueBatchChangeSchedule"),
+			deleteBatchChangeSchedule:     op("DeleteBatchChangeSchedule"),
+			createBatchChangeScheduledRun: op("CreateBatchChangeScheduledRun"),
+			updateBatchChangeScheduledRun: op("UpdateBatchChangeScheduledRun"),
+			listBatchChangeScheduledRuns:  op("ListBatchChangeScheduledRuns"),
+			countBatchChangeScheduledRuns: op("CountBatchChangeScheduledRuns"),
 		}
 	})
 
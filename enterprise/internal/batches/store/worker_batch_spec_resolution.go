@@ -17,7 +17,7 @@ import (
 const batchSpecResolutionMaxNumRetries = 0
 const batchSpecResolutionMaxNumResets = 60
 
-var batchSpecResolutionWorkerOpts = dbworkerstore.Options[*types.BatchSpecResolutionJob]{
+var BatchSpecResolutionWorkerStoreOptions = dbworkerstore.Options[*types.BatchSpecResolutionJob]{
 	Name:              "batch_changes_batch_spec_resolution_worker_store",
 	TableName:         "batch_spec_resolution_jobs",
 	ColumnExpressions: batchSpecResolutionJobColums.ToSqlf(),
@@ -34,5 +34,5 @@ var batchSpecResolutionWorkerOpts = dbworkerstore.Options[*types.BatchSpecResolu
 }
 
 func NewBatchSpecResolutionWorkerStore(observationCtx *observation.Context, handle basestore.TransactableHandle) dbworkerstore.Store[*types.BatchSpecResolutionJob] {
-	return dbworkerstore.New(observationCtx, handle, batchSpecResolutionWorkerOpts)
+	return dbworkerstore.New(observationCtx, handle, BatchSpecResolutionWorkerStoreOptions)
 }
@@ -19,7 +19,7 @@ const bulkProcessorMaxNumRetries = 10
 // makes to process a changeset job when it stalls (process crashes, etc.).
 const bulkProcessorMaxNumResets = 60
 
-var bulkOperationWorkerStoreOpts = dbworkerstore.Options[*types.ChangesetJob]{
+var BulkOperationWorkerStoreOptions = dbworkerstore.Options[*types.ChangesetJob]{
 	Name:              "batches_bulk_worker_store",
 	TableName:         "changeset_jobs",
 	ColumnExpressions: changesetJobColumns.ToSqlf(),
@@ -36,5 +36,5 @@ var bulkOperationWorkerStoreOpts = dbworkerstore.Options[*types.ChangesetJob]{
 }
 
 func NewBulkOperationWorkerStore(observationCtx *observation.Context, handle basestore.TransactableHandle) dbworkerstore.Store[*types.ChangesetJob] {
-	return dbworkerstore.New(observationCtx, handle, bulkOperationWorkerStoreOpts)
+	return dbworkerstore.New(observationCtx, handle, BulkOperationWorkerStoreOptions)
 }
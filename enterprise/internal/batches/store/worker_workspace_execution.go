@@ -20,6 +20,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	batcheslib "github.com/sourcegraph/sourcegraph/lib/batches"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution/cache"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -37,7 +38,7 @@ const batchSpecWorkspaceExecutionJobStalledJobMaximumAge = time.Second * 25
 // reset.
 const batchSpecWorkspaceExecutionJobMaximumNumResets = 3
 
-var batchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btypes.BatchSpecWorkspaceExecutionJob]{
+var BatchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btypes.BatchSpecWorkspaceExecutionJob]{
 	Name:              "batch_spec_workspace_execution_worker_store",
 	TableName:         "batch_spec_workspace_execution_jobs",
 	ColumnExpressions: batchSpecWorkspaceExecutionJobColumnsWithNullQueue.ToSqlf(),
@@ -57,7 +58,7 @@ var batchSpecWorkspaceExecutionWorkerStoreOptions = dbworkerstore.Options[*btype
 // wraps the batch_spec_workspace_execution_jobs table.
 func NewBatchSpecWorkspaceExecutionWorkerStore(observationCtx *observation.Context, handle basestore.TransactableHandle) dbworkerstore.Store[*btypes.BatchSpecWorkspaceExecutionJob] {
 	return &batchSpecWorkspaceExecutionWorkerStore{
-		Store:          dbworkerstore.New(observationCtx, handle, batchSpecWorkspaceExecutionWorkerStoreOptions),
+		Store:          dbworkerstore.New(observationCtx, handle, BatchSpecWorkspaceExecutionWorkerStoreOptions),
 		observationCtx: observationCtx,
 		logger:         log.Scoped("batch-spec-workspace-execution-worker-store", "The worker store backing the executor queue for Batch Changes"),
 	}
@@ -125,6 +126,9 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) markFinal(ctx context.Context,
 	if err := storeCacheResults(ctx, tx, stepResults, spec.UserID); err != nil {
 		return false, err
 	}
+	if err := storeSharedCacheResults(ctx, tx, stepResults, workspace, spec.UserID); err != nil {
+		return false, err
+	}
 
 	return fn(ctx, s.Store.With(tx))
 }
@@ -202,6 +206,9 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 	if err := storeCacheResults(ctx, tx, stepResults, batchSpec.UserID); err != nil {
 		return false, err
 	}
+	if err := storeSharedCacheResults(ctx, tx, stepResults, workspace, batchSpec.UserID); err != nil {
+		return false, err
+	}
 
 	// Find the result for the last step. This is the one we'll be building the execution
 	// result from.
@@ -212,6 +219,10 @@ func (s *batchSpecWorkspaceExecutionWorkerStore) MarkComplete(ctx context.Contex
 		}
 	}
 
+	if err := storeArtifacts(ctx, tx, workspace.ID, latestStepResult.Value.Artifacts); err != nil {
+		return false, err
+	}
+
 	changesetAuthor, err := author.GetChangesetAuthorForUser(ctx, database.UsersWith(s.logger, s), batchSpec.UserID)
 	if err != nil {
 		return false, errors.Wrap(err, "creating changeset author")
@@ -302,6 +313,18 @@ SET
 WHERE id = %s
 `
 
+// storeArtifacts records the given step artifacts for the workspace. Their
+// content has been uploaded by the executor.
+func storeArtifacts(ctx context.Context, tx *Store, workspaceID int64, artifacts map[string]execution.Artifact) error {
+	for name, a := range artifacts {
+		if err := tx.UpsertBatchSpecWorkspaceArtifact(ctx, btypes.NewBatchSpecWorkspaceArtifact(workspaceID, name, a)); err != nil {
+			return errors.Wrapf(err, "failed to save artifact %q", name)
+		}
+	}
+
+	return nil
+}
+
 // storeCacheResults builds DB cache entries for all the results and store them using the given tx.
 func storeCacheResults(ctx context.Context, tx *Store, results []*batcheslib.CacheAfterStepResultMetadata, userID int32) error {
 	for _, result := range results {
@@ -323,6 +346,35 @@ func storeCacheResults(ctx context.Context, tx *Store, results []*batcheslib.Cac
 	return nil
 }
 
+// storeSharedCacheResults stores all the results in the instance-wide cache,
+// using the shared keys computed for the steps of the workspace when it was
+// created.
+func storeSharedCacheResults(ctx context.Context, tx *Store, results []*batcheslib.CacheAfterStepResultMetadata, workspace *btypes.BatchSpecWorkspace, userID int32) error {
+	for _, result := range results {
+		// Shared keys are stored by step number, which is 1-indexed.
+		key, ok := workspace.SharedStepCacheKeys[result.Value.StepIndex+1]
+		if !ok {
+			continue
+		}
+		value, err := json.Marshal(&result.Value)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal shared cache entry")
+		}
+		entry := &btypes.BatchSpecExecutionSharedCacheEntry{
+			RepoID: workspace.RepoID,
+			UserID: userID,
+			Key:    key,
+			Value:  string(value),
+		}
+
+		if err := tx.CreateBatchSpecExecutionSharedCacheEntry(ctx, entry); err != nil {
+			return errors.Wrap(err, "failed to save shared cache entry")
+		}
+	}
+
+	return nil
+}
+
 func extractCacheEntries(events []*batcheslib.LogEvent) (cacheEntries []*batcheslib.CacheAfterStepResultMetadata, err error) {
 	for _, e := range events {
 		if e.Operation == batcheslib.LogEventOperationCacheAfterStepResult {
@@ -32,7 +32,7 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_MarkComplete(t *testing.T) {
 
 	repo, _ := bt.CreateTestRepo(t, ctx, db)
 	s := New(db, &observation.TestContext, nil)
-	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), BatchSpecWorkspaceExecutionWorkerStoreOptions)
 
 	// Setup all the associations
 	batchSpec := &btypes.BatchSpec{UserID: user.ID, NamespaceUserID: user.ID, RawSpec: "horse", Spec: &batcheslib.BatchSpec{
@@ -226,7 +226,7 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_MarkFailed(t *testing.T) {
 
 	repo, _ := bt.CreateTestRepo(t, ctx, db)
 	s := New(db, &observation.TestContext, nil)
-	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), BatchSpecWorkspaceExecutionWorkerStoreOptions)
 
 	// Setup all the associations
 	batchSpec := &btypes.BatchSpec{UserID: user.ID, NamespaceUserID: user.ID, RawSpec: "horse", Spec: &batcheslib.BatchSpec{
@@ -382,7 +382,7 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_MarkComplete_EmptyDiff(t *testin
 	repo, _ := bt.CreateTestRepo(t, ctx, db)
 
 	s := New(db, &observation.TestContext, nil)
-	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+	workStore := dbworkerstore.New(&observation.TestContext, s.Handle(), BatchSpecWorkspaceExecutionWorkerStoreOptions)
 
 	// Setup all the associations
 	batchSpec := &btypes.BatchSpec{UserID: user.ID, NamespaceUserID: user.ID, RawSpec: "horse", Spec: &batcheslib.BatchSpec{
@@ -466,7 +466,7 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_Dequeue_RoundRobin(t *testing.T)
 	repo, _ := bt.CreateTestRepo(t, ctx, db)
 
 	s := New(db, &observation.TestContext, nil)
-	workerStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+	workerStore := dbworkerstore.New(&observation.TestContext, s.Handle(), BatchSpecWorkspaceExecutionWorkerStoreOptions)
 
 	user1 := bt.CreateTestUser(t, db, true)
 	user2 := bt.CreateTestUser(t, db, true)
@@ -515,7 +515,7 @@ func TestBatchSpecWorkspaceExecutionWorkerStore_Dequeue_RoundRobin_NoDoubleDeque
 	repo, _ := bt.CreateTestRepo(t, ctx, db)
 
 	s := New(db, &observation.TestContext, nil)
-	workerStore := dbworkerstore.New(&observation.TestContext, s.Handle(), batchSpecWorkspaceExecutionWorkerStoreOptions)
+	workerStore := dbworkerstore.New(&observation.TestContext, s.Handle(), BatchSpecWorkspaceExecutionWorkerStoreOptions)
 
 	user1 := bt.CreateTestUser(t, db, true)
 	user2 := bt.CreateTestUser(t, db, true)
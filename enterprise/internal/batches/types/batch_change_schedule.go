@@ -0,0 +1,73 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// BatchChangeSchedule is the cron schedule at which the current batch spec of
+// a batch change is re-executed server-side.
+type BatchChangeSchedule struct {
+	BatchChangeID int64
+
+	// Schedule is the cron expression of the schedule, evaluated in UTC.
+	Schedule  string
+	NextRunAt time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// BatchChangeScheduledRunState defines the possible states of a scheduled
+// re-execution of a batch change.
+type BatchChangeScheduledRunState string
+
+// BatchChangeScheduledRunState constants.
+const (
+	BatchChangeScheduledRunStateResolving BatchChangeScheduledRunState = "resolving"
+	BatchChangeScheduledRunStateExecuting BatchChangeScheduledRunState = "executing"
+	BatchChangeScheduledRunStateApplied   BatchChangeScheduledRunState = "applied"
+	BatchChangeScheduledRunStateUnchanged BatchChangeScheduledRunState = "unchanged"
+	BatchChangeScheduledRunStateFailed    BatchChangeScheduledRunState = "failed"
+)
+
+// Valid returns true if the given BatchChangeScheduledRunState is valid.
+func (s BatchChangeScheduledRunState) Valid() bool {
+	switch s {
+	case BatchChangeScheduledRunStateResolving,
+		BatchChangeScheduledRunStateExecuting,
+		BatchChangeScheduledRunStateApplied,
+		BatchChangeScheduledRunStateUnchanged,
+		BatchChangeScheduledRunStateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Finished returns whether the run has come to an end.
+func (s BatchChangeScheduledRunState) Finished() bool {
+	return s == BatchChangeScheduledRunStateApplied ||
+		s == BatchChangeScheduledRunStateUnchanged ||
+		s == BatchChangeScheduledRunStateFailed
+}
+
+// ToGraphQL returns the GraphQL representation of the state.
+func (s BatchChangeScheduledRunState) ToGraphQL() string { return strings.ToUpper(string(s)) }
+
+// BatchChangeScheduledRun is a single scheduled re-execution of a batch
+// change: it resolves the workspaces of the current batch spec again, executes
+// them, and applies the result if the changeset specs differ.
+type BatchChangeScheduledRun struct {
+	ID            int64
+	BatchChangeID int64
+	// BatchSpecID is the batch spec created by the run. It is zero if the run
+	// failed before creating one, or if the batch spec was deleted.
+	BatchSpecID int64
+
+	State          BatchChangeScheduledRunState
+	FailureMessage *string
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
 )
 
@@ -32,3 +33,23 @@ func NewCacheEntryFromResult(key string, result *execution.AfterStepResult) (*Ba
 	entry := &BatchSpecExecutionCacheEntry{Key: key, Value: string(value)}
 	return entry, nil
 }
+
+// BatchSpecExecutionSharedCacheEntry is a step result in the instance-wide
+// cache, which is reused by all batch changes that run the same steps in the
+// same workspace, as long as their user has access to the repository.
+type BatchSpecExecutionSharedCacheEntry struct {
+	ID int64
+
+	RepoID api.RepoID
+	// UserID is the user whose execution created the entry, if they still
+	// exist.
+	UserID int32
+
+	Key   string
+	Value string
+
+	Version int
+
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
@@ -10,6 +10,9 @@ import (
 type StepCacheResult struct {
 	Key   string
 	Value *execution.AfterStepResult
+	// Shared is true if the result was found in the instance-wide cache, and
+	// thus may have been produced for another batch change or user.
+	Shared bool `json:",omitempty"`
 }
 
 type BatchSpecWorkspace struct {
@@ -31,6 +34,11 @@ type BatchSpecWorkspace struct {
 	// The persisted step cache results found for this execution.
 	StepCacheResults map[int]StepCacheResult
 
+	// SharedStepCacheKeys are the keys of the steps in the instance-wide step
+	// cache, by step number. Step results are stored under these keys once the
+	// workspace has been executed.
+	SharedStepCacheKeys map[int]string
+
 	// Skipped is true if this workspace doesn't need to run. (Has no steps, has
 	// cached result, ...)
 	Skipped bool
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/batches/artifacts"
+	"github.com/sourcegraph/sourcegraph/lib/batches/execution"
+)
+
+// BatchSpecWorkspaceArtifact is a file that a step of the batch spec declared
+// as an artifact. The content is stored in the upload store under ObjectKey.
+type BatchSpecWorkspaceArtifact struct {
+	ID                   int64
+	BatchSpecWorkspaceID int64
+
+	// StepIndex is the index of the step that produced the artifact.
+	StepIndex int
+	Name      string
+	Path      string
+	ByteSize  int64
+	ObjectKey string
+
+	CreatedAt time.Time
+}
+
+// NewBatchSpecWorkspaceArtifact returns the BatchSpecWorkspaceArtifact for the
+// artifact with the given name in a step result of the workspace.
+func NewBatchSpecWorkspaceArtifact(workspaceID int64, name string, a execution.Artifact) *BatchSpecWorkspaceArtifact {
+	return &BatchSpecWorkspaceArtifact{
+		BatchSpecWorkspaceID: workspaceID,
+		StepIndex:            a.StepIndex,
+		Name:                 name,
+		Path:                 a.Path,
+		ByteSize:             a.ByteSize,
+		ObjectKey:            artifacts.ObjectKey(a.Key),
+	}
+}
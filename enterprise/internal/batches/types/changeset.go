@@ -15,10 +15,12 @@ import (
 
 	adobatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/azuredevops"
 	bbcs "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/bitbucketcloud"
+	gerritbatches "github.com/sourcegraph/sourcegraph/enterprise/internal/batches/sources/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
@@ -451,6 +453,20 @@ func (c *Changeset) SetMetadata(meta any) error {
 			c.ExternalForkNamespace = ""
 			c.ExternalForkName = ""
 		}
+	case *gerritbatches.AnnotatedChange:
+		c.Metadata = pr
+		c.ExternalID = strconv.Itoa(pr.Number)
+		c.ExternalServiceType = extsvc.TypeGerrit
+		// Gerrit changes are pushed to the review ref of their base branch,
+		// the head branch is only recorded as the topic of the change.
+		if pr.Topic != "" {
+			c.ExternalBranch = gitdomain.EnsureRefPrefix(pr.Topic)
+		} else {
+			c.ExternalBranch = ""
+		}
+		c.ExternalUpdatedAt = pr.Updated.Time
+		c.ExternalForkNamespace = ""
+		c.ExternalForkName = ""
 
 	default:
 		return errors.New("unknown changeset type")
@@ -481,6 +497,8 @@ func (c *Changeset) Title() (string, error) {
 		return m.Title, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.Title, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Subject, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -504,6 +522,8 @@ func (c *Changeset) AuthorName() (string, error) {
 		return m.Author.Username, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreatedBy.UniqueName, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Username, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -535,6 +555,8 @@ func (c *Changeset) AuthorEmail() (string, error) {
 		return "", nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreatedBy.UniqueName, nil
+	case *gerritbatches.AnnotatedChange:
+		return m.Owner.Email, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -555,6 +577,8 @@ func (c *Changeset) ExternalCreatedAt() time.Time {
 		return m.CreatedOn
 	case *adobatches.AnnotatedPullRequest:
 		return m.CreationDate
+	case *gerritbatches.AnnotatedChange:
+		return m.Created.Time
 	default:
 		return time.Time{}
 	}
@@ -573,6 +597,10 @@ func (c *Changeset) Body() (string, error) {
 		return m.Rendered.Description.Raw, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.Description, nil
+	case *gerritbatches.AnnotatedChange:
+		// Gerrit changes have no description, the commit message is used
+		// instead.
+		return m.CurrentCommitMessage(), nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -637,6 +665,8 @@ func (c *Changeset) URL() (s string, err error) {
 		}
 
 		return returnURL.String(), nil
+	case *gerritbatches.AnnotatedChange:
+		return fmt.Sprintf("%s/c/%s/+/%d", strings.TrimSuffix(m.CodeHostURL, "/"), m.Project, m.Number), nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -839,6 +869,34 @@ func (c *Changeset) Events() (events []*ChangesetEvent, err error) {
 				Metadata:    status,
 			})
 		}
+	case *gerritbatches.AnnotatedChange:
+		// Reviews and checks are both votes on the labels of the change, and
+		// state changes are recorded as messages created by Gerrit.
+		var kind ChangesetEventKind
+
+		for _, vote := range m.Votes() {
+			if kind, err = ChangesetEventKindFor(vote); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         vote.Key(),
+				Kind:        kind,
+				Metadata:    vote,
+			})
+		}
+
+		for _, message := range m.Messages {
+			if kind, err = ChangesetEventKindFor(message); err != nil {
+				return
+			}
+			appendEvent(&ChangesetEvent{
+				ChangesetID: c.ID,
+				Key:         message.Key(),
+				Kind:        kind,
+				Metadata:    message,
+			})
+		}
 	}
 	return events, nil
 }
@@ -858,6 +916,8 @@ func (c *Changeset) HeadRefOid() (string, error) {
 		return m.Source.Commit.Hash, nil
 	case *adobatches.AnnotatedPullRequest:
 		return "", nil
+	case *gerritbatches.AnnotatedChange:
+		return m.CurrentRevision, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -877,6 +937,8 @@ func (c *Changeset) HeadRef() (string, error) {
 		return "refs/heads/" + m.Source.Branch.Name, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.SourceRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		return "refs/heads/" + m.Topic, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -897,6 +959,8 @@ func (c *Changeset) BaseRefOid() (string, error) {
 		return m.Destination.Commit.Hash, nil
 	case *adobatches.AnnotatedPullRequest:
 		return "", nil
+	case *gerritbatches.AnnotatedChange:
+		return "", nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -916,6 +980,8 @@ func (c *Changeset) BaseRef() (string, error) {
 		return "refs/heads/" + m.Destination.Branch.Name, nil
 	case *adobatches.AnnotatedPullRequest:
 		return m.TargetRefName, nil
+	case *gerritbatches.AnnotatedChange:
+		return "refs/heads/" + m.Branch, nil
 	default:
 		return "", errors.New("unknown changeset type")
 	}
@@ -1278,6 +1344,34 @@ func ChangesetEventKindFor(e any) (ChangesetEventKind, error) {
 		default:
 			return ChangesetEventKindAzureDevOpsPullRequestBuildPending, nil
 		}
+	case *gerrit.Vote:
+		switch {
+		case e.Label == gerrit.LabelCodeReview && e.Value > 0:
+			return ChangesetEventKindGerritChangeApproved, nil
+		case e.Label == gerrit.LabelCodeReview && e.Value < 0:
+			return ChangesetEventKindGerritChangeRejected, nil
+		case e.Label == gerrit.LabelVerified && e.Value > 0:
+			return ChangesetEventKindGerritChangeBuildSucceeded, nil
+		case e.Label == gerrit.LabelVerified && e.Value < 0:
+			return ChangesetEventKindGerritChangeBuildFailed, nil
+		default:
+			return ChangesetEventKindGerritChangeVoted, nil
+		}
+	case *gerrit.ChangeMessage:
+		switch e.Tag {
+		case gerrit.ChangeMessageTagAbandon:
+			return ChangesetEventKindGerritChangeAbandoned, nil
+		case gerrit.ChangeMessageTagRestore:
+			return ChangesetEventKindGerritChangeRestored, nil
+		case gerrit.ChangeMessageTagMerged:
+			return ChangesetEventKindGerritChangeMerged, nil
+		case gerrit.ChangeMessageTagSetWorkInProgress:
+			return ChangesetEventKindGerritChangeWorkInProgress, nil
+		case gerrit.ChangeMessageTagSetReadyForReview:
+			return ChangesetEventKindGerritChangeReadyForReview, nil
+		default:
+			return ChangesetEventKindGerritChangeCommented, nil
+		}
 	}
 	return ChangesetEventKindInvalid, errors.Errorf("unknown changeset event kind for %T", e)
 }
@@ -1414,6 +1508,18 @@ func NewChangesetEventMetadata(k ChangesetEventKind) (any, error) {
 		default:
 			return new(azuredevops.PullRequestUpdatedEvent), nil
 		}
+	case strings.HasPrefix(string(k), "gerrit"):
+		switch k {
+		case ChangesetEventKindGerritChangeCommented,
+			ChangesetEventKindGerritChangeAbandoned,
+			ChangesetEventKindGerritChangeRestored,
+			ChangesetEventKindGerritChangeMerged,
+			ChangesetEventKindGerritChangeWorkInProgress,
+			ChangesetEventKindGerritChangeReadyForReview:
+			return new(gerrit.ChangeMessage), nil
+		default:
+			return new(gerrit.Vote), nil
+		}
 	}
 	return nil, errors.Errorf("unknown changeset event kind %q", k)
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketcloud"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/bitbucketserver"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/gerrit"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/github"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab"
 	gitlabwebhooks "github.com/sourcegraph/sourcegraph/internal/extsvc/gitlab/webhooks"
@@ -117,6 +119,18 @@ const (
 	ChangesetEventKindAzureDevOpsPullRequestBuildError              ChangesetEventKind = "azuredevops:pullrequest:build_error"
 	ChangesetEventKindAzureDevOpsPullRequestBuildPending            ChangesetEventKind = "azuredevops:pullrequest:build_pending"
 
+	ChangesetEventKindGerritChangeApproved       ChangesetEventKind = "gerrit:change:approved"         // Vote
+	ChangesetEventKindGerritChangeRejected       ChangesetEventKind = "gerrit:change:rejected"         // Vote
+	ChangesetEventKindGerritChangeBuildSucceeded ChangesetEventKind = "gerrit:change:build_succeeded"  // Vote
+	ChangesetEventKindGerritChangeBuildFailed    ChangesetEventKind = "gerrit:change:build_failed"     // Vote
+	ChangesetEventKindGerritChangeVoted          ChangesetEventKind = "gerrit:change:voted"            // Vote
+	ChangesetEventKindGerritChangeCommented      ChangesetEventKind = "gerrit:change:commented"        // ChangeMessage
+	ChangesetEventKindGerritChangeAbandoned      ChangesetEventKind = "gerrit:change:abandoned"        // ChangeMessage
+	ChangesetEventKindGerritChangeRestored       ChangesetEventKind = "gerrit:change:restored"         // ChangeMessage
+	ChangesetEventKindGerritChangeMerged         ChangesetEventKind = "gerrit:change:merged"           // ChangeMessage
+	ChangesetEventKindGerritChangeWorkInProgress ChangesetEventKind = "gerrit:change:work_in_progress" // ChangeMessage
+	ChangesetEventKindGerritChangeReadyForReview ChangesetEventKind = "gerrit:change:ready_for_review" // ChangeMessage
+
 	ChangesetEventKindInvalid ChangesetEventKind = "invalid"
 )
 
@@ -199,6 +213,11 @@ func (e *ChangesetEvent) ReviewAuthor() string {
 		return meta.PullRequest.Reviewers[len(meta.PullRequest.Reviewers)-1].UniqueName
 	case *azuredevops.PullRequestUpdatedEvent:
 		return meta.PullRequest.CreatedBy.UniqueName
+	case *gerrit.Vote:
+		if meta.Username != "" {
+			return meta.Username
+		}
+		return strconv.Itoa(int(meta.ID))
 	default:
 		return ""
 	}
@@ -211,7 +230,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindGitLabApproved,
 		ChangesetEventKindBitbucketCloudApproved,
 		ChangesetEventKindBitbucketCloudPullRequestApproved,
-		ChangesetEventKindAzureDevOpsPullRequestApproved:
+		ChangesetEventKindAzureDevOpsPullRequestApproved,
+		ChangesetEventKindGerritChangeApproved:
 		return ChangesetReviewStateApproved, nil
 
 	// BitbucketServer's "REVIEWED" activity is created when someone clicks
@@ -220,7 +240,8 @@ func (e *ChangesetEvent) ReviewState() (ChangesetReviewState, error) {
 		ChangesetEventKindBitbucketCloudChangesRequested,
 		ChangesetEventKindBitbucketCloudPullRequestChangesRequestCreated,
 		ChangesetEventKindAzureDevOpsPullRequestWaitingForAuthor,
-		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions:
+		ChangesetEventKindAzureDevOpsPullRequestApprovedWithSuggestions,
+		ChangesetEventKindGerritChangeRejected:
 		return ChangesetReviewStateChangesRequested, nil
 
 	case ChangesetEventKindGitHubReviewed:
@@ -367,6 +388,10 @@ func (e *ChangesetEvent) Timestamp() time.Time {
 		t = ev.CreatedDate
 	case *azuredevops.PullRequestMergedEvent:
 		t = ev.CreatedDate
+	case *gerrit.Vote:
+		t = ev.Date.Time
+	case *gerrit.ChangeMessage:
+		t = ev.Date.Time
 	}
 
 	return t
@@ -929,6 +954,14 @@ func (e *ChangesetEvent) Update(o *ChangesetEvent) error {
 		o := o.Metadata.(*azuredevops.PullRequestRejectedEvent)
 		*e = *o
 
+	case *gerrit.Vote:
+		o := o.Metadata.(*gerrit.Vote)
+		*e = *o
+
+	case *gerrit.ChangeMessage:
+		o := o.Metadata.(*gerrit.ChangeMessage)
+		*e = *o
+
 	default:
 		return errors.Errorf("unknown changeset event metadata %T", e)
 	}
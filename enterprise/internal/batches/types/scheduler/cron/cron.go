@@ -0,0 +1,225 @@
+// Package cron parses the cron expressions used to schedule the re-execution
+// of batch changes.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Schedule is a parsed cron expression. All times are evaluated in UTC.
+type Schedule struct {
+	expr string
+
+	minute bits
+	hour   bits
+	dom    bits
+	month  bits
+	dow    bits
+
+	// domStar and dowStar track whether the day of month and day of week
+	// fields were unrestricted: as in every other cron implementation, if both
+	// are restricted then a day matches if either of them matches.
+	domStar bool
+	dowStar bool
+}
+
+// bits is a set of the values allowed in a single field.
+type bits uint64
+
+func (b bits) has(v int) bool { return b&(1<<uint(v)) != 0 }
+
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "minute", min: 0, max: 59}
+	hourField   = field{name: "hour", min: 0, max: 23}
+	domField    = field{name: "day of month", min: 1, max: 31}
+	monthField  = field{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// Day of week allows 7 as an alias for Sunday, which is folded into 0 after
+	// parsing.
+	dowField = field{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// searchLimit bounds how far into the future Next looks for a matching time.
+// Every valid schedule fires at least once in any span of this length.
+const searchLimit = 5 * 366 * 24 * time.Hour
+
+// Parse parses a standard five field cron expression (minute, hour, day of
+// month, month, day of week), or one of the @yearly, @monthly, @weekly, @daily
+// and @hourly descriptors.
+func Parse(expr string) (*Schedule, error) {
+	raw := strings.TrimSpace(expr)
+	if d, ok := descriptors[strings.ToLower(raw)]; ok {
+		raw = d
+	}
+
+	fields := strings.Fields(raw)
+	if len(fields) != 5 {
+		return nil, errors.Errorf("malformed cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	s := &Schedule{expr: expr}
+	var err error
+	if s.minute, _, err = parseField(fields[0], minuteField); err != nil {
+		return nil, errors.Wrapf(err, "malformed cron expression %q", expr)
+	}
+	if s.hour, _, err = parseField(fields[1], hourField); err != nil {
+		return nil, errors.Wrapf(err, "malformed cron expression %q", expr)
+	}
+	if s.dom, s.domStar, err = parseField(fields[2], domField); err != nil {
+		return nil, errors.Wrapf(err, "malformed cron expression %q", expr)
+	}
+	if s.month, _, err = parseField(fields[3], monthField); err != nil {
+		return nil, errors.Wrapf(err, "malformed cron expression %q", expr)
+	}
+	if s.dow, s.dowStar, err = parseField(fields[4], dowField); err != nil {
+		return nil, errors.Wrapf(err, "malformed cron expression %q", expr)
+	}
+	if s.dow.has(7) {
+		s.dow = (s.dow | 1) &^ (1 << 7)
+	}
+
+	// Expressions such as "0 0 30 2 *" parse fine, but never fire.
+	if s.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, errors.Errorf("cron expression %q never matches", expr)
+	}
+
+	return s, nil
+}
+
+// String returns the expression the schedule was parsed from.
+func (s *Schedule) String() string { return s.expr }
+
+// Next returns the first time strictly after the given time that matches the
+// schedule, truncated to the minute and in UTC. If no such time exists, the
+// zero time is returned.
+func (s *Schedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+
+	for t.Before(limit) {
+		if !s.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.hour.has(t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dom.has(t.Day())
+	dow := s.dow.has(int(t.Weekday()))
+
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dow
+	case s.dowStar:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+// parseField parses a comma separated list of values, ranges and steps, and
+// returns whether the field is unrestricted.
+func parseField(raw string, f field) (bits, bool, error) {
+	var b bits
+	star := raw == "*" || raw == "?"
+
+	for _, part := range strings.Split(raw, ",") {
+		r, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			var err error
+			r = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return 0, false, errors.Errorf("invalid step in %s field: %q", f.name, part)
+			}
+		}
+
+		var lo, hi int
+		switch {
+		case r == "*" || r == "?":
+			lo, hi = f.min, f.max
+		case strings.Contains(r, "-"):
+			bounds := strings.SplitN(r, "-", 2)
+			var err error
+			if lo, err = f.value(bounds[0]); err != nil {
+				return 0, false, err
+			}
+			if hi, err = f.value(bounds[1]); err != nil {
+				return 0, false, err
+			}
+			if lo > hi {
+				return 0, false, errors.Errorf("invalid range in %s field: %q", f.name, part)
+			}
+		default:
+			var err error
+			if lo, err = f.value(r); err != nil {
+				return 0, false, err
+			}
+			hi = lo
+			// As in other cron implementations, "5/15" means "5-max/15".
+			if step > 1 {
+				hi = f.max
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			b |= 1 << uint(v)
+		}
+	}
+
+	return b, star, nil
+}
+
+func (f field) value(raw string) (int, error) {
+	if v, ok := f.names[strings.ToLower(raw)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Errorf("invalid value in %s field: %q", f.name, raw)
+	}
+	return v, nil
+}
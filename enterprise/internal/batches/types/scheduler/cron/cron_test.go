@@ -0,0 +1,91 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	for _, expr := range []string{
+		"* * * * *",
+		"0 3 * * 1",
+		"*/15 9-17 * * mon-fri",
+		"0 0 1,15 * *",
+		"30 2 * jan,jul sun",
+		"0 0 * * 7",
+		"5/10 * * * *",
+		"@daily",
+		"@Weekly",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := Parse(expr); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"* * * foo *",
+		"0 0 30 2 *",
+		"@fortnightly",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := Parse(expr); err == nil {
+				t.Error("unexpected nil error")
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2023, 4, 19, 10, 42, 31, 0, time.UTC)
+
+	for expr, want := range map[string]time.Time{
+		"* * * * *":             time.Date(2023, 4, 19, 10, 43, 0, 0, time.UTC),
+		"42 10 * * *":           time.Date(2023, 4, 20, 10, 42, 0, 0, time.UTC),
+		"0 3 * * 1":             time.Date(2023, 4, 24, 3, 0, 0, 0, time.UTC),
+		"*/15 9-17 * * mon-fri": time.Date(2023, 4, 19, 10, 45, 0, 0, time.UTC),
+		"0 0 1,15 * *":          time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 * * 7":             time.Date(2023, 4, 23, 0, 0, 0, 0, time.UTC),
+		"@monthly":              time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		"@yearly":               time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		"0 12 29 2 *":           time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+		// Both day fields restricted: either one matching is enough.
+		"0 0 1 * fri": time.Date(2023, 4, 21, 0, 0, 0, 0, time.UTC),
+	} {
+		t.Run(expr, func(t *testing.T) {
+			s, err := Parse(expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if have := s.Next(now); !have.Equal(want) {
+				t.Errorf("unexpected next time: have=%s want=%s", have, want)
+			}
+		})
+	}
+
+	t.Run("other time zone", func(t *testing.T) {
+		s, err := Parse("0 3 * * *")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		loc := time.FixedZone("UTC+5", 5*60*60)
+		have := s.Next(time.Date(2023, 4, 19, 8, 30, 0, 0, loc))
+		if want := time.Date(2023, 4, 20, 3, 0, 0, 0, time.UTC); !have.Equal(want) {
+			t.Errorf("unexpected next time: have=%s want=%s", have, want)
+		}
+	})
+}
@@ -45,6 +45,13 @@ type StepInfo struct {
 	DiffFound       bool
 	Diff            []byte
 	ExitCode        *int
+
+	// CachedResultFound is true if the result of the step was taken from the
+	// cache instead of running the step. SharedCachedResultFound is true if it
+	// was taken from the instance-wide cache, and thus may have been produced
+	// by another batch change or user.
+	CachedResultFound       bool
+	SharedCachedResultFound bool
 }
 
 // ParseLogLines looks at all given log lines and determines the derived *StepInfo
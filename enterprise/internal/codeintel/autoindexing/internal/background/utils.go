@@ -29,9 +29,13 @@ var IndexWorkerStoreOptions = dbworkerstore.Options[uploadsshared.Index]{
 	ViewName:          "lsif_indexes_with_repository_name u",
 	ColumnExpressions: indexColumnsWithNullRank,
 	Scan:              dbworkerstore.BuildWorkerScan(scanIndex),
+	ScanRecord:        scanIndex,
 	OrderByExpression: sqlf.Sprintf("u.queued_at, u.id"),
 	StalledMaxAge:     StalledIndexMaxAge,
 	MaxNumResets:      IndexMaxNumResets,
+	// Take turns between repositories, so that a repository with many queued
+	// indexes does not delay indexing of all other repositories.
+	FairnessKeyExpression: sqlf.Sprintf("u.repository_id"),
 }
 
 var indexColumnsWithNullRank = []*sqlf.Query{
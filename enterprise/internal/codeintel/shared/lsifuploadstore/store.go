@@ -26,6 +26,9 @@ func New(ctx context.Context, observationCtx *observation.Context, conf *Config)
 			CredentialsFile:         conf.GCSCredentialsFile,
 			CredentialsFileContents: conf.GCSCredentialsFileContents,
 		},
+		Filesystem: uploadstore.FilesystemConfig{
+			Dir: conf.FilesystemDir,
+		},
 	}
 
 	return uploadstore.CreateLazy(ctx, c, uploadstore.NewOperations(observationCtx, "codeintel", "uploadstore"))
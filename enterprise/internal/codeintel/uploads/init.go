@@ -72,6 +72,8 @@ func NewService(
 	return svc
 }
 
+var UploadWorkerStoreOptions = uploadsstore.UploadWorkerStoreOptions
+
 var (
 	bucketName                   = env.Get("CODEINTEL_UPLOADS_RANKING_BUCKET", "lsif-pagerank-experiments", "The GCS bucket.")
 	rankingBucketCredentialsFile = env.Get("CODEINTEL_UPLOADS_RANKING_GOOGLE_APPLICATION_CREDENTIALS_FILE", "", "The path to a service account key file with access to GCS.")
@@ -21,11 +21,13 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/actor"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 	"github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/internal/uploadstore"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound/events"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil"
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
@@ -60,7 +62,11 @@ func NewUploadProcessorWorker(
 
 	metrics := workerutil.NewMetrics(observationCtx, "codeintel_upload_processor", workerutil.WithSampler(func(job workerutil.Record) bool { return true }))
 
-	return dbworker.NewWorker(rootContext, workerStore, handler, workerutil.WorkerOptions{
+	return dbworker.NewWorker[uploadsshared.Upload](rootContext, &webhookWorkerStore{
+		Store:  workerStore,
+		store:  store,
+		logger: observationCtx.Logger.Scoped("UploadProcessedWebhooks", "enqueues webhooks for processed uploads"),
+	}, handler, workerutil.WorkerOptions{
 		Name:                 "precise_code_intel_upload_worker",
 		Description:          "processes precise code-intel uploads",
 		NumHandlers:          workerConcurrency,
@@ -71,6 +77,47 @@ func NewUploadProcessorWorker(
 	})
 }
 
+// webhookWorkerStore enqueues the codeintel:upload_processed webhook in the same
+// transaction that marks an upload as completed, so that the webhook is only sent
+// once the upload is visible as completed.
+type webhookWorkerStore struct {
+	dbworkerstore.Store[uploadsshared.Upload]
+	store  store.Store
+	logger log.Logger
+}
+
+func (s *webhookWorkerStore) MarkComplete(ctx context.Context, id int, options dbworkerstore.MarkFinalOptions) (_ bool, err error) {
+	upload, ok, err := s.store.GetUploadByID(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(err, "store.GetUploadByID")
+	}
+	if !ok {
+		return s.Store.MarkComplete(ctx, id, options)
+	}
+
+	tx, err := basestore.NewWithHandle(s.Store.Handle()).Transact(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	marked, err := s.Store.With(tx).MarkComplete(ctx, id, options)
+	if err != nil || !marked {
+		return marked, err
+	}
+
+	events.Enqueue(ctx, s.logger, tx, events.CodeIntelUploadProcessed, events.CodeIntelUpload{
+		ID:           upload.ID,
+		RepositoryID: upload.RepositoryID,
+		Commit:       upload.Commit,
+		Root:         upload.Root,
+		Indexer:      upload.Indexer,
+		UploadedAt:   upload.UploadedAt,
+	})
+
+	return true, nil
+}
+
 type handler struct {
 	store           store.Store
 	lsifStore       lsifstore.Store
@@ -3,6 +3,7 @@ package background
 import (
 	"net/url"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 )
 
@@ -17,5 +18,24 @@ type actionArgs struct {
 
 	Query          string
 	Results        []*result.CommitMatch
+	ContentChanges []*edb.ContentChange
 	IncludeResults bool
 }
+
+// contentChangeType describes whether a content change is an added or a
+// removed match.
+func contentChangeType(change *edb.ContentChange) string {
+	if change.Removed {
+		return "Removed"
+	}
+	return "Added"
+}
+
+// truncateContentChanges returns at most maxResults changes, and the number of
+// changes that were left out.
+func truncateContentChanges(changes []*edb.ContentChange, maxResults int) (_ []*edb.ContentChange, truncatedCount int) {
+	if len(changes) <= maxResults {
+		return changes, 0
+	}
+	return changes[:maxResults], len(changes) - maxResults
+}
@@ -85,6 +85,19 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *edb.EmailAction)
 		displayResults[i] = toDisplayResult(result, args.ExternalURL)
 	}
 
+	// Content mode monitors report changes to their matches instead of
+	// results.
+	resultNoun := "result"
+	if len(args.ContentChanges) > 0 {
+		resultNoun = "change"
+		truncatedChanges, truncatedChangesCount := truncateContentChanges(args.ContentChanges, 5)
+		for _, change := range truncatedChanges {
+			displayResults = append(displayResults, contentChangeToDisplayResult(change, args.ExternalURL))
+		}
+		totalCount += len(args.ContentChanges)
+		truncatedCount += truncatedChangesCount
+	}
+
 	return &TemplateDataNewSearchResults{
 		Priority:                  priority,
 		CodeMonitorURL:            codeMonitorURL,
@@ -94,8 +107,8 @@ func NewTemplateDataForNewSearchResults(args actionArgs, email *edb.EmailAction)
 		TruncatedResults:          displayResults,
 		TotalCount:                totalCount,
 		TruncatedCount:            truncatedCount,
-		ResultPluralized:          pluralize("result", totalCount),
-		TruncatedResultPluralized: pluralize("result", truncatedCount),
+		ResultPluralized:          pluralize(resultNoun, totalCount),
+		TruncatedResultPluralized: pluralize(resultNoun, truncatedCount),
 		DisplayMoreLink:           args.IncludeResults && truncatedCount > 0,
 	}, nil
 }
@@ -155,6 +168,14 @@ func getCommitURL(externalURL *url.URL, repoName, oid, utmSource string) string
 	return sourcegraphURL(externalURL, fmt.Sprintf("%s/-/commit/%s", repoName, oid), "", utmSource)
 }
 
+func getFileURL(externalURL *url.URL, repoName, path string, lineNumber int, utmSource string) string {
+	u := sourcegraphURL(externalURL, fmt.Sprintf("%s/-/blob/%s", repoName, path), "", utmSource)
+	if lineNumber > 0 {
+		u += fmt.Sprintf("&L%d", lineNumber)
+	}
+	return u
+}
+
 var (
 	externalURLOnce  sync.Once
 	externalURLValue *url.URL
@@ -203,6 +224,11 @@ type DisplayResult struct {
 	RepoName   string
 	CommitID   string
 	Content    string
+
+	// Path and FileURL are set instead of CommitURL and CommitID for the
+	// changes of content mode monitors.
+	Path    string
+	FileURL string
 }
 
 func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *DisplayResult {
@@ -220,3 +246,13 @@ func toDisplayResult(result *searchresult.CommitMatch, externalURL *url.URL) *Di
 		Content:    content,
 	}
 }
+
+func contentChangeToDisplayResult(change *edb.ContentChange, externalURL *url.URL) *DisplayResult {
+	return &DisplayResult{
+		ResultType: contentChangeType(change),
+		RepoName:   string(change.RepoName),
+		Path:       change.Path,
+		FileURL:    getFileURL(externalURL, string(change.RepoName), change.Path, change.LineNumber, utmSourceEmail),
+		Content:    change.Line,
+	}
+}
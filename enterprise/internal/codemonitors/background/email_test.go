@@ -8,6 +8,7 @@ import (
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/require"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/txemail"
 )
 
@@ -185,4 +186,30 @@ func TestEmail(t *testing.T) {
 		})
 	})
 
+	t.Run("content changes with results", func(t *testing.T) {
+		templateData, err := NewTemplateDataForNewSearchResults(actionArgs{
+			MonitorDescription: "My test monitor",
+			ExternalURL:        externalURLMock,
+			Query:              "repo:test crypto/md5",
+			ContentChanges:     []*edb.ContentChange{&addedContentChangeMock},
+			IncludeResults:     true,
+		}, &edb.EmailAction{Monitor: 1})
+		require.NoError(t, err)
+		require.Equal(t, []*DisplayResult{addedContentDisplayResultMock}, templateData.TruncatedResults)
+
+		t.Run("text", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := template.Text.Execute(&buf, templateData)
+			require.NoError(t, err)
+			autogold.ExpectFile(t, autogold.Raw(buf.String()))
+		})
+
+		t.Run("subject", func(t *testing.T) {
+			var buf bytes.Buffer
+			err := template.Subj.Execute(&buf, templateData)
+			require.NoError(t, err)
+			require.Equal(t, "Sourcegraph code monitor My test monitor detected 1 new change", buf.String())
+		})
+	})
+
 }
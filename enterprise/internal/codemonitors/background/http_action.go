@@ -0,0 +1,328 @@
+package background
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// PagerDutyEventsURL is the PagerDuty Events API v2 endpoint, which HTTP
+// actions using the PagerDuty preset post to unless configured otherwise.
+const PagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// teamsTemplate renders a Microsoft Teams message card, as accepted by Teams
+// incoming webhooks.
+const teamsTemplate = `{
+	"@type": "MessageCard",
+	"@context": "https://schema.org/extensions",
+	"summary": {{ json .Summary }},
+	"title": {{ json .MonitorDescription }},
+	"text": {{ json .Summary }},
+	"sections": [
+		{{- range $i, $r := .Results }}{{ if $i }},{{ end }}
+		{
+			"activityTitle": {{ json (printf "%s@%s" $r.Repository (truncate 7 $r.Commit)) }},
+			"activitySubtitle": {{ json $r.CommitURL }},
+			"text": {{ json (printf "<pre>%s</pre>" (truncate 2500 (or $r.Diff $r.Message))) }}
+		}
+		{{- end }}
+		{{- range $i, $c := .ContentChanges }}{{ if or $i $.Results }},{{ end }}
+		{
+			"activityTitle": {{ json (printf "%s match: %s/%s" $c.Change $c.Repository $c.Path) }},
+			"text": {{ json (printf "<pre>%s</pre>" $c.Line) }}
+		}
+		{{- end }}
+	],
+	"potentialAction": [
+		{"@type": "OpenUri", "name": "View results", "targets": [{"os": "default", "uri": {{ json .SearchURL }}}]},
+		{"@type": "OpenUri", "name": "Edit code monitor", "targets": [{"os": "default", "uri": {{ json .MonitorURL }}}]}
+	]
+}`
+
+// pagerDutyTemplate renders a PagerDuty Events API v2 trigger event.
+const pagerDutyTemplate = `{
+	"routing_key": {{ json .RoutingKey }},
+	"event_action": "trigger",
+	"payload": {
+		"summary": {{ json (truncate 1024 .Summary) }},
+		"source": {{ json .MonitorURL }},
+		"severity": "warning",
+		"component": "code-monitor",
+		"custom_details": {
+			"query": {{ json .Query }}
+			{{- if .Results }},
+			"results": {{ json .Results }}
+			{{- end }}
+			{{- if .ContentChanges }},
+			"contentChanges": {{ json .ContentChanges }}
+			{{- end }}
+		}
+	},
+	"links": [
+		{"href": {{ json .SearchURL }}, "text": "View results"},
+		{"href": {{ json .MonitorURL }}, "text": "Edit code monitor"}
+	]
+}`
+
+var httpActionTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	// truncate keeps the first n characters of s, without splitting a
+	// multi-byte character.
+	"truncate": func(n int, s string) string {
+		for i := range s {
+			if n == 0 {
+				return s[:i]
+			}
+			n--
+		}
+		return s
+	},
+}
+
+// ValidateHTTPActionTemplate returns an error if the payload template of an
+// HTTP action cannot be parsed.
+func ValidateHTTPActionTemplate(payloadTemplate string) error {
+	_, err := parseHTTPActionTemplate(payloadTemplate)
+	return err
+}
+
+func parseHTTPActionTemplate(payloadTemplate string) (*template.Template, error) {
+	return template.New("payload").Funcs(httpActionTemplateFuncs).Parse(payloadTemplate)
+}
+
+// httpActionTemplate returns the payload template of an HTTP action, which is
+// either one of the presets or the template configured on the action.
+func httpActionTemplate(a *edb.HTTPAction) (*template.Template, error) {
+	switch a.Preset {
+	case edb.HTTPActionPresetTeams:
+		return parseHTTPActionTemplate(teamsTemplate)
+	case edb.HTTPActionPresetPagerDuty:
+		return parseHTTPActionTemplate(pagerDutyTemplate)
+	case edb.HTTPActionPresetCustom:
+		return parseHTTPActionTemplate(a.PayloadTemplate)
+	default:
+		return nil, errors.Errorf("unknown HTTP action preset %q", a.Preset)
+	}
+}
+
+// httpActionURL returns the URL an HTTP action posts to.
+func httpActionURL(a *edb.HTTPAction) string {
+	if a.URL == "" && a.Preset == edb.HTTPActionPresetPagerDuty {
+		return PagerDutyEventsURL
+	}
+	return a.URL
+}
+
+// httpActionTemplateData is the data payload templates are executed with.
+type httpActionTemplateData struct {
+	MonitorDescription string
+	MonitorOwnerName   string
+	MonitorURL         string
+	Query              string
+	SearchURL          string
+	// Summary is a sentence describing the event, such as "Alice's code
+	// monitor, My monitor, detected 3 new matches."
+	Summary     string
+	ResultCount int
+	// Results and ContentChanges are only set if the action includes
+	// results.
+	Results        []httpActionResult
+	ContentChanges []webhookContentChange
+	RoutingKey     string
+}
+
+type httpActionResult struct {
+	Repository string           `json:"repository"`
+	Commit     string           `json:"commit"`
+	CommitURL  string           `json:"commitURL"`
+	Message    string           `json:"message,omitempty"`
+	Diff       string           `json:"diff,omitempty"`
+	DiffHunks  []httpActionHunk `json:"diffHunks,omitempty"`
+}
+
+// httpActionHunk is a hunk of the diff matched by a diff search.
+type httpActionHunk struct {
+	Path    string `json:"path"`
+	Header  string `json:"header"`
+	Content string `json:"content"`
+}
+
+func newHTTPActionTemplateData(args actionArgs, routingKey string) (*httpActionTemplateData, error) {
+	_, resultCount, _ := truncateResults(args.Results, len(args.Results))
+	resultCount += len(args.ContentChanges)
+
+	matchesNoun := "new matches"
+	if len(args.ContentChanges) > 0 {
+		matchesNoun = "changed matches"
+	}
+
+	data := &httpActionTemplateData{
+		MonitorDescription: args.MonitorDescription,
+		MonitorOwnerName:   args.MonitorOwnerName,
+		MonitorURL:         getCodeMonitorURL(args.ExternalURL, args.MonitorID, args.UTMSource),
+		Query:              args.Query,
+		SearchURL:          getSearchURL(args.ExternalURL, args.Query, args.UTMSource),
+		Summary:            fmt.Sprintf("%s's code monitor, %s, detected %d %s.", args.MonitorOwnerName, args.MonitorDescription, resultCount, matchesNoun),
+		ResultCount:        resultCount,
+		RoutingKey:         routingKey,
+	}
+	if !args.IncludeResults {
+		return data, nil
+	}
+
+	for _, match := range args.Results {
+		res := httpActionResult{
+			Repository: string(match.Repo.Name),
+			Commit:     string(match.Commit.ID),
+			CommitURL:  getCommitURL(args.ExternalURL, string(match.Repo.Name), string(match.Commit.ID), args.UTMSource),
+		}
+		if match.MessagePreview != nil {
+			res.Message = match.MessagePreview.Content
+		}
+		if match.DiffPreview != nil {
+			res.Diff = match.DiffPreview.Content
+			hunks, err := diffHunks(match.DiffPreview.Content)
+			if err != nil {
+				return nil, err
+			}
+			res.DiffHunks = hunks
+		}
+		data.Results = append(data.Results, res)
+	}
+	data.ContentChanges = generateContentChanges(args.ContentChanges)
+	return data, nil
+}
+
+func diffHunks(diff string) ([]httpActionHunk, error) {
+	files, err := result.ParseDiffString(diff)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse diff")
+	}
+
+	var hunks []httpActionHunk
+	for _, file := range files {
+		path := file.NewName
+		if path == "/dev/null" {
+			path = file.OrigName
+		}
+		for _, hunk := range file.Hunks {
+			header := fmt.Sprintf("@@ -%d,%d +%d,%d @@", hunk.OldStart, hunk.OldCount, hunk.NewStart, hunk.NewCount)
+			if hunk.Header != "" {
+				header += " " + hunk.Header
+			}
+			hunks = append(hunks, httpActionHunk{
+				Path:    path,
+				Header:  header,
+				Content: strings.Join(hunk.Lines, "\n"),
+			})
+		}
+	}
+	return hunks, nil
+}
+
+func renderHTTPActionPayload(a *edb.HTTPAction, args actionArgs) ([]byte, error) {
+	tmpl, err := httpActionTemplate(a)
+	if err != nil {
+		return nil, err
+	}
+	data, err := newHTTPActionTemplateData(args, a.RoutingKey)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, errors.Wrap(err, "execute payload template")
+	}
+	return buf.Bytes(), nil
+}
+
+func sendHTTPAction(ctx context.Context, a *edb.HTTPAction, args actionArgs) error {
+	payload, err := renderHTTPActionPayload(a, args)
+	if err != nil {
+		// Retrying won't fix a broken template.
+		return errcode.MakeNonRetryable(err)
+	}
+	return postHTTPActionWithRetries(ctx, httpcli.ExternalDoer, httpActionURL(a), payload, int(a.MaxRetries), a.RetryBackoff)
+}
+
+// maxHTTPActionRetryDelay is the total time a handler waits between retries
+// of an HTTP action, so that a failing endpoint doesn't hold on to the worker.
+// Once it is used up, the request is left to the retries of the worker.
+const maxHTTPActionRetryDelay = 30 * time.Second
+
+// postHTTPActionWithRetries posts the payload, retrying up to maxRetries times
+// if the request fails with an error that may be transient. The delay before
+// the first retry is backoff, and doubles with every further one, as long as
+// the delays add up to at most maxHTTPActionRetryDelay.
+func postHTTPActionWithRetries(ctx context.Context, doer httpcli.Doer, url string, payload []byte, maxRetries int, backoff time.Duration) error {
+	var waited time.Duration
+	for attempt := 0; ; attempt++ {
+		err := postHTTPAction(ctx, doer, url, payload)
+		if err == nil || attempt >= maxRetries || !isRetryableHTTPActionError(err) {
+			return err
+		}
+
+		delay := backoff << attempt
+		if waited+delay > maxHTTPActionRetryDelay {
+			return err
+		}
+		waited += delay
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func postHTTPAction(ctx context.Context, doer httpcli.Doer, url string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "failed new request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := doer.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to post HTTP action")
+	}
+	defer resp.Body.Close()
+
+	// PagerDuty responds with 202 Accepted, so accept any success status.
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return StatusCodeError{
+			Code:   resp.StatusCode,
+			Status: resp.Status,
+			Body:   string(body),
+		}
+	}
+
+	return nil
+}
+
+// isRetryableHTTPActionError returns false for client errors, which will
+// fail the same way when retried.
+func isRetryableHTTPActionError(err error) bool {
+	var statusErr StatusCodeError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code >= 500 || statusErr.Code == http.StatusTooManyRequests || statusErr.Code == http.StatusRequestTimeout
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+package background
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+func TestHTTPActionPayload(t *testing.T) {
+	eu, err := url.Parse("https://sourcegraph.com")
+	require.NoError(t, err)
+
+	args := actionArgs{
+		MonitorDescription: "My test monitor",
+		MonitorOwnerName:   "Camden Cheek",
+		ExternalURL:        eu,
+		MonitorID:          42,
+		Query:              "repo:camdentest -file:id_rsa.pub BEGIN",
+		Results:            []*result.CommitMatch{&diffResultMock, &commitResultMock},
+		IncludeResults:     true,
+	}
+
+	t.Run("custom", func(t *testing.T) {
+		payload, err := renderHTTPActionPayload(&edb.HTTPAction{
+			Preset:          edb.HTTPActionPresetCustom,
+			PayloadTemplate: `{"text": {{ json .Summary }}, "hunks": [{{ range $i, $r := .Results }}{{ range $j, $h := $r.DiffHunks }}{{ if or $i $j }},{{ end }}{{ json $h.Header }}{{ end }}{{ end }}]}`,
+		}, args)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"text": "Camden Cheek's code monitor, My test monitor, detected 3 new matches.", "hunks": ["@@ -97,5 +97,5 @@ func Test() {"]}`, string(payload))
+	})
+
+	t.Run("teams", func(t *testing.T) {
+		payload, err := renderHTTPActionPayload(&edb.HTTPAction{Preset: edb.HTTPActionPresetTeams}, args)
+		require.NoError(t, err)
+		require.True(t, json.Valid(payload))
+		autogold.ExpectFile(t, autogold.Raw(payload))
+	})
+
+	t.Run("teams with content changes", func(t *testing.T) {
+		argsCopy := args
+		argsCopy.Results = nil
+		argsCopy.ContentChanges = []*edb.ContentChange{&addedContentChangeMock}
+
+		payload, err := renderHTTPActionPayload(&edb.HTTPAction{Preset: edb.HTTPActionPresetTeams}, argsCopy)
+		require.NoError(t, err)
+		require.True(t, json.Valid(payload))
+		autogold.ExpectFile(t, autogold.Raw(payload))
+	})
+
+	t.Run("pagerduty", func(t *testing.T) {
+		payload, err := renderHTTPActionPayload(&edb.HTTPAction{Preset: edb.HTTPActionPresetPagerDuty, RoutingKey: "routing-key"}, args)
+		require.NoError(t, err)
+		require.True(t, json.Valid(payload))
+		autogold.ExpectFile(t, autogold.Raw(payload))
+	})
+
+	t.Run("pagerduty without results", func(t *testing.T) {
+		argsCopy := args
+		argsCopy.IncludeResults = false
+
+		payload, err := renderHTTPActionPayload(&edb.HTTPAction{Preset: edb.HTTPActionPresetPagerDuty, RoutingKey: "routing-key"}, argsCopy)
+		require.NoError(t, err)
+		require.True(t, json.Valid(payload))
+		autogold.ExpectFile(t, autogold.Raw(payload))
+	})
+
+	t.Run("invalid template", func(t *testing.T) {
+		require.Error(t, ValidateHTTPActionTemplate(`{{ .Summary`))
+
+		_, err := renderHTTPActionPayload(&edb.HTTPAction{
+			Preset:          edb.HTTPActionPresetCustom,
+			PayloadTemplate: `{{ .NoSuchField }}`,
+		}, args)
+		require.Error(t, err)
+	})
+}
+
+func TestHTTPActionTemplateTruncate(t *testing.T) {
+	truncate := httpActionTemplateFuncs["truncate"].(func(int, string) string)
+	require.Equal(t, "abc", truncate(5, "abc"))
+	require.Equal(t, "ab", truncate(2, "abc"))
+	require.Equal(t, "hé", truncate(2, "héllo"))
+	require.Equal(t, "", truncate(0, "é"))
+}
+
+func TestPostHTTPActionWithRetries(t *testing.T) {
+	newServer := func(statusCodes ...int) (*httptest.Server, *int) {
+		requests := 0
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(statusCodes[requests])
+			requests++
+		}))
+		t.Cleanup(s.Close)
+		return s, &requests
+	}
+
+	t.Run("retries server errors", func(t *testing.T) {
+		s, requests := newServer(500, 503, http.StatusAccepted)
+		err := postHTTPActionWithRetries(context.Background(), s.Client(), s.URL, []byte("{}"), 3, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, *requests)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		s, requests := newServer(500, 500, 500)
+		err := postHTTPActionWithRetries(context.Background(), s.Client(), s.URL, []byte("{}"), 2, 0)
+		require.Error(t, err)
+		require.Equal(t, 3, *requests)
+	})
+
+	t.Run("leaves retries beyond the delay budget to the worker", func(t *testing.T) {
+		s, requests := newServer(500, 200)
+		err := postHTTPActionWithRetries(context.Background(), s.Client(), s.URL, []byte("{}"), 3, maxHTTPActionRetryDelay+time.Second)
+		require.Error(t, err)
+		require.Equal(t, 1, *requests)
+	})
+
+	t.Run("doesn't retry client errors", func(t *testing.T) {
+		s, requests := newServer(400, 200)
+		err := postHTTPActionWithRetries(context.Background(), s.Client(), s.URL, []byte("{}"), 3, 0)
+		require.Error(t, err)
+		require.Equal(t, 1, *requests)
+	})
+}
@@ -26,13 +26,23 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 	}
 
 	truncatedResults, totalCount, truncatedCount := truncateResults(args.Results, 5)
+	truncatedChanges, truncatedChangesCount := truncateContentChanges(args.ContentChanges, 5)
+	totalCount += len(args.ContentChanges)
+	truncatedCount += truncatedChangesCount
+
+	// Content mode monitors report matches which appeared or disappeared.
+	matchesNoun := "new matches"
+	if len(args.ContentChanges) > 0 {
+		matchesNoun = "changed matches"
+	}
 
 	blocks := []slack.Block{
 		newMarkdownSection(fmt.Sprintf(
-			"%s's Sourcegraph Code monitor, *%s*, detected *%d* new matches.",
+			"%s's Sourcegraph Code monitor, *%s*, detected *%d* %s.",
 			args.MonitorOwnerName,
 			args.MonitorDescription,
 			totalCount,
+			matchesNoun,
 		)),
 	}
 
@@ -53,6 +63,20 @@ func slackPayload(args actionArgs) *slack.WebhookMessage {
 			contentRaw := truncateMatchContent(result)
 			blocks = append(blocks, newMarkdownSection(formatCodeBlock(contentRaw)))
 		}
+		for _, change := range truncatedChanges {
+			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
+				"%s match: <%s|%s/%s>",
+				contentChangeType(change),
+				getFileURL(args.ExternalURL, string(change.RepoName), change.Path, change.LineNumber, args.UTMSource),
+				change.RepoName,
+				change.Path,
+			)))
+
+			// Files matched by their path have no line to show.
+			if change.Line != "" {
+				blocks = append(blocks, newMarkdownSection(formatCodeBlock(change.Line)))
+			}
+		}
 		if truncatedCount > 0 {
 			blocks = append(blocks, newMarkdownSection(fmt.Sprintf(
 				"...and <%s|%d more matches>.",
@@ -3,6 +3,7 @@ package background
 import (
 	"net/url"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
@@ -51,6 +52,17 @@ var commitResultMock = result.CommitMatch{
 
 var commitDisplayResultMock = toDisplayResult(&commitResultMock, externalURLMock)
 
+var addedContentChangeMock = edb.ContentChange{
+	ContentMatch: edb.ContentMatch{
+		RepoName:   api.RepoName("github.com/test/test"),
+		Path:       "internal/hash.go",
+		LineNumber: 4,
+		Line:       `	"crypto/md5"`,
+	},
+}
+
+var addedContentDisplayResultMock = contentChangeToDisplayResult(&addedContentChangeMock, externalURLMock)
+
 var longCommitResultMock = result.CommitMatch{
 	Commit: gitdomain.Commit{
 		ID:      api.CommitID("9cb4a43a052f8178566"),
@@ -7,7 +7,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
@@ -57,10 +59,11 @@ func SendTestWebhook(ctx context.Context, doer httpcli.Doer, description string,
 }
 
 type webhookPayload struct {
-	MonitorDescription string          `json:"monitorDescription"`
-	MonitorURL         string          `json:"monitorURL"`
-	Query              string          `json:"query"`
-	Results            []webhookResult `json:"results,omitempty"`
+	MonitorDescription string                 `json:"monitorDescription"`
+	MonitorURL         string                 `json:"monitorURL"`
+	Query              string                 `json:"query"`
+	Results            []webhookResult        `json:"results,omitempty"`
+	ContentChanges     []webhookContentChange `json:"contentChanges,omitempty"`
 }
 
 func generateWebhookPayload(args actionArgs) webhookPayload {
@@ -72,6 +75,7 @@ func generateWebhookPayload(args actionArgs) webhookPayload {
 
 	if args.IncludeResults {
 		p.Results = generateResults(args.Results)
+		p.ContentChanges = generateContentChanges(args.ContentChanges)
 	}
 
 	return p
@@ -106,6 +110,34 @@ func generateResults(in []*result.CommitMatch) []webhookResult {
 	return out
 }
 
+// webhookContentChange is a line matched by the query of a content mode
+// monitor which appeared ("added") or disappeared ("removed") since the
+// previous run.
+type webhookContentChange struct {
+	Change     string `json:"change"`
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+	LineNumber int    `json:"lineNumber,omitempty"`
+	Line       string `json:"line,omitempty"`
+}
+
+func generateContentChanges(in []*edb.ContentChange) []webhookContentChange {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]webhookContentChange, len(in))
+	for i, change := range in {
+		out[i] = webhookContentChange{
+			Change:     strings.ToLower(contentChangeType(change)),
+			Repository: string(change.RepoName),
+			Path:       change.Path,
+			LineNumber: change.LineNumber,
+			Line:       change.Line,
+		}
+	}
+	return out
+}
+
 func rangesToInts(ranges result.Ranges) [][2]int {
 	out := make([][2]int, len(ranges))
 	for i, r := range ranges {
@@ -24,6 +24,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker"
 	dbworkerstore "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 const (
@@ -117,31 +118,35 @@ func newActionJobResetter(_ context.Context, observationCtx *observation.Context
 func createDBWorkerStoreForTriggerJobs(observationCtx *observation.Context, s basestore.ShareableStore) dbworkerstore.Store[*edb.TriggerJob] {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("triggerJobs.dbworker.Store", ""), observationCtx)
 
-	return dbworkerstore.New(observationCtx, s.Handle(), dbworkerstore.Options[*edb.TriggerJob]{
-		Name:              "code_monitors_trigger_jobs_worker_store",
-		TableName:         "cm_trigger_jobs",
-		ColumnExpressions: edb.TriggerJobsColumns,
-		Scan:              dbworkerstore.BuildWorkerScan(edb.ScanTriggerJob),
-		StalledMaxAge:     60 * time.Second,
-		RetryAfter:        10 * time.Second,
-		MaxNumRetries:     3,
-		OrderByExpression: sqlf.Sprintf("id"),
-	})
+	return dbworkerstore.New(observationCtx, s.Handle(), TriggerJobsWorkerStoreOptions)
+}
+
+var TriggerJobsWorkerStoreOptions = dbworkerstore.Options[*edb.TriggerJob]{
+	Name:              "code_monitors_trigger_jobs_worker_store",
+	TableName:         "cm_trigger_jobs",
+	ColumnExpressions: edb.TriggerJobsColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(edb.ScanTriggerJob),
+	StalledMaxAge:     60 * time.Second,
+	RetryAfter:        10 * time.Second,
+	MaxNumRetries:     3,
+	OrderByExpression: sqlf.Sprintf("id"),
 }
 
 func createDBWorkerStoreForActionJobs(observationCtx *observation.Context, s edb.CodeMonitorStore) dbworkerstore.Store[*edb.ActionJob] {
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("actionJobs.dbworker.Store", ""), observationCtx)
 
-	return dbworkerstore.New(observationCtx, s.Handle(), dbworkerstore.Options[*edb.ActionJob]{
-		Name:              "code_monitors_action_jobs_worker_store",
-		TableName:         "cm_action_jobs",
-		ColumnExpressions: edb.ActionJobColumns,
-		Scan:              dbworkerstore.BuildWorkerScan(edb.ScanActionJob),
-		StalledMaxAge:     60 * time.Second,
-		RetryAfter:        10 * time.Second,
-		MaxNumRetries:     3,
-		OrderByExpression: sqlf.Sprintf("id"),
-	})
+	return dbworkerstore.New(observationCtx, s.Handle(), ActionJobsWorkerStoreOptions)
+}
+
+var ActionJobsWorkerStoreOptions = dbworkerstore.Options[*edb.ActionJob]{
+	Name:              "code_monitors_action_jobs_worker_store",
+	TableName:         "cm_action_jobs",
+	ColumnExpressions: edb.ActionJobColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(edb.ScanActionJob),
+	StalledMaxAge:     60 * time.Second,
+	RetryAfter:        10 * time.Second,
+	MaxNumRetries:     3,
+	OrderByExpression: sqlf.Sprintf("id"),
 }
 
 type queryRunner struct {
@@ -179,6 +184,10 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 		return errors.Wrap(err, "query settings")
 	}
 
+	if q.Mode == edb.QueryTriggerModeContent {
+		return r.handleContent(ctx, logger, triggerJob, q, settings)
+	}
+
 	results, searchErr := codemonitors.Search(ctx, logger, r.db, r.enterpriseJobs, q.QueryString, m.ID, settings)
 
 	// Log next_run and latest_result to table cm_queries.
@@ -208,6 +217,58 @@ func (r *queryRunner) Handle(ctx context.Context, logger log.Logger, triggerJob
 	return nil
 }
 
+// handleContent runs the query of a content mode monitor, and triggers its
+// actions for the lines which appeared or disappeared since the previous run.
+func (r *queryRunner) handleContent(ctx context.Context, logger log.Logger, triggerJob *edb.TriggerJob, q *edb.QueryTrigger, settings *schema.Settings) error {
+	cm := r.db.CodeMonitors()
+
+	current, searchErr := codemonitors.SearchContent(ctx, logger, r.db, r.enterpriseJobs, q.QueryString, settings)
+
+	// Content mode monitors don't track the time of their latest result.
+	latestResult := cm.Clock()()
+	if q.LatestResult != nil {
+		latestResult = *q.LatestResult
+	}
+	err := cm.SetQueryTriggerNextRun(ctx, q.ID, cm.Clock()().Add(5*time.Minute), latestResult.UTC())
+	if err != nil {
+		return err
+	}
+
+	if searchErr != nil {
+		return errors.Wrap(searchErr, "execute search")
+	}
+
+	previous, ok, err := cm.GetContentSnapshot(ctx, q.Monitor)
+	if err != nil {
+		return errors.Wrap(err, "GetContentSnapshot")
+	}
+
+	// Without a snapshot every existing match would count as added, so the
+	// first run only stores the snapshot to start from and doesn't trigger.
+	var changes []*edb.ContentChange
+	if ok {
+		changes = codemonitors.DiffContent(previous, current)
+	}
+	if !ok || len(changes) > 0 {
+		if err := cm.SetContentSnapshot(ctx, q.Monitor, current); err != nil {
+			return errors.Wrap(err, "SetContentSnapshot")
+		}
+	}
+
+	err = cm.UpdateTriggerJobWithContentChanges(ctx, triggerJob.ID, q.QueryString, changes)
+	if err != nil {
+		return errors.Wrap(err, "UpdateTriggerJobWithContentChanges")
+	}
+
+	if len(changes) > 0 {
+		_, err := cm.EnqueueActionJobsForMonitor(ctx, q.Monitor, triggerJob.ID)
+		if err != nil {
+			return errors.Wrap(err, "store.EnqueueActionJobsForQuery")
+		}
+	}
+	return nil
+}
+
 type actionRunner struct {
 	edb.CodeMonitorStore
 }
@@ -227,8 +288,10 @@ func (r *actionRunner) Handle(ctx context.Context, logger log.Logger, j *edb.Act
 		return r.handleWebhook(ctx, j)
 	case j.SlackWebhook != nil:
 		return r.handleSlackWebhook(ctx, j)
+	case j.HTTPAction != nil:
+		return r.handleHTTPAction(ctx, j)
 	default:
-		return errors.New("job must be one of type email, webhook, or slack webhook")
+		return errors.New("job must be one of type email, webhook, slack webhook, or HTTP action")
 	}
 }
 
@@ -267,6 +330,7 @@ func (r *actionRunner) handleEmail(ctx context.Context, j *edb.ActionJob) error
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     e.IncludeResults,
 	}
 
@@ -320,6 +384,7 @@ func (r *actionRunner) handleWebhook(ctx context.Context, j *edb.ActionJob) erro
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
@@ -356,12 +421,47 @@ func (r *actionRunner) handleSlackWebhook(ctx context.Context, j *edb.ActionJob)
 		Query:              m.Query,
 		MonitorOwnerName:   m.OwnerName,
 		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
 		IncludeResults:     w.IncludeResults,
 	}
 
 	return sendSlackNotification(ctx, w.URL, args)
 }
 
+func (r *actionRunner) handleHTTPAction(ctx context.Context, j *edb.ActionJob) error {
+	// Unlike the other actions, this doesn't run in a transaction, since
+	// retries with backoff can keep the request in flight for up to
+	// maxHTTPActionRetryDelay.
+	m, err := r.CodeMonitorStore.GetActionJobMetadata(ctx, j.ID)
+	if err != nil {
+		return errors.Wrap(err, "GetActionJobMetadata")
+	}
+
+	a, err := r.CodeMonitorStore.GetHTTPAction(ctx, *j.HTTPAction)
+	if err != nil {
+		return errors.Wrap(err, "GetHTTPAction")
+	}
+
+	externalURL, err := getExternalURL(ctx)
+	if err != nil {
+		return err
+	}
+
+	args := actionArgs{
+		MonitorDescription: m.Description,
+		MonitorID:          a.Monitor,
+		ExternalURL:        externalURL,
+		UTMSource:          "code-monitor-http-action",
+		Query:              m.Query,
+		MonitorOwnerName:   m.OwnerName,
+		Results:            m.Results,
+		ContentChanges:     m.ContentChanges,
+		IncludeResults:     a.IncludeResults,
+	}
+
+	return sendHTTPAction(ctx, a, args)
+}
+
 type StatusCodeError struct {
 	Code   int
 	Status string
@@ -0,0 +1,147 @@
+package codemonitors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strconv"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/envvar"
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/search"
+	"github.com/sourcegraph/sourcegraph/internal/search/client"
+	"github.com/sourcegraph/sourcegraph/internal/search/job/jobutil"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/search/streaming"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// ErrContentResultsIncomplete is returned when the query of a content mode
+// code monitor hits its result limit. Diffing an incomplete result set would
+// report matches beyond the limit as removed, so the run is failed instead.
+var ErrContentResultsIncomplete = errors.New("code monitor query matched more results than its limit, add a count: filter to the query to raise it")
+
+// SearchContent runs the query of a content mode code monitor and returns the
+// lines it matched, by repository.
+func SearchContent(ctx context.Context, logger log.Logger, db database.DB, enterpriseJobs jobutil.EnterpriseJobs, query string, settings *schema.Settings) (map[api.RepoID][]*edb.ContentMatch, error) {
+	searchClient := client.NewSearchClient(logger, db, search.Indexed(), search.SearcherURLs(), enterpriseJobs)
+	inputs, err := searchClient.Plan(
+		ctx,
+		"V3",
+		nil,
+		query,
+		search.Precise,
+		search.Streaming,
+		settings,
+		envvar.SourcegraphDotComMode(),
+	)
+	if err != nil {
+		return nil, errcode.MakeNonRetryable(err)
+	}
+
+	agg := streaming.NewAggregatingStream()
+	_, err = searchClient.Execute(ctx, agg, inputs)
+	if err != nil {
+		return nil, err
+	}
+	if agg.Stats.IsLimitHit {
+		return nil, errcode.MakeNonRetryable(ErrContentResultsIncomplete)
+	}
+
+	snapshot := make(map[api.RepoID][]*edb.ContentMatch)
+	for _, res := range agg.Results {
+		fm, ok := res.(*result.FileMatch)
+		if !ok {
+			return nil, errcode.MakeNonRetryable(errors.Errorf("expected search to only return file matches, but got type %T. Content mode code monitors don't support type:commit, type:diff, type:repo or select: queries.", res))
+		}
+		snapshot[fm.Repo.ID] = append(snapshot[fm.Repo.ID], contentMatches(fm)...)
+	}
+	return snapshot, nil
+}
+
+// DiffContent returns the matches of current which aren't in previous as
+// added, and the matches of previous which aren't in current as removed,
+// ordered by repository, path and line number.
+func DiffContent(previous, current map[api.RepoID][]*edb.ContentMatch) []*edb.ContentChange {
+	var changes []*edb.ContentChange
+	diff := func(from, to map[api.RepoID][]*edb.ContentMatch, removed bool) {
+		for repoID, matches := range from {
+			seen := make(map[string]struct{}, len(to[repoID]))
+			for _, m := range to[repoID] {
+				seen[m.Hash] = struct{}{}
+			}
+			for _, m := range matches {
+				if _, ok := seen[m.Hash]; !ok {
+					changes = append(changes, &edb.ContentChange{ContentMatch: *m, Removed: removed})
+				}
+			}
+		}
+	}
+	diff(current, previous, false)
+	diff(previous, current, true)
+
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.RepoName != b.RepoName {
+			return a.RepoName < b.RepoName
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.LineNumber != b.LineNumber {
+			return a.LineNumber < b.LineNumber
+		}
+		return !a.Removed && b.Removed
+	})
+	return changes
+}
+
+// contentMatches returns the lines matched in a file. A file matched only by
+// its path is returned as a single match without a line.
+func contentMatches(fm *result.FileMatch) []*edb.ContentMatch {
+	newMatch := func(lineNumber int, line string, occurrence int) *edb.ContentMatch {
+		return &edb.ContentMatch{
+			Hash:       contentMatchHash(fm.Path, line, occurrence),
+			RepoID:     fm.Repo.ID,
+			RepoName:   fm.Repo.Name,
+			Path:       fm.Path,
+			LineNumber: lineNumber,
+			Line:       line,
+		}
+	}
+
+	if len(fm.ChunkMatches) == 0 {
+		return []*edb.ContentMatch{newMatch(0, "", 0)}
+	}
+
+	var matches []*edb.ContentMatch
+	// Identical lines in the same file are told apart by their order, so that
+	// a copy of an already matched line is reported as a new match.
+	occurrences := make(map[string]int)
+	for _, lm := range fm.ChunkMatches.AsLineMatches() {
+		if len(lm.OffsetAndLengths) == 0 {
+			// Context line around a match.
+			continue
+		}
+		occurrences[lm.Preview]++
+		matches = append(matches, newMatch(int(lm.LineNumber)+1, lm.Preview, occurrences[lm.Preview]))
+	}
+	return matches
+}
+
+func contentMatchHash(path, line string, occurrence int) string {
+	h := sha256.New()
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write([]byte(line))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.Itoa(occurrence)))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,93 @@
+package codemonitors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestContentMatches(t *testing.T) {
+	fileMatch := func(content string, ranges ...result.Range) *result.FileMatch {
+		return &result.FileMatch{
+			File: result.File{
+				Repo: types.MinimalRepo{ID: 1, Name: "github.com/test/test"},
+				Path: "hash.go",
+			},
+			ChunkMatches: result.ChunkMatches{{
+				Content:      content,
+				ContentStart: result.Location{Line: 2},
+				Ranges:       ranges,
+			}},
+		}
+	}
+	lineRange := func(line int) result.Range {
+		return result.Range{
+			Start: result.Location{Line: line, Column: 1},
+			End:   result.Location{Line: line, Column: 4},
+		}
+	}
+
+	t.Run("context lines are skipped", func(t *testing.T) {
+		matches := contentMatches(fileMatch("import (\n\t\"crypto/md5\"\n)", lineRange(3)))
+		require.Len(t, matches, 1)
+		require.Equal(t, 4, matches[0].LineNumber)
+		require.Equal(t, "\t\"crypto/md5\"", matches[0].Line)
+	})
+
+	t.Run("moved lines keep their hash", func(t *testing.T) {
+		before := contentMatches(fileMatch("\t\"crypto/md5\"", lineRange(2)))
+		after := fileMatch("\t\"crypto/md5\"", lineRange(2))
+		after.ChunkMatches[0].ContentStart.Line = 10
+		after.ChunkMatches[0].Ranges = result.Ranges{lineRange(10)}
+		require.Equal(t, before[0].Hash, contentMatches(after)[0].Hash)
+	})
+
+	t.Run("identical lines have distinct hashes", func(t *testing.T) {
+		matches := contentMatches(fileMatch("md5.New()\nmd5.New()", lineRange(2), lineRange(3)))
+		require.Len(t, matches, 2)
+		require.NotEqual(t, matches[0].Hash, matches[1].Hash)
+	})
+
+	t.Run("path match", func(t *testing.T) {
+		fm := fileMatch("")
+		fm.ChunkMatches = nil
+		matches := contentMatches(fm)
+		require.Len(t, matches, 1)
+		require.Equal(t, 0, matches[0].LineNumber)
+	})
+}
+
+func TestDiffContent(t *testing.T) {
+	match := func(repoID api.RepoID, path, hash string, line int) *edb.ContentMatch {
+		return &edb.ContentMatch{Hash: hash, RepoID: repoID, RepoName: api.RepoName(path[:1]), Path: path, LineNumber: line}
+	}
+
+	previous := map[api.RepoID][]*edb.ContentMatch{
+		1: {match(1, "a.go", "kept", 1), match(1, "a.go", "removed", 2)},
+		2: {match(2, "b.go", "repo gone", 1)},
+	}
+	current := map[api.RepoID][]*edb.ContentMatch{
+		1: {match(1, "a.go", "kept", 5), match(1, "a.go", "added", 1)},
+		3: {match(3, "c.go", "new repo", 1)},
+	}
+
+	var got []string
+	for _, c := range DiffContent(previous, current) {
+		got = append(got, contentChangeString(c))
+	}
+	require.Equal(t, []string{"+added", "-removed", "-repo gone", "+new repo"}, got)
+
+	require.Empty(t, DiffContent(current, current))
+}
+
+func contentChangeString(c *edb.ContentChange) string {
+	if c.Removed {
+		return "-" + c.Hash
+	}
+	return "+" + c.Hash
+}
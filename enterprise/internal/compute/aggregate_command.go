@@ -0,0 +1,81 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+)
+
+// Aggregate is a command that extracts values from search results, to be
+// grouped across all the results of a query by an Aggregator.
+type Aggregate struct {
+	SearchPattern MatchPattern
+	GroupPattern  string
+	Kind          string
+}
+
+func (c *Aggregate) ToSearchPattern() string {
+	return c.SearchPattern.String()
+}
+
+func (c *Aggregate) String() string {
+	return fmt.Sprintf("Aggregate: (%s) -> (%s)", c.SearchPattern.String(), c.GroupPattern)
+}
+
+func (c *Aggregate) Run(ctx context.Context, r result.Match) (Result, error) {
+	values := &AggregateValues{
+		RepositoryID: int32(r.RepoName().ID),
+		Repository:   string(r.RepoName().Name),
+	}
+	switch m := r.(type) {
+	case *result.FileMatch:
+		values.Path = m.Path
+		values.Commit = string(m.CommitID)
+	case *result.CommitDiffMatch:
+		values.Path = m.Path()
+		values.Commit = string(m.Commit.ID)
+		values.CommitDate = &m.Commit.Author.Date
+	case *result.CommitMatch:
+		values.Commit = string(m.Commit.ID)
+		values.CommitDate = &m.Commit.Author.Date
+	}
+
+	// Comby outputs are separated by newlines, so values are extracted from
+	// the output one line at a time.
+	const separator = "\n"
+	kind := "output"
+	if c.Kind == "aggregate.structural" {
+		kind = "output.structural"
+	}
+	for _, content := range resultChunks(r, kind, false) {
+		env := NewMetaEnvironment(r, content)
+		groupPattern, err := substituteMetaVariables(c.GroupPattern, env)
+		if err != nil {
+			return nil, err
+		}
+		out, err := output(ctx, content, c.SearchPattern, groupPattern, separator)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range strings.Split(out, separator) {
+			if value != "" {
+				values.Values = append(values.Values, value)
+			}
+		}
+	}
+	return values, nil
+}
+
+// AggregateValues are the values extracted by the aggregate command from a
+// single search result.
+type AggregateValues struct {
+	Values       []string   `json:"values"`
+	RepositoryID int32      `json:"repositoryID"`
+	Repository   string     `json:"repository"`
+	Path         string     `json:"path,omitempty"`
+	Commit       string     `json:"commit,omitempty"`
+	CommitDate   *time.Time `json:"commitDate,omitempty"`
+}
@@ -0,0 +1,106 @@
+package compute
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestAggregate(t *testing.T) {
+	computeQuery, err := Parse(`content:aggregate(go (\d+\.\d+) -> $1)`)
+	require.NoError(t, err)
+	cmd := computeQuery.Command
+
+	fileIn := func(repo types.MinimalRepo, path string, chunks ...string) result.Match {
+		m := fileMatch(chunks...).(*result.FileMatch)
+		m.Repo, m.Path = repo, path
+		return m
+	}
+	diffIn := func(repo types.MinimalRepo, commit api.CommitID, date time.Time, content string) result.Match {
+		return &result.CommitMatch{
+			Commit: gitdomain.Commit{
+				ID:        commit,
+				Author:    gitdomain.Signature{Name: "bob", Date: date},
+				Committer: &gitdomain.Signature{},
+			},
+			Repo:        repo,
+			DiffPreview: &result.MatchedString{Content: content},
+		}
+	}
+	repoA := types.MinimalRepo{ID: 1, Name: "github.com/sourcegraph/a"}
+	repoB := types.MinimalRepo{ID: 2, Name: "github.com/sourcegraph/b"}
+	jan, feb := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	matches := []result.Match{
+		fileIn(repoA, "go.mod", "go 1.19", "go 1.20"),
+		fileIn(repoA, "tools/go.mod", "go 1.19"),
+		fileIn(repoB, "go.mod", "go 1.19"),
+		diffIn(repoB, "cafe", feb, "go 1.20"),
+		diffIn(repoA, "beef", jan, "go 1.20"),
+	}
+
+	exporter := NewExporter(cmd)
+	for _, m := range matches {
+		r, err := cmd.Run(context.Background(), m)
+		require.NoError(t, err)
+		exporter.Add(r)
+	}
+
+	r, err := cmd.Run(context.Background(), matches[0])
+	require.NoError(t, err)
+	require.Equal(t, &AggregateValues{
+		Values:       []string{"1.19", "1.20"},
+		RepositoryID: 1,
+		Repository:   "github.com/sourcegraph/a",
+		Path:         "go.mod",
+	}, r)
+
+	var csv bytes.Buffer
+	require.NoError(t, exporter.WriteCSV(&csv))
+	autogold.Expect(`value,count,distinct_repositories,distinct_files,first_seen_repository,first_seen_commit,first_seen_date
+1.19,3,2,3,,,
+1.20,3,2,1,github.com/sourcegraph/a,beef,2023-01-01T00:00:00Z
+`).Equal(t, csv.String())
+
+	var json bytes.Buffer
+	require.NoError(t, exporter.WriteJSON(&json))
+	autogold.Expect(`[{"value":"1.19","count":3,"distinctRepositories":2,"distinctFiles":3},{"value":"1.20","count":3,"distinctRepositories":2,"distinctFiles":1,"firstSeen":{"repository":"github.com/sourcegraph/a","commit":"beef","date":"2023-01-01T00:00:00Z"}}]
+`).Equal(t, json.String())
+}
+
+func TestExporter(t *testing.T) {
+	test := func(q string, m result.Match) string {
+		computeQuery, err := Parse(q)
+		require.NoError(t, err)
+		r, err := computeQuery.Command.Run(context.Background(), m)
+		require.NoError(t, err)
+
+		exporter := NewExporter(computeQuery.Command)
+		exporter.Add(r)
+		var b bytes.Buffer
+		require.NoError(t, exporter.WriteCSV(&b))
+		return b.String()
+	}
+
+	autogold.Expect("value\n(1)\n(2)\n").
+		Equal(t, test(`content:output((\d) -> ($1))`, fileMatch("a 1 b 2")))
+
+	autogold.Expect("repository,value\nmy/awesome/repo,1\nmy/awesome/repo,2\n").
+		Equal(t, test(`content:output.extra((\d) -> $1)`, fileMatch("a 1 b 2")))
+
+	autogold.Expect("repository,path,value\nmy/awesome/repo,my/awesome/path.ml,1\nmy/awesome/repo,my/awesome/path.ml,2\n").
+		Equal(t, test(`content:'[0-9]'`, fileMatch("a 1 b 2")))
+
+	// Match only commands have no result for repository matches.
+	autogold.Expect("repository,path,value\n").
+		Equal(t, test(`content:'[0-9]'`, &result.RepoMatch{Name: "my/awesome/repo"}))
+}
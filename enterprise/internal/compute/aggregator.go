@@ -0,0 +1,93 @@
+package compute
+
+import (
+	"sort"
+	"time"
+)
+
+// AggregateGroup is the aggregation of all the occurrences of a value extracted
+// by the aggregate command.
+type AggregateGroup struct {
+	Value string `json:"value"`
+	// Count is the number of occurrences of the value.
+	Count int `json:"count"`
+	// DistinctRepositories is the number of repositories the value occurs in.
+	DistinctRepositories int `json:"distinctRepositories"`
+	// DistinctFiles is the number of files the value occurs in.
+	DistinctFiles int `json:"distinctFiles"`
+	// FirstSeen is the oldest commit the value occurs in. It is only set for
+	// commit and diff search results.
+	FirstSeen *AggregateCommit `json:"firstSeen,omitempty"`
+}
+
+type AggregateCommit struct {
+	Repository string    `json:"repository"`
+	Commit     string    `json:"commit"`
+	Date       time.Time `json:"date"`
+}
+
+type aggregateFile struct {
+	repositoryID int32
+	path         string
+}
+
+type aggregateGroupState struct {
+	group        AggregateGroup
+	repositories map[int32]struct{}
+	files        map[aggregateFile]struct{}
+}
+
+// Aggregator groups the values extracted by the aggregate command across
+// search results. It is not safe for concurrent use.
+type Aggregator struct {
+	groups map[string]*aggregateGroupState
+}
+
+func NewAggregator() *Aggregator {
+	return &Aggregator{groups: map[string]*aggregateGroupState{}}
+}
+
+// Add adds the values extracted from a search result to their groups.
+func (a *Aggregator) Add(v *AggregateValues) {
+	for _, value := range v.Values {
+		s, ok := a.groups[value]
+		if !ok {
+			s = &aggregateGroupState{
+				group:        AggregateGroup{Value: value},
+				repositories: map[int32]struct{}{},
+				files:        map[aggregateFile]struct{}{},
+			}
+			a.groups[value] = s
+		}
+		s.group.Count++
+		s.repositories[v.RepositoryID] = struct{}{}
+		if v.Path != "" {
+			s.files[aggregateFile{repositoryID: v.RepositoryID, path: v.Path}] = struct{}{}
+		}
+		if v.CommitDate != nil && (s.group.FirstSeen == nil || v.CommitDate.Before(s.group.FirstSeen.Date)) {
+			s.group.FirstSeen = &AggregateCommit{
+				Repository: v.Repository,
+				Commit:     v.Commit,
+				Date:       *v.CommitDate,
+			}
+		}
+	}
+}
+
+// Groups returns the groups of values, the most frequent first.
+func (a *Aggregator) Groups() []AggregateGroup {
+	groups := make([]AggregateGroup, 0, len(a.groups))
+	for _, s := range a.groups {
+		g := s.group
+		g.DistinctRepositories = len(s.repositories)
+		g.DistinctFiles = len(s.files)
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Value < groups[j].Value
+	})
+	return groups
+}
@@ -0,0 +1,129 @@
+package compute
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Exporter accumulates the results of a compute command, to export them as a
+// single CSV or JSON document. The values of aggregate commands are grouped
+// across all results. It is not safe for concurrent use.
+type Exporter struct {
+	command    Command
+	aggregator *Aggregator
+	results    []Result
+}
+
+func NewExporter(command Command) *Exporter {
+	e := &Exporter{command: command}
+	if _, ok := command.(*Aggregate); ok {
+		e.aggregator = NewAggregator()
+	}
+	return e
+}
+
+// Add adds a result of the command to the export.
+func (e *Exporter) Add(r Result) {
+	if r == nil {
+		return
+	}
+	if v, ok := r.(*AggregateValues); ok && e.aggregator != nil {
+		e.aggregator.Add(v)
+		return
+	}
+	e.results = append(e.results, r)
+}
+
+// WriteJSON writes the export as a JSON array: the aggregated groups for
+// aggregate commands, and the results for other commands.
+func (e *Exporter) WriteJSON(w io.Writer) error {
+	var v any = e.results
+	if e.aggregator != nil {
+		v = e.aggregator.Groups()
+	} else if e.results == nil {
+		v = []Result{}
+	}
+	return json.NewEncoder(w).Encode(v)
+}
+
+// WriteCSV writes the export as a CSV document with a header row. Every value
+// produced by the command is a row.
+func (e *Exporter) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(e.header()); err != nil {
+		return err
+	}
+	if e.aggregator != nil {
+		for _, g := range e.aggregator.Groups() {
+			row := []string{g.Value, strconv.Itoa(g.Count), strconv.Itoa(g.DistinctRepositories), strconv.Itoa(g.DistinctFiles), "", "", ""}
+			if g.FirstSeen != nil {
+				row[4], row[5], row[6] = g.FirstSeen.Repository, g.FirstSeen.Commit, g.FirstSeen.Date.Format(time.RFC3339)
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+	for _, r := range e.results {
+		if err := cw.WriteAll(e.rows(r)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (e *Exporter) header() []string {
+	switch c := e.command.(type) {
+	case *Aggregate:
+		return []string{"value", "count", "distinct_repositories", "distinct_files", "first_seen_repository", "first_seen_commit", "first_seen_date"}
+	case *MatchOnly:
+		return []string{"repository", "path", "value"}
+	case *Output:
+		if c.Kind == "output.extra" {
+			return []string{"repository", "value"}
+		}
+	}
+	return []string{"value"}
+}
+
+func (e *Exporter) rows(r Result) [][]string {
+	var separator string
+	if c, ok := e.command.(*Output); ok {
+		separator = c.Separator
+	}
+	// splitValue splits the output of a command into its values.
+	splitValue := func(value string) []string {
+		if separator == "" {
+			return []string{value}
+		}
+		var values []string
+		for _, v := range strings.Split(value, separator) {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+		return values
+	}
+
+	var rows [][]string
+	switch v := r.(type) {
+	case *MatchContext:
+		for _, m := range v.Matches {
+			rows = append(rows, []string{v.Repository, v.Path, m.Value})
+		}
+	case *TextExtra:
+		for _, value := range splitValue(v.Value) {
+			rows = append(rows, []string{v.Repository, value})
+		}
+	case *Text:
+		for _, value := range splitValue(v.Value) {
+			rows = append(rows, []string{value})
+		}
+	}
+	return rows
+}
@@ -97,13 +97,16 @@ func toRegexpPattern(value string) (MatchPattern, error) {
 
 var ComputePredicateRegistry = query.PredicateRegistry{
 	query.FieldContent: {
-		"replace":            func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.regexp":     func() query.Predicate { return query.EmptyPredicate{} },
-		"replace.structural": func() query.Predicate { return query.EmptyPredicate{} },
-		"output":             func() query.Predicate { return query.EmptyPredicate{} },
-		"output.regexp":      func() query.Predicate { return query.EmptyPredicate{} },
-		"output.structural":  func() query.Predicate { return query.EmptyPredicate{} },
-		"output.extra":       func() query.Predicate { return query.EmptyPredicate{} },
+		"replace":              func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.regexp":       func() query.Predicate { return query.EmptyPredicate{} },
+		"replace.structural":   func() query.Predicate { return query.EmptyPredicate{} },
+		"output":               func() query.Predicate { return query.EmptyPredicate{} },
+		"output.regexp":        func() query.Predicate { return query.EmptyPredicate{} },
+		"output.structural":    func() query.Predicate { return query.EmptyPredicate{} },
+		"output.extra":         func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate":            func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate.regexp":     func() query.Predicate { return query.EmptyPredicate{} },
+		"aggregate.structural": func() query.Predicate { return query.EmptyPredicate{} },
 	},
 }
 
@@ -217,6 +220,40 @@ func parseOutput(q *query.Basic) (Command, bool, error) {
 	}, true, nil
 }
 
+func parseAggregate(q *query.Basic) (Command, bool, error) {
+	pattern, err := extractPattern(q)
+	if err != nil {
+		return nil, false, err
+	}
+
+	name, args, ok := parseContentPredicate(pattern)
+	if !ok {
+		return nil, false, nil
+	}
+	left, right, err := parseArrowSyntax(args)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var matchPattern MatchPattern
+	switch name {
+	case "aggregate", "aggregate.regexp":
+		var err error
+		matchPattern, err = toRegexpPattern(left)
+		if err != nil {
+			return nil, false, errors.Wrap(err, "aggregate command")
+		}
+	case "aggregate.structural":
+		// structural search doesn't do any match pattern validation
+		matchPattern = &Comby{Value: left}
+	default:
+		// unrecognized name
+		return nil, false, nil
+	}
+
+	return &Aggregate{SearchPattern: matchPattern, GroupPattern: right, Kind: name}, true, nil
+}
+
 func parseMatchOnly(q *query.Basic) (Command, bool, error) {
 	pattern, err := extractPattern(q)
 	if err != nil {
@@ -260,6 +297,7 @@ func first(parsers ...commandParser) commandParser {
 var parseCommand = first(
 	parseReplace,
 	parseOutput,
+	parseAggregate,
 	parseMatchOnly,
 )
 
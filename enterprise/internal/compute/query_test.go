@@ -41,6 +41,12 @@ func TestParse(t *testing.T) {
 
 	autogold.Expect("Command: `Replace in place: () -> (b)`").
 		Equal(t, test("content:replace(->b)"))
+
+	autogold.Expect("Command: `Aggregate: (\"version\": \"(.*)\") -> ($1)`, Parameters: `file:package.json`").
+		Equal(t, test(`file:package.json content:aggregate("version": "(.*)" -> $1)`))
+
+	autogold.Expect("invalid arrow statement, no left and right hand sides of `->`").
+		Equal(t, test("content:aggregate(foo)"))
 }
 
 func TestToSearchQuery(t *testing.T) {
@@ -17,6 +17,7 @@ type ActionJob struct {
 	Email        *int64
 	Webhook      *int64
 	SlackWebhook *int64
+	HTTPAction   *int64
 	TriggerEvent int32
 
 	// Fields demanded by any dbworker.
@@ -38,7 +39,9 @@ type ActionJobMetadata struct {
 	Description string
 	MonitorID   int64
 	Results     []*result.CommitMatch
-	OwnerName   string
+	// ContentChanges are set instead of Results for content mode monitors.
+	ContentChanges []*ContentChange
+	OwnerName      string
 
 	// The query with after: filter.
 	Query string
@@ -51,6 +54,7 @@ var ActionJobColumns = []*sqlf.Query{
 	sqlf.Sprintf("cm_action_jobs.email"),
 	sqlf.Sprintf("cm_action_jobs.webhook"),
 	sqlf.Sprintf("cm_action_jobs.slack_webhook"),
+	sqlf.Sprintf("cm_action_jobs.http_action"),
 	sqlf.Sprintf("cm_action_jobs.trigger_event"),
 	sqlf.Sprintf("cm_action_jobs.state"),
 	sqlf.Sprintf("cm_action_jobs.failure_message"),
@@ -82,6 +86,10 @@ type ListActionJobsOpts struct {
 	// the given slack webhook action. Refers to cm_slack_webhooks(id)
 	SlackWebhookID *int
 
+	// HTTPActionID, if set, will filter to only actions jobs that are
+	// executing the given HTTP action. Refers to cm_http_actions(id)
+	HTTPActionID *int
+
 	// First, if defined, limits the operation to only the first n results
 	First *int
 
@@ -105,6 +113,9 @@ func (o ListActionJobsOpts) Conds() *sqlf.Query {
 	if o.SlackWebhookID != nil {
 		conds = append(conds, sqlf.Sprintf("slack_webhook = %s", *o.SlackWebhookID))
 	}
+	if o.HTTPActionID != nil {
+		conds = append(conds, sqlf.Sprintf("http_action = %s", *o.HTTPActionID))
+	}
 	if o.After != nil {
 		conds = append(conds, sqlf.Sprintf("id > %s", *o.After))
 	}
@@ -192,14 +203,25 @@ WITH due_emails AS (
 	SELECT DISTINCT slack_webhook as id FROM cm_action_jobs
 	WHERE state = 'queued'
 		OR state = 'processing'
+), due_http_actions AS (
+	SELECT id
+	FROM cm_http_actions
+	WHERE monitor = %s
+		AND enabled = true
+	EXCEPT
+	SELECT DISTINCT http_action as id FROM cm_action_jobs
+	WHERE state = 'queued'
+		OR state = 'processing'
 )
-INSERT INTO cm_action_jobs (email, webhook, slack_webhook, trigger_event)
-SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+INSERT INTO cm_action_jobs (email, webhook, slack_webhook, http_action, trigger_event)
+SELECT id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_emails
+UNION
+SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), %s::integer from due_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_webhooks
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, CAST(NULL AS BIGINT), %s::integer from due_slack_webhooks
 UNION
-SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_slack_webhooks
-ORDER BY 1, 2, 3
+SELECT CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), CAST(NULL AS BIGINT), id, %s::integer from due_http_actions
+ORDER BY 1, 2, 3, 4
 RETURNING %s
 `
 
@@ -209,6 +231,8 @@ func (s *codeMonitorStore) EnqueueActionJobsForMonitor(ctx context.Context, moni
 		monitorID,
 		monitorID,
 		monitorID,
+		monitorID,
+		triggerJobID,
 		triggerJobID,
 		triggerJobID,
 		triggerJobID,
@@ -228,6 +252,7 @@ SELECT
 	ctj.query_string,
 	cm.id AS monitorID,
 	ctj.search_results,
+	ctj.content_changes,
 	CASE WHEN LENGTH(users.display_name) > 0 THEN users.display_name ELSE users.username END
 FROM cm_action_jobs caj
 INNER JOIN cm_trigger_jobs ctj on caj.trigger_event = ctj.id
@@ -240,15 +265,20 @@ WHERE caj.id = %s
 // GetActionJobMetada returns the set of fields needed to execute all action jobs
 func (s *codeMonitorStore) GetActionJobMetadata(ctx context.Context, jobID int32) (*ActionJobMetadata, error) {
 	row := s.Store.QueryRow(ctx, sqlf.Sprintf(getActionJobMetadataFmtStr, jobID))
-	var resultsJSON []byte
+	var resultsJSON, changesJSON []byte
 	m := &ActionJobMetadata{}
-	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &m.OwnerName)
+	err := row.Scan(&m.Description, &m.Query, &m.MonitorID, &resultsJSON, &changesJSON, &m.OwnerName)
 	if err != nil {
 		return nil, err
 	}
 	if err := json.Unmarshal(resultsJSON, &m.Results); err != nil {
 		return nil, err
 	}
+	if len(changesJSON) > 0 {
+		if err := json.Unmarshal(changesJSON, &m.ContentChanges); err != nil {
+			return nil, err
+		}
+	}
 	return m, nil
 }
 
@@ -283,6 +313,7 @@ func ScanActionJob(row dbutil.Scanner) (*ActionJob, error) {
 		&aj.Email,
 		&aj.Webhook,
 		&aj.SlackWebhook,
+		&aj.HTTPAction,
 		&aj.TriggerEvent,
 		&aj.State,
 		&aj.FailureMessage,
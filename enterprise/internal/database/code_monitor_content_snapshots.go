@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+)
+
+// ContentMatch is a line matched by the query of a content mode code monitor.
+type ContentMatch struct {
+	// Hash identifies the match across runs. It is derived from the path, the
+	// content of the line and the occurrence of that content in the file, but
+	// not its line number, so that edits elsewhere in the file don't make the
+	// match appear to change while duplicate lines stay distinct.
+	Hash       string
+	RepoID     api.RepoID
+	RepoName   api.RepoName
+	Path       string
+	LineNumber int
+	Line       string
+}
+
+// ContentChange is a match of a content mode code monitor which appeared or
+// disappeared since the previous run.
+type ContentChange struct {
+	ContentMatch
+	Removed bool
+}
+
+func (s *codeMonitorStore) GetContentSnapshot(ctx context.Context, monitorID int64) (_ map[api.RepoID][]*ContentMatch, ok bool, err error) {
+	ok, _, err = basestore.ScanFirstBool(s.Query(ctx, sqlf.Sprintf(
+		"SELECT content_snapshot_at IS NOT NULL FROM cm_queries WHERE monitor = %s",
+		monitorID,
+	)))
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	rawQuery := `
+	SELECT repo_id, matches
+	FROM cm_content_snapshots
+	WHERE monitor_id = %s
+	`
+
+	rows, err := s.Query(ctx, sqlf.Sprintf(rawQuery, monitorID))
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	snapshot := make(map[api.RepoID][]*ContentMatch)
+	for rows.Next() {
+		var (
+			repoID      int32
+			matchesJSON []byte
+		)
+		if err := rows.Scan(&repoID, &matchesJSON); err != nil {
+			return nil, false, err
+		}
+		var matches []*ContentMatch
+		if err := json.Unmarshal(matchesJSON, &matches); err != nil {
+			return nil, false, err
+		}
+		snapshot[api.RepoID(repoID)] = matches
+	}
+	return snapshot, true, rows.Err()
+}
+
+func (s *codeMonitorStore) SetContentSnapshot(ctx context.Context, monitorID int64, snapshot map[api.RepoID][]*ContentMatch) (err error) {
+	tx, err := s.Transact(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { err = tx.Done(err) }()
+
+	if err := tx.Exec(ctx, sqlf.Sprintf("DELETE FROM cm_content_snapshots WHERE monitor_id = %s", monitorID)); err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, sqlf.Sprintf("UPDATE cm_queries SET content_snapshot_at = %s WHERE monitor = %s", s.Now(), monitorID)); err != nil {
+		return err
+	}
+
+	rawQuery := `
+	INSERT INTO cm_content_snapshots (monitor_id, repo_id, matches)
+	VALUES (%s, %s, %s)
+	`
+
+	for repoID, matches := range snapshot {
+		if len(matches) == 0 {
+			continue
+		}
+		matchesJSON, err := json.Marshal(matches)
+		if err != nil {
+			return err
+		}
+		if err := tx.Exec(ctx, sqlf.Sprintf(rawQuery, monitorID, int64(repoID), matchesJSON)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreContentSnapshot(t *testing.T) {
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+	fixtures := populateCodeMonitorFixtures(t, db)
+	cm := db.CodeMonitors()
+
+	// No snapshot has been taken yet.
+	snapshot, ok, err := cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Empty(t, snapshot)
+
+	match := &ContentMatch{
+		Hash:       "hash1",
+		RepoID:     fixtures.Repo.ID,
+		RepoName:   fixtures.Repo.Name,
+		Path:       "main.go",
+		LineNumber: 3,
+		Line:       `import "crypto/md5"`,
+	}
+	want := map[api.RepoID][]*ContentMatch{fixtures.Repo.ID: {match}}
+	require.NoError(t, cm.SetContentSnapshot(ctx, fixtures.Monitor.ID, want))
+
+	snapshot, ok, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, want, snapshot)
+
+	// Repos without matches are dropped from the snapshot, but an empty
+	// snapshot is still distinct from no snapshot.
+	require.NoError(t, cm.SetContentSnapshot(ctx, fixtures.Monitor.ID, map[api.RepoID][]*ContentMatch{fixtures.Repo.ID: nil}))
+
+	snapshot, ok, err = cm.GetContentSnapshot(ctx, fixtures.Monitor.ID)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Empty(t, snapshot)
+}
@@ -0,0 +1,268 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+)
+
+// HTTPActionPreset selects the payload an HTTP action posts.
+type HTTPActionPreset string
+
+const (
+	// HTTPActionPresetCustom renders the payload template of the action.
+	HTTPActionPresetCustom HTTPActionPreset = "custom"
+	// HTTPActionPresetTeams posts a Microsoft Teams message card.
+	HTTPActionPresetTeams HTTPActionPreset = "teams"
+	// HTTPActionPresetPagerDuty triggers a PagerDuty Events API v2 alert.
+	HTTPActionPresetPagerDuty HTTPActionPreset = "pagerduty"
+)
+
+type HTTPAction struct {
+	ID              int64
+	Monitor         int64
+	Enabled         bool
+	URL             string
+	IncludeResults  bool
+	Preset          HTTPActionPreset
+	PayloadTemplate string
+	RoutingKey      string
+
+	// MaxRetries is the number of times a failed request is retried before
+	// the action job fails. RetryBackoff is the delay before the first retry,
+	// and doubles with every further one. Retries stop early once the delays
+	// would add up to more than 30 seconds, and the job is retried later.
+	MaxRetries   int32
+	RetryBackoff time.Duration
+
+	CreatedBy int32
+	CreatedAt time.Time
+	ChangedBy int32
+	ChangedAt time.Time
+}
+
+type HTTPActionArgs struct {
+	Enabled         bool
+	IncludeResults  bool
+	URL             string
+	Preset          HTTPActionPreset
+	PayloadTemplate string
+	RoutingKey      string
+	MaxRetries      int32
+	RetryBackoff    time.Duration
+}
+
+const updateHTTPActionQuery = `
+UPDATE cm_http_actions
+SET enabled = %s,
+	include_results = %s,
+	url = %s,
+	preset = %s,
+	payload_template = %s,
+	routing_key = %s,
+	max_retries = %s,
+	retry_backoff_seconds = %s,
+	changed_by = %s,
+	changed_at = %s
+WHERE
+	id = %s
+	AND EXISTS (
+		SELECT 1 FROM cm_monitors
+		WHERE cm_monitors.id = cm_http_actions.monitor
+			AND cm_monitors.namespace_user_id = %s
+	)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) UpdateHTTPAction(ctx context.Context, id int64, args *HTTPActionArgs) (*HTTPAction, error) {
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		updateHTTPActionQuery,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.Preset,
+		args.PayloadTemplate,
+		args.RoutingKey,
+		args.MaxRetries,
+		int32(args.RetryBackoff/time.Second),
+		a.UID,
+		s.Now(),
+		id,
+		a.UID,
+		sqlf.Join(httpActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanHTTPAction(row)
+}
+
+const createHTTPActionQuery = `
+INSERT INTO cm_http_actions
+(monitor, enabled, include_results, url, preset, payload_template, routing_key, max_retries, retry_backoff_seconds, created_by, created_at, changed_by, changed_at)
+VALUES (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+RETURNING %s;
+`
+
+func (s *codeMonitorStore) CreateHTTPAction(ctx context.Context, monitorID int64, args *HTTPActionArgs) (*HTTPAction, error) {
+	now := s.Now()
+	a := actor.FromContext(ctx)
+	q := sqlf.Sprintf(
+		createHTTPActionQuery,
+		monitorID,
+		args.Enabled,
+		args.IncludeResults,
+		args.URL,
+		args.Preset,
+		args.PayloadTemplate,
+		args.RoutingKey,
+		args.MaxRetries,
+		int32(args.RetryBackoff/time.Second),
+		a.UID,
+		now,
+		a.UID,
+		now,
+		sqlf.Join(httpActionColumns, ","),
+	)
+
+	row := s.QueryRow(ctx, q)
+	return scanHTTPAction(row)
+}
+
+const deleteHTTPActionQuery = `
+DELETE FROM cm_http_actions
+WHERE id in (%s)
+	AND MONITOR = %s
+`
+
+func (s *codeMonitorStore) DeleteHTTPActions(ctx context.Context, monitorID int64, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	deleteIDs := make([]*sqlf.Query, 0, len(ids))
+	for _, id := range ids {
+		deleteIDs = append(deleteIDs, sqlf.Sprintf("%d", id))
+	}
+	q := sqlf.Sprintf(
+		deleteHTTPActionQuery,
+		sqlf.Join(deleteIDs, ","),
+		monitorID,
+	)
+
+	return s.Exec(ctx, q)
+}
+
+const countHTTPActionsQuery = `
+SELECT COUNT(*)
+FROM cm_http_actions
+WHERE monitor = %s;
+`
+
+func (s *codeMonitorStore) CountHTTPActions(ctx context.Context, monitorID int64) (int, error) {
+	var count int
+	err := s.QueryRow(ctx, sqlf.Sprintf(countHTTPActionsQuery, monitorID)).Scan(&count)
+	return count, err
+}
+
+const getHTTPActionQuery = `
+SELECT %s -- HTTPActionColumns
+FROM cm_http_actions
+WHERE id = %s
+`
+
+func (s *codeMonitorStore) GetHTTPAction(ctx context.Context, id int64) (*HTTPAction, error) {
+	q := sqlf.Sprintf(
+		getHTTPActionQuery,
+		sqlf.Join(httpActionColumns, ","),
+		id,
+	)
+	row := s.QueryRow(ctx, q)
+	return scanHTTPAction(row)
+}
+
+const listHTTPActionsQuery = `
+SELECT %s -- HTTPActionColumns
+FROM cm_http_actions
+WHERE %s
+ORDER BY id ASC
+LIMIT %s;
+`
+
+func (s *codeMonitorStore) ListHTTPActions(ctx context.Context, opts ListActionsOpts) ([]*HTTPAction, error) {
+	q := sqlf.Sprintf(
+		listHTTPActionsQuery,
+		sqlf.Join(httpActionColumns, ","),
+		opts.Conds(),
+		opts.Limit(),
+	)
+	rows, err := s.Query(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanHTTPActions(rows)
+}
+
+// httpActionColumns is the set of columns in the cm_http_actions table
+// This must be kept in sync with scanHTTPAction
+var httpActionColumns = []*sqlf.Query{
+	sqlf.Sprintf("cm_http_actions.id"),
+	sqlf.Sprintf("cm_http_actions.monitor"),
+	sqlf.Sprintf("cm_http_actions.enabled"),
+	sqlf.Sprintf("cm_http_actions.url"),
+	sqlf.Sprintf("cm_http_actions.include_results"),
+	sqlf.Sprintf("cm_http_actions.preset"),
+	sqlf.Sprintf("cm_http_actions.payload_template"),
+	sqlf.Sprintf("cm_http_actions.routing_key"),
+	sqlf.Sprintf("cm_http_actions.max_retries"),
+	sqlf.Sprintf("cm_http_actions.retry_backoff_seconds"),
+	sqlf.Sprintf("cm_http_actions.created_by"),
+	sqlf.Sprintf("cm_http_actions.created_at"),
+	sqlf.Sprintf("cm_http_actions.changed_by"),
+	sqlf.Sprintf("cm_http_actions.changed_at"),
+}
+
+func scanHTTPActions(rows *sql.Rows) ([]*HTTPAction, error) {
+	var as []*HTTPAction
+	for rows.Next() {
+		a, err := scanHTTPAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	return as, rows.Err()
+}
+
+// scanHTTPAction scans an HTTPAction from a *sql.Row or *sql.Rows.
+// It must be kept in sync with httpActionColumns.
+func scanHTTPAction(scanner dbutil.Scanner) (*HTTPAction, error) {
+	var (
+		a                   HTTPAction
+		retryBackoffSeconds int32
+	)
+	err := scanner.Scan(
+		&a.ID,
+		&a.Monitor,
+		&a.Enabled,
+		&a.URL,
+		&a.IncludeResults,
+		&a.Preset,
+		&a.PayloadTemplate,
+		&a.RoutingKey,
+		&a.MaxRetries,
+		&retryBackoffSeconds,
+		&a.CreatedBy,
+		&a.CreatedAt,
+		&a.ChangedBy,
+		&a.ChangedAt,
+	)
+	a.RetryBackoff = time.Duration(retryBackoffSeconds) * time.Second
+	return &a, err
+}
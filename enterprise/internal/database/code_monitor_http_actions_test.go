@@ -0,0 +1,157 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/internal/actor"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeMonitorStoreHTTPActions(t *testing.T) {
+	ctx := context.Background()
+	args1 := &HTTPActionArgs{
+		Enabled:         true,
+		URL:             "https://icanhazcheezburger.com/http_action",
+		Preset:          HTTPActionPresetCustom,
+		PayloadTemplate: `{"text": {{ json .MonitorDescription }}}`,
+		MaxRetries:      3,
+		RetryBackoff:    10 * time.Second,
+	}
+	args2 := &HTTPActionArgs{
+		Enabled:      false,
+		URL:          "https://events.pagerduty.com/v2/enqueue",
+		Preset:       HTTPActionPresetPagerDuty,
+		RoutingKey:   "routing-key",
+		MaxRetries:   5,
+		RetryBackoff: time.Minute,
+	}
+
+	logger := logtest.Scoped(t)
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitors(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateHTTPAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+		require.Equal(t, args1.PayloadTemplate, action.PayloadTemplate)
+		require.Equal(t, args1.RetryBackoff, action.RetryBackoff)
+
+		got, err := s.GetHTTPAction(ctx, action.ID)
+		require.NoError(t, err)
+
+		require.Equal(t, action, got)
+	})
+
+	t.Run("CreateUpdateGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitors(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action, err := s.CreateHTTPAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		updated, err := s.UpdateHTTPAction(ctx, action.ID, args2)
+		require.NoError(t, err)
+		require.Equal(t, false, updated.Enabled)
+		require.Equal(t, HTTPActionPresetPagerDuty, updated.Preset)
+		require.Equal(t, "routing-key", updated.RoutingKey)
+		require.Equal(t, int32(5), updated.MaxRetries)
+		require.Equal(t, time.Minute, updated.RetryBackoff)
+
+		got, err := s.GetHTTPAction(ctx, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, updated, got)
+	})
+
+	t.Run("CreateDeleteGet", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitors(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		action1, err := s.CreateHTTPAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		action2, err := s.CreateHTTPAction(ctx, fixtures.monitor.ID, args2)
+		require.NoError(t, err)
+
+		err = s.DeleteHTTPActions(ctx, fixtures.monitor.ID, action1.ID)
+		require.NoError(t, err)
+
+		_, err = s.GetHTTPAction(ctx, action1.ID)
+		require.Error(t, err)
+
+		_, err = s.GetHTTPAction(ctx, action2.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("ListCountCreate", func(t *testing.T) {
+		t.Parallel()
+
+		db := database.NewDB(logger, dbtest.NewDB(logger, t))
+		_, _, ctx := newTestUser(ctx, t, db)
+		s := CodeMonitors(db)
+		fixtures := s.insertTestMonitor(ctx, t)
+
+		count, err := s.CountHTTPActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 0, count)
+
+		_, err = s.CreateHTTPAction(ctx, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		_, err = s.CreateHTTPAction(ctx, fixtures.monitor.ID, args2)
+		require.NoError(t, err)
+
+		count, err = s.CountHTTPActions(ctx, fixtures.monitor.ID)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		first := 1
+		actions, err := s.ListHTTPActions(ctx, ListActionsOpts{MonitorID: &fixtures.monitor.ID, First: &first})
+		require.NoError(t, err)
+		require.Len(t, actions, 1)
+	})
+
+	t.Run("Update permissions", func(t *testing.T) {
+		ctx, db, s := newTestStore(t)
+		uid1 := insertTestUser(ctx, t, db, "u1", false)
+		ctx1 := actor.WithActor(ctx, actor.FromUser(uid1))
+		uid2 := insertTestUser(ctx, t, db, "u2", false)
+		ctx2 := actor.WithActor(ctx, actor.FromUser(uid2))
+		fixtures := s.insertTestMonitor(ctx1, t)
+		_ = s.insertTestMonitor(ctx2, t)
+
+		action, err := s.CreateHTTPAction(ctx1, fixtures.monitor.ID, args1)
+		require.NoError(t, err)
+
+		// User1 can update it
+		_, err = s.UpdateHTTPAction(ctx1, action.ID, args2)
+		require.NoError(t, err)
+
+		// User2 cannot update it
+		_, err = s.UpdateHTTPAction(ctx2, action.ID, args1)
+		require.Error(t, err)
+
+		action, err = s.GetHTTPAction(ctx1, action.ID)
+		require.NoError(t, err)
+		require.Equal(t, args2.URL, action.URL)
+	})
+}
@@ -48,6 +48,7 @@ func TestSetQueryTriggerNextRun(t *testing.T) {
 		ID:           fixtures.query.ID,
 		Monitor:      fixtures.monitor.ID,
 		QueryString:  fixtures.query.QueryString,
+		Mode:         QueryTriggerModeCommit,
 		CreatedBy:    fixtures.query.CreatedBy,
 		CreatedAt:    fixtures.query.CreatedAt,
 		NextRun:      wantNextRun,
@@ -68,16 +69,17 @@ func TestUpdateTrigger(t *testing.T) {
 	_ = s.insertTestMonitor(ctx2, t)
 
 	// User1 can update it
-	err := s.UpdateQueryTrigger(ctx1, fixtures.query.ID, "query1")
+	err := s.UpdateQueryTrigger(ctx1, fixtures.query.ID, "query1", QueryTriggerModeContent)
 	require.NoError(t, err)
 
 	// User2 cannot update it
-	err = s.UpdateQueryTrigger(ctx2, fixtures.query.ID, "query2")
+	err = s.UpdateQueryTrigger(ctx2, fixtures.query.ID, "query2", QueryTriggerModeCommit)
 	require.Error(t, err)
 
 	qt, err := s.GetQueryTriggerForMonitor(ctx1, fixtures.query.ID)
 	require.NoError(t, err)
 	require.Equal(t, qt.QueryString, "query1")
+	require.Equal(t, qt.Mode, QueryTriggerModeContent)
 }
 
 func TestResetTriggerQueryTimestamps(t *testing.T) {
@@ -95,6 +97,7 @@ func TestResetTriggerQueryTimestamps(t *testing.T) {
 		ID:           fixtures.query.ID,
 		Monitor:      fixtures.monitor.ID,
 		QueryString:  fixtures.query.QueryString,
+		Mode:         QueryTriggerModeCommit,
 		NextRun:      s.Now().UTC(),
 		LatestResult: nil,
 		CreatedBy:    fixtures.query.CreatedBy,
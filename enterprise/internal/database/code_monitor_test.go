@@ -48,7 +48,7 @@ func (s *codeMonitorStore) insertTestMonitor(ctx context.Context, t *testing.T)
 	require.NoError(t, err)
 
 	// Create trigger.
-	fixtures.query, err = s.CreateQueryTrigger(ctx, fixtures.monitor.ID, testQuery)
+	fixtures.query, err = s.CreateQueryTrigger(ctx, fixtures.monitor.ID, testQuery, QueryTriggerModeCommit)
 	require.NoError(t, err)
 
 	for i, a := range actions {
@@ -85,7 +85,7 @@ func populateCodeMonitorFixtures(t *testing.T, db EnterpriseDB) codeMonitorTestF
 	ctx = actor.WithActor(ctx, actor.FromUser(u.ID))
 	m, err := db.CodeMonitors().CreateMonitor(ctx, MonitorArgs{NamespaceUserID: &u.ID, Enabled: true})
 	require.NoError(t, err)
-	q, err := db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, "type:commit repo:.")
+	q, err := db.CodeMonitors().CreateQueryTrigger(ctx, m.ID, "type:commit repo:.", QueryTriggerModeCommit)
 	require.NoError(t, err)
 	return codeMonitorTestFixtures{User: u, Monitor: m, Query: q, Repo: r}
 }
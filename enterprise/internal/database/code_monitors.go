@@ -39,8 +39,8 @@ type CodeMonitorStore interface {
 	ListMonitors(context.Context, ListMonitorsOpts) ([]*Monitor, error)
 	CountMonitors(ctx context.Context, userID int32) (int32, error)
 
-	CreateQueryTrigger(ctx context.Context, monitorID int64, query string) (*QueryTrigger, error)
-	UpdateQueryTrigger(ctx context.Context, id int64, query string) error
+	CreateQueryTrigger(ctx context.Context, monitorID int64, query string, mode QueryTriggerMode) (*QueryTrigger, error)
+	UpdateQueryTrigger(ctx context.Context, id int64, query string, mode QueryTriggerMode) error
 	GetQueryTriggerForMonitor(ctx context.Context, monitorID int64) (*QueryTrigger, error)
 	ResetQueryTriggerTimestamps(ctx context.Context, queryID int64) error
 	SetQueryTriggerNextRun(ctx context.Context, triggerQueryID int64, next time.Time, latestResults time.Time) error
@@ -50,6 +50,7 @@ type CodeMonitorStore interface {
 	CountQueryTriggerJobs(ctx context.Context, queryID int64) (int32, error)
 
 	UpdateTriggerJobWithResults(ctx context.Context, triggerJobID int32, queryString string, results []*result.CommitMatch) error
+	UpdateTriggerJobWithContentChanges(ctx context.Context, triggerJobID int32, queryString string, changes []*ContentChange) error
 	DeleteOldTriggerJobs(ctx context.Context, retentionInDays int) error
 
 	UpdateEmailAction(_ context.Context, id int64, _ *EmailActionArgs) (*EmailAction, error)
@@ -72,6 +73,13 @@ type CodeMonitorStore interface {
 	GetSlackWebhookAction(ctx context.Context, id int64) (*SlackWebhookAction, error)
 	ListSlackWebhookActions(context.Context, ListActionsOpts) ([]*SlackWebhookAction, error)
 
+	UpdateHTTPAction(_ context.Context, id int64, _ *HTTPActionArgs) (*HTTPAction, error)
+	CreateHTTPAction(ctx context.Context, monitorID int64, _ *HTTPActionArgs) (*HTTPAction, error)
+	DeleteHTTPActions(ctx context.Context, monitorID int64, ids ...int64) error
+	CountHTTPActions(ctx context.Context, monitorID int64) (int, error)
+	GetHTTPAction(ctx context.Context, id int64) (*HTTPAction, error)
+	ListHTTPActions(context.Context, ListActionsOpts) ([]*HTTPAction, error)
+
 	CreateRecipient(ctx context.Context, emailID int64, userID, orgID *int32) (*Recipient, error)
 	DeleteRecipients(ctx context.Context, emailID int64) error
 	ListRecipients(context.Context, ListRecipientsOpts) ([]*Recipient, error)
@@ -90,6 +98,15 @@ type CodeMonitorStore interface {
 	HasAnyLastSearched(ctx context.Context, monitorID int64) (bool, error)
 	UpsertLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID, lastSearched []string) error
 	GetLastSearched(ctx context.Context, monitorID int64, repoID api.RepoID) ([]string, error)
+
+	// GetContentSnapshot returns the lines matched by the query of a content
+	// mode code monitor on its previous run, by repository. The boolean is
+	// false if no snapshot has been stored for the monitor yet, as opposed to
+	// an empty snapshot stored by a run without matches.
+	GetContentSnapshot(ctx context.Context, monitorID int64) (map[api.RepoID][]*ContentMatch, bool, error)
+	// SetContentSnapshot replaces the lines matched by the query of a content
+	// mode code monitor on its previous run.
+	SetContentSnapshot(ctx context.Context, monitorID int64, snapshot map[api.RepoID][]*ContentMatch) error
 }
 
 // codeMonitorStore exposes methods to read and write codemonitors domain models
@@ -204,7 +221,7 @@ func (s *TestStore) InsertTestMonitor(ctx context.Context, t *testing.T) (*Monit
 	}
 
 	// Create trigger.
-	_, err = s.CreateQueryTrigger(ctx, m.ID, testQuery)
+	_, err = s.CreateQueryTrigger(ctx, m.ID, testQuery, QueryTriggerModeCommit)
 	if err != nil {
 		return nil, err
 	}
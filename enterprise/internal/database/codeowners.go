@@ -35,6 +35,21 @@ type CodeownersStore interface {
 	ListCodeowners(ctx context.Context, opts ListCodeownersOpts) ([]*types.CodeownersFile, int32, error)
 	// CountCodeownersFiles counts the number of manually ingested Codeowners files.
 	CountCodeownersFiles(context.Context) (int32, error)
+
+	// CreateCodeownersRepoPattern creates a given Codeowners file for a repo name pattern in the database.
+	CreateCodeownersRepoPattern(ctx context.Context, pattern *types.CodeownersRepoPattern) error
+	// UpdateCodeownersRepoPattern updates a Codeowners file for a repo name pattern in the database, matched by pattern.
+	UpdateCodeownersRepoPattern(ctx context.Context, pattern *types.CodeownersRepoPattern) error
+	// DeleteCodeownersRepoPatterns deletes the Codeowners files for the given repo name patterns.
+	// At least one pattern must be given.
+	DeleteCodeownersRepoPatterns(ctx context.Context, repoPatterns ...string) error
+	// GetCodeownersRepoPattern gets the Codeowners file for the given repo name pattern if it exists.
+	GetCodeownersRepoPattern(ctx context.Context, repoPattern string) (*types.CodeownersRepoPattern, error)
+	// ListCodeownersRepoPatterns lists all the Codeowners files for repo name patterns.
+	ListCodeownersRepoPatterns(ctx context.Context) ([]*types.CodeownersRepoPattern, error)
+	// ListCodeownersRepoPatternsForRepo lists the Codeowners files for the repo name patterns
+	// that match the given repo, in the order they were created.
+	ListCodeownersRepoPatternsForRepo(ctx context.Context, name api.RepoName) ([]*types.CodeownersRepoPattern, error)
 }
 
 type codeownersStore struct {
@@ -63,6 +78,9 @@ func (s *codeownersStore) CreateCodeownersFile(ctx context.Context, file *types.
 		if file.UpdatedAt.IsZero() {
 			file.UpdatedAt = file.CreatedAt
 		}
+		if file.Mode == "" {
+			file.Mode = types.CodeownersModeOverride
+		}
 
 		protoBytes, err := proto.Marshal(file.Proto)
 		if err != nil {
@@ -75,6 +93,7 @@ func (s *codeownersStore) CreateCodeownersFile(ctx context.Context, file *types.
 			file.Contents,
 			protoBytes,
 			file.RepoID,
+			file.Mode,
 			file.CreatedAt,
 			file.UpdatedAt,
 		)
@@ -97,6 +116,7 @@ var codeownersColumns = []*sqlf.Query{
 	sqlf.Sprintf("contents"),
 	sqlf.Sprintf("contents_proto"),
 	sqlf.Sprintf("repo_id"),
+	sqlf.Sprintf("mode"),
 	sqlf.Sprintf("created_at"),
 	sqlf.Sprintf("updated_at"),
 }
@@ -104,7 +124,7 @@ var codeownersColumns = []*sqlf.Query{
 const createCodeownersQueryFmtStr = `
 INSERT INTO codeowners
 (%s)
-VALUES (%s, %s, %s, %s, %s)
+VALUES (%s, %s, %s, %s, %s, %s)
 `
 
 func (s *codeownersStore) UpdateCodeownersFile(ctx context.Context, file *types.CodeownersFile) error {
@@ -112,6 +132,9 @@ func (s *codeownersStore) UpdateCodeownersFile(ctx context.Context, file *types.
 		if file.UpdatedAt.IsZero() {
 			file.UpdatedAt = timeutil.Now()
 		}
+		if file.Mode == "" {
+			file.Mode = types.CodeownersModeOverride
+		}
 
 		conds := []*sqlf.Query{
 			sqlf.Sprintf("repo_id = %s", file.RepoID),
@@ -126,6 +149,7 @@ func (s *codeownersStore) UpdateCodeownersFile(ctx context.Context, file *types.
 			updateCodeownersQueryFmtStr,
 			file.Contents,
 			protoBytes,
+			file.Mode,
 			file.UpdatedAt,
 			sqlf.Join(conds, "AND"),
 		)
@@ -150,6 +174,7 @@ UPDATE codeowners
 SET
     contents = %s,
     contents_proto = %s,
+    mode = %s,
     updated_at = %s
 WHERE
     %s
@@ -295,6 +320,7 @@ func scanCodeownersRow(sc dbutil.Scanner, c *types.CodeownersFile) error {
 		&c.Contents,
 		&protoBytes,
 		&c.RepoID,
+		&c.Mode,
 		&c.CreatedAt,
 		&c.UpdatedAt,
 	); err != nil {
@@ -0,0 +1,220 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgconn"
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"google.golang.org/protobuf/proto"
+
+	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type CodeownersRepoPatternNotFoundError struct {
+	args any
+}
+
+func (e CodeownersRepoPatternNotFoundError) Error() string {
+	return fmt.Sprintf("codeowners repo pattern not found: %v", e.args)
+}
+
+func (CodeownersRepoPatternNotFoundError) NotFound() bool {
+	return true
+}
+
+var ErrCodeownersRepoPatternAlreadyExists = errors.New("codeowners file has already been ingested for this repository pattern")
+
+func (s *codeownersStore) CreateCodeownersRepoPattern(ctx context.Context, pattern *types.CodeownersRepoPattern) error {
+	if pattern.CreatedAt.IsZero() {
+		pattern.CreatedAt = timeutil.Now()
+	}
+	if pattern.UpdatedAt.IsZero() {
+		pattern.UpdatedAt = pattern.CreatedAt
+	}
+	if pattern.Mode == "" {
+		pattern.Mode = types.CodeownersModeOverride
+	}
+
+	protoBytes, err := proto.Marshal(pattern.Proto)
+	if err != nil {
+		return err
+	}
+
+	q := sqlf.Sprintf(
+		createCodeownersRepoPatternQueryFmtStr,
+		pattern.RepoPattern,
+		pattern.Contents,
+		protoBytes,
+		pattern.Mode,
+		pattern.CreatedAt,
+		pattern.UpdatedAt,
+	)
+
+	id, _, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	if err != nil {
+		var e *pgconn.PgError
+		if errors.As(err, &e) && e.ConstraintName == "codeowners_repo_patterns_repo_pattern_key" {
+			return ErrCodeownersRepoPatternAlreadyExists
+		}
+		return err
+	}
+	pattern.ID = int32(id)
+	return nil
+}
+
+const createCodeownersRepoPatternQueryFmtStr = `
+INSERT INTO codeowners_repo_patterns
+(repo_pattern, contents, contents_proto, mode, created_at, updated_at)
+VALUES (%s, %s, %s, %s, %s, %s)
+RETURNING id
+`
+
+func (s *codeownersStore) UpdateCodeownersRepoPattern(ctx context.Context, pattern *types.CodeownersRepoPattern) error {
+	if pattern.UpdatedAt.IsZero() {
+		pattern.UpdatedAt = timeutil.Now()
+	}
+	if pattern.Mode == "" {
+		pattern.Mode = types.CodeownersModeOverride
+	}
+
+	protoBytes, err := proto.Marshal(pattern.Proto)
+	if err != nil {
+		return err
+	}
+
+	q := sqlf.Sprintf(
+		updateCodeownersRepoPatternQueryFmtStr,
+		pattern.Contents,
+		protoBytes,
+		pattern.Mode,
+		pattern.UpdatedAt,
+		pattern.RepoPattern,
+	)
+
+	id, ok, err := basestore.ScanFirstInt(s.Query(ctx, q))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return CodeownersRepoPatternNotFoundError{args: pattern.RepoPattern}
+	}
+	pattern.ID = int32(id)
+	return nil
+}
+
+const updateCodeownersRepoPatternQueryFmtStr = `
+UPDATE codeowners_repo_patterns
+SET
+    contents = %s,
+    contents_proto = %s,
+    mode = %s,
+    updated_at = %s
+WHERE
+    repo_pattern = %s
+RETURNING id
+`
+
+func (s *codeownersStore) DeleteCodeownersRepoPatterns(ctx context.Context, repoPatterns ...string) error {
+	if len(repoPatterns) == 0 {
+		return errors.New("at least one repository pattern must be given")
+	}
+	q := sqlf.Sprintf(deleteCodeownersRepoPatternsQueryFmtStr, pq.Array(repoPatterns))
+
+	res, err := s.Handle().ExecContext(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return CodeownersRepoPatternNotFoundError{args: repoPatterns}
+	}
+	return nil
+}
+
+const deleteCodeownersRepoPatternsQueryFmtStr = `
+DELETE FROM codeowners_repo_patterns
+WHERE repo_pattern = ANY (%s)
+`
+
+func (s *codeownersStore) GetCodeownersRepoPattern(ctx context.Context, repoPattern string) (*types.CodeownersRepoPattern, error) {
+	q := sqlf.Sprintf(
+		listCodeownersRepoPatternsQueryFmtStr,
+		sqlf.Join(codeownersRepoPatternsColumns, ", "),
+		sqlf.Sprintf("repo_pattern = %s", repoPattern),
+	)
+	patterns, err := scanCodeownersRepoPatterns(s.Query(ctx, q))
+	if err != nil {
+		return nil, err
+	}
+	if len(patterns) != 1 {
+		return nil, CodeownersRepoPatternNotFoundError{args: repoPattern}
+	}
+	return patterns[0], nil
+}
+
+func (s *codeownersStore) ListCodeownersRepoPatterns(ctx context.Context) ([]*types.CodeownersRepoPattern, error) {
+	q := sqlf.Sprintf(
+		listCodeownersRepoPatternsQueryFmtStr,
+		sqlf.Join(codeownersRepoPatternsColumns, ", "),
+		sqlf.Sprintf("TRUE"),
+	)
+	return scanCodeownersRepoPatterns(s.Query(ctx, q))
+}
+
+func (s *codeownersStore) ListCodeownersRepoPatternsForRepo(ctx context.Context, name api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+	// Patterns are globs, where `*` matches any sequence of characters. Like
+	// repository names, they are matched case-insensitively. The LIKE
+	// metacharacters of the patterns are escaped, so that they only match
+	// themselves.
+	q := sqlf.Sprintf(
+		listCodeownersRepoPatternsQueryFmtStr,
+		sqlf.Join(codeownersRepoPatternsColumns, ", "),
+		sqlf.Sprintf(`lower(%s) LIKE replace(replace(replace(replace(lower(repo_pattern), '\', '\\'), '%%', '\%%'), '_', '\_'), '*', '%%')`, string(name)),
+	)
+	return scanCodeownersRepoPatterns(s.Query(ctx, q))
+}
+
+var codeownersRepoPatternsColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("repo_pattern"),
+	sqlf.Sprintf("contents"),
+	sqlf.Sprintf("contents_proto"),
+	sqlf.Sprintf("mode"),
+	sqlf.Sprintf("created_at"),
+	sqlf.Sprintf("updated_at"),
+}
+
+const listCodeownersRepoPatternsQueryFmtStr = `
+SELECT %s
+FROM codeowners_repo_patterns
+WHERE %s
+ORDER BY id ASC
+`
+
+var scanCodeownersRepoPatterns = basestore.NewSliceScanner(func(s dbutil.Scanner) (*types.CodeownersRepoPattern, error) {
+	p := types.CodeownersRepoPattern{Proto: new(codeownerspb.File)}
+	var protoBytes []byte
+	if err := s.Scan(
+		&p.ID,
+		&p.RepoPattern,
+		&p.Contents,
+		&protoBytes,
+		&p.Mode,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &p, proto.Unmarshal(protoBytes, p.Proto)
+})
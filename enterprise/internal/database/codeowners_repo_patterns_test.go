@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestCodeowners_RepoPatterns(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+	store := db.Codeowners()
+
+	newPattern := func(repoPattern, handle string, mode owntypes.CodeownersMode) *owntypes.CodeownersRepoPattern {
+		f := newCodeownersFile("*", handle, 0)
+		return &owntypes.CodeownersRepoPattern{
+			RepoPattern: repoPattern,
+			Contents:    f.Contents,
+			Proto:       f.Proto,
+			Mode:        mode,
+		}
+	}
+	org := newPattern("github.com/sourcegraph/*", "org-owner", owntypes.CodeownersModeMerge)
+	require.NoError(t, store.CreateCodeownersRepoPattern(ctx, org))
+	all := newPattern("*", "everyone", "")
+	require.NoError(t, store.CreateCodeownersRepoPattern(ctx, all))
+	require.Equal(t, owntypes.CodeownersModeOverride, all.Mode)
+
+	t.Run("create duplicate", func(t *testing.T) {
+		err := store.CreateCodeownersRepoPattern(ctx, newPattern("*", "someone", ""))
+		require.ErrorIs(t, err, ErrCodeownersRepoPatternAlreadyExists)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		got, err := store.GetCodeownersRepoPattern(ctx, org.RepoPattern)
+		require.NoError(t, err)
+		if diff := cmp.Diff(org, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+
+		_, err = store.GetCodeownersRepoPattern(ctx, "github.com/*")
+		require.ErrorAs(t, err, &CodeownersRepoPatternNotFoundError{})
+	})
+
+	t.Run("list for repo", func(t *testing.T) {
+		got, err := store.ListCodeownersRepoPatternsForRepo(ctx, api.RepoName("GitHub.com/sourcegraph/sourcegraph"))
+		require.NoError(t, err)
+		if diff := cmp.Diff([]*owntypes.CodeownersRepoPattern{org, all}, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+
+		got, err = store.ListCodeownersRepoPatternsForRepo(ctx, api.RepoName("gitlab.com/sourcegraph/sourcegraph"))
+		require.NoError(t, err)
+		if diff := cmp.Diff([]*owntypes.CodeownersRepoPattern{all}, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updated := newPattern(org.RepoPattern, "new-owner", owntypes.CodeownersModeOverride)
+		updated.CreatedAt = org.CreatedAt
+		require.NoError(t, store.UpdateCodeownersRepoPattern(ctx, updated))
+		require.Equal(t, org.ID, updated.ID)
+
+		got, err := store.GetCodeownersRepoPattern(ctx, org.RepoPattern)
+		require.NoError(t, err)
+		if diff := cmp.Diff(updated, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+
+		err = store.UpdateCodeownersRepoPattern(ctx, newPattern("github.com/*", "someone", ""))
+		require.ErrorAs(t, err, &CodeownersRepoPatternNotFoundError{})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, store.DeleteCodeownersRepoPatterns(ctx, org.RepoPattern))
+		got, err := store.ListCodeownersRepoPatterns(ctx)
+		require.NoError(t, err)
+		if diff := cmp.Diff([]*owntypes.CodeownersRepoPattern{all}, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+
+		err = store.DeleteCodeownersRepoPatterns(ctx, org.RepoPattern)
+		require.ErrorAs(t, err, &CodeownersRepoPatternNotFoundError{})
+
+		require.Error(t, store.DeleteCodeownersRepoPatterns(ctx))
+	})
+
+	t.Run("list for repo with LIKE metacharacters", func(t *testing.T) {
+		special := newPattern(`github.com/my_org\%/*`, "special-owner", "")
+		require.NoError(t, store.CreateCodeownersRepoPattern(ctx, special))
+
+		got, err := store.ListCodeownersRepoPatternsForRepo(ctx, api.RepoName(`github.com/my_org\%/repo`))
+		require.NoError(t, err)
+		if diff := cmp.Diff([]*owntypes.CodeownersRepoPattern{all, special}, got, protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+
+		// `_`, `%` and `\` only match themselves.
+		for _, name := range []api.RepoName{`github.com/myXorg\%/repo`, `github.com/my_orgX%/repo`, `github.com/my_org\X/repo`} {
+			got, err = store.ListCodeownersRepoPatternsForRepo(ctx, name)
+			require.NoError(t, err)
+			if diff := cmp.Diff([]*owntypes.CodeownersRepoPattern{all}, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("%s: %s", name, diff)
+			}
+		}
+	})
+}
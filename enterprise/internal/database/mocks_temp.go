@@ -35,6 +35,9 @@ type MockCodeMonitorStore struct {
 	// CountActionJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountActionJobs.
 	CountActionJobsFunc *CodeMonitorStoreCountActionJobsFunc
+	// CountHTTPActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method CountHTTPActions.
+	CountHTTPActionsFunc *CodeMonitorStoreCountHTTPActionsFunc
 	// CountMonitorsFunc is an instance of a mock function object
 	// controlling the behavior of the method CountMonitors.
 	CountMonitorsFunc *CodeMonitorStoreCountMonitorsFunc
@@ -53,6 +56,9 @@ type MockCodeMonitorStore struct {
 	// CreateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateEmailAction.
 	CreateEmailActionFunc *CodeMonitorStoreCreateEmailActionFunc
+	// CreateHTTPActionFunc is an instance of a mock function object
+	// controlling the behavior of the method CreateHTTPAction.
+	CreateHTTPActionFunc *CodeMonitorStoreCreateHTTPActionFunc
 	// CreateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateMonitor.
 	CreateMonitorFunc *CodeMonitorStoreCreateMonitorFunc
@@ -71,6 +77,9 @@ type MockCodeMonitorStore struct {
 	// DeleteEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteEmailActions.
 	DeleteEmailActionsFunc *CodeMonitorStoreDeleteEmailActionsFunc
+	// DeleteHTTPActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method DeleteHTTPActions.
+	DeleteHTTPActionsFunc *CodeMonitorStoreDeleteHTTPActionsFunc
 	// DeleteMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteMonitor.
 	DeleteMonitorFunc *CodeMonitorStoreDeleteMonitorFunc
@@ -106,9 +115,15 @@ type MockCodeMonitorStore struct {
 	// GetActionJobMetadataFunc is an instance of a mock function object
 	// controlling the behavior of the method GetActionJobMetadata.
 	GetActionJobMetadataFunc *CodeMonitorStoreGetActionJobMetadataFunc
+	// GetContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method GetContentSnapshot.
+	GetContentSnapshotFunc *CodeMonitorStoreGetContentSnapshotFunc
 	// GetEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method GetEmailAction.
 	GetEmailActionFunc *CodeMonitorStoreGetEmailActionFunc
+	// GetHTTPActionFunc is an instance of a mock function object
+	// controlling the behavior of the method GetHTTPAction.
+	GetHTTPActionFunc *CodeMonitorStoreGetHTTPActionFunc
 	// GetLastSearchedFunc is an instance of a mock function object
 	// controlling the behavior of the method GetLastSearched.
 	GetLastSearchedFunc *CodeMonitorStoreGetLastSearchedFunc
@@ -140,6 +155,9 @@ type MockCodeMonitorStore struct {
 	// ListEmailActionsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListEmailActions.
 	ListEmailActionsFunc *CodeMonitorStoreListEmailActionsFunc
+	// ListHTTPActionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListHTTPActions.
+	ListHTTPActionsFunc *CodeMonitorStoreListHTTPActionsFunc
 	// ListMonitorsFunc is an instance of a mock function object controlling
 	// the behavior of the method ListMonitors.
 	ListMonitorsFunc *CodeMonitorStoreListMonitorsFunc
@@ -162,6 +180,9 @@ type MockCodeMonitorStore struct {
 	// object controlling the behavior of the method
 	// ResetQueryTriggerTimestamps.
 	ResetQueryTriggerTimestampsFunc *CodeMonitorStoreResetQueryTriggerTimestampsFunc
+	// SetContentSnapshotFunc is an instance of a mock function object
+	// controlling the behavior of the method SetContentSnapshot.
+	SetContentSnapshotFunc *CodeMonitorStoreSetContentSnapshotFunc
 	// SetQueryTriggerNextRunFunc is an instance of a mock function object
 	// controlling the behavior of the method SetQueryTriggerNextRun.
 	SetQueryTriggerNextRunFunc *CodeMonitorStoreSetQueryTriggerNextRunFunc
@@ -171,6 +192,9 @@ type MockCodeMonitorStore struct {
 	// UpdateEmailActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateEmailAction.
 	UpdateEmailActionFunc *CodeMonitorStoreUpdateEmailActionFunc
+	// UpdateHTTPActionFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateHTTPAction.
+	UpdateHTTPActionFunc *CodeMonitorStoreUpdateHTTPActionFunc
 	// UpdateMonitorFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateMonitor.
 	UpdateMonitorFunc *CodeMonitorStoreUpdateMonitorFunc
@@ -183,6 +207,10 @@ type MockCodeMonitorStore struct {
 	// UpdateSlackWebhookActionFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateSlackWebhookAction.
 	UpdateSlackWebhookActionFunc *CodeMonitorStoreUpdateSlackWebhookActionFunc
+	// UpdateTriggerJobWithContentChangesFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// UpdateTriggerJobWithContentChanges.
+	UpdateTriggerJobWithContentChangesFunc *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc
 	// UpdateTriggerJobWithResultsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// UpdateTriggerJobWithResults.
@@ -210,6 +238,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CountHTTPActionsFunc: &CodeMonitorStoreCountHTTPActionsFunc{
+			defaultHook: func(context.Context, int64) (r0 int, r1 error) {
+				return
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, int32) (r0 int32, r1 error) {
 				return
@@ -240,13 +273,18 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		CreateHTTPActionFunc: &CodeMonitorStoreCreateHTTPActionFunc{
+			defaultHook: func(context.Context, int64, *HTTPActionArgs) (r0 *HTTPAction, r1 error) {
+				return
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, MonitorArgs) (r0 *Monitor, r1 error) {
 				return
 			},
 		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (r0 *QueryTrigger, r1 error) {
+			defaultHook: func(context.Context, int64, string, QueryTriggerMode) (r0 *QueryTrigger, r1 error) {
 				return
 			},
 		},
@@ -270,6 +308,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		DeleteHTTPActionsFunc: &CodeMonitorStoreDeleteHTTPActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) (r0 error) {
+				return
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) (r0 error) {
 				return
@@ -325,11 +368,21 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (r0 map[api.RepoID][]*ContentMatch, r1 bool, r2 error) {
+				return
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (r0 *EmailAction, r1 error) {
 				return
 			},
 		},
+		GetHTTPActionFunc: &CodeMonitorStoreGetHTTPActionFunc{
+			defaultHook: func(context.Context, int64) (r0 *HTTPAction, r1 error) {
+				return
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) (r0 []string, r1 error) {
 				return
@@ -380,6 +433,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		ListHTTPActionsFunc: &CodeMonitorStoreListHTTPActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) (r0 []*HTTPAction, r1 error) {
+				return
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, ListMonitorsOpts) (r0 []*Monitor, r1 error) {
 				return
@@ -415,6 +473,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		SetContentSnapshotFunc: &CodeMonitorStoreSetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, map[api.RepoID][]*ContentMatch) (r0 error) {
+				return
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) (r0 error) {
 				return
@@ -430,6 +493,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateHTTPActionFunc: &CodeMonitorStoreUpdateHTTPActionFunc{
+			defaultHook: func(context.Context, int64, *HTTPActionArgs) (r0 *HTTPAction, r1 error) {
+				return
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, MonitorArgs) (r0 *Monitor, r1 error) {
 				return
@@ -441,7 +509,7 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (r0 error) {
+			defaultHook: func(context.Context, int64, string, QueryTriggerMode) (r0 error) {
 				return
 			},
 		},
@@ -450,6 +518,11 @@ func NewMockCodeMonitorStore() *MockCodeMonitorStore {
 				return
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []*ContentChange) (r0 error) {
+				return
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) (r0 error) {
 				return
@@ -482,6 +555,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CountActionJobs")
 			},
 		},
+		CountHTTPActionsFunc: &CodeMonitorStoreCountHTTPActionsFunc{
+			defaultHook: func(context.Context, int64) (int, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CountHTTPActions")
+			},
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: func(context.Context, int32) (int32, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CountMonitors")
@@ -512,13 +590,18 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateEmailAction")
 			},
 		},
+		CreateHTTPActionFunc: &CodeMonitorStoreCreateHTTPActionFunc{
+			defaultHook: func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.CreateHTTPAction")
+			},
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: func(context.Context, MonitorArgs) (*Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateMonitor")
 			},
 		},
 		CreateQueryTriggerFunc: &CodeMonitorStoreCreateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) (*QueryTrigger, error) {
+			defaultHook: func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.CreateQueryTrigger")
 			},
 		},
@@ -542,6 +625,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteEmailActions")
 			},
 		},
+		DeleteHTTPActionsFunc: &CodeMonitorStoreDeleteHTTPActionsFunc{
+			defaultHook: func(context.Context, int64, ...int64) error {
+				panic("unexpected invocation of MockCodeMonitorStore.DeleteHTTPActions")
+			},
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: func(context.Context, int64) error {
 				panic("unexpected invocation of MockCodeMonitorStore.DeleteMonitor")
@@ -597,11 +685,21 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.GetActionJobMetadata")
 			},
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetContentSnapshot")
+			},
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: func(context.Context, int64) (*EmailAction, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetEmailAction")
 			},
 		},
+		GetHTTPActionFunc: &CodeMonitorStoreGetHTTPActionFunc{
+			defaultHook: func(context.Context, int64) (*HTTPAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.GetHTTPAction")
+			},
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: func(context.Context, int64, api.RepoID) ([]string, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.GetLastSearched")
@@ -652,6 +750,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ListEmailActions")
 			},
 		},
+		ListHTTPActionsFunc: &CodeMonitorStoreListHTTPActionsFunc{
+			defaultHook: func(context.Context, ListActionsOpts) ([]*HTTPAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.ListHTTPActions")
+			},
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.ListMonitors")
@@ -687,6 +790,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.ResetQueryTriggerTimestamps")
 			},
 		},
+		SetContentSnapshotFunc: &CodeMonitorStoreSetContentSnapshotFunc{
+			defaultHook: func(context.Context, int64, map[api.RepoID][]*ContentMatch) error {
+				panic("unexpected invocation of MockCodeMonitorStore.SetContentSnapshot")
+			},
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: func(context.Context, int64, time.Time, time.Time) error {
 				panic("unexpected invocation of MockCodeMonitorStore.SetQueryTriggerNextRun")
@@ -702,6 +810,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateEmailAction")
 			},
 		},
+		UpdateHTTPActionFunc: &CodeMonitorStoreUpdateHTTPActionFunc{
+			defaultHook: func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateHTTPAction")
+			},
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: func(context.Context, int64, MonitorArgs) (*Monitor, error) {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateMonitor")
@@ -713,7 +826,7 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 			},
 		},
 		UpdateQueryTriggerFunc: &CodeMonitorStoreUpdateQueryTriggerFunc{
-			defaultHook: func(context.Context, int64, string) error {
+			defaultHook: func(context.Context, int64, string, QueryTriggerMode) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateQueryTrigger")
 			},
 		},
@@ -722,6 +835,11 @@ func NewStrictMockCodeMonitorStore() *MockCodeMonitorStore {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateSlackWebhookAction")
 			},
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: func(context.Context, int32, string, []*ContentChange) error {
+				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithContentChanges")
+			},
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: func(context.Context, int32, string, []*result.CommitMatch) error {
 				panic("unexpected invocation of MockCodeMonitorStore.UpdateTriggerJobWithResults")
@@ -751,6 +869,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CountActionJobsFunc: &CodeMonitorStoreCountActionJobsFunc{
 			defaultHook: i.CountActionJobs,
 		},
+		CountHTTPActionsFunc: &CodeMonitorStoreCountHTTPActionsFunc{
+			defaultHook: i.CountHTTPActions,
+		},
 		CountMonitorsFunc: &CodeMonitorStoreCountMonitorsFunc{
 			defaultHook: i.CountMonitors,
 		},
@@ -769,6 +890,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		CreateEmailActionFunc: &CodeMonitorStoreCreateEmailActionFunc{
 			defaultHook: i.CreateEmailAction,
 		},
+		CreateHTTPActionFunc: &CodeMonitorStoreCreateHTTPActionFunc{
+			defaultHook: i.CreateHTTPAction,
+		},
 		CreateMonitorFunc: &CodeMonitorStoreCreateMonitorFunc{
 			defaultHook: i.CreateMonitor,
 		},
@@ -787,6 +911,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		DeleteEmailActionsFunc: &CodeMonitorStoreDeleteEmailActionsFunc{
 			defaultHook: i.DeleteEmailActions,
 		},
+		DeleteHTTPActionsFunc: &CodeMonitorStoreDeleteHTTPActionsFunc{
+			defaultHook: i.DeleteHTTPActions,
+		},
 		DeleteMonitorFunc: &CodeMonitorStoreDeleteMonitorFunc{
 			defaultHook: i.DeleteMonitor,
 		},
@@ -820,9 +947,15 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		GetActionJobMetadataFunc: &CodeMonitorStoreGetActionJobMetadataFunc{
 			defaultHook: i.GetActionJobMetadata,
 		},
+		GetContentSnapshotFunc: &CodeMonitorStoreGetContentSnapshotFunc{
+			defaultHook: i.GetContentSnapshot,
+		},
 		GetEmailActionFunc: &CodeMonitorStoreGetEmailActionFunc{
 			defaultHook: i.GetEmailAction,
 		},
+		GetHTTPActionFunc: &CodeMonitorStoreGetHTTPActionFunc{
+			defaultHook: i.GetHTTPAction,
+		},
 		GetLastSearchedFunc: &CodeMonitorStoreGetLastSearchedFunc{
 			defaultHook: i.GetLastSearched,
 		},
@@ -853,6 +986,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		ListEmailActionsFunc: &CodeMonitorStoreListEmailActionsFunc{
 			defaultHook: i.ListEmailActions,
 		},
+		ListHTTPActionsFunc: &CodeMonitorStoreListHTTPActionsFunc{
+			defaultHook: i.ListHTTPActions,
+		},
 		ListMonitorsFunc: &CodeMonitorStoreListMonitorsFunc{
 			defaultHook: i.ListMonitors,
 		},
@@ -874,6 +1010,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		ResetQueryTriggerTimestampsFunc: &CodeMonitorStoreResetQueryTriggerTimestampsFunc{
 			defaultHook: i.ResetQueryTriggerTimestamps,
 		},
+		SetContentSnapshotFunc: &CodeMonitorStoreSetContentSnapshotFunc{
+			defaultHook: i.SetContentSnapshot,
+		},
 		SetQueryTriggerNextRunFunc: &CodeMonitorStoreSetQueryTriggerNextRunFunc{
 			defaultHook: i.SetQueryTriggerNextRun,
 		},
@@ -883,6 +1022,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpdateEmailActionFunc: &CodeMonitorStoreUpdateEmailActionFunc{
 			defaultHook: i.UpdateEmailAction,
 		},
+		UpdateHTTPActionFunc: &CodeMonitorStoreUpdateHTTPActionFunc{
+			defaultHook: i.UpdateHTTPAction,
+		},
 		UpdateMonitorFunc: &CodeMonitorStoreUpdateMonitorFunc{
 			defaultHook: i.UpdateMonitor,
 		},
@@ -895,6 +1037,9 @@ func NewMockCodeMonitorStoreFrom(i CodeMonitorStore) *MockCodeMonitorStore {
 		UpdateSlackWebhookActionFunc: &CodeMonitorStoreUpdateSlackWebhookActionFunc{
 			defaultHook: i.UpdateSlackWebhookAction,
 		},
+		UpdateTriggerJobWithContentChangesFunc: &CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc{
+			defaultHook: i.UpdateTriggerJobWithContentChanges,
+		},
 		UpdateTriggerJobWithResultsFunc: &CodeMonitorStoreUpdateTriggerJobWithResultsFunc{
 			defaultHook: i.UpdateTriggerJobWithResults,
 		},
@@ -1117,6 +1262,117 @@ func (c CodeMonitorStoreCountActionJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCountHTTPActionsFunc describes the behavior when the
+// CountHTTPActions method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCountHTTPActionsFunc struct {
+	defaultHook func(context.Context, int64) (int, error)
+	hooks       []func(context.Context, int64) (int, error)
+	history     []CodeMonitorStoreCountHTTPActionsFuncCall
+	mutex       sync.Mutex
+}
+
+// CountHTTPActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CountHTTPActions(v0 context.Context, v1 int64) (int, error) {
+	r0, r1 := m.CountHTTPActionsFunc.nextHook()(v0, v1)
+	m.CountHTTPActionsFunc.appendCall(CodeMonitorStoreCountHTTPActionsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CountHTTPActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCountHTTPActionsFunc) SetDefaultHook(hook func(context.Context, int64) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CountHTTPActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCountHTTPActionsFunc) PushHook(hook func(context.Context, int64) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCountHTTPActionsFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCountHTTPActionsFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, int64) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCountHTTPActionsFunc) nextHook() func(context.Context, int64) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCountHTTPActionsFunc) appendCall(r0 CodeMonitorStoreCountHTTPActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCountHTTPActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCountHTTPActionsFunc) History() []CodeMonitorStoreCountHTTPActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCountHTTPActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCountHTTPActionsFuncCall is an object that describes an
+// invocation of method CountHTTPActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCountHTTPActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCountHTTPActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCountHTTPActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCountMonitorsFunc describes the behavior when the
 // CountMonitors method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -1787,6 +2043,120 @@ func (c CodeMonitorStoreCreateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreCreateHTTPActionFunc describes the behavior when the
+// CreateHTTPAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreCreateHTTPActionFunc struct {
+	defaultHook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)
+	hooks       []func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)
+	history     []CodeMonitorStoreCreateHTTPActionFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateHTTPAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) CreateHTTPAction(v0 context.Context, v1 int64, v2 *HTTPActionArgs) (*HTTPAction, error) {
+	r0, r1 := m.CreateHTTPActionFunc.nextHook()(v0, v1, v2)
+	m.CreateHTTPActionFunc.appendCall(CodeMonitorStoreCreateHTTPActionFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreateHTTPAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreCreateHTTPActionFunc) SetDefaultHook(hook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateHTTPAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreCreateHTTPActionFunc) PushHook(hook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreCreateHTTPActionFunc) SetDefaultReturn(r0 *HTTPAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreCreateHTTPActionFunc) PushReturn(r0 *HTTPAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreCreateHTTPActionFunc) nextHook() func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreCreateHTTPActionFunc) appendCall(r0 CodeMonitorStoreCreateHTTPActionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreCreateHTTPActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreCreateHTTPActionFunc) History() []CodeMonitorStoreCreateHTTPActionFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreCreateHTTPActionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreCreateHTTPActionFuncCall is an object that describes an
+// invocation of method CreateHTTPAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreCreateHTTPActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *HTTPActionArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *HTTPAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreCreateHTTPActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreCreateHTTPActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
 // CodeMonitorStoreCreateMonitorFunc describes the behavior when the
 // CreateMonitor method of the parent MockCodeMonitorStore instance is
 // invoked.
@@ -1901,24 +2271,24 @@ func (c CodeMonitorStoreCreateMonitorFuncCall) Results() []interface{} {
 // CreateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreCreateQueryTriggerFunc struct {
-	defaultHook func(context.Context, int64, string) (*QueryTrigger, error)
-	hooks       []func(context.Context, int64, string) (*QueryTrigger, error)
+	defaultHook func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error)
+	hooks       []func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error)
 	history     []CodeMonitorStoreCreateQueryTriggerFuncCall
 	mutex       sync.Mutex
 }
 
 // CreateQueryTrigger delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) CreateQueryTrigger(v0 context.Context, v1 int64, v2 string) (*QueryTrigger, error) {
-	r0, r1 := m.CreateQueryTriggerFunc.nextHook()(v0, v1, v2)
-	m.CreateQueryTriggerFunc.appendCall(CodeMonitorStoreCreateQueryTriggerFuncCall{v0, v1, v2, r0, r1})
+func (m *MockCodeMonitorStore) CreateQueryTrigger(v0 context.Context, v1 int64, v2 string, v3 QueryTriggerMode) (*QueryTrigger, error) {
+	r0, r1 := m.CreateQueryTriggerFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateQueryTriggerFunc.appendCall(CodeMonitorStoreCreateQueryTriggerFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the CreateQueryTrigger
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string) (*QueryTrigger, error)) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error)) {
 	f.defaultHook = hook
 }
 
@@ -1927,7 +2297,7 @@ func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultHook(hook func(contex
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string) (*QueryTrigger, error)) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -1936,19 +2306,19 @@ func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushHook(hook func(context.Cont
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreCreateQueryTriggerFunc) SetDefaultReturn(r0 *QueryTrigger, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, string) (*QueryTrigger, error) {
+	f.SetDefaultHook(func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreCreateQueryTriggerFunc) PushReturn(r0 *QueryTrigger, r1 error) {
-	f.PushHook(func(context.Context, int64, string) (*QueryTrigger, error) {
+	f.PushHook(func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreCreateQueryTriggerFunc) nextHook() func(context.Context, int64, string) (*QueryTrigger, error) {
+func (f *CodeMonitorStoreCreateQueryTriggerFunc) nextHook() func(context.Context, int64, string, QueryTriggerMode) (*QueryTrigger, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -1991,6 +2361,9 @@ type CodeMonitorStoreCreateQueryTriggerFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 QueryTriggerMode
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 *QueryTrigger
@@ -2002,7 +2375,7 @@ type CodeMonitorStoreCreateQueryTriggerFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreCreateQueryTriggerFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
@@ -2480,35 +2853,153 @@ func (c CodeMonitorStoreDeleteEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// CodeMonitorStoreDeleteMonitorFunc describes the behavior when the
-// DeleteMonitor method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreDeleteHTTPActionsFunc describes the behavior when the
+// DeleteHTTPActions method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreDeleteMonitorFunc struct {
-	defaultHook func(context.Context, int64) error
-	hooks       []func(context.Context, int64) error
-	history     []CodeMonitorStoreDeleteMonitorFuncCall
+type CodeMonitorStoreDeleteHTTPActionsFunc struct {
+	defaultHook func(context.Context, int64, ...int64) error
+	hooks       []func(context.Context, int64, ...int64) error
+	history     []CodeMonitorStoreDeleteHTTPActionsFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteMonitor delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) DeleteMonitor(v0 context.Context, v1 int64) error {
-	r0 := m.DeleteMonitorFunc.nextHook()(v0, v1)
-	m.DeleteMonitorFunc.appendCall(CodeMonitorStoreDeleteMonitorFuncCall{v0, v1, r0})
+// DeleteHTTPActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteHTTPActions(v0 context.Context, v1 int64, v2 ...int64) error {
+	r0 := m.DeleteHTTPActionsFunc.nextHook()(v0, v1, v2...)
+	m.DeleteHTTPActionsFunc.appendCall(CodeMonitorStoreDeleteHTTPActionsFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the DeleteMonitor method
-// of the parent MockCodeMonitorStore instance is invoked and the hook queue
-// is empty.
-func (f *CodeMonitorStoreDeleteMonitorFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+// SetDefaultHook sets function that is called when the DeleteHTTPActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) SetDefaultHook(hook func(context.Context, int64, ...int64) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// DeleteMonitor method of the parent MockCodeMonitorStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
+// DeleteHTTPActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) PushHook(hook func(context.Context, int64, ...int64) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, ...int64) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) nextHook() func(context.Context, int64, ...int64) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) appendCall(r0 CodeMonitorStoreDeleteHTTPActionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreDeleteHTTPActionsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreDeleteHTTPActionsFunc) History() []CodeMonitorStoreDeleteHTTPActionsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreDeleteHTTPActionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreDeleteHTTPActionsFuncCall is an object that describes an
+// invocation of method DeleteHTTPActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreDeleteHTTPActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeMonitorStoreDeleteHTTPActionsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreDeleteHTTPActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeMonitorStoreDeleteMonitorFunc describes the behavior when the
+// DeleteMonitor method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreDeleteMonitorFunc struct {
+	defaultHook func(context.Context, int64) error
+	hooks       []func(context.Context, int64) error
+	history     []CodeMonitorStoreDeleteMonitorFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteMonitor delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) DeleteMonitor(v0 context.Context, v1 int64) error {
+	r0 := m.DeleteMonitorFunc.nextHook()(v0, v1)
+	m.DeleteMonitorFunc.appendCall(CodeMonitorStoreDeleteMonitorFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteMonitor method
+// of the parent MockCodeMonitorStore instance is invoked and the hook queue
+// is empty.
+func (f *CodeMonitorStoreDeleteMonitorFunc) SetDefaultHook(hook func(context.Context, int64) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteMonitor method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
 func (f *CodeMonitorStoreDeleteMonitorFunc) PushHook(hook func(context.Context, int64) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
@@ -3694,36 +4185,37 @@ func (c CodeMonitorStoreGetActionJobMetadataFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreGetEmailActionFunc describes the behavior when the
-// GetEmailAction method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreGetContentSnapshotFunc describes the behavior when the
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreGetEmailActionFunc struct {
-	defaultHook func(context.Context, int64) (*EmailAction, error)
-	hooks       []func(context.Context, int64) (*EmailAction, error)
-	history     []CodeMonitorStoreGetEmailActionFuncCall
+type CodeMonitorStoreGetContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error)
+	hooks       []func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error)
+	history     []CodeMonitorStoreGetContentSnapshotFuncCall
 	mutex       sync.Mutex
 }
 
-// GetEmailAction delegates to the next hook function in the queue and
+// GetContentSnapshot delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) GetEmailAction(v0 context.Context, v1 int64) (*EmailAction, error) {
-	r0, r1 := m.GetEmailActionFunc.nextHook()(v0, v1)
-	m.GetEmailActionFunc.appendCall(CodeMonitorStoreGetEmailActionFuncCall{v0, v1, r0, r1})
-	return r0, r1
+func (m *MockCodeMonitorStore) GetContentSnapshot(v0 context.Context, v1 int64) (map[api.RepoID][]*ContentMatch, bool, error) {
+	r0, r1, r2 := m.GetContentSnapshotFunc.nextHook()(v0, v1)
+	m.GetContentSnapshotFunc.appendCall(CodeMonitorStoreGetContentSnapshotFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
 }
 
-// SetDefaultHook sets function that is called when the GetEmailAction
+// SetDefaultHook sets function that is called when the GetContentSnapshot
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreGetEmailActionFunc) SetDefaultHook(hook func(context.Context, int64) (*EmailAction, error)) {
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetEmailAction method of the parent MockCodeMonitorStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *CodeMonitorStoreGetEmailActionFunc) PushHook(hook func(context.Context, int64) (*EmailAction, error)) {
+// GetContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushHook(hook func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3731,20 +4223,20 @@ func (f *CodeMonitorStoreGetEmailActionFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreGetEmailActionFunc) SetDefaultReturn(r0 *EmailAction, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) (*EmailAction, error) {
-		return r0, r1
+func (f *CodeMonitorStoreGetContentSnapshotFunc) SetDefaultReturn(r0 map[api.RepoID][]*ContentMatch, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error) {
+		return r0, r1, r2
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreGetEmailActionFunc) PushReturn(r0 *EmailAction, r1 error) {
-	f.PushHook(func(context.Context, int64) (*EmailAction, error) {
-		return r0, r1
+func (f *CodeMonitorStoreGetContentSnapshotFunc) PushReturn(r0 map[api.RepoID][]*ContentMatch, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error) {
+		return r0, r1, r2
 	})
 }
 
-func (f *CodeMonitorStoreGetEmailActionFunc) nextHook() func(context.Context, int64) (*EmailAction, error) {
+func (f *CodeMonitorStoreGetContentSnapshotFunc) nextHook() func(context.Context, int64) (map[api.RepoID][]*ContentMatch, bool, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3757,27 +4249,27 @@ func (f *CodeMonitorStoreGetEmailActionFunc) nextHook() func(context.Context, in
 	return hook
 }
 
-func (f *CodeMonitorStoreGetEmailActionFunc) appendCall(r0 CodeMonitorStoreGetEmailActionFuncCall) {
+func (f *CodeMonitorStoreGetContentSnapshotFunc) appendCall(r0 CodeMonitorStoreGetContentSnapshotFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreGetEmailActionFuncCall
+// History returns a sequence of CodeMonitorStoreGetContentSnapshotFuncCall
 // objects describing the invocations of this function.
-func (f *CodeMonitorStoreGetEmailActionFunc) History() []CodeMonitorStoreGetEmailActionFuncCall {
+func (f *CodeMonitorStoreGetContentSnapshotFunc) History() []CodeMonitorStoreGetContentSnapshotFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreGetEmailActionFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreGetContentSnapshotFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreGetEmailActionFuncCall is an object that describes an
-// invocation of method GetEmailAction on an instance of
+// CodeMonitorStoreGetContentSnapshotFuncCall is an object that describes an
+// invocation of method GetContentSnapshot on an instance of
 // MockCodeMonitorStore.
-type CodeMonitorStoreGetEmailActionFuncCall struct {
+type CodeMonitorStoreGetContentSnapshotFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -3786,55 +4278,57 @@ type CodeMonitorStoreGetEmailActionFuncCall struct {
 	Arg1 int64
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *EmailAction
+	Result0 map[api.RepoID][]*ContentMatch
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
-	Result1 error
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreGetEmailActionFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreGetEmailActionFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c CodeMonitorStoreGetContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// CodeMonitorStoreGetLastSearchedFunc describes the behavior when the
-// GetLastSearched method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreGetEmailActionFunc describes the behavior when the
+// GetEmailAction method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreGetLastSearchedFunc struct {
-	defaultHook func(context.Context, int64, api.RepoID) ([]string, error)
-	hooks       []func(context.Context, int64, api.RepoID) ([]string, error)
-	history     []CodeMonitorStoreGetLastSearchedFuncCall
+type CodeMonitorStoreGetEmailActionFunc struct {
+	defaultHook func(context.Context, int64) (*EmailAction, error)
+	hooks       []func(context.Context, int64) (*EmailAction, error)
+	history     []CodeMonitorStoreGetEmailActionFuncCall
 	mutex       sync.Mutex
 }
 
-// GetLastSearched delegates to the next hook function in the queue and
+// GetEmailAction delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) GetLastSearched(v0 context.Context, v1 int64, v2 api.RepoID) ([]string, error) {
-	r0, r1 := m.GetLastSearchedFunc.nextHook()(v0, v1, v2)
-	m.GetLastSearchedFunc.appendCall(CodeMonitorStoreGetLastSearchedFuncCall{v0, v1, v2, r0, r1})
+func (m *MockCodeMonitorStore) GetEmailAction(v0 context.Context, v1 int64) (*EmailAction, error) {
+	r0, r1 := m.GetEmailActionFunc.nextHook()(v0, v1)
+	m.GetEmailActionFunc.appendCall(CodeMonitorStoreGetEmailActionFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetLastSearched
+// SetDefaultHook sets function that is called when the GetEmailAction
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreGetLastSearchedFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID) ([]string, error)) {
+func (f *CodeMonitorStoreGetEmailActionFunc) SetDefaultHook(hook func(context.Context, int64) (*EmailAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetLastSearched method of the parent MockCodeMonitorStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *CodeMonitorStoreGetLastSearchedFunc) PushHook(hook func(context.Context, int64, api.RepoID) ([]string, error)) {
+// GetEmailAction method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetEmailActionFunc) PushHook(hook func(context.Context, int64) (*EmailAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3842,20 +4336,20 @@ func (f *CodeMonitorStoreGetLastSearchedFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreGetLastSearchedFunc) SetDefaultReturn(r0 []string, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, api.RepoID) ([]string, error) {
+func (f *CodeMonitorStoreGetEmailActionFunc) SetDefaultReturn(r0 *EmailAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*EmailAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreGetLastSearchedFunc) PushReturn(r0 []string, r1 error) {
-	f.PushHook(func(context.Context, int64, api.RepoID) ([]string, error) {
+func (f *CodeMonitorStoreGetEmailActionFunc) PushReturn(r0 *EmailAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*EmailAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreGetLastSearchedFunc) nextHook() func(context.Context, int64, api.RepoID) ([]string, error) {
+func (f *CodeMonitorStoreGetEmailActionFunc) nextHook() func(context.Context, int64) (*EmailAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3868,39 +4362,36 @@ func (f *CodeMonitorStoreGetLastSearchedFunc) nextHook() func(context.Context, i
 	return hook
 }
 
-func (f *CodeMonitorStoreGetLastSearchedFunc) appendCall(r0 CodeMonitorStoreGetLastSearchedFuncCall) {
+func (f *CodeMonitorStoreGetEmailActionFunc) appendCall(r0 CodeMonitorStoreGetEmailActionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreGetLastSearchedFuncCall
+// History returns a sequence of CodeMonitorStoreGetEmailActionFuncCall
 // objects describing the invocations of this function.
-func (f *CodeMonitorStoreGetLastSearchedFunc) History() []CodeMonitorStoreGetLastSearchedFuncCall {
+func (f *CodeMonitorStoreGetEmailActionFunc) History() []CodeMonitorStoreGetEmailActionFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreGetLastSearchedFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreGetEmailActionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreGetLastSearchedFuncCall is an object that describes an
-// invocation of method GetLastSearched on an instance of
+// CodeMonitorStoreGetEmailActionFuncCall is an object that describes an
+// invocation of method GetEmailAction on an instance of
 // MockCodeMonitorStore.
-type CodeMonitorStoreGetLastSearchedFuncCall struct {
+type CodeMonitorStoreGetEmailActionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 int64
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []string
+	Result0 *EmailAction
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -3908,45 +4399,46 @@ type CodeMonitorStoreGetLastSearchedFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreGetLastSearchedFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c CodeMonitorStoreGetEmailActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreGetLastSearchedFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreGetEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreGetMonitorFunc describes the behavior when the GetMonitor
-// method of the parent MockCodeMonitorStore instance is invoked.
-type CodeMonitorStoreGetMonitorFunc struct {
-	defaultHook func(context.Context, int64) (*Monitor, error)
-	hooks       []func(context.Context, int64) (*Monitor, error)
-	history     []CodeMonitorStoreGetMonitorFuncCall
+// CodeMonitorStoreGetHTTPActionFunc describes the behavior when the
+// GetHTTPAction method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetHTTPActionFunc struct {
+	defaultHook func(context.Context, int64) (*HTTPAction, error)
+	hooks       []func(context.Context, int64) (*HTTPAction, error)
+	history     []CodeMonitorStoreGetHTTPActionFuncCall
 	mutex       sync.Mutex
 }
 
-// GetMonitor delegates to the next hook function in the queue and stores
+// GetHTTPAction delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) GetMonitor(v0 context.Context, v1 int64) (*Monitor, error) {
-	r0, r1 := m.GetMonitorFunc.nextHook()(v0, v1)
-	m.GetMonitorFunc.appendCall(CodeMonitorStoreGetMonitorFuncCall{v0, v1, r0, r1})
+func (m *MockCodeMonitorStore) GetHTTPAction(v0 context.Context, v1 int64) (*HTTPAction, error) {
+	r0, r1 := m.GetHTTPActionFunc.nextHook()(v0, v1)
+	m.GetHTTPActionFunc.appendCall(CodeMonitorStoreGetHTTPActionFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetMonitor method of
-// the parent MockCodeMonitorStore instance is invoked and the hook queue is
-// empty.
-func (f *CodeMonitorStoreGetMonitorFunc) SetDefaultHook(hook func(context.Context, int64) (*Monitor, error)) {
+// SetDefaultHook sets function that is called when the GetHTTPAction method
+// of the parent MockCodeMonitorStore instance is invoked and the hook queue
+// is empty.
+func (f *CodeMonitorStoreGetHTTPActionFunc) SetDefaultHook(hook func(context.Context, int64) (*HTTPAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetMonitor method of the parent MockCodeMonitorStore instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *CodeMonitorStoreGetMonitorFunc) PushHook(hook func(context.Context, int64) (*Monitor, error)) {
+// GetHTTPAction method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetHTTPActionFunc) PushHook(hook func(context.Context, int64) (*HTTPAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -3954,20 +4446,20 @@ func (f *CodeMonitorStoreGetMonitorFunc) PushHook(hook func(context.Context, int
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreGetMonitorFunc) SetDefaultReturn(r0 *Monitor, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64) (*Monitor, error) {
+func (f *CodeMonitorStoreGetHTTPActionFunc) SetDefaultReturn(r0 *HTTPAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreGetMonitorFunc) PushReturn(r0 *Monitor, r1 error) {
-	f.PushHook(func(context.Context, int64) (*Monitor, error) {
+func (f *CodeMonitorStoreGetHTTPActionFunc) PushReturn(r0 *HTTPAction, r1 error) {
+	f.PushHook(func(context.Context, int64) (*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreGetMonitorFunc) nextHook() func(context.Context, int64) (*Monitor, error) {
+func (f *CodeMonitorStoreGetHTTPActionFunc) nextHook() func(context.Context, int64) (*HTTPAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -3980,24 +4472,247 @@ func (f *CodeMonitorStoreGetMonitorFunc) nextHook() func(context.Context, int64)
 	return hook
 }
 
-func (f *CodeMonitorStoreGetMonitorFunc) appendCall(r0 CodeMonitorStoreGetMonitorFuncCall) {
+func (f *CodeMonitorStoreGetHTTPActionFunc) appendCall(r0 CodeMonitorStoreGetHTTPActionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreGetMonitorFuncCall objects
-// describing the invocations of this function.
-func (f *CodeMonitorStoreGetMonitorFunc) History() []CodeMonitorStoreGetMonitorFuncCall {
+// History returns a sequence of CodeMonitorStoreGetHTTPActionFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetHTTPActionFunc) History() []CodeMonitorStoreGetHTTPActionFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreGetMonitorFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreGetHTTPActionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreGetMonitorFuncCall is an object that describes an
+// CodeMonitorStoreGetHTTPActionFuncCall is an object that describes an
+// invocation of method GetHTTPAction on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetHTTPActionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *HTTPAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetHTTPActionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetHTTPActionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetLastSearchedFunc describes the behavior when the
+// GetLastSearched method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreGetLastSearchedFunc struct {
+	defaultHook func(context.Context, int64, api.RepoID) ([]string, error)
+	hooks       []func(context.Context, int64, api.RepoID) ([]string, error)
+	history     []CodeMonitorStoreGetLastSearchedFuncCall
+	mutex       sync.Mutex
+}
+
+// GetLastSearched delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetLastSearched(v0 context.Context, v1 int64, v2 api.RepoID) ([]string, error) {
+	r0, r1 := m.GetLastSearchedFunc.nextHook()(v0, v1, v2)
+	m.GetLastSearchedFunc.appendCall(CodeMonitorStoreGetLastSearchedFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetLastSearched
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreGetLastSearchedFunc) SetDefaultHook(hook func(context.Context, int64, api.RepoID) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetLastSearched method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreGetLastSearchedFunc) PushHook(hook func(context.Context, int64, api.RepoID) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetLastSearchedFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, api.RepoID) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetLastSearchedFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, int64, api.RepoID) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetLastSearchedFunc) nextHook() func(context.Context, int64, api.RepoID) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetLastSearchedFunc) appendCall(r0 CodeMonitorStoreGetLastSearchedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetLastSearchedFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreGetLastSearchedFunc) History() []CodeMonitorStoreGetLastSearchedFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetLastSearchedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetLastSearchedFuncCall is an object that describes an
+// invocation of method GetLastSearched on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreGetLastSearchedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.RepoID
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreGetLastSearchedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreGetLastSearchedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreGetMonitorFunc describes the behavior when the GetMonitor
+// method of the parent MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreGetMonitorFunc struct {
+	defaultHook func(context.Context, int64) (*Monitor, error)
+	hooks       []func(context.Context, int64) (*Monitor, error)
+	history     []CodeMonitorStoreGetMonitorFuncCall
+	mutex       sync.Mutex
+}
+
+// GetMonitor delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) GetMonitor(v0 context.Context, v1 int64) (*Monitor, error) {
+	r0, r1 := m.GetMonitorFunc.nextHook()(v0, v1)
+	m.GetMonitorFunc.appendCall(CodeMonitorStoreGetMonitorFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetMonitor method of
+// the parent MockCodeMonitorStore instance is invoked and the hook queue is
+// empty.
+func (f *CodeMonitorStoreGetMonitorFunc) SetDefaultHook(hook func(context.Context, int64) (*Monitor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetMonitor method of the parent MockCodeMonitorStore instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreGetMonitorFunc) PushHook(hook func(context.Context, int64) (*Monitor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreGetMonitorFunc) SetDefaultReturn(r0 *Monitor, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64) (*Monitor, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreGetMonitorFunc) PushReturn(r0 *Monitor, r1 error) {
+	f.PushHook(func(context.Context, int64) (*Monitor, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreGetMonitorFunc) nextHook() func(context.Context, int64) (*Monitor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreGetMonitorFunc) appendCall(r0 CodeMonitorStoreGetMonitorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreGetMonitorFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreGetMonitorFunc) History() []CodeMonitorStoreGetMonitorFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreGetMonitorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreGetMonitorFuncCall is an object that describes an
 // invocation of method GetMonitor on an instance of MockCodeMonitorStore.
 type CodeMonitorStoreGetMonitorFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
@@ -4904,36 +5619,37 @@ func (c CodeMonitorStoreListEmailActionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreListMonitorsFunc describes the behavior when the
-// ListMonitors method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreListHTTPActionsFunc describes the behavior when the
+// ListHTTPActions method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreListMonitorsFunc struct {
-	defaultHook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)
-	hooks       []func(context.Context, ListMonitorsOpts) ([]*Monitor, error)
-	history     []CodeMonitorStoreListMonitorsFuncCall
+type CodeMonitorStoreListHTTPActionsFunc struct {
+	defaultHook func(context.Context, ListActionsOpts) ([]*HTTPAction, error)
+	hooks       []func(context.Context, ListActionsOpts) ([]*HTTPAction, error)
+	history     []CodeMonitorStoreListHTTPActionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListMonitors delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) ListMonitors(v0 context.Context, v1 ListMonitorsOpts) ([]*Monitor, error) {
-	r0, r1 := m.ListMonitorsFunc.nextHook()(v0, v1)
-	m.ListMonitorsFunc.appendCall(CodeMonitorStoreListMonitorsFuncCall{v0, v1, r0, r1})
+// ListHTTPActions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListHTTPActions(v0 context.Context, v1 ListActionsOpts) ([]*HTTPAction, error) {
+	r0, r1 := m.ListHTTPActionsFunc.nextHook()(v0, v1)
+	m.ListHTTPActionsFunc.appendCall(CodeMonitorStoreListHTTPActionsFuncCall{v0, v1, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the ListMonitors method
-// of the parent MockCodeMonitorStore instance is invoked and the hook queue
-// is empty.
-func (f *CodeMonitorStoreListMonitorsFunc) SetDefaultHook(hook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)) {
+// SetDefaultHook sets function that is called when the ListHTTPActions
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreListHTTPActionsFunc) SetDefaultHook(hook func(context.Context, ListActionsOpts) ([]*HTTPAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListMonitors method of the parent MockCodeMonitorStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *CodeMonitorStoreListMonitorsFunc) PushHook(hook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)) {
+// ListHTTPActions method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreListHTTPActionsFunc) PushHook(hook func(context.Context, ListActionsOpts) ([]*HTTPAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -4941,20 +5657,20 @@ func (f *CodeMonitorStoreListMonitorsFunc) PushHook(hook func(context.Context, L
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreListMonitorsFunc) SetDefaultReturn(r0 []*Monitor, r1 error) {
-	f.SetDefaultHook(func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+func (f *CodeMonitorStoreListHTTPActionsFunc) SetDefaultReturn(r0 []*HTTPAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListActionsOpts) ([]*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreListMonitorsFunc) PushReturn(r0 []*Monitor, r1 error) {
-	f.PushHook(func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+func (f *CodeMonitorStoreListHTTPActionsFunc) PushReturn(r0 []*HTTPAction, r1 error) {
+	f.PushHook(func(context.Context, ListActionsOpts) ([]*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreListMonitorsFunc) nextHook() func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+func (f *CodeMonitorStoreListHTTPActionsFunc) nextHook() func(context.Context, ListActionsOpts) ([]*HTTPAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -4967,26 +5683,136 @@ func (f *CodeMonitorStoreListMonitorsFunc) nextHook() func(context.Context, List
 	return hook
 }
 
-func (f *CodeMonitorStoreListMonitorsFunc) appendCall(r0 CodeMonitorStoreListMonitorsFuncCall) {
+func (f *CodeMonitorStoreListHTTPActionsFunc) appendCall(r0 CodeMonitorStoreListHTTPActionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreListMonitorsFuncCall
+// History returns a sequence of CodeMonitorStoreListHTTPActionsFuncCall
 // objects describing the invocations of this function.
-func (f *CodeMonitorStoreListMonitorsFunc) History() []CodeMonitorStoreListMonitorsFuncCall {
+func (f *CodeMonitorStoreListHTTPActionsFunc) History() []CodeMonitorStoreListHTTPActionsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreListMonitorsFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreListHTTPActionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreListMonitorsFuncCall is an object that describes an
-// invocation of method ListMonitors on an instance of MockCodeMonitorStore.
-type CodeMonitorStoreListMonitorsFuncCall struct {
+// CodeMonitorStoreListHTTPActionsFuncCall is an object that describes an
+// invocation of method ListHTTPActions on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreListHTTPActionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 ListActionsOpts
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*HTTPAction
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreListHTTPActionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreListHTTPActionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreListMonitorsFunc describes the behavior when the
+// ListMonitors method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreListMonitorsFunc struct {
+	defaultHook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)
+	hooks       []func(context.Context, ListMonitorsOpts) ([]*Monitor, error)
+	history     []CodeMonitorStoreListMonitorsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListMonitors delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) ListMonitors(v0 context.Context, v1 ListMonitorsOpts) ([]*Monitor, error) {
+	r0, r1 := m.ListMonitorsFunc.nextHook()(v0, v1)
+	m.ListMonitorsFunc.appendCall(CodeMonitorStoreListMonitorsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListMonitors method
+// of the parent MockCodeMonitorStore instance is invoked and the hook queue
+// is empty.
+func (f *CodeMonitorStoreListMonitorsFunc) SetDefaultHook(hook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListMonitors method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreListMonitorsFunc) PushHook(hook func(context.Context, ListMonitorsOpts) ([]*Monitor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreListMonitorsFunc) SetDefaultReturn(r0 []*Monitor, r1 error) {
+	f.SetDefaultHook(func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreListMonitorsFunc) PushReturn(r0 []*Monitor, r1 error) {
+	f.PushHook(func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreListMonitorsFunc) nextHook() func(context.Context, ListMonitorsOpts) ([]*Monitor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreListMonitorsFunc) appendCall(r0 CodeMonitorStoreListMonitorsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreListMonitorsFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreListMonitorsFunc) History() []CodeMonitorStoreListMonitorsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreListMonitorsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreListMonitorsFuncCall is an object that describes an
+// invocation of method ListMonitors on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreListMonitorsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -5666,6 +6492,117 @@ func (c CodeMonitorStoreResetQueryTriggerTimestampsFuncCall) Results() []interfa
 	return []interface{}{c.Result0}
 }
 
+// CodeMonitorStoreSetContentSnapshotFunc describes the behavior when the
+// SetContentSnapshot method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreSetContentSnapshotFunc struct {
+	defaultHook func(context.Context, int64, map[api.RepoID][]*ContentMatch) error
+	hooks       []func(context.Context, int64, map[api.RepoID][]*ContentMatch) error
+	history     []CodeMonitorStoreSetContentSnapshotFuncCall
+	mutex       sync.Mutex
+}
+
+// SetContentSnapshot delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) SetContentSnapshot(v0 context.Context, v1 int64, v2 map[api.RepoID][]*ContentMatch) error {
+	r0 := m.SetContentSnapshotFunc.nextHook()(v0, v1, v2)
+	m.SetContentSnapshotFunc.appendCall(CodeMonitorStoreSetContentSnapshotFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the SetContentSnapshot
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreSetContentSnapshotFunc) SetDefaultHook(hook func(context.Context, int64, map[api.RepoID][]*ContentMatch) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetContentSnapshot method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreSetContentSnapshotFunc) PushHook(hook func(context.Context, int64, map[api.RepoID][]*ContentMatch) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreSetContentSnapshotFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int64, map[api.RepoID][]*ContentMatch) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreSetContentSnapshotFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int64, map[api.RepoID][]*ContentMatch) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreSetContentSnapshotFunc) nextHook() func(context.Context, int64, map[api.RepoID][]*ContentMatch) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreSetContentSnapshotFunc) appendCall(r0 CodeMonitorStoreSetContentSnapshotFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreSetContentSnapshotFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreSetContentSnapshotFunc) History() []CodeMonitorStoreSetContentSnapshotFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreSetContentSnapshotFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreSetContentSnapshotFuncCall is an object that describes an
+// invocation of method SetContentSnapshot on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreSetContentSnapshotFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 map[api.RepoID][]*ContentMatch
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreSetContentSnapshotFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreSetContentSnapshotFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreSetQueryTriggerNextRunFunc describes the behavior when
 // the SetQueryTriggerNextRun method of the parent MockCodeMonitorStore
 // instance is invoked.
@@ -6000,36 +6937,37 @@ func (c CodeMonitorStoreUpdateEmailActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreUpdateMonitorFunc describes the behavior when the
-// UpdateMonitor method of the parent MockCodeMonitorStore instance is
+// CodeMonitorStoreUpdateHTTPActionFunc describes the behavior when the
+// UpdateHTTPAction method of the parent MockCodeMonitorStore instance is
 // invoked.
-type CodeMonitorStoreUpdateMonitorFunc struct {
-	defaultHook func(context.Context, int64, MonitorArgs) (*Monitor, error)
-	hooks       []func(context.Context, int64, MonitorArgs) (*Monitor, error)
-	history     []CodeMonitorStoreUpdateMonitorFuncCall
+type CodeMonitorStoreUpdateHTTPActionFunc struct {
+	defaultHook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)
+	hooks       []func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)
+	history     []CodeMonitorStoreUpdateHTTPActionFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateMonitor delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateMonitor(v0 context.Context, v1 int64, v2 MonitorArgs) (*Monitor, error) {
-	r0, r1 := m.UpdateMonitorFunc.nextHook()(v0, v1, v2)
-	m.UpdateMonitorFunc.appendCall(CodeMonitorStoreUpdateMonitorFuncCall{v0, v1, v2, r0, r1})
+// UpdateHTTPAction delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateHTTPAction(v0 context.Context, v1 int64, v2 *HTTPActionArgs) (*HTTPAction, error) {
+	r0, r1 := m.UpdateHTTPActionFunc.nextHook()(v0, v1, v2)
+	m.UpdateHTTPActionFunc.appendCall(CodeMonitorStoreUpdateHTTPActionFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the UpdateMonitor method
-// of the parent MockCodeMonitorStore instance is invoked and the hook queue
-// is empty.
-func (f *CodeMonitorStoreUpdateMonitorFunc) SetDefaultHook(hook func(context.Context, int64, MonitorArgs) (*Monitor, error)) {
+// SetDefaultHook sets function that is called when the UpdateHTTPAction
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) SetDefaultHook(hook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateMonitor method of the parent MockCodeMonitorStore instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *CodeMonitorStoreUpdateMonitorFunc) PushHook(hook func(context.Context, int64, MonitorArgs) (*Monitor, error)) {
+// UpdateHTTPAction method of the parent MockCodeMonitorStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) PushHook(hook func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6037,20 +6975,20 @@ func (f *CodeMonitorStoreUpdateMonitorFunc) PushHook(hook func(context.Context,
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeMonitorStoreUpdateMonitorFunc) SetDefaultReturn(r0 *Monitor, r1 error) {
-	f.SetDefaultHook(func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) SetDefaultReturn(r0 *HTTPAction, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeMonitorStoreUpdateMonitorFunc) PushReturn(r0 *Monitor, r1 error) {
-	f.PushHook(func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) PushReturn(r0 *HTTPAction, r1 error) {
+	f.PushHook(func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
 		return r0, r1
 	})
 }
 
-func (f *CodeMonitorStoreUpdateMonitorFunc) nextHook() func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) nextHook() func(context.Context, int64, *HTTPActionArgs) (*HTTPAction, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6063,27 +7001,27 @@ func (f *CodeMonitorStoreUpdateMonitorFunc) nextHook() func(context.Context, int
 	return hook
 }
 
-func (f *CodeMonitorStoreUpdateMonitorFunc) appendCall(r0 CodeMonitorStoreUpdateMonitorFuncCall) {
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) appendCall(r0 CodeMonitorStoreUpdateHTTPActionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeMonitorStoreUpdateMonitorFuncCall
+// History returns a sequence of CodeMonitorStoreUpdateHTTPActionFuncCall
 // objects describing the invocations of this function.
-func (f *CodeMonitorStoreUpdateMonitorFunc) History() []CodeMonitorStoreUpdateMonitorFuncCall {
+func (f *CodeMonitorStoreUpdateHTTPActionFunc) History() []CodeMonitorStoreUpdateHTTPActionFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeMonitorStoreUpdateMonitorFuncCall, len(f.history))
+	history := make([]CodeMonitorStoreUpdateHTTPActionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeMonitorStoreUpdateMonitorFuncCall is an object that describes an
-// invocation of method UpdateMonitor on an instance of
+// CodeMonitorStoreUpdateHTTPActionFuncCall is an object that describes an
+// invocation of method UpdateHTTPAction on an instance of
 // MockCodeMonitorStore.
-type CodeMonitorStoreUpdateMonitorFuncCall struct {
+type CodeMonitorStoreUpdateHTTPActionFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -6092,10 +7030,10 @@ type CodeMonitorStoreUpdateMonitorFuncCall struct {
 	Arg1 int64
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 MonitorArgs
+	Arg2 *HTTPActionArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *Monitor
+	Result0 *HTTPAction
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6103,37 +7041,150 @@ type CodeMonitorStoreUpdateMonitorFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeMonitorStoreUpdateMonitorFuncCall) Args() []interface{} {
+func (c CodeMonitorStoreUpdateHTTPActionFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeMonitorStoreUpdateMonitorFuncCall) Results() []interface{} {
+func (c CodeMonitorStoreUpdateHTTPActionFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeMonitorStoreUpdateMonitorEnabledFunc describes the behavior when the
-// UpdateMonitorEnabled method of the parent MockCodeMonitorStore instance
-// is invoked.
-type CodeMonitorStoreUpdateMonitorEnabledFunc struct {
-	defaultHook func(context.Context, int64, bool) (*Monitor, error)
-	hooks       []func(context.Context, int64, bool) (*Monitor, error)
-	history     []CodeMonitorStoreUpdateMonitorEnabledFuncCall
+// CodeMonitorStoreUpdateMonitorFunc describes the behavior when the
+// UpdateMonitor method of the parent MockCodeMonitorStore instance is
+// invoked.
+type CodeMonitorStoreUpdateMonitorFunc struct {
+	defaultHook func(context.Context, int64, MonitorArgs) (*Monitor, error)
+	hooks       []func(context.Context, int64, MonitorArgs) (*Monitor, error)
+	history     []CodeMonitorStoreUpdateMonitorFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateMonitorEnabled delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateMonitorEnabled(v0 context.Context, v1 int64, v2 bool) (*Monitor, error) {
-	r0, r1 := m.UpdateMonitorEnabledFunc.nextHook()(v0, v1, v2)
-	m.UpdateMonitorEnabledFunc.appendCall(CodeMonitorStoreUpdateMonitorEnabledFuncCall{v0, v1, v2, r0, r1})
+// UpdateMonitor delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateMonitor(v0 context.Context, v1 int64, v2 MonitorArgs) (*Monitor, error) {
+	r0, r1 := m.UpdateMonitorFunc.nextHook()(v0, v1, v2)
+	m.UpdateMonitorFunc.appendCall(CodeMonitorStoreUpdateMonitorFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the UpdateMonitorEnabled
-// method of the parent MockCodeMonitorStore instance is invoked and the
-// hook queue is empty.
+// SetDefaultHook sets function that is called when the UpdateMonitor method
+// of the parent MockCodeMonitorStore instance is invoked and the hook queue
+// is empty.
+func (f *CodeMonitorStoreUpdateMonitorFunc) SetDefaultHook(hook func(context.Context, int64, MonitorArgs) (*Monitor, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateMonitor method of the parent MockCodeMonitorStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *CodeMonitorStoreUpdateMonitorFunc) PushHook(hook func(context.Context, int64, MonitorArgs) (*Monitor, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateMonitorFunc) SetDefaultReturn(r0 *Monitor, r1 error) {
+	f.SetDefaultHook(func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateMonitorFunc) PushReturn(r0 *Monitor, r1 error) {
+	f.PushHook(func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeMonitorStoreUpdateMonitorFunc) nextHook() func(context.Context, int64, MonitorArgs) (*Monitor, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateMonitorFunc) appendCall(r0 CodeMonitorStoreUpdateMonitorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeMonitorStoreUpdateMonitorFuncCall
+// objects describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateMonitorFunc) History() []CodeMonitorStoreUpdateMonitorFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateMonitorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateMonitorFuncCall is an object that describes an
+// invocation of method UpdateMonitor on an instance of
+// MockCodeMonitorStore.
+type CodeMonitorStoreUpdateMonitorFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int64
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 MonitorArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *Monitor
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateMonitorFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateMonitorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeMonitorStoreUpdateMonitorEnabledFunc describes the behavior when the
+// UpdateMonitorEnabled method of the parent MockCodeMonitorStore instance
+// is invoked.
+type CodeMonitorStoreUpdateMonitorEnabledFunc struct {
+	defaultHook func(context.Context, int64, bool) (*Monitor, error)
+	hooks       []func(context.Context, int64, bool) (*Monitor, error)
+	history     []CodeMonitorStoreUpdateMonitorEnabledFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateMonitorEnabled delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateMonitorEnabled(v0 context.Context, v1 int64, v2 bool) (*Monitor, error) {
+	r0, r1 := m.UpdateMonitorEnabledFunc.nextHook()(v0, v1, v2)
+	m.UpdateMonitorEnabledFunc.appendCall(CodeMonitorStoreUpdateMonitorEnabledFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the UpdateMonitorEnabled
+// method of the parent MockCodeMonitorStore instance is invoked and the
+// hook queue is empty.
 func (f *CodeMonitorStoreUpdateMonitorEnabledFunc) SetDefaultHook(hook func(context.Context, int64, bool) (*Monitor, error)) {
 	f.defaultHook = hook
 }
@@ -6232,24 +7283,24 @@ func (c CodeMonitorStoreUpdateMonitorEnabledFuncCall) Results() []interface{} {
 // UpdateQueryTrigger method of the parent MockCodeMonitorStore instance is
 // invoked.
 type CodeMonitorStoreUpdateQueryTriggerFunc struct {
-	defaultHook func(context.Context, int64, string) error
-	hooks       []func(context.Context, int64, string) error
+	defaultHook func(context.Context, int64, string, QueryTriggerMode) error
+	hooks       []func(context.Context, int64, string, QueryTriggerMode) error
 	history     []CodeMonitorStoreUpdateQueryTriggerFuncCall
 	mutex       sync.Mutex
 }
 
 // UpdateQueryTrigger delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockCodeMonitorStore) UpdateQueryTrigger(v0 context.Context, v1 int64, v2 string) error {
-	r0 := m.UpdateQueryTriggerFunc.nextHook()(v0, v1, v2)
-	m.UpdateQueryTriggerFunc.appendCall(CodeMonitorStoreUpdateQueryTriggerFuncCall{v0, v1, v2, r0})
+func (m *MockCodeMonitorStore) UpdateQueryTrigger(v0 context.Context, v1 int64, v2 string, v3 QueryTriggerMode) error {
+	r0 := m.UpdateQueryTriggerFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateQueryTriggerFunc.appendCall(CodeMonitorStoreUpdateQueryTriggerFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the UpdateQueryTrigger
 // method of the parent MockCodeMonitorStore instance is invoked and the
 // hook queue is empty.
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string) error) {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(context.Context, int64, string, QueryTriggerMode) error) {
 	f.defaultHook = hook
 }
 
@@ -6258,7 +7309,7 @@ func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultHook(hook func(contex
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string) error) {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Context, int64, string, QueryTriggerMode) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6267,19 +7318,19 @@ func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushHook(hook func(context.Cont
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
 func (f *CodeMonitorStoreUpdateQueryTriggerFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, int64, string) error {
+	f.SetDefaultHook(func(context.Context, int64, string, QueryTriggerMode) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
 func (f *CodeMonitorStoreUpdateQueryTriggerFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, int64, string) error {
+	f.PushHook(func(context.Context, int64, string, QueryTriggerMode) error {
 		return r0
 	})
 }
 
-func (f *CodeMonitorStoreUpdateQueryTriggerFunc) nextHook() func(context.Context, int64, string) error {
+func (f *CodeMonitorStoreUpdateQueryTriggerFunc) nextHook() func(context.Context, int64, string, QueryTriggerMode) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6322,6 +7373,9 @@ type CodeMonitorStoreUpdateQueryTriggerFuncCall struct {
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
 	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 QueryTriggerMode
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -6330,7 +7384,7 @@ type CodeMonitorStoreUpdateQueryTriggerFuncCall struct {
 // Args returns an interface slice containing the arguments of this
 // invocation.
 func (c CodeMonitorStoreUpdateQueryTriggerFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
@@ -6460,6 +7514,121 @@ func (c CodeMonitorStoreUpdateSlackWebhookActionFuncCall) Results() []interface{
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc describes the
+// behavior when the UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc struct {
+	defaultHook func(context.Context, int32, string, []*ContentChange) error
+	hooks       []func(context.Context, int32, string, []*ContentChange) error
+	history     []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateTriggerJobWithContentChanges delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeMonitorStore) UpdateTriggerJobWithContentChanges(v0 context.Context, v1 int32, v2 string, v3 []*ContentChange) error {
+	r0 := m.UpdateTriggerJobWithContentChangesFunc.nextHook()(v0, v1, v2, v3)
+	m.UpdateTriggerJobWithContentChangesFunc.appendCall(CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall{v0, v1, v2, v3, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance is invoked and the hook queue is empty.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultHook(hook func(context.Context, int32, string, []*ContentChange) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateTriggerJobWithContentChanges method of the parent
+// MockCodeMonitorStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushHook(hook func(context.Context, int32, string, []*ContentChange) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int32, string, []*ContentChange) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int32, string, []*ContentChange) error {
+		return r0
+	})
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) nextHook() func(context.Context, int32, string, []*ContentChange) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) appendCall(r0 CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall objects
+// describing the invocations of this function.
+func (f *CodeMonitorStoreUpdateTriggerJobWithContentChangesFunc) History() []CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall is an object
+// that describes an invocation of method UpdateTriggerJobWithContentChanges
+// on an instance of MockCodeMonitorStore.
+type CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int32
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []*ContentChange
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeMonitorStoreUpdateTriggerJobWithContentChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeMonitorStoreUpdateTriggerJobWithResultsFunc describes the behavior
 // when the UpdateTriggerJobWithResults method of the parent
 // MockCodeMonitorStore instance is invoked.
@@ -6820,24 +7989,47 @@ type MockCodeownersStore struct {
 	// CreateCodeownersFileFunc is an instance of a mock function object
 	// controlling the behavior of the method CreateCodeownersFile.
 	CreateCodeownersFileFunc *CodeownersStoreCreateCodeownersFileFunc
+	// CreateCodeownersRepoPatternFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// CreateCodeownersRepoPattern.
+	CreateCodeownersRepoPatternFunc *CodeownersStoreCreateCodeownersRepoPatternFunc
 	// DeleteCodeownersForReposFunc is an instance of a mock function object
 	// controlling the behavior of the method DeleteCodeownersForRepos.
 	DeleteCodeownersForReposFunc *CodeownersStoreDeleteCodeownersForReposFunc
+	// DeleteCodeownersRepoPatternsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// DeleteCodeownersRepoPatterns.
+	DeleteCodeownersRepoPatternsFunc *CodeownersStoreDeleteCodeownersRepoPatternsFunc
 	// DoneFunc is an instance of a mock function object controlling the
 	// behavior of the method Done.
 	DoneFunc *CodeownersStoreDoneFunc
 	// GetCodeownersForRepoFunc is an instance of a mock function object
 	// controlling the behavior of the method GetCodeownersForRepo.
 	GetCodeownersForRepoFunc *CodeownersStoreGetCodeownersForRepoFunc
+	// GetCodeownersRepoPatternFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCodeownersRepoPattern.
+	GetCodeownersRepoPatternFunc *CodeownersStoreGetCodeownersRepoPatternFunc
 	// HandleFunc is an instance of a mock function object controlling the
 	// behavior of the method Handle.
 	HandleFunc *CodeownersStoreHandleFunc
 	// ListCodeownersFunc is an instance of a mock function object
 	// controlling the behavior of the method ListCodeowners.
 	ListCodeownersFunc *CodeownersStoreListCodeownersFunc
+	// ListCodeownersRepoPatternsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListCodeownersRepoPatterns.
+	ListCodeownersRepoPatternsFunc *CodeownersStoreListCodeownersRepoPatternsFunc
+	// ListCodeownersRepoPatternsForRepoFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListCodeownersRepoPatternsForRepo.
+	ListCodeownersRepoPatternsForRepoFunc *CodeownersStoreListCodeownersRepoPatternsForRepoFunc
 	// UpdateCodeownersFileFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdateCodeownersFile.
 	UpdateCodeownersFileFunc *CodeownersStoreUpdateCodeownersFileFunc
+	// UpdateCodeownersRepoPatternFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UpdateCodeownersRepoPattern.
+	UpdateCodeownersRepoPatternFunc *CodeownersStoreUpdateCodeownersRepoPatternFunc
 }
 
 // NewMockCodeownersStore creates a new mock of the CodeownersStore
@@ -6855,11 +8047,21 @@ func NewMockCodeownersStore() *MockCodeownersStore {
 				return
 			},
 		},
+		CreateCodeownersRepoPatternFunc: &CodeownersStoreCreateCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, *types.CodeownersRepoPattern) (r0 error) {
+				return
+			},
+		},
 		DeleteCodeownersForReposFunc: &CodeownersStoreDeleteCodeownersForReposFunc{
 			defaultHook: func(context.Context, ...api.RepoID) (r0 error) {
 				return
 			},
 		},
+		DeleteCodeownersRepoPatternsFunc: &CodeownersStoreDeleteCodeownersRepoPatternsFunc{
+			defaultHook: func(context.Context, ...string) (r0 error) {
+				return
+			},
+		},
 		DoneFunc: &CodeownersStoreDoneFunc{
 			defaultHook: func(error) (r0 error) {
 				return
@@ -6870,6 +8072,11 @@ func NewMockCodeownersStore() *MockCodeownersStore {
 				return
 			},
 		},
+		GetCodeownersRepoPatternFunc: &CodeownersStoreGetCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, string) (r0 *types.CodeownersRepoPattern, r1 error) {
+				return
+			},
+		},
 		HandleFunc: &CodeownersStoreHandleFunc{
 			defaultHook: func() (r0 basestore.TransactableHandle) {
 				return
@@ -6880,11 +8087,26 @@ func NewMockCodeownersStore() *MockCodeownersStore {
 				return
 			},
 		},
+		ListCodeownersRepoPatternsFunc: &CodeownersStoreListCodeownersRepoPatternsFunc{
+			defaultHook: func(context.Context) (r0 []*types.CodeownersRepoPattern, r1 error) {
+				return
+			},
+		},
+		ListCodeownersRepoPatternsForRepoFunc: &CodeownersStoreListCodeownersRepoPatternsForRepoFunc{
+			defaultHook: func(context.Context, api.RepoName) (r0 []*types.CodeownersRepoPattern, r1 error) {
+				return
+			},
+		},
 		UpdateCodeownersFileFunc: &CodeownersStoreUpdateCodeownersFileFunc{
 			defaultHook: func(context.Context, *types.CodeownersFile) (r0 error) {
 				return
 			},
 		},
+		UpdateCodeownersRepoPatternFunc: &CodeownersStoreUpdateCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, *types.CodeownersRepoPattern) (r0 error) {
+				return
+			},
+		},
 	}
 }
 
@@ -6902,11 +8124,21 @@ func NewStrictMockCodeownersStore() *MockCodeownersStore {
 				panic("unexpected invocation of MockCodeownersStore.CreateCodeownersFile")
 			},
 		},
+		CreateCodeownersRepoPatternFunc: &CodeownersStoreCreateCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, *types.CodeownersRepoPattern) error {
+				panic("unexpected invocation of MockCodeownersStore.CreateCodeownersRepoPattern")
+			},
+		},
 		DeleteCodeownersForReposFunc: &CodeownersStoreDeleteCodeownersForReposFunc{
 			defaultHook: func(context.Context, ...api.RepoID) error {
 				panic("unexpected invocation of MockCodeownersStore.DeleteCodeownersForRepos")
 			},
 		},
+		DeleteCodeownersRepoPatternsFunc: &CodeownersStoreDeleteCodeownersRepoPatternsFunc{
+			defaultHook: func(context.Context, ...string) error {
+				panic("unexpected invocation of MockCodeownersStore.DeleteCodeownersRepoPatterns")
+			},
+		},
 		DoneFunc: &CodeownersStoreDoneFunc{
 			defaultHook: func(error) error {
 				panic("unexpected invocation of MockCodeownersStore.Done")
@@ -6917,6 +8149,11 @@ func NewStrictMockCodeownersStore() *MockCodeownersStore {
 				panic("unexpected invocation of MockCodeownersStore.GetCodeownersForRepo")
 			},
 		},
+		GetCodeownersRepoPatternFunc: &CodeownersStoreGetCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, string) (*types.CodeownersRepoPattern, error) {
+				panic("unexpected invocation of MockCodeownersStore.GetCodeownersRepoPattern")
+			},
+		},
 		HandleFunc: &CodeownersStoreHandleFunc{
 			defaultHook: func() basestore.TransactableHandle {
 				panic("unexpected invocation of MockCodeownersStore.Handle")
@@ -6927,11 +8164,26 @@ func NewStrictMockCodeownersStore() *MockCodeownersStore {
 				panic("unexpected invocation of MockCodeownersStore.ListCodeowners")
 			},
 		},
+		ListCodeownersRepoPatternsFunc: &CodeownersStoreListCodeownersRepoPatternsFunc{
+			defaultHook: func(context.Context) ([]*types.CodeownersRepoPattern, error) {
+				panic("unexpected invocation of MockCodeownersStore.ListCodeownersRepoPatterns")
+			},
+		},
+		ListCodeownersRepoPatternsForRepoFunc: &CodeownersStoreListCodeownersRepoPatternsForRepoFunc{
+			defaultHook: func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+				panic("unexpected invocation of MockCodeownersStore.ListCodeownersRepoPatternsForRepo")
+			},
+		},
 		UpdateCodeownersFileFunc: &CodeownersStoreUpdateCodeownersFileFunc{
 			defaultHook: func(context.Context, *types.CodeownersFile) error {
 				panic("unexpected invocation of MockCodeownersStore.UpdateCodeownersFile")
 			},
 		},
+		UpdateCodeownersRepoPatternFunc: &CodeownersStoreUpdateCodeownersRepoPatternFunc{
+			defaultHook: func(context.Context, *types.CodeownersRepoPattern) error {
+				panic("unexpected invocation of MockCodeownersStore.UpdateCodeownersRepoPattern")
+			},
+		},
 	}
 }
 
@@ -6946,24 +8198,42 @@ func NewMockCodeownersStoreFrom(i CodeownersStore) *MockCodeownersStore {
 		CreateCodeownersFileFunc: &CodeownersStoreCreateCodeownersFileFunc{
 			defaultHook: i.CreateCodeownersFile,
 		},
+		CreateCodeownersRepoPatternFunc: &CodeownersStoreCreateCodeownersRepoPatternFunc{
+			defaultHook: i.CreateCodeownersRepoPattern,
+		},
 		DeleteCodeownersForReposFunc: &CodeownersStoreDeleteCodeownersForReposFunc{
 			defaultHook: i.DeleteCodeownersForRepos,
 		},
+		DeleteCodeownersRepoPatternsFunc: &CodeownersStoreDeleteCodeownersRepoPatternsFunc{
+			defaultHook: i.DeleteCodeownersRepoPatterns,
+		},
 		DoneFunc: &CodeownersStoreDoneFunc{
 			defaultHook: i.Done,
 		},
 		GetCodeownersForRepoFunc: &CodeownersStoreGetCodeownersForRepoFunc{
 			defaultHook: i.GetCodeownersForRepo,
 		},
+		GetCodeownersRepoPatternFunc: &CodeownersStoreGetCodeownersRepoPatternFunc{
+			defaultHook: i.GetCodeownersRepoPattern,
+		},
 		HandleFunc: &CodeownersStoreHandleFunc{
 			defaultHook: i.Handle,
 		},
 		ListCodeownersFunc: &CodeownersStoreListCodeownersFunc{
 			defaultHook: i.ListCodeowners,
 		},
+		ListCodeownersRepoPatternsFunc: &CodeownersStoreListCodeownersRepoPatternsFunc{
+			defaultHook: i.ListCodeownersRepoPatterns,
+		},
+		ListCodeownersRepoPatternsForRepoFunc: &CodeownersStoreListCodeownersRepoPatternsForRepoFunc{
+			defaultHook: i.ListCodeownersRepoPatternsForRepo,
+		},
 		UpdateCodeownersFileFunc: &CodeownersStoreUpdateCodeownersFileFunc{
 			defaultHook: i.UpdateCodeownersFile,
 		},
+		UpdateCodeownersRepoPatternFunc: &CodeownersStoreUpdateCodeownersRepoPatternFunc{
+			defaultHook: i.UpdateCodeownersRepoPattern,
+		},
 	}
 }
 
@@ -7183,28 +8453,137 @@ func (c CodeownersStoreCreateCodeownersFileFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// CodeownersStoreDeleteCodeownersForReposFunc describes the behavior when
-// the DeleteCodeownersForRepos method of the parent MockCodeownersStore
-// instance is invoked.
-type CodeownersStoreDeleteCodeownersForReposFunc struct {
-	defaultHook func(context.Context, ...api.RepoID) error
-	hooks       []func(context.Context, ...api.RepoID) error
-	history     []CodeownersStoreDeleteCodeownersForReposFuncCall
+// CodeownersStoreCreateCodeownersRepoPatternFunc describes the behavior
+// when the CreateCodeownersRepoPattern method of the parent
+// MockCodeownersStore instance is invoked.
+type CodeownersStoreCreateCodeownersRepoPatternFunc struct {
+	defaultHook func(context.Context, *types.CodeownersRepoPattern) error
+	hooks       []func(context.Context, *types.CodeownersRepoPattern) error
+	history     []CodeownersStoreCreateCodeownersRepoPatternFuncCall
 	mutex       sync.Mutex
 }
 
-// DeleteCodeownersForRepos delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockCodeownersStore) DeleteCodeownersForRepos(v0 context.Context, v1 ...api.RepoID) error {
-	r0 := m.DeleteCodeownersForReposFunc.nextHook()(v0, v1...)
-	m.DeleteCodeownersForReposFunc.appendCall(CodeownersStoreDeleteCodeownersForReposFuncCall{v0, v1, r0})
+// CreateCodeownersRepoPattern delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) CreateCodeownersRepoPattern(v0 context.Context, v1 *types.CodeownersRepoPattern) error {
+	r0 := m.CreateCodeownersRepoPatternFunc.nextHook()(v0, v1)
+	m.CreateCodeownersRepoPatternFunc.appendCall(CodeownersStoreCreateCodeownersRepoPatternFuncCall{v0, v1, r0})
 	return r0
 }
 
 // SetDefaultHook sets function that is called when the
-// DeleteCodeownersForRepos method of the parent MockCodeownersStore
+// CreateCodeownersRepoPattern method of the parent MockCodeownersStore
 // instance is invoked and the hook queue is empty.
-func (f *CodeownersStoreDeleteCodeownersForReposFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) SetDefaultHook(hook func(context.Context, *types.CodeownersRepoPattern) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) PushHook(hook func(context.Context, *types.CodeownersRepoPattern) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.CodeownersRepoPattern) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.CodeownersRepoPattern) error {
+		return r0
+	})
+}
+
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) nextHook() func(context.Context, *types.CodeownersRepoPattern) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) appendCall(r0 CodeownersStoreCreateCodeownersRepoPatternFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeownersStoreCreateCodeownersRepoPatternFuncCall objects describing the
+// invocations of this function.
+func (f *CodeownersStoreCreateCodeownersRepoPatternFunc) History() []CodeownersStoreCreateCodeownersRepoPatternFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreCreateCodeownersRepoPatternFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreCreateCodeownersRepoPatternFuncCall is an object that
+// describes an invocation of method CreateCodeownersRepoPattern on an
+// instance of MockCodeownersStore.
+type CodeownersStoreCreateCodeownersRepoPatternFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.CodeownersRepoPattern
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersStoreCreateCodeownersRepoPatternFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreCreateCodeownersRepoPatternFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeownersStoreDeleteCodeownersForReposFunc describes the behavior when
+// the DeleteCodeownersForRepos method of the parent MockCodeownersStore
+// instance is invoked.
+type CodeownersStoreDeleteCodeownersForReposFunc struct {
+	defaultHook func(context.Context, ...api.RepoID) error
+	hooks       []func(context.Context, ...api.RepoID) error
+	history     []CodeownersStoreDeleteCodeownersForReposFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteCodeownersForRepos delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) DeleteCodeownersForRepos(v0 context.Context, v1 ...api.RepoID) error {
+	r0 := m.DeleteCodeownersForReposFunc.nextHook()(v0, v1...)
+	m.DeleteCodeownersForReposFunc.appendCall(CodeownersStoreDeleteCodeownersForReposFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteCodeownersForRepos method of the parent MockCodeownersStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreDeleteCodeownersForReposFunc) SetDefaultHook(hook func(context.Context, ...api.RepoID) error) {
 	f.defaultHook = hook
 }
 
@@ -7299,6 +8678,122 @@ func (c CodeownersStoreDeleteCodeownersForReposFuncCall) Results() []interface{}
 	return []interface{}{c.Result0}
 }
 
+// CodeownersStoreDeleteCodeownersRepoPatternsFunc describes the behavior
+// when the DeleteCodeownersRepoPatterns method of the parent
+// MockCodeownersStore instance is invoked.
+type CodeownersStoreDeleteCodeownersRepoPatternsFunc struct {
+	defaultHook func(context.Context, ...string) error
+	hooks       []func(context.Context, ...string) error
+	history     []CodeownersStoreDeleteCodeownersRepoPatternsFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteCodeownersRepoPatterns delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) DeleteCodeownersRepoPatterns(v0 context.Context, v1 ...string) error {
+	r0 := m.DeleteCodeownersRepoPatternsFunc.nextHook()(v0, v1...)
+	m.DeleteCodeownersRepoPatternsFunc.appendCall(CodeownersStoreDeleteCodeownersRepoPatternsFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// DeleteCodeownersRepoPatterns method of the parent MockCodeownersStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) SetDefaultHook(hook func(context.Context, ...string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteCodeownersRepoPatterns method of the parent MockCodeownersStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) PushHook(hook func(context.Context, ...string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, ...string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, ...string) error {
+		return r0
+	})
+}
+
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) nextHook() func(context.Context, ...string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) appendCall(r0 CodeownersStoreDeleteCodeownersRepoPatternsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeownersStoreDeleteCodeownersRepoPatternsFuncCall objects describing
+// the invocations of this function.
+func (f *CodeownersStoreDeleteCodeownersRepoPatternsFunc) History() []CodeownersStoreDeleteCodeownersRepoPatternsFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreDeleteCodeownersRepoPatternsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreDeleteCodeownersRepoPatternsFuncCall is an object that
+// describes an invocation of method DeleteCodeownersRepoPatterns on an
+// instance of MockCodeownersStore.
+type CodeownersStoreDeleteCodeownersRepoPatternsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg1 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c CodeownersStoreDeleteCodeownersRepoPatternsFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg1 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0}, trailing...)
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreDeleteCodeownersRepoPatternsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // CodeownersStoreDoneFunc describes the behavior when the Done method of
 // the parent MockCodeownersStore instance is invoked.
 type CodeownersStoreDoneFunc struct {
@@ -7512,35 +9007,37 @@ func (c CodeownersStoreGetCodeownersForRepoFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeownersStoreHandleFunc describes the behavior when the Handle method
-// of the parent MockCodeownersStore instance is invoked.
-type CodeownersStoreHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []CodeownersStoreHandleFuncCall
+// CodeownersStoreGetCodeownersRepoPatternFunc describes the behavior when
+// the GetCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance is invoked.
+type CodeownersStoreGetCodeownersRepoPatternFunc struct {
+	defaultHook func(context.Context, string) (*types.CodeownersRepoPattern, error)
+	hooks       []func(context.Context, string) (*types.CodeownersRepoPattern, error)
+	history     []CodeownersStoreGetCodeownersRepoPatternFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockCodeownersStore) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(CodeownersStoreHandleFuncCall{r0})
-	return r0
+// GetCodeownersRepoPattern delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) GetCodeownersRepoPattern(v0 context.Context, v1 string) (*types.CodeownersRepoPattern, error) {
+	r0, r1 := m.GetCodeownersRepoPatternFunc.nextHook()(v0, v1)
+	m.GetCodeownersRepoPatternFunc.appendCall(CodeownersStoreGetCodeownersRepoPatternFuncCall{v0, v1, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockCodeownersStore instance is invoked and the hook queue is
-// empty.
-func (f *CodeownersStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the
+// GetCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) SetDefaultHook(hook func(context.Context, string) (*types.CodeownersRepoPattern, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockCodeownersStore instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *CodeownersStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// GetCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) PushHook(hook func(context.Context, string) (*types.CodeownersRepoPattern, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7548,20 +9045,20 @@ func (f *CodeownersStoreHandleFunc) PushHook(hook func() basestore.TransactableH
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeownersStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
-		return r0
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) SetDefaultReturn(r0 *types.CodeownersRepoPattern, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (*types.CodeownersRepoPattern, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeownersStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
-		return r0
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) PushReturn(r0 *types.CodeownersRepoPattern, r1 error) {
+	f.PushHook(func(context.Context, string) (*types.CodeownersRepoPattern, error) {
+		return r0, r1
 	})
 }
 
-func (f *CodeownersStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) nextHook() func(context.Context, string) (*types.CodeownersRepoPattern, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7574,55 +9071,165 @@ func (f *CodeownersStoreHandleFunc) nextHook() func() basestore.TransactableHand
 	return hook
 }
 
-func (f *CodeownersStoreHandleFunc) appendCall(r0 CodeownersStoreHandleFuncCall) {
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) appendCall(r0 CodeownersStoreGetCodeownersRepoPatternFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeownersStoreHandleFuncCall objects
-// describing the invocations of this function.
-func (f *CodeownersStoreHandleFunc) History() []CodeownersStoreHandleFuncCall {
+// History returns a sequence of
+// CodeownersStoreGetCodeownersRepoPatternFuncCall objects describing the
+// invocations of this function.
+func (f *CodeownersStoreGetCodeownersRepoPatternFunc) History() []CodeownersStoreGetCodeownersRepoPatternFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeownersStoreHandleFuncCall, len(f.history))
+	history := make([]CodeownersStoreGetCodeownersRepoPatternFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeownersStoreHandleFuncCall is an object that describes an invocation
-// of method Handle on an instance of MockCodeownersStore.
-type CodeownersStoreHandleFuncCall struct {
+// CodeownersStoreGetCodeownersRepoPatternFuncCall is an object that
+// describes an invocation of method GetCodeownersRepoPattern on an instance
+// of MockCodeownersStore.
+type CodeownersStoreGetCodeownersRepoPatternFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 *types.CodeownersRepoPattern
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeownersStoreHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c CodeownersStoreGetCodeownersRepoPatternFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeownersStoreHandleFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c CodeownersStoreGetCodeownersRepoPatternFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// CodeownersStoreListCodeownersFunc describes the behavior when the
-// ListCodeowners method of the parent MockCodeownersStore instance is
-// invoked.
-type CodeownersStoreListCodeownersFunc struct {
-	defaultHook func(context.Context, ListCodeownersOpts) ([]*types.CodeownersFile, int32, error)
-	hooks       []func(context.Context, ListCodeownersOpts) ([]*types.CodeownersFile, int32, error)
-	history     []CodeownersStoreListCodeownersFuncCall
+// CodeownersStoreHandleFunc describes the behavior when the Handle method
+// of the parent MockCodeownersStore instance is invoked.
+type CodeownersStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []CodeownersStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// ListCodeowners delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockCodeownersStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(CodeownersStoreHandleFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockCodeownersStore instance is invoked and the hook queue is
+// empty.
+func (f *CodeownersStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Handle method of the parent MockCodeownersStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *CodeownersStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
+		return r0
+	})
+}
+
+func (f *CodeownersStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreHandleFunc) appendCall(r0 CodeownersStoreHandleFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersStoreHandleFuncCall objects
+// describing the invocations of this function.
+func (f *CodeownersStoreHandleFunc) History() []CodeownersStoreHandleFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreHandleFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreHandleFuncCall is an object that describes an invocation
+// of method Handle on an instance of MockCodeownersStore.
+type CodeownersStoreHandleFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 basestore.TransactableHandle
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersStoreHandleFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreHandleFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeownersStoreListCodeownersFunc describes the behavior when the
+// ListCodeowners method of the parent MockCodeownersStore instance is
+// invoked.
+type CodeownersStoreListCodeownersFunc struct {
+	defaultHook func(context.Context, ListCodeownersOpts) ([]*types.CodeownersFile, int32, error)
+	hooks       []func(context.Context, ListCodeownersOpts) ([]*types.CodeownersFile, int32, error)
+	history     []CodeownersStoreListCodeownersFuncCall
+	mutex       sync.Mutex
+}
+
+// ListCodeowners delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
 func (m *MockCodeownersStore) ListCodeowners(v0 context.Context, v1 ListCodeownersOpts) ([]*types.CodeownersFile, int32, error) {
 	r0, r1, r2 := m.ListCodeownersFunc.nextHook()(v0, v1)
 	m.ListCodeownersFunc.appendCall(CodeownersStoreListCodeownersFuncCall{v0, v1, r0, r1, r2})
@@ -7724,37 +9331,37 @@ func (c CodeownersStoreListCodeownersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1, c.Result2}
 }
 
-// CodeownersStoreUpdateCodeownersFileFunc describes the behavior when the
-// UpdateCodeownersFile method of the parent MockCodeownersStore instance is
-// invoked.
-type CodeownersStoreUpdateCodeownersFileFunc struct {
-	defaultHook func(context.Context, *types.CodeownersFile) error
-	hooks       []func(context.Context, *types.CodeownersFile) error
-	history     []CodeownersStoreUpdateCodeownersFileFuncCall
+// CodeownersStoreListCodeownersRepoPatternsFunc describes the behavior when
+// the ListCodeownersRepoPatterns method of the parent MockCodeownersStore
+// instance is invoked.
+type CodeownersStoreListCodeownersRepoPatternsFunc struct {
+	defaultHook func(context.Context) ([]*types.CodeownersRepoPattern, error)
+	hooks       []func(context.Context) ([]*types.CodeownersRepoPattern, error)
+	history     []CodeownersStoreListCodeownersRepoPatternsFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdateCodeownersFile delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockCodeownersStore) UpdateCodeownersFile(v0 context.Context, v1 *types.CodeownersFile) error {
-	r0 := m.UpdateCodeownersFileFunc.nextHook()(v0, v1)
-	m.UpdateCodeownersFileFunc.appendCall(CodeownersStoreUpdateCodeownersFileFuncCall{v0, v1, r0})
-	return r0
+// ListCodeownersRepoPatterns delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) ListCodeownersRepoPatterns(v0 context.Context) ([]*types.CodeownersRepoPattern, error) {
+	r0, r1 := m.ListCodeownersRepoPatternsFunc.nextHook()(v0)
+	m.ListCodeownersRepoPatternsFunc.appendCall(CodeownersStoreListCodeownersRepoPatternsFuncCall{v0, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the UpdateCodeownersFile
-// method of the parent MockCodeownersStore instance is invoked and the hook
-// queue is empty.
-func (f *CodeownersStoreUpdateCodeownersFileFunc) SetDefaultHook(hook func(context.Context, *types.CodeownersFile) error) {
+// SetDefaultHook sets function that is called when the
+// ListCodeownersRepoPatterns method of the parent MockCodeownersStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) SetDefaultHook(hook func(context.Context) ([]*types.CodeownersRepoPattern, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdateCodeownersFile method of the parent MockCodeownersStore instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *CodeownersStoreUpdateCodeownersFileFunc) PushHook(hook func(context.Context, *types.CodeownersFile) error) {
+// ListCodeownersRepoPatterns method of the parent MockCodeownersStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) PushHook(hook func(context.Context) ([]*types.CodeownersRepoPattern, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7762,20 +9369,20 @@ func (f *CodeownersStoreUpdateCodeownersFileFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *CodeownersStoreUpdateCodeownersFileFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, *types.CodeownersFile) error {
-		return r0
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) SetDefaultReturn(r0 []*types.CodeownersRepoPattern, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]*types.CodeownersRepoPattern, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *CodeownersStoreUpdateCodeownersFileFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, *types.CodeownersFile) error {
-		return r0
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) PushReturn(r0 []*types.CodeownersRepoPattern, r1 error) {
+	f.PushHook(func(context.Context) ([]*types.CodeownersRepoPattern, error) {
+		return r0, r1
 	})
 }
 
-func (f *CodeownersStoreUpdateCodeownersFileFunc) nextHook() func(context.Context, *types.CodeownersFile) error {
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) nextHook() func(context.Context) ([]*types.CodeownersRepoPattern, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7788,70 +9395,400 @@ func (f *CodeownersStoreUpdateCodeownersFileFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *CodeownersStoreUpdateCodeownersFileFunc) appendCall(r0 CodeownersStoreUpdateCodeownersFileFuncCall) {
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) appendCall(r0 CodeownersStoreListCodeownersRepoPatternsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of CodeownersStoreUpdateCodeownersFileFuncCall
-// objects describing the invocations of this function.
-func (f *CodeownersStoreUpdateCodeownersFileFunc) History() []CodeownersStoreUpdateCodeownersFileFuncCall {
+// History returns a sequence of
+// CodeownersStoreListCodeownersRepoPatternsFuncCall objects describing the
+// invocations of this function.
+func (f *CodeownersStoreListCodeownersRepoPatternsFunc) History() []CodeownersStoreListCodeownersRepoPatternsFuncCall {
 	f.mutex.Lock()
-	history := make([]CodeownersStoreUpdateCodeownersFileFuncCall, len(f.history))
+	history := make([]CodeownersStoreListCodeownersRepoPatternsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// CodeownersStoreUpdateCodeownersFileFuncCall is an object that describes
-// an invocation of method UpdateCodeownersFile on an instance of
-// MockCodeownersStore.
-type CodeownersStoreUpdateCodeownersFileFuncCall struct {
+// CodeownersStoreListCodeownersRepoPatternsFuncCall is an object that
+// describes an invocation of method ListCodeownersRepoPatterns on an
+// instance of MockCodeownersStore.
+type CodeownersStoreListCodeownersRepoPatternsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []*types.CodeownersRepoPattern
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersStoreListCodeownersRepoPatternsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreListCodeownersRepoPatternsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// CodeownersStoreListCodeownersRepoPatternsForRepoFunc describes the
+// behavior when the ListCodeownersRepoPatternsForRepo method of the parent
+// MockCodeownersStore instance is invoked.
+type CodeownersStoreListCodeownersRepoPatternsForRepoFunc struct {
+	defaultHook func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error)
+	hooks       []func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error)
+	history     []CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// ListCodeownersRepoPatternsForRepo delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) ListCodeownersRepoPatternsForRepo(v0 context.Context, v1 api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+	r0, r1 := m.ListCodeownersRepoPatternsForRepoFunc.nextHook()(v0, v1)
+	m.ListCodeownersRepoPatternsForRepoFunc.appendCall(CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListCodeownersRepoPatternsForRepo method of the parent
+// MockCodeownersStore instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) SetDefaultHook(hook func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListCodeownersRepoPatternsForRepo method of the parent
+// MockCodeownersStore instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) PushHook(hook func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) SetDefaultReturn(r0 []*types.CodeownersRepoPattern, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) PushReturn(r0 []*types.CodeownersRepoPattern, r1 error) {
+	f.PushHook(func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+		return r0, r1
+	})
+}
+
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) nextHook() func(context.Context, api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) appendCall(r0 CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall objects
+// describing the invocations of this function.
+func (f *CodeownersStoreListCodeownersRepoPatternsForRepoFunc) History() []CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall is an object
+// that describes an invocation of method ListCodeownersRepoPatternsForRepo
+// on an instance of MockCodeownersStore.
+type CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 *types.CodeownersFile
+	Arg1 api.RepoName
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 error
+	Result0 []*types.CodeownersRepoPattern
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c CodeownersStoreUpdateCodeownersFileFuncCall) Args() []interface{} {
+func (c CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c CodeownersStoreUpdateCodeownersFileFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c CodeownersStoreListCodeownersRepoPatternsForRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// MockEnterpriseDB is a mock implementation of the EnterpriseDB interface
-// (from the package
-// github.com/sourcegraph/sourcegraph/enterprise/internal/database) used for
-// unit testing.
-type MockEnterpriseDB struct {
-	// AccessRequestsFunc is an instance of a mock function object
-	// controlling the behavior of the method AccessRequests.
-	AccessRequestsFunc *EnterpriseDBAccessRequestsFunc
-	// AccessTokensFunc is an instance of a mock function object controlling
-	// the behavior of the method AccessTokens.
-	AccessTokensFunc *EnterpriseDBAccessTokensFunc
-	// AuthzFunc is an instance of a mock function object controlling the
-	// behavior of the method Authz.
-	AuthzFunc *EnterpriseDBAuthzFunc
-	// BitbucketProjectPermissionsFunc is an instance of a mock function
-	// object controlling the behavior of the method
-	// BitbucketProjectPermissions.
-	BitbucketProjectPermissionsFunc *EnterpriseDBBitbucketProjectPermissionsFunc
-	// CodeMonitorsFunc is an instance of a mock function object controlling
-	// the behavior of the method CodeMonitors.
+// CodeownersStoreUpdateCodeownersFileFunc describes the behavior when the
+// UpdateCodeownersFile method of the parent MockCodeownersStore instance is
+// invoked.
+type CodeownersStoreUpdateCodeownersFileFunc struct {
+	defaultHook func(context.Context, *types.CodeownersFile) error
+	hooks       []func(context.Context, *types.CodeownersFile) error
+	history     []CodeownersStoreUpdateCodeownersFileFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateCodeownersFile delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) UpdateCodeownersFile(v0 context.Context, v1 *types.CodeownersFile) error {
+	r0 := m.UpdateCodeownersFileFunc.nextHook()(v0, v1)
+	m.UpdateCodeownersFileFunc.appendCall(CodeownersStoreUpdateCodeownersFileFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the UpdateCodeownersFile
+// method of the parent MockCodeownersStore instance is invoked and the hook
+// queue is empty.
+func (f *CodeownersStoreUpdateCodeownersFileFunc) SetDefaultHook(hook func(context.Context, *types.CodeownersFile) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateCodeownersFile method of the parent MockCodeownersStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *CodeownersStoreUpdateCodeownersFileFunc) PushHook(hook func(context.Context, *types.CodeownersFile) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreUpdateCodeownersFileFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.CodeownersFile) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreUpdateCodeownersFileFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.CodeownersFile) error {
+		return r0
+	})
+}
+
+func (f *CodeownersStoreUpdateCodeownersFileFunc) nextHook() func(context.Context, *types.CodeownersFile) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreUpdateCodeownersFileFunc) appendCall(r0 CodeownersStoreUpdateCodeownersFileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of CodeownersStoreUpdateCodeownersFileFuncCall
+// objects describing the invocations of this function.
+func (f *CodeownersStoreUpdateCodeownersFileFunc) History() []CodeownersStoreUpdateCodeownersFileFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreUpdateCodeownersFileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreUpdateCodeownersFileFuncCall is an object that describes
+// an invocation of method UpdateCodeownersFile on an instance of
+// MockCodeownersStore.
+type CodeownersStoreUpdateCodeownersFileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.CodeownersFile
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersStoreUpdateCodeownersFileFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreUpdateCodeownersFileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// CodeownersStoreUpdateCodeownersRepoPatternFunc describes the behavior
+// when the UpdateCodeownersRepoPattern method of the parent
+// MockCodeownersStore instance is invoked.
+type CodeownersStoreUpdateCodeownersRepoPatternFunc struct {
+	defaultHook func(context.Context, *types.CodeownersRepoPattern) error
+	hooks       []func(context.Context, *types.CodeownersRepoPattern) error
+	history     []CodeownersStoreUpdateCodeownersRepoPatternFuncCall
+	mutex       sync.Mutex
+}
+
+// UpdateCodeownersRepoPattern delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockCodeownersStore) UpdateCodeownersRepoPattern(v0 context.Context, v1 *types.CodeownersRepoPattern) error {
+	r0 := m.UpdateCodeownersRepoPatternFunc.nextHook()(v0, v1)
+	m.UpdateCodeownersRepoPatternFunc.appendCall(CodeownersStoreUpdateCodeownersRepoPatternFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// UpdateCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance is invoked and the hook queue is empty.
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) SetDefaultHook(hook func(context.Context, *types.CodeownersRepoPattern) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// UpdateCodeownersRepoPattern method of the parent MockCodeownersStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) PushHook(hook func(context.Context, *types.CodeownersRepoPattern) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, *types.CodeownersRepoPattern) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, *types.CodeownersRepoPattern) error {
+		return r0
+	})
+}
+
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) nextHook() func(context.Context, *types.CodeownersRepoPattern) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) appendCall(r0 CodeownersStoreUpdateCodeownersRepoPatternFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// CodeownersStoreUpdateCodeownersRepoPatternFuncCall objects describing the
+// invocations of this function.
+func (f *CodeownersStoreUpdateCodeownersRepoPatternFunc) History() []CodeownersStoreUpdateCodeownersRepoPatternFuncCall {
+	f.mutex.Lock()
+	history := make([]CodeownersStoreUpdateCodeownersRepoPatternFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// CodeownersStoreUpdateCodeownersRepoPatternFuncCall is an object that
+// describes an invocation of method UpdateCodeownersRepoPattern on an
+// instance of MockCodeownersStore.
+type CodeownersStoreUpdateCodeownersRepoPatternFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *types.CodeownersRepoPattern
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c CodeownersStoreUpdateCodeownersRepoPatternFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c CodeownersStoreUpdateCodeownersRepoPatternFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// MockEnterpriseDB is a mock implementation of the EnterpriseDB interface
+// (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/internal/database) used for
+// unit testing.
+type MockEnterpriseDB struct {
+	// AccessRequestsFunc is an instance of a mock function object
+	// controlling the behavior of the method AccessRequests.
+	AccessRequestsFunc *EnterpriseDBAccessRequestsFunc
+	// AccessTokensFunc is an instance of a mock function object controlling
+	// the behavior of the method AccessTokens.
+	AccessTokensFunc *EnterpriseDBAccessTokensFunc
+	// AuthzFunc is an instance of a mock function object controlling the
+	// behavior of the method Authz.
+	AuthzFunc *EnterpriseDBAuthzFunc
+	// BitbucketProjectPermissionsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// BitbucketProjectPermissions.
+	BitbucketProjectPermissionsFunc *EnterpriseDBBitbucketProjectPermissionsFunc
+	// CodeMonitorsFunc is an instance of a mock function object controlling
+	// the behavior of the method CodeMonitors.
 	CodeMonitorsFunc *EnterpriseDBCodeMonitorsFunc
 	// CodeownersFunc is an instance of a mock function object controlling
 	// the behavior of the method Codeowners.
@@ -7916,6 +9853,9 @@ type MockEnterpriseDB struct {
 	// OutboundWebhooksFunc is an instance of a mock function object
 	// controlling the behavior of the method OutboundWebhooks.
 	OutboundWebhooksFunc *EnterpriseDBOutboundWebhooksFunc
+	// OwnSignalsFunc is an instance of a mock function object controlling
+	// the behavior of the method OwnSignals.
+	OwnSignalsFunc *EnterpriseDBOwnSignalsFunc
 	// PermissionSyncJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method PermissionSyncJobs.
 	PermissionSyncJobsFunc *EnterpriseDBPermissionSyncJobsFunc
@@ -8136,6 +10076,11 @@ func NewMockEnterpriseDB() *MockEnterpriseDB {
 				return
 			},
 		},
+		OwnSignalsFunc: &EnterpriseDBOwnSignalsFunc{
+			defaultHook: func() (r0 OwnSignalStore) {
+				return
+			},
+		},
 		PermissionSyncJobsFunc: &EnterpriseDBPermissionSyncJobsFunc{
 			defaultHook: func() (r0 database.PermissionSyncJobStore) {
 				return
@@ -8413,6 +10358,11 @@ func NewStrictMockEnterpriseDB() *MockEnterpriseDB {
 				panic("unexpected invocation of MockEnterpriseDB.OutboundWebhooks")
 			},
 		},
+		OwnSignalsFunc: &EnterpriseDBOwnSignalsFunc{
+			defaultHook: func() OwnSignalStore {
+				panic("unexpected invocation of MockEnterpriseDB.OwnSignals")
+			},
+		},
 		PermissionSyncJobsFunc: &EnterpriseDBPermissionSyncJobsFunc{
 			defaultHook: func() database.PermissionSyncJobStore {
 				panic("unexpected invocation of MockEnterpriseDB.PermissionSyncJobs")
@@ -8639,6 +10589,9 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 		OutboundWebhooksFunc: &EnterpriseDBOutboundWebhooksFunc{
 			defaultHook: i.OutboundWebhooks,
 		},
+		OwnSignalsFunc: &EnterpriseDBOwnSignalsFunc{
+			defaultHook: i.OwnSignals,
+		},
 		PermissionSyncJobsFunc: &EnterpriseDBPermissionSyncJobsFunc{
 			defaultHook: i.PermissionSyncJobs,
 		},
@@ -8726,35 +10679,829 @@ func NewMockEnterpriseDBFrom(i EnterpriseDB) *MockEnterpriseDB {
 	}
 }
 
-// EnterpriseDBAccessRequestsFunc describes the behavior when the
-// AccessRequests method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBAccessRequestsFunc struct {
-	defaultHook func() database.AccessRequestStore
-	hooks       []func() database.AccessRequestStore
-	history     []EnterpriseDBAccessRequestsFuncCall
+// EnterpriseDBAccessRequestsFunc describes the behavior when the
+// AccessRequests method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBAccessRequestsFunc struct {
+	defaultHook func() database.AccessRequestStore
+	hooks       []func() database.AccessRequestStore
+	history     []EnterpriseDBAccessRequestsFuncCall
+	mutex       sync.Mutex
+}
+
+// AccessRequests delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) AccessRequests() database.AccessRequestStore {
+	r0 := m.AccessRequestsFunc.nextHook()()
+	m.AccessRequestsFunc.appendCall(EnterpriseDBAccessRequestsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AccessRequests
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBAccessRequestsFunc) SetDefaultHook(hook func() database.AccessRequestStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AccessRequests method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBAccessRequestsFunc) PushHook(hook func() database.AccessRequestStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBAccessRequestsFunc) SetDefaultReturn(r0 database.AccessRequestStore) {
+	f.SetDefaultHook(func() database.AccessRequestStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBAccessRequestsFunc) PushReturn(r0 database.AccessRequestStore) {
+	f.PushHook(func() database.AccessRequestStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBAccessRequestsFunc) nextHook() func() database.AccessRequestStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBAccessRequestsFunc) appendCall(r0 EnterpriseDBAccessRequestsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBAccessRequestsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBAccessRequestsFunc) History() []EnterpriseDBAccessRequestsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBAccessRequestsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBAccessRequestsFuncCall is an object that describes an
+// invocation of method AccessRequests on an instance of MockEnterpriseDB.
+type EnterpriseDBAccessRequestsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.AccessRequestStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBAccessRequestsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBAccessRequestsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBAccessTokensFunc describes the behavior when the AccessTokens
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBAccessTokensFunc struct {
+	defaultHook func() database.AccessTokenStore
+	hooks       []func() database.AccessTokenStore
+	history     []EnterpriseDBAccessTokensFuncCall
+	mutex       sync.Mutex
+}
+
+// AccessTokens delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) AccessTokens() database.AccessTokenStore {
+	r0 := m.AccessTokensFunc.nextHook()()
+	m.AccessTokensFunc.appendCall(EnterpriseDBAccessTokensFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the AccessTokens method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBAccessTokensFunc) SetDefaultHook(hook func() database.AccessTokenStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AccessTokens method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBAccessTokensFunc) PushHook(hook func() database.AccessTokenStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBAccessTokensFunc) SetDefaultReturn(r0 database.AccessTokenStore) {
+	f.SetDefaultHook(func() database.AccessTokenStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBAccessTokensFunc) PushReturn(r0 database.AccessTokenStore) {
+	f.PushHook(func() database.AccessTokenStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBAccessTokensFunc) nextHook() func() database.AccessTokenStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBAccessTokensFunc) appendCall(r0 EnterpriseDBAccessTokensFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBAccessTokensFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBAccessTokensFunc) History() []EnterpriseDBAccessTokensFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBAccessTokensFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBAccessTokensFuncCall is an object that describes an
+// invocation of method AccessTokens on an instance of MockEnterpriseDB.
+type EnterpriseDBAccessTokensFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.AccessTokenStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBAccessTokensFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBAccessTokensFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBAuthzFunc describes the behavior when the Authz method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBAuthzFunc struct {
+	defaultHook func() database.AuthzStore
+	hooks       []func() database.AuthzStore
+	history     []EnterpriseDBAuthzFuncCall
+	mutex       sync.Mutex
+}
+
+// Authz delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Authz() database.AuthzStore {
+	r0 := m.AuthzFunc.nextHook()()
+	m.AuthzFunc.appendCall(EnterpriseDBAuthzFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authz method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBAuthzFunc) SetDefaultHook(hook func() database.AuthzStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authz method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBAuthzFunc) SetDefaultReturn(r0 database.AuthzStore) {
+	f.SetDefaultHook(func() database.AuthzStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBAuthzFunc) PushReturn(r0 database.AuthzStore) {
+	f.PushHook(func() database.AuthzStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBAuthzFunc) appendCall(r0 EnterpriseDBAuthzFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBAuthzFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBAuthzFunc) History() []EnterpriseDBAuthzFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBAuthzFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBAuthzFuncCall is an object that describes an invocation of
+// method Authz on an instance of MockEnterpriseDB.
+type EnterpriseDBAuthzFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.AuthzStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBAuthzFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBAuthzFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBBitbucketProjectPermissionsFunc describes the behavior when
+// the BitbucketProjectPermissions method of the parent MockEnterpriseDB
+// instance is invoked.
+type EnterpriseDBBitbucketProjectPermissionsFunc struct {
+	defaultHook func() database.BitbucketProjectPermissionsStore
+	hooks       []func() database.BitbucketProjectPermissionsStore
+	history     []EnterpriseDBBitbucketProjectPermissionsFuncCall
+	mutex       sync.Mutex
+}
+
+// BitbucketProjectPermissions delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) BitbucketProjectPermissions() database.BitbucketProjectPermissionsStore {
+	r0 := m.BitbucketProjectPermissionsFunc.nextHook()()
+	m.BitbucketProjectPermissionsFunc.appendCall(EnterpriseDBBitbucketProjectPermissionsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// BitbucketProjectPermissions method of the parent MockEnterpriseDB
+// instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) SetDefaultHook(hook func() database.BitbucketProjectPermissionsStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// BitbucketProjectPermissions method of the parent MockEnterpriseDB
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) PushHook(hook func() database.BitbucketProjectPermissionsStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) SetDefaultReturn(r0 database.BitbucketProjectPermissionsStore) {
+	f.SetDefaultHook(func() database.BitbucketProjectPermissionsStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) PushReturn(r0 database.BitbucketProjectPermissionsStore) {
+	f.PushHook(func() database.BitbucketProjectPermissionsStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) nextHook() func() database.BitbucketProjectPermissionsStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) appendCall(r0 EnterpriseDBBitbucketProjectPermissionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// EnterpriseDBBitbucketProjectPermissionsFuncCall objects describing the
+// invocations of this function.
+func (f *EnterpriseDBBitbucketProjectPermissionsFunc) History() []EnterpriseDBBitbucketProjectPermissionsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBBitbucketProjectPermissionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBBitbucketProjectPermissionsFuncCall is an object that
+// describes an invocation of method BitbucketProjectPermissions on an
+// instance of MockEnterpriseDB.
+type EnterpriseDBBitbucketProjectPermissionsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.BitbucketProjectPermissionsStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBBitbucketProjectPermissionsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBBitbucketProjectPermissionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBCodeMonitorsFunc describes the behavior when the CodeMonitors
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBCodeMonitorsFunc struct {
+	defaultHook func() CodeMonitorStore
+	hooks       []func() CodeMonitorStore
+	history     []EnterpriseDBCodeMonitorsFuncCall
+	mutex       sync.Mutex
+}
+
+// CodeMonitors delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) CodeMonitors() CodeMonitorStore {
+	r0 := m.CodeMonitorsFunc.nextHook()()
+	m.CodeMonitorsFunc.appendCall(EnterpriseDBCodeMonitorsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the CodeMonitors method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBCodeMonitorsFunc) SetDefaultHook(hook func() CodeMonitorStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CodeMonitors method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBCodeMonitorsFunc) PushHook(hook func() CodeMonitorStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBCodeMonitorsFunc) SetDefaultReturn(r0 CodeMonitorStore) {
+	f.SetDefaultHook(func() CodeMonitorStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBCodeMonitorsFunc) PushReturn(r0 CodeMonitorStore) {
+	f.PushHook(func() CodeMonitorStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBCodeMonitorsFunc) nextHook() func() CodeMonitorStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBCodeMonitorsFunc) appendCall(r0 EnterpriseDBCodeMonitorsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBCodeMonitorsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBCodeMonitorsFunc) History() []EnterpriseDBCodeMonitorsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBCodeMonitorsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBCodeMonitorsFuncCall is an object that describes an
+// invocation of method CodeMonitors on an instance of MockEnterpriseDB.
+type EnterpriseDBCodeMonitorsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 CodeMonitorStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBCodeMonitorsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBCodeMonitorsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBCodeownersFunc describes the behavior when the Codeowners
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBCodeownersFunc struct {
+	defaultHook func() CodeownersStore
+	hooks       []func() CodeownersStore
+	history     []EnterpriseDBCodeownersFuncCall
+	mutex       sync.Mutex
+}
+
+// Codeowners delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Codeowners() CodeownersStore {
+	r0 := m.CodeownersFunc.nextHook()()
+	m.CodeownersFunc.appendCall(EnterpriseDBCodeownersFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Codeowners method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBCodeownersFunc) SetDefaultHook(hook func() CodeownersStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Codeowners method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBCodeownersFunc) PushHook(hook func() CodeownersStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBCodeownersFunc) SetDefaultReturn(r0 CodeownersStore) {
+	f.SetDefaultHook(func() CodeownersStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBCodeownersFunc) PushReturn(r0 CodeownersStore) {
+	f.PushHook(func() CodeownersStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBCodeownersFunc) nextHook() func() CodeownersStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBCodeownersFunc) appendCall(r0 EnterpriseDBCodeownersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBCodeownersFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBCodeownersFunc) History() []EnterpriseDBCodeownersFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBCodeownersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBCodeownersFuncCall is an object that describes an invocation
+// of method Codeowners on an instance of MockEnterpriseDB.
+type EnterpriseDBCodeownersFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 CodeownersStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBCodeownersFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBCodeownersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBConfFunc describes the behavior when the Conf method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBConfFunc struct {
+	defaultHook func() database.ConfStore
+	hooks       []func() database.ConfStore
+	history     []EnterpriseDBConfFuncCall
+	mutex       sync.Mutex
+}
+
+// Conf delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Conf() database.ConfStore {
+	r0 := m.ConfFunc.nextHook()()
+	m.ConfFunc.appendCall(EnterpriseDBConfFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Conf method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBConfFunc) SetDefaultHook(hook func() database.ConfStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Conf method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBConfFunc) PushHook(hook func() database.ConfStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBConfFunc) SetDefaultReturn(r0 database.ConfStore) {
+	f.SetDefaultHook(func() database.ConfStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBConfFunc) PushReturn(r0 database.ConfStore) {
+	f.PushHook(func() database.ConfStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBConfFunc) nextHook() func() database.ConfStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBConfFunc) appendCall(r0 EnterpriseDBConfFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBConfFuncCall objects describing
+// the invocations of this function.
+func (f *EnterpriseDBConfFunc) History() []EnterpriseDBConfFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBConfFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBConfFuncCall is an object that describes an invocation of
+// method Conf on an instance of MockEnterpriseDB.
+type EnterpriseDBConfFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.ConfStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBConfFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBConfFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBEventLogsFunc describes the behavior when the EventLogs
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBEventLogsFunc struct {
+	defaultHook func() database.EventLogStore
+	hooks       []func() database.EventLogStore
+	history     []EnterpriseDBEventLogsFuncCall
+	mutex       sync.Mutex
+}
+
+// EventLogs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) EventLogs() database.EventLogStore {
+	r0 := m.EventLogsFunc.nextHook()()
+	m.EventLogsFunc.appendCall(EnterpriseDBEventLogsFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the EventLogs method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBEventLogsFunc) SetDefaultHook(hook func() database.EventLogStore) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EventLogs method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBEventLogsFunc) PushHook(hook func() database.EventLogStore) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *EnterpriseDBEventLogsFunc) SetDefaultReturn(r0 database.EventLogStore) {
+	f.SetDefaultHook(func() database.EventLogStore {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBEventLogsFunc) PushReturn(r0 database.EventLogStore) {
+	f.PushHook(func() database.EventLogStore {
+		return r0
+	})
+}
+
+func (f *EnterpriseDBEventLogsFunc) nextHook() func() database.EventLogStore {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *EnterpriseDBEventLogsFunc) appendCall(r0 EnterpriseDBEventLogsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of EnterpriseDBEventLogsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBEventLogsFunc) History() []EnterpriseDBEventLogsFuncCall {
+	f.mutex.Lock()
+	history := make([]EnterpriseDBEventLogsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// EnterpriseDBEventLogsFuncCall is an object that describes an invocation
+// of method EventLogs on an instance of MockEnterpriseDB.
+type EnterpriseDBEventLogsFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 database.EventLogStore
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c EnterpriseDBEventLogsFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c EnterpriseDBEventLogsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// EnterpriseDBExecContextFunc describes the behavior when the ExecContext
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBExecContextFunc struct {
+	defaultHook func(context.Context, string, ...interface{}) (sql.Result, error)
+	hooks       []func(context.Context, string, ...interface{}) (sql.Result, error)
+	history     []EnterpriseDBExecContextFuncCall
 	mutex       sync.Mutex
 }
 
-// AccessRequests delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) AccessRequests() database.AccessRequestStore {
-	r0 := m.AccessRequestsFunc.nextHook()()
-	m.AccessRequestsFunc.appendCall(EnterpriseDBAccessRequestsFuncCall{r0})
-	return r0
+// ExecContext delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) ExecContext(v0 context.Context, v1 string, v2 ...interface{}) (sql.Result, error) {
+	r0, r1 := m.ExecContextFunc.nextHook()(v0, v1, v2...)
+	m.ExecContextFunc.appendCall(EnterpriseDBExecContextFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the AccessRequests
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBAccessRequestsFunc) SetDefaultHook(hook func() database.AccessRequestStore) {
+// SetDefaultHook sets function that is called when the ExecContext method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBExecContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) (sql.Result, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AccessRequests method of the parent MockEnterpriseDB instance invokes the
+// ExecContext method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBAccessRequestsFunc) PushHook(hook func() database.AccessRequestStore) {
+func (f *EnterpriseDBExecContextFunc) PushHook(hook func(context.Context, string, ...interface{}) (sql.Result, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8762,20 +11509,20 @@ func (f *EnterpriseDBAccessRequestsFunc) PushHook(hook func() database.AccessReq
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBAccessRequestsFunc) SetDefaultReturn(r0 database.AccessRequestStore) {
-	f.SetDefaultHook(func() database.AccessRequestStore {
-		return r0
+func (f *EnterpriseDBExecContextFunc) SetDefaultReturn(r0 sql.Result, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, ...interface{}) (sql.Result, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBAccessRequestsFunc) PushReturn(r0 database.AccessRequestStore) {
-	f.PushHook(func() database.AccessRequestStore {
-		return r0
+func (f *EnterpriseDBExecContextFunc) PushReturn(r0 sql.Result, r1 error) {
+	f.PushHook(func(context.Context, string, ...interface{}) (sql.Result, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnterpriseDBAccessRequestsFunc) nextHook() func() database.AccessRequestStore {
+func (f *EnterpriseDBExecContextFunc) nextHook() func(context.Context, string, ...interface{}) (sql.Result, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8788,72 +11535,93 @@ func (f *EnterpriseDBAccessRequestsFunc) nextHook() func() database.AccessReques
 	return hook
 }
 
-func (f *EnterpriseDBAccessRequestsFunc) appendCall(r0 EnterpriseDBAccessRequestsFuncCall) {
+func (f *EnterpriseDBExecContextFunc) appendCall(r0 EnterpriseDBExecContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBAccessRequestsFuncCall objects
+// History returns a sequence of EnterpriseDBExecContextFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBAccessRequestsFunc) History() []EnterpriseDBAccessRequestsFuncCall {
+func (f *EnterpriseDBExecContextFunc) History() []EnterpriseDBExecContextFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBAccessRequestsFuncCall, len(f.history))
+	history := make([]EnterpriseDBExecContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBAccessRequestsFuncCall is an object that describes an
-// invocation of method AccessRequests on an instance of MockEnterpriseDB.
-type EnterpriseDBAccessRequestsFuncCall struct {
+// EnterpriseDBExecContextFuncCall is an object that describes an invocation
+// of method ExecContext on an instance of MockEnterpriseDB.
+type EnterpriseDBExecContextFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []interface{}
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.AccessRequestStore
+	Result0 sql.Result
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c EnterpriseDBAccessRequestsFuncCall) Args() []interface{} {
-	return []interface{}{}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c EnterpriseDBExecContextFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBAccessRequestsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c EnterpriseDBExecContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnterpriseDBAccessTokensFunc describes the behavior when the AccessTokens
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBAccessTokensFunc struct {
-	defaultHook func() database.AccessTokenStore
-	hooks       []func() database.AccessTokenStore
-	history     []EnterpriseDBAccessTokensFuncCall
+// EnterpriseDBExecutorSecretAccessLogsFunc describes the behavior when the
+// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
+// is invoked.
+type EnterpriseDBExecutorSecretAccessLogsFunc struct {
+	defaultHook func() database.ExecutorSecretAccessLogStore
+	hooks       []func() database.ExecutorSecretAccessLogStore
+	history     []EnterpriseDBExecutorSecretAccessLogsFuncCall
 	mutex       sync.Mutex
 }
 
-// AccessTokens delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) AccessTokens() database.AccessTokenStore {
-	r0 := m.AccessTokensFunc.nextHook()()
-	m.AccessTokensFunc.appendCall(EnterpriseDBAccessTokensFuncCall{r0})
+// ExecutorSecretAccessLogs delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) ExecutorSecretAccessLogs() database.ExecutorSecretAccessLogStore {
+	r0 := m.ExecutorSecretAccessLogsFunc.nextHook()()
+	m.ExecutorSecretAccessLogsFunc.appendCall(EnterpriseDBExecutorSecretAccessLogsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the AccessTokens method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBAccessTokensFunc) SetDefaultHook(hook func() database.AccessTokenStore) {
+// SetDefaultHook sets function that is called when the
+// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
+// is invoked and the hook queue is empty.
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) SetDefaultHook(hook func() database.ExecutorSecretAccessLogStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AccessTokens method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBAccessTokensFunc) PushHook(hook func() database.AccessTokenStore) {
+// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) PushHook(hook func() database.ExecutorSecretAccessLogStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8861,20 +11629,20 @@ func (f *EnterpriseDBAccessTokensFunc) PushHook(hook func() database.AccessToken
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBAccessTokensFunc) SetDefaultReturn(r0 database.AccessTokenStore) {
-	f.SetDefaultHook(func() database.AccessTokenStore {
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) SetDefaultReturn(r0 database.ExecutorSecretAccessLogStore) {
+	f.SetDefaultHook(func() database.ExecutorSecretAccessLogStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBAccessTokensFunc) PushReturn(r0 database.AccessTokenStore) {
-	f.PushHook(func() database.AccessTokenStore {
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) PushReturn(r0 database.ExecutorSecretAccessLogStore) {
+	f.PushHook(func() database.ExecutorSecretAccessLogStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBAccessTokensFunc) nextHook() func() database.AccessTokenStore {
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) nextHook() func() database.ExecutorSecretAccessLogStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8887,71 +11655,75 @@ func (f *EnterpriseDBAccessTokensFunc) nextHook() func() database.AccessTokenSto
 	return hook
 }
 
-func (f *EnterpriseDBAccessTokensFunc) appendCall(r0 EnterpriseDBAccessTokensFuncCall) {
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) appendCall(r0 EnterpriseDBExecutorSecretAccessLogsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBAccessTokensFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBAccessTokensFunc) History() []EnterpriseDBAccessTokensFuncCall {
+// History returns a sequence of
+// EnterpriseDBExecutorSecretAccessLogsFuncCall objects describing the
+// invocations of this function.
+func (f *EnterpriseDBExecutorSecretAccessLogsFunc) History() []EnterpriseDBExecutorSecretAccessLogsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBAccessTokensFuncCall, len(f.history))
+	history := make([]EnterpriseDBExecutorSecretAccessLogsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBAccessTokensFuncCall is an object that describes an
-// invocation of method AccessTokens on an instance of MockEnterpriseDB.
-type EnterpriseDBAccessTokensFuncCall struct {
+// EnterpriseDBExecutorSecretAccessLogsFuncCall is an object that describes
+// an invocation of method ExecutorSecretAccessLogs on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBExecutorSecretAccessLogsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.AccessTokenStore
+	Result0 database.ExecutorSecretAccessLogStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBAccessTokensFuncCall) Args() []interface{} {
+func (c EnterpriseDBExecutorSecretAccessLogsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBAccessTokensFuncCall) Results() []interface{} {
+func (c EnterpriseDBExecutorSecretAccessLogsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBAuthzFunc describes the behavior when the Authz method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBAuthzFunc struct {
-	defaultHook func() database.AuthzStore
-	hooks       []func() database.AuthzStore
-	history     []EnterpriseDBAuthzFuncCall
+// EnterpriseDBExecutorSecretsFunc describes the behavior when the
+// ExecutorSecrets method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBExecutorSecretsFunc struct {
+	defaultHook func(encryption.Key) database.ExecutorSecretStore
+	hooks       []func(encryption.Key) database.ExecutorSecretStore
+	history     []EnterpriseDBExecutorSecretsFuncCall
 	mutex       sync.Mutex
 }
 
-// Authz delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Authz() database.AuthzStore {
-	r0 := m.AuthzFunc.nextHook()()
-	m.AuthzFunc.appendCall(EnterpriseDBAuthzFuncCall{r0})
+// ExecutorSecrets delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) ExecutorSecrets(v0 encryption.Key) database.ExecutorSecretStore {
+	r0 := m.ExecutorSecretsFunc.nextHook()(v0)
+	m.ExecutorSecretsFunc.appendCall(EnterpriseDBExecutorSecretsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Authz method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBAuthzFunc) SetDefaultHook(hook func() database.AuthzStore) {
+// SetDefaultHook sets function that is called when the ExecutorSecrets
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBExecutorSecretsFunc) SetDefaultHook(hook func(encryption.Key) database.ExecutorSecretStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Authz method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
+// ExecutorSecrets method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBExecutorSecretsFunc) PushHook(hook func(encryption.Key) database.ExecutorSecretStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8959,20 +11731,20 @@ func (f *EnterpriseDBAuthzFunc) PushHook(hook func() database.AuthzStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBAuthzFunc) SetDefaultReturn(r0 database.AuthzStore) {
-	f.SetDefaultHook(func() database.AuthzStore {
+func (f *EnterpriseDBExecutorSecretsFunc) SetDefaultReturn(r0 database.ExecutorSecretStore) {
+	f.SetDefaultHook(func(encryption.Key) database.ExecutorSecretStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBAuthzFunc) PushReturn(r0 database.AuthzStore) {
-	f.PushHook(func() database.AuthzStore {
+func (f *EnterpriseDBExecutorSecretsFunc) PushReturn(r0 database.ExecutorSecretStore) {
+	f.PushHook(func(encryption.Key) database.ExecutorSecretStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
+func (f *EnterpriseDBExecutorSecretsFunc) nextHook() func(encryption.Key) database.ExecutorSecretStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8985,74 +11757,75 @@ func (f *EnterpriseDBAuthzFunc) nextHook() func() database.AuthzStore {
 	return hook
 }
 
-func (f *EnterpriseDBAuthzFunc) appendCall(r0 EnterpriseDBAuthzFuncCall) {
+func (f *EnterpriseDBExecutorSecretsFunc) appendCall(r0 EnterpriseDBExecutorSecretsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBAuthzFuncCall objects
+// History returns a sequence of EnterpriseDBExecutorSecretsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBAuthzFunc) History() []EnterpriseDBAuthzFuncCall {
+func (f *EnterpriseDBExecutorSecretsFunc) History() []EnterpriseDBExecutorSecretsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBAuthzFuncCall, len(f.history))
+	history := make([]EnterpriseDBExecutorSecretsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBAuthzFuncCall is an object that describes an invocation of
-// method Authz on an instance of MockEnterpriseDB.
-type EnterpriseDBAuthzFuncCall struct {
+// EnterpriseDBExecutorSecretsFuncCall is an object that describes an
+// invocation of method ExecutorSecrets on an instance of MockEnterpriseDB.
+type EnterpriseDBExecutorSecretsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.AuthzStore
+	Result0 database.ExecutorSecretStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBAuthzFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBExecutorSecretsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBAuthzFuncCall) Results() []interface{} {
+func (c EnterpriseDBExecutorSecretsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBBitbucketProjectPermissionsFunc describes the behavior when
-// the BitbucketProjectPermissions method of the parent MockEnterpriseDB
-// instance is invoked.
-type EnterpriseDBBitbucketProjectPermissionsFunc struct {
-	defaultHook func() database.BitbucketProjectPermissionsStore
-	hooks       []func() database.BitbucketProjectPermissionsStore
-	history     []EnterpriseDBBitbucketProjectPermissionsFuncCall
+// EnterpriseDBExecutorsFunc describes the behavior when the Executors
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBExecutorsFunc struct {
+	defaultHook func() database.ExecutorStore
+	hooks       []func() database.ExecutorStore
+	history     []EnterpriseDBExecutorsFuncCall
 	mutex       sync.Mutex
 }
 
-// BitbucketProjectPermissions delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) BitbucketProjectPermissions() database.BitbucketProjectPermissionsStore {
-	r0 := m.BitbucketProjectPermissionsFunc.nextHook()()
-	m.BitbucketProjectPermissionsFunc.appendCall(EnterpriseDBBitbucketProjectPermissionsFuncCall{r0})
+// Executors delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Executors() database.ExecutorStore {
+	r0 := m.ExecutorsFunc.nextHook()()
+	m.ExecutorsFunc.appendCall(EnterpriseDBExecutorsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// BitbucketProjectPermissions method of the parent MockEnterpriseDB
-// instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) SetDefaultHook(hook func() database.BitbucketProjectPermissionsStore) {
+// SetDefaultHook sets function that is called when the Executors method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBExecutorsFunc) SetDefaultHook(hook func() database.ExecutorStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// BitbucketProjectPermissions method of the parent MockEnterpriseDB
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) PushHook(hook func() database.BitbucketProjectPermissionsStore) {
+// Executors method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBExecutorsFunc) PushHook(hook func() database.ExecutorStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9060,20 +11833,20 @@ func (f *EnterpriseDBBitbucketProjectPermissionsFunc) PushHook(hook func() datab
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) SetDefaultReturn(r0 database.BitbucketProjectPermissionsStore) {
-	f.SetDefaultHook(func() database.BitbucketProjectPermissionsStore {
+func (f *EnterpriseDBExecutorsFunc) SetDefaultReturn(r0 database.ExecutorStore) {
+	f.SetDefaultHook(func() database.ExecutorStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) PushReturn(r0 database.BitbucketProjectPermissionsStore) {
-	f.PushHook(func() database.BitbucketProjectPermissionsStore {
+func (f *EnterpriseDBExecutorsFunc) PushReturn(r0 database.ExecutorStore) {
+	f.PushHook(func() database.ExecutorStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) nextHook() func() database.BitbucketProjectPermissionsStore {
+func (f *EnterpriseDBExecutorsFunc) nextHook() func() database.ExecutorStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9086,74 +11859,73 @@ func (f *EnterpriseDBBitbucketProjectPermissionsFunc) nextHook() func() database
 	return hook
 }
 
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) appendCall(r0 EnterpriseDBBitbucketProjectPermissionsFuncCall) {
+func (f *EnterpriseDBExecutorsFunc) appendCall(r0 EnterpriseDBExecutorsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnterpriseDBBitbucketProjectPermissionsFuncCall objects describing the
-// invocations of this function.
-func (f *EnterpriseDBBitbucketProjectPermissionsFunc) History() []EnterpriseDBBitbucketProjectPermissionsFuncCall {
+// History returns a sequence of EnterpriseDBExecutorsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBExecutorsFunc) History() []EnterpriseDBExecutorsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBBitbucketProjectPermissionsFuncCall, len(f.history))
+	history := make([]EnterpriseDBExecutorsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBBitbucketProjectPermissionsFuncCall is an object that
-// describes an invocation of method BitbucketProjectPermissions on an
-// instance of MockEnterpriseDB.
-type EnterpriseDBBitbucketProjectPermissionsFuncCall struct {
+// EnterpriseDBExecutorsFuncCall is an object that describes an invocation
+// of method Executors on an instance of MockEnterpriseDB.
+type EnterpriseDBExecutorsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.BitbucketProjectPermissionsStore
+	Result0 database.ExecutorStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBBitbucketProjectPermissionsFuncCall) Args() []interface{} {
+func (c EnterpriseDBExecutorsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBBitbucketProjectPermissionsFuncCall) Results() []interface{} {
+func (c EnterpriseDBExecutorsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBCodeMonitorsFunc describes the behavior when the CodeMonitors
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBCodeMonitorsFunc struct {
-	defaultHook func() CodeMonitorStore
-	hooks       []func() CodeMonitorStore
-	history     []EnterpriseDBCodeMonitorsFuncCall
+// EnterpriseDBExternalServicesFunc describes the behavior when the
+// ExternalServices method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBExternalServicesFunc struct {
+	defaultHook func() database.ExternalServiceStore
+	hooks       []func() database.ExternalServiceStore
+	history     []EnterpriseDBExternalServicesFuncCall
 	mutex       sync.Mutex
 }
 
-// CodeMonitors delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) CodeMonitors() CodeMonitorStore {
-	r0 := m.CodeMonitorsFunc.nextHook()()
-	m.CodeMonitorsFunc.appendCall(EnterpriseDBCodeMonitorsFuncCall{r0})
+// ExternalServices delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) ExternalServices() database.ExternalServiceStore {
+	r0 := m.ExternalServicesFunc.nextHook()()
+	m.ExternalServicesFunc.appendCall(EnterpriseDBExternalServicesFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the CodeMonitors method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBCodeMonitorsFunc) SetDefaultHook(hook func() CodeMonitorStore) {
+// SetDefaultHook sets function that is called when the ExternalServices
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBExternalServicesFunc) SetDefaultHook(hook func() database.ExternalServiceStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CodeMonitors method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBCodeMonitorsFunc) PushHook(hook func() CodeMonitorStore) {
+// ExternalServices method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBExternalServicesFunc) PushHook(hook func() database.ExternalServiceStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9161,20 +11933,20 @@ func (f *EnterpriseDBCodeMonitorsFunc) PushHook(hook func() CodeMonitorStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBCodeMonitorsFunc) SetDefaultReturn(r0 CodeMonitorStore) {
-	f.SetDefaultHook(func() CodeMonitorStore {
+func (f *EnterpriseDBExternalServicesFunc) SetDefaultReturn(r0 database.ExternalServiceStore) {
+	f.SetDefaultHook(func() database.ExternalServiceStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBCodeMonitorsFunc) PushReturn(r0 CodeMonitorStore) {
-	f.PushHook(func() CodeMonitorStore {
+func (f *EnterpriseDBExternalServicesFunc) PushReturn(r0 database.ExternalServiceStore) {
+	f.PushHook(func() database.ExternalServiceStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBCodeMonitorsFunc) nextHook() func() CodeMonitorStore {
+func (f *EnterpriseDBExternalServicesFunc) nextHook() func() database.ExternalServiceStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9187,72 +11959,72 @@ func (f *EnterpriseDBCodeMonitorsFunc) nextHook() func() CodeMonitorStore {
 	return hook
 }
 
-func (f *EnterpriseDBCodeMonitorsFunc) appendCall(r0 EnterpriseDBCodeMonitorsFuncCall) {
+func (f *EnterpriseDBExternalServicesFunc) appendCall(r0 EnterpriseDBExternalServicesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBCodeMonitorsFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBCodeMonitorsFunc) History() []EnterpriseDBCodeMonitorsFuncCall {
+// History returns a sequence of EnterpriseDBExternalServicesFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBExternalServicesFunc) History() []EnterpriseDBExternalServicesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBCodeMonitorsFuncCall, len(f.history))
+	history := make([]EnterpriseDBExternalServicesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBCodeMonitorsFuncCall is an object that describes an
-// invocation of method CodeMonitors on an instance of MockEnterpriseDB.
-type EnterpriseDBCodeMonitorsFuncCall struct {
+// EnterpriseDBExternalServicesFuncCall is an object that describes an
+// invocation of method ExternalServices on an instance of MockEnterpriseDB.
+type EnterpriseDBExternalServicesFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 CodeMonitorStore
+	Result0 database.ExternalServiceStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBCodeMonitorsFuncCall) Args() []interface{} {
+func (c EnterpriseDBExternalServicesFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBCodeMonitorsFuncCall) Results() []interface{} {
+func (c EnterpriseDBExternalServicesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBCodeownersFunc describes the behavior when the Codeowners
+// EnterpriseDBFeatureFlagsFunc describes the behavior when the FeatureFlags
 // method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBCodeownersFunc struct {
-	defaultHook func() CodeownersStore
-	hooks       []func() CodeownersStore
-	history     []EnterpriseDBCodeownersFuncCall
+type EnterpriseDBFeatureFlagsFunc struct {
+	defaultHook func() database.FeatureFlagStore
+	hooks       []func() database.FeatureFlagStore
+	history     []EnterpriseDBFeatureFlagsFuncCall
 	mutex       sync.Mutex
 }
 
-// Codeowners delegates to the next hook function in the queue and stores
+// FeatureFlags delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Codeowners() CodeownersStore {
-	r0 := m.CodeownersFunc.nextHook()()
-	m.CodeownersFunc.appendCall(EnterpriseDBCodeownersFuncCall{r0})
+func (m *MockEnterpriseDB) FeatureFlags() database.FeatureFlagStore {
+	r0 := m.FeatureFlagsFunc.nextHook()()
+	m.FeatureFlagsFunc.appendCall(EnterpriseDBFeatureFlagsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Codeowners method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the FeatureFlags method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBCodeownersFunc) SetDefaultHook(hook func() CodeownersStore) {
+func (f *EnterpriseDBFeatureFlagsFunc) SetDefaultHook(hook func() database.FeatureFlagStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Codeowners method of the parent MockEnterpriseDB instance invokes the
+// FeatureFlags method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBCodeownersFunc) PushHook(hook func() CodeownersStore) {
+func (f *EnterpriseDBFeatureFlagsFunc) PushHook(hook func() database.FeatureFlagStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9260,20 +12032,20 @@ func (f *EnterpriseDBCodeownersFunc) PushHook(hook func() CodeownersStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBCodeownersFunc) SetDefaultReturn(r0 CodeownersStore) {
-	f.SetDefaultHook(func() CodeownersStore {
+func (f *EnterpriseDBFeatureFlagsFunc) SetDefaultReturn(r0 database.FeatureFlagStore) {
+	f.SetDefaultHook(func() database.FeatureFlagStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBCodeownersFunc) PushReturn(r0 CodeownersStore) {
-	f.PushHook(func() CodeownersStore {
+func (f *EnterpriseDBFeatureFlagsFunc) PushReturn(r0 database.FeatureFlagStore) {
+	f.PushHook(func() database.FeatureFlagStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBCodeownersFunc) nextHook() func() CodeownersStore {
+func (f *EnterpriseDBFeatureFlagsFunc) nextHook() func() database.FeatureFlagStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9286,71 +12058,74 @@ func (f *EnterpriseDBCodeownersFunc) nextHook() func() CodeownersStore {
 	return hook
 }
 
-func (f *EnterpriseDBCodeownersFunc) appendCall(r0 EnterpriseDBCodeownersFuncCall) {
+func (f *EnterpriseDBFeatureFlagsFunc) appendCall(r0 EnterpriseDBFeatureFlagsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBCodeownersFuncCall objects
+// History returns a sequence of EnterpriseDBFeatureFlagsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBCodeownersFunc) History() []EnterpriseDBCodeownersFuncCall {
+func (f *EnterpriseDBFeatureFlagsFunc) History() []EnterpriseDBFeatureFlagsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBCodeownersFuncCall, len(f.history))
+	history := make([]EnterpriseDBFeatureFlagsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBCodeownersFuncCall is an object that describes an invocation
-// of method Codeowners on an instance of MockEnterpriseDB.
-type EnterpriseDBCodeownersFuncCall struct {
+// EnterpriseDBFeatureFlagsFuncCall is an object that describes an
+// invocation of method FeatureFlags on an instance of MockEnterpriseDB.
+type EnterpriseDBFeatureFlagsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 CodeownersStore
+	Result0 database.FeatureFlagStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBCodeownersFuncCall) Args() []interface{} {
+func (c EnterpriseDBFeatureFlagsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBCodeownersFuncCall) Results() []interface{} {
+func (c EnterpriseDBFeatureFlagsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBConfFunc describes the behavior when the Conf method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBConfFunc struct {
-	defaultHook func() database.ConfStore
-	hooks       []func() database.ConfStore
-	history     []EnterpriseDBConfFuncCall
+// EnterpriseDBGitserverLocalCloneFunc describes the behavior when the
+// GitserverLocalClone method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBGitserverLocalCloneFunc struct {
+	defaultHook func() database.GitserverLocalCloneStore
+	hooks       []func() database.GitserverLocalCloneStore
+	history     []EnterpriseDBGitserverLocalCloneFuncCall
 	mutex       sync.Mutex
 }
 
-// Conf delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Conf() database.ConfStore {
-	r0 := m.ConfFunc.nextHook()()
-	m.ConfFunc.appendCall(EnterpriseDBConfFuncCall{r0})
+// GitserverLocalClone delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) GitserverLocalClone() database.GitserverLocalCloneStore {
+	r0 := m.GitserverLocalCloneFunc.nextHook()()
+	m.GitserverLocalCloneFunc.appendCall(EnterpriseDBGitserverLocalCloneFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Conf method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBConfFunc) SetDefaultHook(hook func() database.ConfStore) {
+// SetDefaultHook sets function that is called when the GitserverLocalClone
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBGitserverLocalCloneFunc) SetDefaultHook(hook func() database.GitserverLocalCloneStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Conf method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBConfFunc) PushHook(hook func() database.ConfStore) {
+// GitserverLocalClone method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBGitserverLocalCloneFunc) PushHook(hook func() database.GitserverLocalCloneStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9358,20 +12133,20 @@ func (f *EnterpriseDBConfFunc) PushHook(hook func() database.ConfStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBConfFunc) SetDefaultReturn(r0 database.ConfStore) {
-	f.SetDefaultHook(func() database.ConfStore {
+func (f *EnterpriseDBGitserverLocalCloneFunc) SetDefaultReturn(r0 database.GitserverLocalCloneStore) {
+	f.SetDefaultHook(func() database.GitserverLocalCloneStore {
 		return r0
 	})
 }
 
-// PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBConfFunc) PushReturn(r0 database.ConfStore) {
-	f.PushHook(func() database.ConfStore {
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *EnterpriseDBGitserverLocalCloneFunc) PushReturn(r0 database.GitserverLocalCloneStore) {
+	f.PushHook(func() database.GitserverLocalCloneStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBConfFunc) nextHook() func() database.ConfStore {
+func (f *EnterpriseDBGitserverLocalCloneFunc) nextHook() func() database.GitserverLocalCloneStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9384,72 +12159,73 @@ func (f *EnterpriseDBConfFunc) nextHook() func() database.ConfStore {
 	return hook
 }
 
-func (f *EnterpriseDBConfFunc) appendCall(r0 EnterpriseDBConfFuncCall) {
+func (f *EnterpriseDBGitserverLocalCloneFunc) appendCall(r0 EnterpriseDBGitserverLocalCloneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBConfFuncCall objects describing
-// the invocations of this function.
-func (f *EnterpriseDBConfFunc) History() []EnterpriseDBConfFuncCall {
+// History returns a sequence of EnterpriseDBGitserverLocalCloneFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBGitserverLocalCloneFunc) History() []EnterpriseDBGitserverLocalCloneFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBConfFuncCall, len(f.history))
+	history := make([]EnterpriseDBGitserverLocalCloneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBConfFuncCall is an object that describes an invocation of
-// method Conf on an instance of MockEnterpriseDB.
-type EnterpriseDBConfFuncCall struct {
+// EnterpriseDBGitserverLocalCloneFuncCall is an object that describes an
+// invocation of method GitserverLocalClone on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBGitserverLocalCloneFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ConfStore
+	Result0 database.GitserverLocalCloneStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBConfFuncCall) Args() []interface{} {
+func (c EnterpriseDBGitserverLocalCloneFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBConfFuncCall) Results() []interface{} {
+func (c EnterpriseDBGitserverLocalCloneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBEventLogsFunc describes the behavior when the EventLogs
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBEventLogsFunc struct {
-	defaultHook func() database.EventLogStore
-	hooks       []func() database.EventLogStore
-	history     []EnterpriseDBEventLogsFuncCall
+// EnterpriseDBGitserverReposFunc describes the behavior when the
+// GitserverRepos method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBGitserverReposFunc struct {
+	defaultHook func() database.GitserverRepoStore
+	hooks       []func() database.GitserverRepoStore
+	history     []EnterpriseDBGitserverReposFuncCall
 	mutex       sync.Mutex
 }
 
-// EventLogs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) EventLogs() database.EventLogStore {
-	r0 := m.EventLogsFunc.nextHook()()
-	m.EventLogsFunc.appendCall(EnterpriseDBEventLogsFuncCall{r0})
+// GitserverRepos delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) GitserverRepos() database.GitserverRepoStore {
+	r0 := m.GitserverReposFunc.nextHook()()
+	m.GitserverReposFunc.appendCall(EnterpriseDBGitserverReposFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the EventLogs method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBEventLogsFunc) SetDefaultHook(hook func() database.EventLogStore) {
+// SetDefaultHook sets function that is called when the GitserverRepos
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBGitserverReposFunc) SetDefaultHook(hook func() database.GitserverRepoStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// EventLogs method of the parent MockEnterpriseDB instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBEventLogsFunc) PushHook(hook func() database.EventLogStore) {
+// GitserverRepos method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBGitserverReposFunc) PushHook(hook func() database.GitserverRepoStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9457,20 +12233,20 @@ func (f *EnterpriseDBEventLogsFunc) PushHook(hook func() database.EventLogStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBEventLogsFunc) SetDefaultReturn(r0 database.EventLogStore) {
-	f.SetDefaultHook(func() database.EventLogStore {
+func (f *EnterpriseDBGitserverReposFunc) SetDefaultReturn(r0 database.GitserverRepoStore) {
+	f.SetDefaultHook(func() database.GitserverRepoStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBEventLogsFunc) PushReturn(r0 database.EventLogStore) {
-	f.PushHook(func() database.EventLogStore {
+func (f *EnterpriseDBGitserverReposFunc) PushReturn(r0 database.GitserverRepoStore) {
+	f.PushHook(func() database.GitserverRepoStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBEventLogsFunc) nextHook() func() database.EventLogStore {
+func (f *EnterpriseDBGitserverReposFunc) nextHook() func() database.GitserverRepoStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9483,72 +12259,72 @@ func (f *EnterpriseDBEventLogsFunc) nextHook() func() database.EventLogStore {
 	return hook
 }
 
-func (f *EnterpriseDBEventLogsFunc) appendCall(r0 EnterpriseDBEventLogsFuncCall) {
+func (f *EnterpriseDBGitserverReposFunc) appendCall(r0 EnterpriseDBGitserverReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBEventLogsFuncCall objects
+// History returns a sequence of EnterpriseDBGitserverReposFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBEventLogsFunc) History() []EnterpriseDBEventLogsFuncCall {
+func (f *EnterpriseDBGitserverReposFunc) History() []EnterpriseDBGitserverReposFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBEventLogsFuncCall, len(f.history))
+	history := make([]EnterpriseDBGitserverReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBEventLogsFuncCall is an object that describes an invocation
-// of method EventLogs on an instance of MockEnterpriseDB.
-type EnterpriseDBEventLogsFuncCall struct {
+// EnterpriseDBGitserverReposFuncCall is an object that describes an
+// invocation of method GitserverRepos on an instance of MockEnterpriseDB.
+type EnterpriseDBGitserverReposFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.EventLogStore
+	Result0 database.GitserverRepoStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBEventLogsFuncCall) Args() []interface{} {
+func (c EnterpriseDBGitserverReposFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBEventLogsFuncCall) Results() []interface{} {
+func (c EnterpriseDBGitserverReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBExecContextFunc describes the behavior when the ExecContext
+// EnterpriseDBGlobalStateFunc describes the behavior when the GlobalState
 // method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBExecContextFunc struct {
-	defaultHook func(context.Context, string, ...interface{}) (sql.Result, error)
-	hooks       []func(context.Context, string, ...interface{}) (sql.Result, error)
-	history     []EnterpriseDBExecContextFuncCall
+type EnterpriseDBGlobalStateFunc struct {
+	defaultHook func() database.GlobalStateStore
+	hooks       []func() database.GlobalStateStore
+	history     []EnterpriseDBGlobalStateFuncCall
 	mutex       sync.Mutex
 }
 
-// ExecContext delegates to the next hook function in the queue and stores
+// GlobalState delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) ExecContext(v0 context.Context, v1 string, v2 ...interface{}) (sql.Result, error) {
-	r0, r1 := m.ExecContextFunc.nextHook()(v0, v1, v2...)
-	m.ExecContextFunc.appendCall(EnterpriseDBExecContextFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockEnterpriseDB) GlobalState() database.GlobalStateStore {
+	r0 := m.GlobalStateFunc.nextHook()()
+	m.GlobalStateFunc.appendCall(EnterpriseDBGlobalStateFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the ExecContext method
+// SetDefaultHook sets function that is called when the GlobalState method
 // of the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBExecContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) (sql.Result, error)) {
+func (f *EnterpriseDBGlobalStateFunc) SetDefaultHook(hook func() database.GlobalStateStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ExecContext method of the parent MockEnterpriseDB instance invokes the
+// GlobalState method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBExecContextFunc) PushHook(hook func(context.Context, string, ...interface{}) (sql.Result, error)) {
+func (f *EnterpriseDBGlobalStateFunc) PushHook(hook func() database.GlobalStateStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9556,20 +12332,20 @@ func (f *EnterpriseDBExecContextFunc) PushHook(hook func(context.Context, string
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBExecContextFunc) SetDefaultReturn(r0 sql.Result, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, ...interface{}) (sql.Result, error) {
-		return r0, r1
+func (f *EnterpriseDBGlobalStateFunc) SetDefaultReturn(r0 database.GlobalStateStore) {
+	f.SetDefaultHook(func() database.GlobalStateStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBExecContextFunc) PushReturn(r0 sql.Result, r1 error) {
-	f.PushHook(func(context.Context, string, ...interface{}) (sql.Result, error) {
-		return r0, r1
+func (f *EnterpriseDBGlobalStateFunc) PushReturn(r0 database.GlobalStateStore) {
+	f.PushHook(func() database.GlobalStateStore {
+		return r0
 	})
 }
 
-func (f *EnterpriseDBExecContextFunc) nextHook() func(context.Context, string, ...interface{}) (sql.Result, error) {
+func (f *EnterpriseDBGlobalStateFunc) nextHook() func() database.GlobalStateStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9582,93 +12358,71 @@ func (f *EnterpriseDBExecContextFunc) nextHook() func(context.Context, string, .
 	return hook
 }
 
-func (f *EnterpriseDBExecContextFunc) appendCall(r0 EnterpriseDBExecContextFuncCall) {
+func (f *EnterpriseDBGlobalStateFunc) appendCall(r0 EnterpriseDBGlobalStateFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBExecContextFuncCall objects
+// History returns a sequence of EnterpriseDBGlobalStateFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBExecContextFunc) History() []EnterpriseDBExecContextFuncCall {
+func (f *EnterpriseDBGlobalStateFunc) History() []EnterpriseDBGlobalStateFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBExecContextFuncCall, len(f.history))
+	history := make([]EnterpriseDBGlobalStateFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBExecContextFuncCall is an object that describes an invocation
-// of method ExecContext on an instance of MockEnterpriseDB.
-type EnterpriseDBExecContextFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Arg2 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg2 []interface{}
+// EnterpriseDBGlobalStateFuncCall is an object that describes an invocation
+// of method GlobalState on an instance of MockEnterpriseDB.
+type EnterpriseDBGlobalStateFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 sql.Result
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 database.GlobalStateStore
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c EnterpriseDBExecContextFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg2 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+// invocation.
+func (c EnterpriseDBGlobalStateFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBExecContextFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c EnterpriseDBGlobalStateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBExecutorSecretAccessLogsFunc describes the behavior when the
-// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
-// is invoked.
-type EnterpriseDBExecutorSecretAccessLogsFunc struct {
-	defaultHook func() database.ExecutorSecretAccessLogStore
-	hooks       []func() database.ExecutorSecretAccessLogStore
-	history     []EnterpriseDBExecutorSecretAccessLogsFuncCall
+// EnterpriseDBHandleFunc describes the behavior when the Handle method of
+// the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []EnterpriseDBHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// ExecutorSecretAccessLogs delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) ExecutorSecretAccessLogs() database.ExecutorSecretAccessLogStore {
-	r0 := m.ExecutorSecretAccessLogsFunc.nextHook()()
-	m.ExecutorSecretAccessLogsFunc.appendCall(EnterpriseDBExecutorSecretAccessLogsFuncCall{r0})
+// Handle delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(EnterpriseDBHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
-// is invoked and the hook queue is empty.
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) SetDefaultHook(hook func() database.ExecutorSecretAccessLogStore) {
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ExecutorSecretAccessLogs method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) PushHook(hook func() database.ExecutorSecretAccessLogStore) {
+// Handle method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9676,20 +12430,20 @@ func (f *EnterpriseDBExecutorSecretAccessLogsFunc) PushHook(hook func() database
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) SetDefaultReturn(r0 database.ExecutorSecretAccessLogStore) {
-	f.SetDefaultHook(func() database.ExecutorSecretAccessLogStore {
+func (f *EnterpriseDBHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) PushReturn(r0 database.ExecutorSecretAccessLogStore) {
-	f.PushHook(func() database.ExecutorSecretAccessLogStore {
+func (f *EnterpriseDBHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) nextHook() func() database.ExecutorSecretAccessLogStore {
+func (f *EnterpriseDBHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9702,75 +12456,74 @@ func (f *EnterpriseDBExecutorSecretAccessLogsFunc) nextHook() func() database.Ex
 	return hook
 }
 
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) appendCall(r0 EnterpriseDBExecutorSecretAccessLogsFuncCall) {
+func (f *EnterpriseDBHandleFunc) appendCall(r0 EnterpriseDBHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// EnterpriseDBExecutorSecretAccessLogsFuncCall objects describing the
-// invocations of this function.
-func (f *EnterpriseDBExecutorSecretAccessLogsFunc) History() []EnterpriseDBExecutorSecretAccessLogsFuncCall {
+// History returns a sequence of EnterpriseDBHandleFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBHandleFunc) History() []EnterpriseDBHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBExecutorSecretAccessLogsFuncCall, len(f.history))
+	history := make([]EnterpriseDBHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBExecutorSecretAccessLogsFuncCall is an object that describes
-// an invocation of method ExecutorSecretAccessLogs on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBExecutorSecretAccessLogsFuncCall struct {
+// EnterpriseDBHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockEnterpriseDB.
+type EnterpriseDBHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ExecutorSecretAccessLogStore
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBExecutorSecretAccessLogsFuncCall) Args() []interface{} {
+func (c EnterpriseDBHandleFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBExecutorSecretAccessLogsFuncCall) Results() []interface{} {
+func (c EnterpriseDBHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBExecutorSecretsFunc describes the behavior when the
-// ExecutorSecrets method of the parent MockEnterpriseDB instance is
+// EnterpriseDBNamespacePermissionsFunc describes the behavior when the
+// NamespacePermissions method of the parent MockEnterpriseDB instance is
 // invoked.
-type EnterpriseDBExecutorSecretsFunc struct {
-	defaultHook func(encryption.Key) database.ExecutorSecretStore
-	hooks       []func(encryption.Key) database.ExecutorSecretStore
-	history     []EnterpriseDBExecutorSecretsFuncCall
+type EnterpriseDBNamespacePermissionsFunc struct {
+	defaultHook func() database.NamespacePermissionStore
+	hooks       []func() database.NamespacePermissionStore
+	history     []EnterpriseDBNamespacePermissionsFuncCall
 	mutex       sync.Mutex
 }
 
-// ExecutorSecrets delegates to the next hook function in the queue and
+// NamespacePermissions delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) ExecutorSecrets(v0 encryption.Key) database.ExecutorSecretStore {
-	r0 := m.ExecutorSecretsFunc.nextHook()(v0)
-	m.ExecutorSecretsFunc.appendCall(EnterpriseDBExecutorSecretsFuncCall{v0, r0})
+func (m *MockEnterpriseDB) NamespacePermissions() database.NamespacePermissionStore {
+	r0 := m.NamespacePermissionsFunc.nextHook()()
+	m.NamespacePermissionsFunc.appendCall(EnterpriseDBNamespacePermissionsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the ExecutorSecrets
+// SetDefaultHook sets function that is called when the NamespacePermissions
 // method of the parent MockEnterpriseDB instance is invoked and the hook
 // queue is empty.
-func (f *EnterpriseDBExecutorSecretsFunc) SetDefaultHook(hook func(encryption.Key) database.ExecutorSecretStore) {
+func (f *EnterpriseDBNamespacePermissionsFunc) SetDefaultHook(hook func() database.NamespacePermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ExecutorSecrets method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBExecutorSecretsFunc) PushHook(hook func(encryption.Key) database.ExecutorSecretStore) {
+// NamespacePermissions method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBNamespacePermissionsFunc) PushHook(hook func() database.NamespacePermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9778,20 +12531,20 @@ func (f *EnterpriseDBExecutorSecretsFunc) PushHook(hook func(encryption.Key) dat
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBExecutorSecretsFunc) SetDefaultReturn(r0 database.ExecutorSecretStore) {
-	f.SetDefaultHook(func(encryption.Key) database.ExecutorSecretStore {
+func (f *EnterpriseDBNamespacePermissionsFunc) SetDefaultReturn(r0 database.NamespacePermissionStore) {
+	f.SetDefaultHook(func() database.NamespacePermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBExecutorSecretsFunc) PushReturn(r0 database.ExecutorSecretStore) {
-	f.PushHook(func(encryption.Key) database.ExecutorSecretStore {
+func (f *EnterpriseDBNamespacePermissionsFunc) PushReturn(r0 database.NamespacePermissionStore) {
+	f.PushHook(func() database.NamespacePermissionStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBExecutorSecretsFunc) nextHook() func(encryption.Key) database.ExecutorSecretStore {
+func (f *EnterpriseDBNamespacePermissionsFunc) nextHook() func() database.NamespacePermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9804,75 +12557,73 @@ func (f *EnterpriseDBExecutorSecretsFunc) nextHook() func(encryption.Key) databa
 	return hook
 }
 
-func (f *EnterpriseDBExecutorSecretsFunc) appendCall(r0 EnterpriseDBExecutorSecretsFuncCall) {
+func (f *EnterpriseDBNamespacePermissionsFunc) appendCall(r0 EnterpriseDBNamespacePermissionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBExecutorSecretsFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBExecutorSecretsFunc) History() []EnterpriseDBExecutorSecretsFuncCall {
+// History returns a sequence of EnterpriseDBNamespacePermissionsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBNamespacePermissionsFunc) History() []EnterpriseDBNamespacePermissionsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBExecutorSecretsFuncCall, len(f.history))
+	history := make([]EnterpriseDBNamespacePermissionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBExecutorSecretsFuncCall is an object that describes an
-// invocation of method ExecutorSecrets on an instance of MockEnterpriseDB.
-type EnterpriseDBExecutorSecretsFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 encryption.Key
+// EnterpriseDBNamespacePermissionsFuncCall is an object that describes an
+// invocation of method NamespacePermissions on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBNamespacePermissionsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ExecutorSecretStore
+	Result0 database.NamespacePermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBExecutorSecretsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c EnterpriseDBNamespacePermissionsFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBExecutorSecretsFuncCall) Results() []interface{} {
+func (c EnterpriseDBNamespacePermissionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBExecutorsFunc describes the behavior when the Executors
+// EnterpriseDBNamespacesFunc describes the behavior when the Namespaces
 // method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBExecutorsFunc struct {
-	defaultHook func() database.ExecutorStore
-	hooks       []func() database.ExecutorStore
-	history     []EnterpriseDBExecutorsFuncCall
+type EnterpriseDBNamespacesFunc struct {
+	defaultHook func() database.NamespaceStore
+	hooks       []func() database.NamespaceStore
+	history     []EnterpriseDBNamespacesFuncCall
 	mutex       sync.Mutex
 }
 
-// Executors delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Executors() database.ExecutorStore {
-	r0 := m.ExecutorsFunc.nextHook()()
-	m.ExecutorsFunc.appendCall(EnterpriseDBExecutorsFuncCall{r0})
+// Namespaces delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Namespaces() database.NamespaceStore {
+	r0 := m.NamespacesFunc.nextHook()()
+	m.NamespacesFunc.appendCall(EnterpriseDBNamespacesFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Executors method of
+// SetDefaultHook sets function that is called when the Namespaces method of
 // the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBExecutorsFunc) SetDefaultHook(hook func() database.ExecutorStore) {
+func (f *EnterpriseDBNamespacesFunc) SetDefaultHook(hook func() database.NamespaceStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Executors method of the parent MockEnterpriseDB instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBExecutorsFunc) PushHook(hook func() database.ExecutorStore) {
+// Namespaces method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBNamespacesFunc) PushHook(hook func() database.NamespaceStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9880,20 +12631,20 @@ func (f *EnterpriseDBExecutorsFunc) PushHook(hook func() database.ExecutorStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBExecutorsFunc) SetDefaultReturn(r0 database.ExecutorStore) {
-	f.SetDefaultHook(func() database.ExecutorStore {
+func (f *EnterpriseDBNamespacesFunc) SetDefaultReturn(r0 database.NamespaceStore) {
+	f.SetDefaultHook(func() database.NamespaceStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBExecutorsFunc) PushReturn(r0 database.ExecutorStore) {
-	f.PushHook(func() database.ExecutorStore {
+func (f *EnterpriseDBNamespacesFunc) PushReturn(r0 database.NamespaceStore) {
+	f.PushHook(func() database.NamespaceStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBExecutorsFunc) nextHook() func() database.ExecutorStore {
+func (f *EnterpriseDBNamespacesFunc) nextHook() func() database.NamespaceStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -9906,73 +12657,72 @@ func (f *EnterpriseDBExecutorsFunc) nextHook() func() database.ExecutorStore {
 	return hook
 }
 
-func (f *EnterpriseDBExecutorsFunc) appendCall(r0 EnterpriseDBExecutorsFuncCall) {
+func (f *EnterpriseDBNamespacesFunc) appendCall(r0 EnterpriseDBNamespacesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBExecutorsFuncCall objects
+// History returns a sequence of EnterpriseDBNamespacesFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBExecutorsFunc) History() []EnterpriseDBExecutorsFuncCall {
+func (f *EnterpriseDBNamespacesFunc) History() []EnterpriseDBNamespacesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBExecutorsFuncCall, len(f.history))
+	history := make([]EnterpriseDBNamespacesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBExecutorsFuncCall is an object that describes an invocation
-// of method Executors on an instance of MockEnterpriseDB.
-type EnterpriseDBExecutorsFuncCall struct {
+// EnterpriseDBNamespacesFuncCall is an object that describes an invocation
+// of method Namespaces on an instance of MockEnterpriseDB.
+type EnterpriseDBNamespacesFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ExecutorStore
+	Result0 database.NamespaceStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBExecutorsFuncCall) Args() []interface{} {
+func (c EnterpriseDBNamespacesFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBExecutorsFuncCall) Results() []interface{} {
+func (c EnterpriseDBNamespacesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBExternalServicesFunc describes the behavior when the
-// ExternalServices method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBExternalServicesFunc struct {
-	defaultHook func() database.ExternalServiceStore
-	hooks       []func() database.ExternalServiceStore
-	history     []EnterpriseDBExternalServicesFuncCall
+// EnterpriseDBOrgInvitationsFunc describes the behavior when the
+// OrgInvitations method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBOrgInvitationsFunc struct {
+	defaultHook func() database.OrgInvitationStore
+	hooks       []func() database.OrgInvitationStore
+	history     []EnterpriseDBOrgInvitationsFuncCall
 	mutex       sync.Mutex
 }
 
-// ExternalServices delegates to the next hook function in the queue and
+// OrgInvitations delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) ExternalServices() database.ExternalServiceStore {
-	r0 := m.ExternalServicesFunc.nextHook()()
-	m.ExternalServicesFunc.appendCall(EnterpriseDBExternalServicesFuncCall{r0})
+func (m *MockEnterpriseDB) OrgInvitations() database.OrgInvitationStore {
+	r0 := m.OrgInvitationsFunc.nextHook()()
+	m.OrgInvitationsFunc.appendCall(EnterpriseDBOrgInvitationsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the ExternalServices
+// SetDefaultHook sets function that is called when the OrgInvitations
 // method of the parent MockEnterpriseDB instance is invoked and the hook
 // queue is empty.
-func (f *EnterpriseDBExternalServicesFunc) SetDefaultHook(hook func() database.ExternalServiceStore) {
+func (f *EnterpriseDBOrgInvitationsFunc) SetDefaultHook(hook func() database.OrgInvitationStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ExternalServices method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBExternalServicesFunc) PushHook(hook func() database.ExternalServiceStore) {
+// OrgInvitations method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBOrgInvitationsFunc) PushHook(hook func() database.OrgInvitationStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -9980,20 +12730,20 @@ func (f *EnterpriseDBExternalServicesFunc) PushHook(hook func() database.Externa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBExternalServicesFunc) SetDefaultReturn(r0 database.ExternalServiceStore) {
-	f.SetDefaultHook(func() database.ExternalServiceStore {
+func (f *EnterpriseDBOrgInvitationsFunc) SetDefaultReturn(r0 database.OrgInvitationStore) {
+	f.SetDefaultHook(func() database.OrgInvitationStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBExternalServicesFunc) PushReturn(r0 database.ExternalServiceStore) {
-	f.PushHook(func() database.ExternalServiceStore {
+func (f *EnterpriseDBOrgInvitationsFunc) PushReturn(r0 database.OrgInvitationStore) {
+	f.PushHook(func() database.OrgInvitationStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBExternalServicesFunc) nextHook() func() database.ExternalServiceStore {
+func (f *EnterpriseDBOrgInvitationsFunc) nextHook() func() database.OrgInvitationStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10006,72 +12756,72 @@ func (f *EnterpriseDBExternalServicesFunc) nextHook() func() database.ExternalSe
 	return hook
 }
 
-func (f *EnterpriseDBExternalServicesFunc) appendCall(r0 EnterpriseDBExternalServicesFuncCall) {
+func (f *EnterpriseDBOrgInvitationsFunc) appendCall(r0 EnterpriseDBOrgInvitationsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBExternalServicesFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBExternalServicesFunc) History() []EnterpriseDBExternalServicesFuncCall {
+// History returns a sequence of EnterpriseDBOrgInvitationsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBOrgInvitationsFunc) History() []EnterpriseDBOrgInvitationsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBExternalServicesFuncCall, len(f.history))
+	history := make([]EnterpriseDBOrgInvitationsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBExternalServicesFuncCall is an object that describes an
-// invocation of method ExternalServices on an instance of MockEnterpriseDB.
-type EnterpriseDBExternalServicesFuncCall struct {
+// EnterpriseDBOrgInvitationsFuncCall is an object that describes an
+// invocation of method OrgInvitations on an instance of MockEnterpriseDB.
+type EnterpriseDBOrgInvitationsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ExternalServiceStore
+	Result0 database.OrgInvitationStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBExternalServicesFuncCall) Args() []interface{} {
+func (c EnterpriseDBOrgInvitationsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBExternalServicesFuncCall) Results() []interface{} {
+func (c EnterpriseDBOrgInvitationsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBFeatureFlagsFunc describes the behavior when the FeatureFlags
+// EnterpriseDBOrgMembersFunc describes the behavior when the OrgMembers
 // method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBFeatureFlagsFunc struct {
-	defaultHook func() database.FeatureFlagStore
-	hooks       []func() database.FeatureFlagStore
-	history     []EnterpriseDBFeatureFlagsFuncCall
+type EnterpriseDBOrgMembersFunc struct {
+	defaultHook func() database.OrgMemberStore
+	hooks       []func() database.OrgMemberStore
+	history     []EnterpriseDBOrgMembersFuncCall
 	mutex       sync.Mutex
 }
 
-// FeatureFlags delegates to the next hook function in the queue and stores
+// OrgMembers delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) FeatureFlags() database.FeatureFlagStore {
-	r0 := m.FeatureFlagsFunc.nextHook()()
-	m.FeatureFlagsFunc.appendCall(EnterpriseDBFeatureFlagsFuncCall{r0})
+func (m *MockEnterpriseDB) OrgMembers() database.OrgMemberStore {
+	r0 := m.OrgMembersFunc.nextHook()()
+	m.OrgMembersFunc.appendCall(EnterpriseDBOrgMembersFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the FeatureFlags method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the OrgMembers method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBFeatureFlagsFunc) SetDefaultHook(hook func() database.FeatureFlagStore) {
+func (f *EnterpriseDBOrgMembersFunc) SetDefaultHook(hook func() database.OrgMemberStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// FeatureFlags method of the parent MockEnterpriseDB instance invokes the
+// OrgMembers method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBFeatureFlagsFunc) PushHook(hook func() database.FeatureFlagStore) {
+func (f *EnterpriseDBOrgMembersFunc) PushHook(hook func() database.OrgMemberStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10079,20 +12829,20 @@ func (f *EnterpriseDBFeatureFlagsFunc) PushHook(hook func() database.FeatureFlag
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBFeatureFlagsFunc) SetDefaultReturn(r0 database.FeatureFlagStore) {
-	f.SetDefaultHook(func() database.FeatureFlagStore {
+func (f *EnterpriseDBOrgMembersFunc) SetDefaultReturn(r0 database.OrgMemberStore) {
+	f.SetDefaultHook(func() database.OrgMemberStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBFeatureFlagsFunc) PushReturn(r0 database.FeatureFlagStore) {
-	f.PushHook(func() database.FeatureFlagStore {
+func (f *EnterpriseDBOrgMembersFunc) PushReturn(r0 database.OrgMemberStore) {
+	f.PushHook(func() database.OrgMemberStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBFeatureFlagsFunc) nextHook() func() database.FeatureFlagStore {
+func (f *EnterpriseDBOrgMembersFunc) nextHook() func() database.OrgMemberStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10105,74 +12855,71 @@ func (f *EnterpriseDBFeatureFlagsFunc) nextHook() func() database.FeatureFlagSto
 	return hook
 }
 
-func (f *EnterpriseDBFeatureFlagsFunc) appendCall(r0 EnterpriseDBFeatureFlagsFuncCall) {
+func (f *EnterpriseDBOrgMembersFunc) appendCall(r0 EnterpriseDBOrgMembersFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBFeatureFlagsFuncCall objects
+// History returns a sequence of EnterpriseDBOrgMembersFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBFeatureFlagsFunc) History() []EnterpriseDBFeatureFlagsFuncCall {
+func (f *EnterpriseDBOrgMembersFunc) History() []EnterpriseDBOrgMembersFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBFeatureFlagsFuncCall, len(f.history))
+	history := make([]EnterpriseDBOrgMembersFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBFeatureFlagsFuncCall is an object that describes an
-// invocation of method FeatureFlags on an instance of MockEnterpriseDB.
-type EnterpriseDBFeatureFlagsFuncCall struct {
+// EnterpriseDBOrgMembersFuncCall is an object that describes an invocation
+// of method OrgMembers on an instance of MockEnterpriseDB.
+type EnterpriseDBOrgMembersFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.FeatureFlagStore
+	Result0 database.OrgMemberStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBFeatureFlagsFuncCall) Args() []interface{} {
+func (c EnterpriseDBOrgMembersFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBFeatureFlagsFuncCall) Results() []interface{} {
+func (c EnterpriseDBOrgMembersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBGitserverLocalCloneFunc describes the behavior when the
-// GitserverLocalClone method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBGitserverLocalCloneFunc struct {
-	defaultHook func() database.GitserverLocalCloneStore
-	hooks       []func() database.GitserverLocalCloneStore
-	history     []EnterpriseDBGitserverLocalCloneFuncCall
+// EnterpriseDBOrgsFunc describes the behavior when the Orgs method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBOrgsFunc struct {
+	defaultHook func() database.OrgStore
+	hooks       []func() database.OrgStore
+	history     []EnterpriseDBOrgsFuncCall
 	mutex       sync.Mutex
 }
 
-// GitserverLocalClone delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) GitserverLocalClone() database.GitserverLocalCloneStore {
-	r0 := m.GitserverLocalCloneFunc.nextHook()()
-	m.GitserverLocalCloneFunc.appendCall(EnterpriseDBGitserverLocalCloneFuncCall{r0})
+// Orgs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Orgs() database.OrgStore {
+	r0 := m.OrgsFunc.nextHook()()
+	m.OrgsFunc.appendCall(EnterpriseDBOrgsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the GitserverLocalClone
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBGitserverLocalCloneFunc) SetDefaultHook(hook func() database.GitserverLocalCloneStore) {
+// SetDefaultHook sets function that is called when the Orgs method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBOrgsFunc) SetDefaultHook(hook func() database.OrgStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GitserverLocalClone method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBGitserverLocalCloneFunc) PushHook(hook func() database.GitserverLocalCloneStore) {
+// Orgs method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBOrgsFunc) PushHook(hook func() database.OrgStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10180,20 +12927,20 @@ func (f *EnterpriseDBGitserverLocalCloneFunc) PushHook(hook func() database.Gits
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBGitserverLocalCloneFunc) SetDefaultReturn(r0 database.GitserverLocalCloneStore) {
-	f.SetDefaultHook(func() database.GitserverLocalCloneStore {
+func (f *EnterpriseDBOrgsFunc) SetDefaultReturn(r0 database.OrgStore) {
+	f.SetDefaultHook(func() database.OrgStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBGitserverLocalCloneFunc) PushReturn(r0 database.GitserverLocalCloneStore) {
-	f.PushHook(func() database.GitserverLocalCloneStore {
+func (f *EnterpriseDBOrgsFunc) PushReturn(r0 database.OrgStore) {
+	f.PushHook(func() database.OrgStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBGitserverLocalCloneFunc) nextHook() func() database.GitserverLocalCloneStore {
+func (f *EnterpriseDBOrgsFunc) nextHook() func() database.OrgStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10206,73 +12953,74 @@ func (f *EnterpriseDBGitserverLocalCloneFunc) nextHook() func() database.Gitserv
 	return hook
 }
 
-func (f *EnterpriseDBGitserverLocalCloneFunc) appendCall(r0 EnterpriseDBGitserverLocalCloneFuncCall) {
+func (f *EnterpriseDBOrgsFunc) appendCall(r0 EnterpriseDBOrgsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBGitserverLocalCloneFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBGitserverLocalCloneFunc) History() []EnterpriseDBGitserverLocalCloneFuncCall {
+// History returns a sequence of EnterpriseDBOrgsFuncCall objects describing
+// the invocations of this function.
+func (f *EnterpriseDBOrgsFunc) History() []EnterpriseDBOrgsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBGitserverLocalCloneFuncCall, len(f.history))
+	history := make([]EnterpriseDBOrgsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBGitserverLocalCloneFuncCall is an object that describes an
-// invocation of method GitserverLocalClone on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBGitserverLocalCloneFuncCall struct {
+// EnterpriseDBOrgsFuncCall is an object that describes an invocation of
+// method Orgs on an instance of MockEnterpriseDB.
+type EnterpriseDBOrgsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.GitserverLocalCloneStore
+	Result0 database.OrgStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBGitserverLocalCloneFuncCall) Args() []interface{} {
+func (c EnterpriseDBOrgsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBGitserverLocalCloneFuncCall) Results() []interface{} {
+func (c EnterpriseDBOrgsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBGitserverReposFunc describes the behavior when the
-// GitserverRepos method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBGitserverReposFunc struct {
-	defaultHook func() database.GitserverRepoStore
-	hooks       []func() database.GitserverRepoStore
-	history     []EnterpriseDBGitserverReposFuncCall
+// EnterpriseDBOutboundWebhookJobsFunc describes the behavior when the
+// OutboundWebhookJobs method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBOutboundWebhookJobsFunc struct {
+	defaultHook func(encryption.Key) database.OutboundWebhookJobStore
+	hooks       []func(encryption.Key) database.OutboundWebhookJobStore
+	history     []EnterpriseDBOutboundWebhookJobsFuncCall
 	mutex       sync.Mutex
 }
 
-// GitserverRepos delegates to the next hook function in the queue and
+// OutboundWebhookJobs delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) GitserverRepos() database.GitserverRepoStore {
-	r0 := m.GitserverReposFunc.nextHook()()
-	m.GitserverReposFunc.appendCall(EnterpriseDBGitserverReposFuncCall{r0})
+func (m *MockEnterpriseDB) OutboundWebhookJobs(v0 encryption.Key) database.OutboundWebhookJobStore {
+	r0 := m.OutboundWebhookJobsFunc.nextHook()(v0)
+	m.OutboundWebhookJobsFunc.appendCall(EnterpriseDBOutboundWebhookJobsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the GitserverRepos
+// SetDefaultHook sets function that is called when the OutboundWebhookJobs
 // method of the parent MockEnterpriseDB instance is invoked and the hook
 // queue is empty.
-func (f *EnterpriseDBGitserverReposFunc) SetDefaultHook(hook func() database.GitserverRepoStore) {
+func (f *EnterpriseDBOutboundWebhookJobsFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookJobStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GitserverRepos method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBGitserverReposFunc) PushHook(hook func() database.GitserverRepoStore) {
+// OutboundWebhookJobs method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBOutboundWebhookJobsFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookJobStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10280,20 +13028,20 @@ func (f *EnterpriseDBGitserverReposFunc) PushHook(hook func() database.Gitserver
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBGitserverReposFunc) SetDefaultReturn(r0 database.GitserverRepoStore) {
-	f.SetDefaultHook(func() database.GitserverRepoStore {
+func (f *EnterpriseDBOutboundWebhookJobsFunc) SetDefaultReturn(r0 database.OutboundWebhookJobStore) {
+	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookJobStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBGitserverReposFunc) PushReturn(r0 database.GitserverRepoStore) {
-	f.PushHook(func() database.GitserverRepoStore {
+func (f *EnterpriseDBOutboundWebhookJobsFunc) PushReturn(r0 database.OutboundWebhookJobStore) {
+	f.PushHook(func(encryption.Key) database.OutboundWebhookJobStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBGitserverReposFunc) nextHook() func() database.GitserverRepoStore {
+func (f *EnterpriseDBOutboundWebhookJobsFunc) nextHook() func(encryption.Key) database.OutboundWebhookJobStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10306,72 +13054,78 @@ func (f *EnterpriseDBGitserverReposFunc) nextHook() func() database.GitserverRep
 	return hook
 }
 
-func (f *EnterpriseDBGitserverReposFunc) appendCall(r0 EnterpriseDBGitserverReposFuncCall) {
+func (f *EnterpriseDBOutboundWebhookJobsFunc) appendCall(r0 EnterpriseDBOutboundWebhookJobsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBGitserverReposFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBGitserverReposFunc) History() []EnterpriseDBGitserverReposFuncCall {
+// History returns a sequence of EnterpriseDBOutboundWebhookJobsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBOutboundWebhookJobsFunc) History() []EnterpriseDBOutboundWebhookJobsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBGitserverReposFuncCall, len(f.history))
+	history := make([]EnterpriseDBOutboundWebhookJobsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBGitserverReposFuncCall is an object that describes an
-// invocation of method GitserverRepos on an instance of MockEnterpriseDB.
-type EnterpriseDBGitserverReposFuncCall struct {
+// EnterpriseDBOutboundWebhookJobsFuncCall is an object that describes an
+// invocation of method OutboundWebhookJobs on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBOutboundWebhookJobsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.GitserverRepoStore
+	Result0 database.OutboundWebhookJobStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBGitserverReposFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBOutboundWebhookJobsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBGitserverReposFuncCall) Results() []interface{} {
+func (c EnterpriseDBOutboundWebhookJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBGlobalStateFunc describes the behavior when the GlobalState
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBGlobalStateFunc struct {
-	defaultHook func() database.GlobalStateStore
-	hooks       []func() database.GlobalStateStore
-	history     []EnterpriseDBGlobalStateFuncCall
+// EnterpriseDBOutboundWebhookLogsFunc describes the behavior when the
+// OutboundWebhookLogs method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBOutboundWebhookLogsFunc struct {
+	defaultHook func(encryption.Key) database.OutboundWebhookLogStore
+	hooks       []func(encryption.Key) database.OutboundWebhookLogStore
+	history     []EnterpriseDBOutboundWebhookLogsFuncCall
 	mutex       sync.Mutex
 }
 
-// GlobalState delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) GlobalState() database.GlobalStateStore {
-	r0 := m.GlobalStateFunc.nextHook()()
-	m.GlobalStateFunc.appendCall(EnterpriseDBGlobalStateFuncCall{r0})
+// OutboundWebhookLogs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) OutboundWebhookLogs(v0 encryption.Key) database.OutboundWebhookLogStore {
+	r0 := m.OutboundWebhookLogsFunc.nextHook()(v0)
+	m.OutboundWebhookLogsFunc.appendCall(EnterpriseDBOutboundWebhookLogsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the GlobalState method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBGlobalStateFunc) SetDefaultHook(hook func() database.GlobalStateStore) {
+// SetDefaultHook sets function that is called when the OutboundWebhookLogs
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBOutboundWebhookLogsFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookLogStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GlobalState method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBGlobalStateFunc) PushHook(hook func() database.GlobalStateStore) {
+// OutboundWebhookLogs method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBOutboundWebhookLogsFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookLogStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10379,20 +13133,20 @@ func (f *EnterpriseDBGlobalStateFunc) PushHook(hook func() database.GlobalStateS
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBGlobalStateFunc) SetDefaultReturn(r0 database.GlobalStateStore) {
-	f.SetDefaultHook(func() database.GlobalStateStore {
+func (f *EnterpriseDBOutboundWebhookLogsFunc) SetDefaultReturn(r0 database.OutboundWebhookLogStore) {
+	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookLogStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBGlobalStateFunc) PushReturn(r0 database.GlobalStateStore) {
-	f.PushHook(func() database.GlobalStateStore {
+func (f *EnterpriseDBOutboundWebhookLogsFunc) PushReturn(r0 database.OutboundWebhookLogStore) {
+	f.PushHook(func(encryption.Key) database.OutboundWebhookLogStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBGlobalStateFunc) nextHook() func() database.GlobalStateStore {
+func (f *EnterpriseDBOutboundWebhookLogsFunc) nextHook() func(encryption.Key) database.OutboundWebhookLogStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10405,71 +13159,77 @@ func (f *EnterpriseDBGlobalStateFunc) nextHook() func() database.GlobalStateStor
 	return hook
 }
 
-func (f *EnterpriseDBGlobalStateFunc) appendCall(r0 EnterpriseDBGlobalStateFuncCall) {
+func (f *EnterpriseDBOutboundWebhookLogsFunc) appendCall(r0 EnterpriseDBOutboundWebhookLogsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBGlobalStateFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBGlobalStateFunc) History() []EnterpriseDBGlobalStateFuncCall {
+// History returns a sequence of EnterpriseDBOutboundWebhookLogsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBOutboundWebhookLogsFunc) History() []EnterpriseDBOutboundWebhookLogsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBGlobalStateFuncCall, len(f.history))
+	history := make([]EnterpriseDBOutboundWebhookLogsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBGlobalStateFuncCall is an object that describes an invocation
-// of method GlobalState on an instance of MockEnterpriseDB.
-type EnterpriseDBGlobalStateFuncCall struct {
+// EnterpriseDBOutboundWebhookLogsFuncCall is an object that describes an
+// invocation of method OutboundWebhookLogs on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBOutboundWebhookLogsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.GlobalStateStore
+	Result0 database.OutboundWebhookLogStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBGlobalStateFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBOutboundWebhookLogsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBGlobalStateFuncCall) Results() []interface{} {
+func (c EnterpriseDBOutboundWebhookLogsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBHandleFunc describes the behavior when the Handle method of
-// the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBHandleFunc struct {
-	defaultHook func() basestore.TransactableHandle
-	hooks       []func() basestore.TransactableHandle
-	history     []EnterpriseDBHandleFuncCall
+// EnterpriseDBOutboundWebhooksFunc describes the behavior when the
+// OutboundWebhooks method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBOutboundWebhooksFunc struct {
+	defaultHook func(encryption.Key) database.OutboundWebhookStore
+	hooks       []func(encryption.Key) database.OutboundWebhookStore
+	history     []EnterpriseDBOutboundWebhooksFuncCall
 	mutex       sync.Mutex
 }
 
-// Handle delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Handle() basestore.TransactableHandle {
-	r0 := m.HandleFunc.nextHook()()
-	m.HandleFunc.appendCall(EnterpriseDBHandleFuncCall{r0})
+// OutboundWebhooks delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) OutboundWebhooks(v0 encryption.Key) database.OutboundWebhookStore {
+	r0 := m.OutboundWebhooksFunc.nextHook()(v0)
+	m.OutboundWebhooksFunc.appendCall(EnterpriseDBOutboundWebhooksFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Handle method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
+// SetDefaultHook sets function that is called when the OutboundWebhooks
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBOutboundWebhooksFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Handle method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
+// OutboundWebhooks method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBOutboundWebhooksFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10477,20 +13237,20 @@ func (f *EnterpriseDBHandleFunc) PushHook(hook func() basestore.TransactableHand
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
-	f.SetDefaultHook(func() basestore.TransactableHandle {
+func (f *EnterpriseDBOutboundWebhooksFunc) SetDefaultReturn(r0 database.OutboundWebhookStore) {
+	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
-	f.PushHook(func() basestore.TransactableHandle {
+func (f *EnterpriseDBOutboundWebhooksFunc) PushReturn(r0 database.OutboundWebhookStore) {
+	f.PushHook(func(encryption.Key) database.OutboundWebhookStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBHandleFunc) nextHook() func() basestore.TransactableHandle {
+func (f *EnterpriseDBOutboundWebhooksFunc) nextHook() func(encryption.Key) database.OutboundWebhookStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10503,74 +13263,75 @@ func (f *EnterpriseDBHandleFunc) nextHook() func() basestore.TransactableHandle
 	return hook
 }
 
-func (f *EnterpriseDBHandleFunc) appendCall(r0 EnterpriseDBHandleFuncCall) {
+func (f *EnterpriseDBOutboundWebhooksFunc) appendCall(r0 EnterpriseDBOutboundWebhooksFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBHandleFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBHandleFunc) History() []EnterpriseDBHandleFuncCall {
+// History returns a sequence of EnterpriseDBOutboundWebhooksFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBOutboundWebhooksFunc) History() []EnterpriseDBOutboundWebhooksFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBHandleFuncCall, len(f.history))
+	history := make([]EnterpriseDBOutboundWebhooksFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBHandleFuncCall is an object that describes an invocation of
-// method Handle on an instance of MockEnterpriseDB.
-type EnterpriseDBHandleFuncCall struct {
+// EnterpriseDBOutboundWebhooksFuncCall is an object that describes an
+// invocation of method OutboundWebhooks on an instance of MockEnterpriseDB.
+type EnterpriseDBOutboundWebhooksFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 basestore.TransactableHandle
+	Result0 database.OutboundWebhookStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBHandleFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBOutboundWebhooksFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBHandleFuncCall) Results() []interface{} {
+func (c EnterpriseDBOutboundWebhooksFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBNamespacePermissionsFunc describes the behavior when the
-// NamespacePermissions method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBNamespacePermissionsFunc struct {
-	defaultHook func() database.NamespacePermissionStore
-	hooks       []func() database.NamespacePermissionStore
-	history     []EnterpriseDBNamespacePermissionsFuncCall
+// EnterpriseDBOwnSignalsFunc describes the behavior when the OwnSignals
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBOwnSignalsFunc struct {
+	defaultHook func() OwnSignalStore
+	hooks       []func() OwnSignalStore
+	history     []EnterpriseDBOwnSignalsFuncCall
 	mutex       sync.Mutex
 }
 
-// NamespacePermissions delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) NamespacePermissions() database.NamespacePermissionStore {
-	r0 := m.NamespacePermissionsFunc.nextHook()()
-	m.NamespacePermissionsFunc.appendCall(EnterpriseDBNamespacePermissionsFuncCall{r0})
+// OwnSignals delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) OwnSignals() OwnSignalStore {
+	r0 := m.OwnSignalsFunc.nextHook()()
+	m.OwnSignalsFunc.appendCall(EnterpriseDBOwnSignalsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the NamespacePermissions
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBNamespacePermissionsFunc) SetDefaultHook(hook func() database.NamespacePermissionStore) {
+// SetDefaultHook sets function that is called when the OwnSignals method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBOwnSignalsFunc) SetDefaultHook(hook func() OwnSignalStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// NamespacePermissions method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBNamespacePermissionsFunc) PushHook(hook func() database.NamespacePermissionStore) {
+// OwnSignals method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBOwnSignalsFunc) PushHook(hook func() OwnSignalStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10578,20 +13339,20 @@ func (f *EnterpriseDBNamespacePermissionsFunc) PushHook(hook func() database.Nam
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBNamespacePermissionsFunc) SetDefaultReturn(r0 database.NamespacePermissionStore) {
-	f.SetDefaultHook(func() database.NamespacePermissionStore {
+func (f *EnterpriseDBOwnSignalsFunc) SetDefaultReturn(r0 OwnSignalStore) {
+	f.SetDefaultHook(func() OwnSignalStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBNamespacePermissionsFunc) PushReturn(r0 database.NamespacePermissionStore) {
-	f.PushHook(func() database.NamespacePermissionStore {
+func (f *EnterpriseDBOwnSignalsFunc) PushReturn(r0 OwnSignalStore) {
+	f.PushHook(func() OwnSignalStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBNamespacePermissionsFunc) nextHook() func() database.NamespacePermissionStore {
+func (f *EnterpriseDBOwnSignalsFunc) nextHook() func() OwnSignalStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10604,73 +13365,73 @@ func (f *EnterpriseDBNamespacePermissionsFunc) nextHook() func() database.Namesp
 	return hook
 }
 
-func (f *EnterpriseDBNamespacePermissionsFunc) appendCall(r0 EnterpriseDBNamespacePermissionsFuncCall) {
+func (f *EnterpriseDBOwnSignalsFunc) appendCall(r0 EnterpriseDBOwnSignalsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBNamespacePermissionsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBNamespacePermissionsFunc) History() []EnterpriseDBNamespacePermissionsFuncCall {
+// History returns a sequence of EnterpriseDBOwnSignalsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBOwnSignalsFunc) History() []EnterpriseDBOwnSignalsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBNamespacePermissionsFuncCall, len(f.history))
+	history := make([]EnterpriseDBOwnSignalsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBNamespacePermissionsFuncCall is an object that describes an
-// invocation of method NamespacePermissions on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBNamespacePermissionsFuncCall struct {
+// EnterpriseDBOwnSignalsFuncCall is an object that describes an invocation
+// of method OwnSignals on an instance of MockEnterpriseDB.
+type EnterpriseDBOwnSignalsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.NamespacePermissionStore
+	Result0 OwnSignalStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBNamespacePermissionsFuncCall) Args() []interface{} {
+func (c EnterpriseDBOwnSignalsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBNamespacePermissionsFuncCall) Results() []interface{} {
+func (c EnterpriseDBOwnSignalsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBNamespacesFunc describes the behavior when the Namespaces
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBNamespacesFunc struct {
-	defaultHook func() database.NamespaceStore
-	hooks       []func() database.NamespaceStore
-	history     []EnterpriseDBNamespacesFuncCall
+// EnterpriseDBPermissionSyncJobsFunc describes the behavior when the
+// PermissionSyncJobs method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBPermissionSyncJobsFunc struct {
+	defaultHook func() database.PermissionSyncJobStore
+	hooks       []func() database.PermissionSyncJobStore
+	history     []EnterpriseDBPermissionSyncJobsFuncCall
 	mutex       sync.Mutex
 }
 
-// Namespaces delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Namespaces() database.NamespaceStore {
-	r0 := m.NamespacesFunc.nextHook()()
-	m.NamespacesFunc.appendCall(EnterpriseDBNamespacesFuncCall{r0})
+// PermissionSyncJobs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) PermissionSyncJobs() database.PermissionSyncJobStore {
+	r0 := m.PermissionSyncJobsFunc.nextHook()()
+	m.PermissionSyncJobsFunc.appendCall(EnterpriseDBPermissionSyncJobsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Namespaces method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBNamespacesFunc) SetDefaultHook(hook func() database.NamespaceStore) {
+// SetDefaultHook sets function that is called when the PermissionSyncJobs
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBPermissionSyncJobsFunc) SetDefaultHook(hook func() database.PermissionSyncJobStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Namespaces method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBNamespacesFunc) PushHook(hook func() database.NamespaceStore) {
+// PermissionSyncJobs method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBPermissionSyncJobsFunc) PushHook(hook func() database.PermissionSyncJobStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10678,20 +13439,20 @@ func (f *EnterpriseDBNamespacesFunc) PushHook(hook func() database.NamespaceStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBNamespacesFunc) SetDefaultReturn(r0 database.NamespaceStore) {
-	f.SetDefaultHook(func() database.NamespaceStore {
+func (f *EnterpriseDBPermissionSyncJobsFunc) SetDefaultReturn(r0 database.PermissionSyncJobStore) {
+	f.SetDefaultHook(func() database.PermissionSyncJobStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBNamespacesFunc) PushReturn(r0 database.NamespaceStore) {
-	f.PushHook(func() database.NamespaceStore {
+func (f *EnterpriseDBPermissionSyncJobsFunc) PushReturn(r0 database.PermissionSyncJobStore) {
+	f.PushHook(func() database.PermissionSyncJobStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBNamespacesFunc) nextHook() func() database.NamespaceStore {
+func (f *EnterpriseDBPermissionSyncJobsFunc) nextHook() func() database.PermissionSyncJobStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10704,72 +13465,73 @@ func (f *EnterpriseDBNamespacesFunc) nextHook() func() database.NamespaceStore {
 	return hook
 }
 
-func (f *EnterpriseDBNamespacesFunc) appendCall(r0 EnterpriseDBNamespacesFuncCall) {
+func (f *EnterpriseDBPermissionSyncJobsFunc) appendCall(r0 EnterpriseDBPermissionSyncJobsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBNamespacesFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBNamespacesFunc) History() []EnterpriseDBNamespacesFuncCall {
+// History returns a sequence of EnterpriseDBPermissionSyncJobsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBPermissionSyncJobsFunc) History() []EnterpriseDBPermissionSyncJobsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBNamespacesFuncCall, len(f.history))
+	history := make([]EnterpriseDBPermissionSyncJobsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBNamespacesFuncCall is an object that describes an invocation
-// of method Namespaces on an instance of MockEnterpriseDB.
-type EnterpriseDBNamespacesFuncCall struct {
+// EnterpriseDBPermissionSyncJobsFuncCall is an object that describes an
+// invocation of method PermissionSyncJobs on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBPermissionSyncJobsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.NamespaceStore
+	Result0 database.PermissionSyncJobStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBNamespacesFuncCall) Args() []interface{} {
+func (c EnterpriseDBPermissionSyncJobsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBNamespacesFuncCall) Results() []interface{} {
+func (c EnterpriseDBPermissionSyncJobsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBOrgInvitationsFunc describes the behavior when the
-// OrgInvitations method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBOrgInvitationsFunc struct {
-	defaultHook func() database.OrgInvitationStore
-	hooks       []func() database.OrgInvitationStore
-	history     []EnterpriseDBOrgInvitationsFuncCall
+// EnterpriseDBPermissionsFunc describes the behavior when the Permissions
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBPermissionsFunc struct {
+	defaultHook func() database.PermissionStore
+	hooks       []func() database.PermissionStore
+	history     []EnterpriseDBPermissionsFuncCall
 	mutex       sync.Mutex
 }
 
-// OrgInvitations delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) OrgInvitations() database.OrgInvitationStore {
-	r0 := m.OrgInvitationsFunc.nextHook()()
-	m.OrgInvitationsFunc.appendCall(EnterpriseDBOrgInvitationsFuncCall{r0})
+// Permissions delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Permissions() database.PermissionStore {
+	r0 := m.PermissionsFunc.nextHook()()
+	m.PermissionsFunc.appendCall(EnterpriseDBPermissionsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the OrgInvitations
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBOrgInvitationsFunc) SetDefaultHook(hook func() database.OrgInvitationStore) {
+// SetDefaultHook sets function that is called when the Permissions method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBPermissionsFunc) SetDefaultHook(hook func() database.PermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// OrgInvitations method of the parent MockEnterpriseDB instance invokes the
+// Permissions method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBOrgInvitationsFunc) PushHook(hook func() database.OrgInvitationStore) {
+func (f *EnterpriseDBPermissionsFunc) PushHook(hook func() database.PermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10777,20 +13539,20 @@ func (f *EnterpriseDBOrgInvitationsFunc) PushHook(hook func() database.OrgInvita
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOrgInvitationsFunc) SetDefaultReturn(r0 database.OrgInvitationStore) {
-	f.SetDefaultHook(func() database.OrgInvitationStore {
+func (f *EnterpriseDBPermissionsFunc) SetDefaultReturn(r0 database.PermissionStore) {
+	f.SetDefaultHook(func() database.PermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOrgInvitationsFunc) PushReturn(r0 database.OrgInvitationStore) {
-	f.PushHook(func() database.OrgInvitationStore {
+func (f *EnterpriseDBPermissionsFunc) PushReturn(r0 database.PermissionStore) {
+	f.PushHook(func() database.PermissionStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBOrgInvitationsFunc) nextHook() func() database.OrgInvitationStore {
+func (f *EnterpriseDBPermissionsFunc) nextHook() func() database.PermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10803,72 +13565,71 @@ func (f *EnterpriseDBOrgInvitationsFunc) nextHook() func() database.OrgInvitatio
 	return hook
 }
 
-func (f *EnterpriseDBOrgInvitationsFunc) appendCall(r0 EnterpriseDBOrgInvitationsFuncCall) {
+func (f *EnterpriseDBPermissionsFunc) appendCall(r0 EnterpriseDBPermissionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBOrgInvitationsFuncCall objects
+// History returns a sequence of EnterpriseDBPermissionsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBOrgInvitationsFunc) History() []EnterpriseDBOrgInvitationsFuncCall {
+func (f *EnterpriseDBPermissionsFunc) History() []EnterpriseDBPermissionsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOrgInvitationsFuncCall, len(f.history))
+	history := make([]EnterpriseDBPermissionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOrgInvitationsFuncCall is an object that describes an
-// invocation of method OrgInvitations on an instance of MockEnterpriseDB.
-type EnterpriseDBOrgInvitationsFuncCall struct {
+// EnterpriseDBPermissionsFuncCall is an object that describes an invocation
+// of method Permissions on an instance of MockEnterpriseDB.
+type EnterpriseDBPermissionsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OrgInvitationStore
+	Result0 database.PermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBOrgInvitationsFuncCall) Args() []interface{} {
+func (c EnterpriseDBPermissionsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOrgInvitationsFuncCall) Results() []interface{} {
+func (c EnterpriseDBPermissionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBOrgMembersFunc describes the behavior when the OrgMembers
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBOrgMembersFunc struct {
-	defaultHook func() database.OrgMemberStore
-	hooks       []func() database.OrgMemberStore
-	history     []EnterpriseDBOrgMembersFuncCall
+// EnterpriseDBPermsFunc describes the behavior when the Perms method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBPermsFunc struct {
+	defaultHook func() PermsStore
+	hooks       []func() PermsStore
+	history     []EnterpriseDBPermsFuncCall
 	mutex       sync.Mutex
 }
 
-// OrgMembers delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) OrgMembers() database.OrgMemberStore {
-	r0 := m.OrgMembersFunc.nextHook()()
-	m.OrgMembersFunc.appendCall(EnterpriseDBOrgMembersFuncCall{r0})
+// Perms delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Perms() PermsStore {
+	r0 := m.PermsFunc.nextHook()()
+	m.PermsFunc.appendCall(EnterpriseDBPermsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the OrgMembers method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBOrgMembersFunc) SetDefaultHook(hook func() database.OrgMemberStore) {
+// SetDefaultHook sets function that is called when the Perms method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBPermsFunc) SetDefaultHook(hook func() PermsStore) {
 	f.defaultHook = hook
 }
 
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// OrgMembers method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBOrgMembersFunc) PushHook(hook func() database.OrgMemberStore) {
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Perms method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBPermsFunc) PushHook(hook func() PermsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10876,20 +13637,20 @@ func (f *EnterpriseDBOrgMembersFunc) PushHook(hook func() database.OrgMemberStor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOrgMembersFunc) SetDefaultReturn(r0 database.OrgMemberStore) {
-	f.SetDefaultHook(func() database.OrgMemberStore {
+func (f *EnterpriseDBPermsFunc) SetDefaultReturn(r0 PermsStore) {
+	f.SetDefaultHook(func() PermsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOrgMembersFunc) PushReturn(r0 database.OrgMemberStore) {
-	f.PushHook(func() database.OrgMemberStore {
+func (f *EnterpriseDBPermsFunc) PushReturn(r0 PermsStore) {
+	f.PushHook(func() PermsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBOrgMembersFunc) nextHook() func() database.OrgMemberStore {
+func (f *EnterpriseDBPermsFunc) nextHook() func() PermsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -10902,71 +13663,72 @@ func (f *EnterpriseDBOrgMembersFunc) nextHook() func() database.OrgMemberStore {
 	return hook
 }
 
-func (f *EnterpriseDBOrgMembersFunc) appendCall(r0 EnterpriseDBOrgMembersFuncCall) {
+func (f *EnterpriseDBPermsFunc) appendCall(r0 EnterpriseDBPermsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBOrgMembersFuncCall objects
+// History returns a sequence of EnterpriseDBPermsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBOrgMembersFunc) History() []EnterpriseDBOrgMembersFuncCall {
+func (f *EnterpriseDBPermsFunc) History() []EnterpriseDBPermsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOrgMembersFuncCall, len(f.history))
+	history := make([]EnterpriseDBPermsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOrgMembersFuncCall is an object that describes an invocation
-// of method OrgMembers on an instance of MockEnterpriseDB.
-type EnterpriseDBOrgMembersFuncCall struct {
+// EnterpriseDBPermsFuncCall is an object that describes an invocation of
+// method Perms on an instance of MockEnterpriseDB.
+type EnterpriseDBPermsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OrgMemberStore
+	Result0 PermsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBOrgMembersFuncCall) Args() []interface{} {
+func (c EnterpriseDBPermsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOrgMembersFuncCall) Results() []interface{} {
+func (c EnterpriseDBPermsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBOrgsFunc describes the behavior when the Orgs method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBOrgsFunc struct {
-	defaultHook func() database.OrgStore
-	hooks       []func() database.OrgStore
-	history     []EnterpriseDBOrgsFuncCall
+// EnterpriseDBPhabricatorFunc describes the behavior when the Phabricator
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBPhabricatorFunc struct {
+	defaultHook func() database.PhabricatorStore
+	hooks       []func() database.PhabricatorStore
+	history     []EnterpriseDBPhabricatorFuncCall
 	mutex       sync.Mutex
 }
 
-// Orgs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Orgs() database.OrgStore {
-	r0 := m.OrgsFunc.nextHook()()
-	m.OrgsFunc.appendCall(EnterpriseDBOrgsFuncCall{r0})
+// Phabricator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Phabricator() database.PhabricatorStore {
+	r0 := m.PhabricatorFunc.nextHook()()
+	m.PhabricatorFunc.appendCall(EnterpriseDBPhabricatorFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Orgs method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBOrgsFunc) SetDefaultHook(hook func() database.OrgStore) {
+// SetDefaultHook sets function that is called when the Phabricator method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBPhabricatorFunc) SetDefaultHook(hook func() database.PhabricatorStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Orgs method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBOrgsFunc) PushHook(hook func() database.OrgStore) {
+// Phabricator method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBPhabricatorFunc) PushHook(hook func() database.PhabricatorStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -10974,20 +13736,20 @@ func (f *EnterpriseDBOrgsFunc) PushHook(hook func() database.OrgStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOrgsFunc) SetDefaultReturn(r0 database.OrgStore) {
-	f.SetDefaultHook(func() database.OrgStore {
+func (f *EnterpriseDBPhabricatorFunc) SetDefaultReturn(r0 database.PhabricatorStore) {
+	f.SetDefaultHook(func() database.PhabricatorStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOrgsFunc) PushReturn(r0 database.OrgStore) {
-	f.PushHook(func() database.OrgStore {
+func (f *EnterpriseDBPhabricatorFunc) PushReturn(r0 database.PhabricatorStore) {
+	f.PushHook(func() database.PhabricatorStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBOrgsFunc) nextHook() func() database.OrgStore {
+func (f *EnterpriseDBPhabricatorFunc) nextHook() func() database.PhabricatorStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11000,74 +13762,72 @@ func (f *EnterpriseDBOrgsFunc) nextHook() func() database.OrgStore {
 	return hook
 }
 
-func (f *EnterpriseDBOrgsFunc) appendCall(r0 EnterpriseDBOrgsFuncCall) {
+func (f *EnterpriseDBPhabricatorFunc) appendCall(r0 EnterpriseDBPhabricatorFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBOrgsFuncCall objects describing
-// the invocations of this function.
-func (f *EnterpriseDBOrgsFunc) History() []EnterpriseDBOrgsFuncCall {
+// History returns a sequence of EnterpriseDBPhabricatorFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBPhabricatorFunc) History() []EnterpriseDBPhabricatorFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOrgsFuncCall, len(f.history))
+	history := make([]EnterpriseDBPhabricatorFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOrgsFuncCall is an object that describes an invocation of
-// method Orgs on an instance of MockEnterpriseDB.
-type EnterpriseDBOrgsFuncCall struct {
+// EnterpriseDBPhabricatorFuncCall is an object that describes an invocation
+// of method Phabricator on an instance of MockEnterpriseDB.
+type EnterpriseDBPhabricatorFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OrgStore
+	Result0 database.PhabricatorStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBOrgsFuncCall) Args() []interface{} {
+func (c EnterpriseDBPhabricatorFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOrgsFuncCall) Results() []interface{} {
+func (c EnterpriseDBPhabricatorFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBOutboundWebhookJobsFunc describes the behavior when the
-// OutboundWebhookJobs method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBOutboundWebhookJobsFunc struct {
-	defaultHook func(encryption.Key) database.OutboundWebhookJobStore
-	hooks       []func(encryption.Key) database.OutboundWebhookJobStore
-	history     []EnterpriseDBOutboundWebhookJobsFuncCall
+// EnterpriseDBQueryContextFunc describes the behavior when the QueryContext
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBQueryContextFunc struct {
+	defaultHook func(context.Context, string, ...interface{}) (*sql.Rows, error)
+	hooks       []func(context.Context, string, ...interface{}) (*sql.Rows, error)
+	history     []EnterpriseDBQueryContextFuncCall
 	mutex       sync.Mutex
 }
 
-// OutboundWebhookJobs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) OutboundWebhookJobs(v0 encryption.Key) database.OutboundWebhookJobStore {
-	r0 := m.OutboundWebhookJobsFunc.nextHook()(v0)
-	m.OutboundWebhookJobsFunc.appendCall(EnterpriseDBOutboundWebhookJobsFuncCall{v0, r0})
-	return r0
+// QueryContext delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) QueryContext(v0 context.Context, v1 string, v2 ...interface{}) (*sql.Rows, error) {
+	r0, r1 := m.QueryContextFunc.nextHook()(v0, v1, v2...)
+	m.QueryContextFunc.appendCall(EnterpriseDBQueryContextFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the OutboundWebhookJobs
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBOutboundWebhookJobsFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookJobStore) {
+// SetDefaultHook sets function that is called when the QueryContext method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBQueryContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) (*sql.Rows, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// OutboundWebhookJobs method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBOutboundWebhookJobsFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookJobStore) {
+// QueryContext method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBQueryContextFunc) PushHook(hook func(context.Context, string, ...interface{}) (*sql.Rows, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11075,20 +13835,20 @@ func (f *EnterpriseDBOutboundWebhookJobsFunc) PushHook(hook func(encryption.Key)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOutboundWebhookJobsFunc) SetDefaultReturn(r0 database.OutboundWebhookJobStore) {
-	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookJobStore {
-		return r0
+func (f *EnterpriseDBQueryContextFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, ...interface{}) (*sql.Rows, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOutboundWebhookJobsFunc) PushReturn(r0 database.OutboundWebhookJobStore) {
-	f.PushHook(func(encryption.Key) database.OutboundWebhookJobStore {
-		return r0
+func (f *EnterpriseDBQueryContextFunc) PushReturn(r0 *sql.Rows, r1 error) {
+	f.PushHook(func(context.Context, string, ...interface{}) (*sql.Rows, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnterpriseDBOutboundWebhookJobsFunc) nextHook() func(encryption.Key) database.OutboundWebhookJobStore {
+func (f *EnterpriseDBQueryContextFunc) nextHook() func(context.Context, string, ...interface{}) (*sql.Rows, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11101,78 +13861,92 @@ func (f *EnterpriseDBOutboundWebhookJobsFunc) nextHook() func(encryption.Key) da
 	return hook
 }
 
-func (f *EnterpriseDBOutboundWebhookJobsFunc) appendCall(r0 EnterpriseDBOutboundWebhookJobsFuncCall) {
+func (f *EnterpriseDBQueryContextFunc) appendCall(r0 EnterpriseDBQueryContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBOutboundWebhookJobsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBOutboundWebhookJobsFunc) History() []EnterpriseDBOutboundWebhookJobsFuncCall {
+// History returns a sequence of EnterpriseDBQueryContextFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBQueryContextFunc) History() []EnterpriseDBQueryContextFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOutboundWebhookJobsFuncCall, len(f.history))
+	history := make([]EnterpriseDBQueryContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOutboundWebhookJobsFuncCall is an object that describes an
-// invocation of method OutboundWebhookJobs on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBOutboundWebhookJobsFuncCall struct {
+// EnterpriseDBQueryContextFuncCall is an object that describes an
+// invocation of method QueryContext on an instance of MockEnterpriseDB.
+type EnterpriseDBQueryContextFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 encryption.Key
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []interface{}
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookJobStore
+	Result0 *sql.Rows
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c EnterpriseDBOutboundWebhookJobsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c EnterpriseDBQueryContextFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOutboundWebhookJobsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c EnterpriseDBQueryContextFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnterpriseDBOutboundWebhookLogsFunc describes the behavior when the
-// OutboundWebhookLogs method of the parent MockEnterpriseDB instance is
+// EnterpriseDBQueryRowContextFunc describes the behavior when the
+// QueryRowContext method of the parent MockEnterpriseDB instance is
 // invoked.
-type EnterpriseDBOutboundWebhookLogsFunc struct {
-	defaultHook func(encryption.Key) database.OutboundWebhookLogStore
-	hooks       []func(encryption.Key) database.OutboundWebhookLogStore
-	history     []EnterpriseDBOutboundWebhookLogsFuncCall
+type EnterpriseDBQueryRowContextFunc struct {
+	defaultHook func(context.Context, string, ...interface{}) *sql.Row
+	hooks       []func(context.Context, string, ...interface{}) *sql.Row
+	history     []EnterpriseDBQueryRowContextFuncCall
 	mutex       sync.Mutex
 }
 
-// OutboundWebhookLogs delegates to the next hook function in the queue and
+// QueryRowContext delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) OutboundWebhookLogs(v0 encryption.Key) database.OutboundWebhookLogStore {
-	r0 := m.OutboundWebhookLogsFunc.nextHook()(v0)
-	m.OutboundWebhookLogsFunc.appendCall(EnterpriseDBOutboundWebhookLogsFuncCall{v0, r0})
+func (m *MockEnterpriseDB) QueryRowContext(v0 context.Context, v1 string, v2 ...interface{}) *sql.Row {
+	r0 := m.QueryRowContextFunc.nextHook()(v0, v1, v2...)
+	m.QueryRowContextFunc.appendCall(EnterpriseDBQueryRowContextFuncCall{v0, v1, v2, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the OutboundWebhookLogs
+// SetDefaultHook sets function that is called when the QueryRowContext
 // method of the parent MockEnterpriseDB instance is invoked and the hook
 // queue is empty.
-func (f *EnterpriseDBOutboundWebhookLogsFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookLogStore) {
+func (f *EnterpriseDBQueryRowContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) *sql.Row) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// OutboundWebhookLogs method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBOutboundWebhookLogsFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookLogStore) {
+// QueryRowContext method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBQueryRowContextFunc) PushHook(hook func(context.Context, string, ...interface{}) *sql.Row) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11180,20 +13954,20 @@ func (f *EnterpriseDBOutboundWebhookLogsFunc) PushHook(hook func(encryption.Key)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOutboundWebhookLogsFunc) SetDefaultReturn(r0 database.OutboundWebhookLogStore) {
-	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookLogStore {
+func (f *EnterpriseDBQueryRowContextFunc) SetDefaultReturn(r0 *sql.Row) {
+	f.SetDefaultHook(func(context.Context, string, ...interface{}) *sql.Row {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOutboundWebhookLogsFunc) PushReturn(r0 database.OutboundWebhookLogStore) {
-	f.PushHook(func(encryption.Key) database.OutboundWebhookLogStore {
+func (f *EnterpriseDBQueryRowContextFunc) PushReturn(r0 *sql.Row) {
+	f.PushHook(func(context.Context, string, ...interface{}) *sql.Row {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBOutboundWebhookLogsFunc) nextHook() func(encryption.Key) database.OutboundWebhookLogStore {
+func (f *EnterpriseDBQueryRowContextFunc) nextHook() func(context.Context, string, ...interface{}) *sql.Row {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11206,77 +13980,88 @@ func (f *EnterpriseDBOutboundWebhookLogsFunc) nextHook() func(encryption.Key) da
 	return hook
 }
 
-func (f *EnterpriseDBOutboundWebhookLogsFunc) appendCall(r0 EnterpriseDBOutboundWebhookLogsFuncCall) {
+func (f *EnterpriseDBQueryRowContextFunc) appendCall(r0 EnterpriseDBQueryRowContextFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
-
-// History returns a sequence of EnterpriseDBOutboundWebhookLogsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBOutboundWebhookLogsFunc) History() []EnterpriseDBOutboundWebhookLogsFuncCall {
+
+// History returns a sequence of EnterpriseDBQueryRowContextFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBQueryRowContextFunc) History() []EnterpriseDBQueryRowContextFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOutboundWebhookLogsFuncCall, len(f.history))
+	history := make([]EnterpriseDBQueryRowContextFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOutboundWebhookLogsFuncCall is an object that describes an
-// invocation of method OutboundWebhookLogs on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBOutboundWebhookLogsFuncCall struct {
+// EnterpriseDBQueryRowContextFuncCall is an object that describes an
+// invocation of method QueryRowContext on an instance of MockEnterpriseDB.
+type EnterpriseDBQueryRowContextFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 encryption.Key
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is a slice containing the values of the variadic arguments
+	// passed to this method invocation.
+	Arg2 []interface{}
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookLogStore
+	Result0 *sql.Row
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation.
-func (c EnterpriseDBOutboundWebhookLogsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+// invocation. The variadic slice argument is flattened in this array such
+// that one positional argument and three variadic arguments would result in
+// a slice of four, not two.
+func (c EnterpriseDBQueryRowContextFuncCall) Args() []interface{} {
+	trailing := []interface{}{}
+	for _, val := range c.Arg2 {
+		trailing = append(trailing, val)
+	}
+
+	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOutboundWebhookLogsFuncCall) Results() []interface{} {
+func (c EnterpriseDBQueryRowContextFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBOutboundWebhooksFunc describes the behavior when the
-// OutboundWebhooks method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBOutboundWebhooksFunc struct {
-	defaultHook func(encryption.Key) database.OutboundWebhookStore
-	hooks       []func(encryption.Key) database.OutboundWebhookStore
-	history     []EnterpriseDBOutboundWebhooksFuncCall
+// EnterpriseDBRedisKeyValueFunc describes the behavior when the
+// RedisKeyValue method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRedisKeyValueFunc struct {
+	defaultHook func() database.RedisKeyValueStore
+	hooks       []func() database.RedisKeyValueStore
+	history     []EnterpriseDBRedisKeyValueFuncCall
 	mutex       sync.Mutex
 }
 
-// OutboundWebhooks delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) OutboundWebhooks(v0 encryption.Key) database.OutboundWebhookStore {
-	r0 := m.OutboundWebhooksFunc.nextHook()(v0)
-	m.OutboundWebhooksFunc.appendCall(EnterpriseDBOutboundWebhooksFuncCall{v0, r0})
+// RedisKeyValue delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RedisKeyValue() database.RedisKeyValueStore {
+	r0 := m.RedisKeyValueFunc.nextHook()()
+	m.RedisKeyValueFunc.appendCall(EnterpriseDBRedisKeyValueFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the OutboundWebhooks
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBOutboundWebhooksFunc) SetDefaultHook(hook func(encryption.Key) database.OutboundWebhookStore) {
+// SetDefaultHook sets function that is called when the RedisKeyValue method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBRedisKeyValueFunc) SetDefaultHook(hook func() database.RedisKeyValueStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// OutboundWebhooks method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBOutboundWebhooksFunc) PushHook(hook func(encryption.Key) database.OutboundWebhookStore) {
+// RedisKeyValue method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBRedisKeyValueFunc) PushHook(hook func() database.RedisKeyValueStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11284,20 +14069,20 @@ func (f *EnterpriseDBOutboundWebhooksFunc) PushHook(hook func(encryption.Key) da
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBOutboundWebhooksFunc) SetDefaultReturn(r0 database.OutboundWebhookStore) {
-	f.SetDefaultHook(func(encryption.Key) database.OutboundWebhookStore {
+func (f *EnterpriseDBRedisKeyValueFunc) SetDefaultReturn(r0 database.RedisKeyValueStore) {
+	f.SetDefaultHook(func() database.RedisKeyValueStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBOutboundWebhooksFunc) PushReturn(r0 database.OutboundWebhookStore) {
-	f.PushHook(func(encryption.Key) database.OutboundWebhookStore {
+func (f *EnterpriseDBRedisKeyValueFunc) PushReturn(r0 database.RedisKeyValueStore) {
+	f.PushHook(func() database.RedisKeyValueStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBOutboundWebhooksFunc) nextHook() func(encryption.Key) database.OutboundWebhookStore {
+func (f *EnterpriseDBRedisKeyValueFunc) nextHook() func() database.RedisKeyValueStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11310,76 +14095,72 @@ func (f *EnterpriseDBOutboundWebhooksFunc) nextHook() func(encryption.Key) datab
 	return hook
 }
 
-func (f *EnterpriseDBOutboundWebhooksFunc) appendCall(r0 EnterpriseDBOutboundWebhooksFuncCall) {
+func (f *EnterpriseDBRedisKeyValueFunc) appendCall(r0 EnterpriseDBRedisKeyValueFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBOutboundWebhooksFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBOutboundWebhooksFunc) History() []EnterpriseDBOutboundWebhooksFuncCall {
+// History returns a sequence of EnterpriseDBRedisKeyValueFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBRedisKeyValueFunc) History() []EnterpriseDBRedisKeyValueFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBOutboundWebhooksFuncCall, len(f.history))
+	history := make([]EnterpriseDBRedisKeyValueFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBOutboundWebhooksFuncCall is an object that describes an
-// invocation of method OutboundWebhooks on an instance of MockEnterpriseDB.
-type EnterpriseDBOutboundWebhooksFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 encryption.Key
+// EnterpriseDBRedisKeyValueFuncCall is an object that describes an
+// invocation of method RedisKeyValue on an instance of MockEnterpriseDB.
+type EnterpriseDBRedisKeyValueFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.OutboundWebhookStore
+	Result0 database.RedisKeyValueStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBOutboundWebhooksFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c EnterpriseDBRedisKeyValueFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBOutboundWebhooksFuncCall) Results() []interface{} {
+func (c EnterpriseDBRedisKeyValueFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBPermissionSyncJobsFunc describes the behavior when the
-// PermissionSyncJobs method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBPermissionSyncJobsFunc struct {
-	defaultHook func() database.PermissionSyncJobStore
-	hooks       []func() database.PermissionSyncJobStore
-	history     []EnterpriseDBPermissionSyncJobsFuncCall
+// EnterpriseDBRepoKVPsFunc describes the behavior when the RepoKVPs method
+// of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRepoKVPsFunc struct {
+	defaultHook func() database.RepoKVPStore
+	hooks       []func() database.RepoKVPStore
+	history     []EnterpriseDBRepoKVPsFuncCall
 	mutex       sync.Mutex
 }
 
-// PermissionSyncJobs delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) PermissionSyncJobs() database.PermissionSyncJobStore {
-	r0 := m.PermissionSyncJobsFunc.nextHook()()
-	m.PermissionSyncJobsFunc.appendCall(EnterpriseDBPermissionSyncJobsFuncCall{r0})
+// RepoKVPs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RepoKVPs() database.RepoKVPStore {
+	r0 := m.RepoKVPsFunc.nextHook()()
+	m.RepoKVPsFunc.appendCall(EnterpriseDBRepoKVPsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the PermissionSyncJobs
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBPermissionSyncJobsFunc) SetDefaultHook(hook func() database.PermissionSyncJobStore) {
+// SetDefaultHook sets function that is called when the RepoKVPs method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBRepoKVPsFunc) SetDefaultHook(hook func() database.RepoKVPStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// PermissionSyncJobs method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBPermissionSyncJobsFunc) PushHook(hook func() database.PermissionSyncJobStore) {
+// RepoKVPs method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBRepoKVPsFunc) PushHook(hook func() database.RepoKVPStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11387,20 +14168,20 @@ func (f *EnterpriseDBPermissionSyncJobsFunc) PushHook(hook func() database.Permi
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBPermissionSyncJobsFunc) SetDefaultReturn(r0 database.PermissionSyncJobStore) {
-	f.SetDefaultHook(func() database.PermissionSyncJobStore {
+func (f *EnterpriseDBRepoKVPsFunc) SetDefaultReturn(r0 database.RepoKVPStore) {
+	f.SetDefaultHook(func() database.RepoKVPStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBPermissionSyncJobsFunc) PushReturn(r0 database.PermissionSyncJobStore) {
-	f.PushHook(func() database.PermissionSyncJobStore {
+func (f *EnterpriseDBRepoKVPsFunc) PushReturn(r0 database.RepoKVPStore) {
+	f.PushHook(func() database.RepoKVPStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBPermissionSyncJobsFunc) nextHook() func() database.PermissionSyncJobStore {
+func (f *EnterpriseDBRepoKVPsFunc) nextHook() func() database.RepoKVPStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11413,73 +14194,72 @@ func (f *EnterpriseDBPermissionSyncJobsFunc) nextHook() func() database.Permissi
 	return hook
 }
 
-func (f *EnterpriseDBPermissionSyncJobsFunc) appendCall(r0 EnterpriseDBPermissionSyncJobsFuncCall) {
+func (f *EnterpriseDBRepoKVPsFunc) appendCall(r0 EnterpriseDBRepoKVPsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBPermissionSyncJobsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBPermissionSyncJobsFunc) History() []EnterpriseDBPermissionSyncJobsFuncCall {
+// History returns a sequence of EnterpriseDBRepoKVPsFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBRepoKVPsFunc) History() []EnterpriseDBRepoKVPsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBPermissionSyncJobsFuncCall, len(f.history))
+	history := make([]EnterpriseDBRepoKVPsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBPermissionSyncJobsFuncCall is an object that describes an
-// invocation of method PermissionSyncJobs on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBPermissionSyncJobsFuncCall struct {
+// EnterpriseDBRepoKVPsFuncCall is an object that describes an invocation of
+// method RepoKVPs on an instance of MockEnterpriseDB.
+type EnterpriseDBRepoKVPsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.PermissionSyncJobStore
+	Result0 database.RepoKVPStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBPermissionSyncJobsFuncCall) Args() []interface{} {
+func (c EnterpriseDBRepoKVPsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBPermissionSyncJobsFuncCall) Results() []interface{} {
+func (c EnterpriseDBRepoKVPsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBPermissionsFunc describes the behavior when the Permissions
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBPermissionsFunc struct {
-	defaultHook func() database.PermissionStore
-	hooks       []func() database.PermissionStore
-	history     []EnterpriseDBPermissionsFuncCall
+// EnterpriseDBRepoStatisticsFunc describes the behavior when the
+// RepoStatistics method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRepoStatisticsFunc struct {
+	defaultHook func() database.RepoStatisticsStore
+	hooks       []func() database.RepoStatisticsStore
+	history     []EnterpriseDBRepoStatisticsFuncCall
 	mutex       sync.Mutex
 }
 
-// Permissions delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Permissions() database.PermissionStore {
-	r0 := m.PermissionsFunc.nextHook()()
-	m.PermissionsFunc.appendCall(EnterpriseDBPermissionsFuncCall{r0})
+// RepoStatistics delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RepoStatistics() database.RepoStatisticsStore {
+	r0 := m.RepoStatisticsFunc.nextHook()()
+	m.RepoStatisticsFunc.appendCall(EnterpriseDBRepoStatisticsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Permissions method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBPermissionsFunc) SetDefaultHook(hook func() database.PermissionStore) {
+// SetDefaultHook sets function that is called when the RepoStatistics
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBRepoStatisticsFunc) SetDefaultHook(hook func() database.RepoStatisticsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Permissions method of the parent MockEnterpriseDB instance invokes the
+// RepoStatistics method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBPermissionsFunc) PushHook(hook func() database.PermissionStore) {
+func (f *EnterpriseDBRepoStatisticsFunc) PushHook(hook func() database.RepoStatisticsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11487,20 +14267,20 @@ func (f *EnterpriseDBPermissionsFunc) PushHook(hook func() database.PermissionSt
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBPermissionsFunc) SetDefaultReturn(r0 database.PermissionStore) {
-	f.SetDefaultHook(func() database.PermissionStore {
+func (f *EnterpriseDBRepoStatisticsFunc) SetDefaultReturn(r0 database.RepoStatisticsStore) {
+	f.SetDefaultHook(func() database.RepoStatisticsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBPermissionsFunc) PushReturn(r0 database.PermissionStore) {
-	f.PushHook(func() database.PermissionStore {
+func (f *EnterpriseDBRepoStatisticsFunc) PushReturn(r0 database.RepoStatisticsStore) {
+	f.PushHook(func() database.RepoStatisticsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBPermissionsFunc) nextHook() func() database.PermissionStore {
+func (f *EnterpriseDBRepoStatisticsFunc) nextHook() func() database.RepoStatisticsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11513,71 +14293,71 @@ func (f *EnterpriseDBPermissionsFunc) nextHook() func() database.PermissionStore
 	return hook
 }
 
-func (f *EnterpriseDBPermissionsFunc) appendCall(r0 EnterpriseDBPermissionsFuncCall) {
+func (f *EnterpriseDBRepoStatisticsFunc) appendCall(r0 EnterpriseDBRepoStatisticsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBPermissionsFuncCall objects
+// History returns a sequence of EnterpriseDBRepoStatisticsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBPermissionsFunc) History() []EnterpriseDBPermissionsFuncCall {
+func (f *EnterpriseDBRepoStatisticsFunc) History() []EnterpriseDBRepoStatisticsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBPermissionsFuncCall, len(f.history))
+	history := make([]EnterpriseDBRepoStatisticsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBPermissionsFuncCall is an object that describes an invocation
-// of method Permissions on an instance of MockEnterpriseDB.
-type EnterpriseDBPermissionsFuncCall struct {
+// EnterpriseDBRepoStatisticsFuncCall is an object that describes an
+// invocation of method RepoStatistics on an instance of MockEnterpriseDB.
+type EnterpriseDBRepoStatisticsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.PermissionStore
+	Result0 database.RepoStatisticsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBPermissionsFuncCall) Args() []interface{} {
+func (c EnterpriseDBRepoStatisticsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBPermissionsFuncCall) Results() []interface{} {
+func (c EnterpriseDBRepoStatisticsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBPermsFunc describes the behavior when the Perms method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBPermsFunc struct {
-	defaultHook func() PermsStore
-	hooks       []func() PermsStore
-	history     []EnterpriseDBPermsFuncCall
+// EnterpriseDBReposFunc describes the behavior when the Repos method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBReposFunc struct {
+	defaultHook func() database.RepoStore
+	hooks       []func() database.RepoStore
+	history     []EnterpriseDBReposFuncCall
 	mutex       sync.Mutex
 }
 
-// Perms delegates to the next hook function in the queue and stores the
+// Repos delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Perms() PermsStore {
-	r0 := m.PermsFunc.nextHook()()
-	m.PermsFunc.appendCall(EnterpriseDBPermsFuncCall{r0})
+func (m *MockEnterpriseDB) Repos() database.RepoStore {
+	r0 := m.ReposFunc.nextHook()()
+	m.ReposFunc.appendCall(EnterpriseDBReposFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Perms method of the
+// SetDefaultHook sets function that is called when the Repos method of the
 // parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBPermsFunc) SetDefaultHook(hook func() PermsStore) {
+func (f *EnterpriseDBReposFunc) SetDefaultHook(hook func() database.RepoStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Perms method of the parent MockEnterpriseDB instance invokes the hook at
+// Repos method of the parent MockEnterpriseDB instance invokes the hook at
 // the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *EnterpriseDBPermsFunc) PushHook(hook func() PermsStore) {
+func (f *EnterpriseDBReposFunc) PushHook(hook func() database.RepoStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11585,20 +14365,20 @@ func (f *EnterpriseDBPermsFunc) PushHook(hook func() PermsStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBPermsFunc) SetDefaultReturn(r0 PermsStore) {
-	f.SetDefaultHook(func() PermsStore {
+func (f *EnterpriseDBReposFunc) SetDefaultReturn(r0 database.RepoStore) {
+	f.SetDefaultHook(func() database.RepoStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBPermsFunc) PushReturn(r0 PermsStore) {
-	f.PushHook(func() PermsStore {
+func (f *EnterpriseDBReposFunc) PushReturn(r0 database.RepoStore) {
+	f.PushHook(func() database.RepoStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBPermsFunc) nextHook() func() PermsStore {
+func (f *EnterpriseDBReposFunc) nextHook() func() database.RepoStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11611,72 +14391,73 @@ func (f *EnterpriseDBPermsFunc) nextHook() func() PermsStore {
 	return hook
 }
 
-func (f *EnterpriseDBPermsFunc) appendCall(r0 EnterpriseDBPermsFuncCall) {
+func (f *EnterpriseDBReposFunc) appendCall(r0 EnterpriseDBReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBPermsFuncCall objects
+// History returns a sequence of EnterpriseDBReposFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBPermsFunc) History() []EnterpriseDBPermsFuncCall {
+func (f *EnterpriseDBReposFunc) History() []EnterpriseDBReposFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBPermsFuncCall, len(f.history))
+	history := make([]EnterpriseDBReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBPermsFuncCall is an object that describes an invocation of
-// method Perms on an instance of MockEnterpriseDB.
-type EnterpriseDBPermsFuncCall struct {
+// EnterpriseDBReposFuncCall is an object that describes an invocation of
+// method Repos on an instance of MockEnterpriseDB.
+type EnterpriseDBReposFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 PermsStore
+	Result0 database.RepoStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBPermsFuncCall) Args() []interface{} {
+func (c EnterpriseDBReposFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBPermsFuncCall) Results() []interface{} {
+func (c EnterpriseDBReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBPhabricatorFunc describes the behavior when the Phabricator
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBPhabricatorFunc struct {
-	defaultHook func() database.PhabricatorStore
-	hooks       []func() database.PhabricatorStore
-	history     []EnterpriseDBPhabricatorFuncCall
+// EnterpriseDBRolePermissionsFunc describes the behavior when the
+// RolePermissions method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBRolePermissionsFunc struct {
+	defaultHook func() database.RolePermissionStore
+	hooks       []func() database.RolePermissionStore
+	history     []EnterpriseDBRolePermissionsFuncCall
 	mutex       sync.Mutex
 }
 
-// Phabricator delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Phabricator() database.PhabricatorStore {
-	r0 := m.PhabricatorFunc.nextHook()()
-	m.PhabricatorFunc.appendCall(EnterpriseDBPhabricatorFuncCall{r0})
+// RolePermissions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) RolePermissions() database.RolePermissionStore {
+	r0 := m.RolePermissionsFunc.nextHook()()
+	m.RolePermissionsFunc.appendCall(EnterpriseDBRolePermissionsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Phabricator method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBPhabricatorFunc) SetDefaultHook(hook func() database.PhabricatorStore) {
+// SetDefaultHook sets function that is called when the RolePermissions
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBRolePermissionsFunc) SetDefaultHook(hook func() database.RolePermissionStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Phabricator method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBPhabricatorFunc) PushHook(hook func() database.PhabricatorStore) {
+// RolePermissions method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBRolePermissionsFunc) PushHook(hook func() database.RolePermissionStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11684,20 +14465,20 @@ func (f *EnterpriseDBPhabricatorFunc) PushHook(hook func() database.PhabricatorS
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBPhabricatorFunc) SetDefaultReturn(r0 database.PhabricatorStore) {
-	f.SetDefaultHook(func() database.PhabricatorStore {
+func (f *EnterpriseDBRolePermissionsFunc) SetDefaultReturn(r0 database.RolePermissionStore) {
+	f.SetDefaultHook(func() database.RolePermissionStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBPhabricatorFunc) PushReturn(r0 database.PhabricatorStore) {
-	f.PushHook(func() database.PhabricatorStore {
+func (f *EnterpriseDBRolePermissionsFunc) PushReturn(r0 database.RolePermissionStore) {
+	f.PushHook(func() database.RolePermissionStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBPhabricatorFunc) nextHook() func() database.PhabricatorStore {
+func (f *EnterpriseDBRolePermissionsFunc) nextHook() func() database.RolePermissionStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11710,72 +14491,71 @@ func (f *EnterpriseDBPhabricatorFunc) nextHook() func() database.PhabricatorStor
 	return hook
 }
 
-func (f *EnterpriseDBPhabricatorFunc) appendCall(r0 EnterpriseDBPhabricatorFuncCall) {
+func (f *EnterpriseDBRolePermissionsFunc) appendCall(r0 EnterpriseDBRolePermissionsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBPhabricatorFuncCall objects
+// History returns a sequence of EnterpriseDBRolePermissionsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBPhabricatorFunc) History() []EnterpriseDBPhabricatorFuncCall {
+func (f *EnterpriseDBRolePermissionsFunc) History() []EnterpriseDBRolePermissionsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBPhabricatorFuncCall, len(f.history))
+	history := make([]EnterpriseDBRolePermissionsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBPhabricatorFuncCall is an object that describes an invocation
-// of method Phabricator on an instance of MockEnterpriseDB.
-type EnterpriseDBPhabricatorFuncCall struct {
+// EnterpriseDBRolePermissionsFuncCall is an object that describes an
+// invocation of method RolePermissions on an instance of MockEnterpriseDB.
+type EnterpriseDBRolePermissionsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.PhabricatorStore
+	Result0 database.RolePermissionStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBPhabricatorFuncCall) Args() []interface{} {
+func (c EnterpriseDBRolePermissionsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBPhabricatorFuncCall) Results() []interface{} {
+func (c EnterpriseDBRolePermissionsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBQueryContextFunc describes the behavior when the QueryContext
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBQueryContextFunc struct {
-	defaultHook func(context.Context, string, ...interface{}) (*sql.Rows, error)
-	hooks       []func(context.Context, string, ...interface{}) (*sql.Rows, error)
-	history     []EnterpriseDBQueryContextFuncCall
+// EnterpriseDBRolesFunc describes the behavior when the Roles method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBRolesFunc struct {
+	defaultHook func() database.RoleStore
+	hooks       []func() database.RoleStore
+	history     []EnterpriseDBRolesFuncCall
 	mutex       sync.Mutex
 }
 
-// QueryContext delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) QueryContext(v0 context.Context, v1 string, v2 ...interface{}) (*sql.Rows, error) {
-	r0, r1 := m.QueryContextFunc.nextHook()(v0, v1, v2...)
-	m.QueryContextFunc.appendCall(EnterpriseDBQueryContextFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+// Roles delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Roles() database.RoleStore {
+	r0 := m.RolesFunc.nextHook()()
+	m.RolesFunc.appendCall(EnterpriseDBRolesFuncCall{r0})
+	return r0
 }
 
-// SetDefaultHook sets function that is called when the QueryContext method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBQueryContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) (*sql.Rows, error)) {
+// SetDefaultHook sets function that is called when the Roles method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBRolesFunc) SetDefaultHook(hook func() database.RoleStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// QueryContext method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBQueryContextFunc) PushHook(hook func(context.Context, string, ...interface{}) (*sql.Rows, error)) {
+// Roles method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBRolesFunc) PushHook(hook func() database.RoleStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11783,20 +14563,20 @@ func (f *EnterpriseDBQueryContextFunc) PushHook(hook func(context.Context, strin
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBQueryContextFunc) SetDefaultReturn(r0 *sql.Rows, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, ...interface{}) (*sql.Rows, error) {
-		return r0, r1
+func (f *EnterpriseDBRolesFunc) SetDefaultReturn(r0 database.RoleStore) {
+	f.SetDefaultHook(func() database.RoleStore {
+		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBQueryContextFunc) PushReturn(r0 *sql.Rows, r1 error) {
-	f.PushHook(func(context.Context, string, ...interface{}) (*sql.Rows, error) {
-		return r0, r1
+func (f *EnterpriseDBRolesFunc) PushReturn(r0 database.RoleStore) {
+	f.PushHook(func() database.RoleStore {
+		return r0
 	})
 }
 
-func (f *EnterpriseDBQueryContextFunc) nextHook() func(context.Context, string, ...interface{}) (*sql.Rows, error) {
+func (f *EnterpriseDBRolesFunc) nextHook() func() database.RoleStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11809,92 +14589,72 @@ func (f *EnterpriseDBQueryContextFunc) nextHook() func(context.Context, string,
 	return hook
 }
 
-func (f *EnterpriseDBQueryContextFunc) appendCall(r0 EnterpriseDBQueryContextFuncCall) {
+func (f *EnterpriseDBRolesFunc) appendCall(r0 EnterpriseDBRolesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBQueryContextFuncCall objects
+// History returns a sequence of EnterpriseDBRolesFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBQueryContextFunc) History() []EnterpriseDBQueryContextFuncCall {
+func (f *EnterpriseDBRolesFunc) History() []EnterpriseDBRolesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBQueryContextFuncCall, len(f.history))
+	history := make([]EnterpriseDBRolesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBQueryContextFuncCall is an object that describes an
-// invocation of method QueryContext on an instance of MockEnterpriseDB.
-type EnterpriseDBQueryContextFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Arg2 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg2 []interface{}
+// EnterpriseDBRolesFuncCall is an object that describes an invocation of
+// method Roles on an instance of MockEnterpriseDB.
+type EnterpriseDBRolesFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *sql.Rows
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Result0 database.RoleStore
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c EnterpriseDBQueryContextFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg2 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+// invocation.
+func (c EnterpriseDBRolesFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBQueryContextFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c EnterpriseDBRolesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBQueryRowContextFunc describes the behavior when the
-// QueryRowContext method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBQueryRowContextFunc struct {
-	defaultHook func(context.Context, string, ...interface{}) *sql.Row
-	hooks       []func(context.Context, string, ...interface{}) *sql.Row
-	history     []EnterpriseDBQueryRowContextFuncCall
+// EnterpriseDBSavedSearchesFunc describes the behavior when the
+// SavedSearches method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBSavedSearchesFunc struct {
+	defaultHook func() database.SavedSearchStore
+	hooks       []func() database.SavedSearchStore
+	history     []EnterpriseDBSavedSearchesFuncCall
 	mutex       sync.Mutex
 }
 
-// QueryRowContext delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) QueryRowContext(v0 context.Context, v1 string, v2 ...interface{}) *sql.Row {
-	r0 := m.QueryRowContextFunc.nextHook()(v0, v1, v2...)
-	m.QueryRowContextFunc.appendCall(EnterpriseDBQueryRowContextFuncCall{v0, v1, v2, r0})
+// SavedSearches delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) SavedSearches() database.SavedSearchStore {
+	r0 := m.SavedSearchesFunc.nextHook()()
+	m.SavedSearchesFunc.appendCall(EnterpriseDBSavedSearchesFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the QueryRowContext
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBQueryRowContextFunc) SetDefaultHook(hook func(context.Context, string, ...interface{}) *sql.Row) {
+// SetDefaultHook sets function that is called when the SavedSearches method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBSavedSearchesFunc) SetDefaultHook(hook func() database.SavedSearchStore) {
 	f.defaultHook = hook
 }
-
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// QueryRowContext method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBQueryRowContextFunc) PushHook(hook func(context.Context, string, ...interface{}) *sql.Row) {
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SavedSearches method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBSavedSearchesFunc) PushHook(hook func() database.SavedSearchStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -11902,20 +14662,20 @@ func (f *EnterpriseDBQueryRowContextFunc) PushHook(hook func(context.Context, st
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBQueryRowContextFunc) SetDefaultReturn(r0 *sql.Row) {
-	f.SetDefaultHook(func(context.Context, string, ...interface{}) *sql.Row {
+func (f *EnterpriseDBSavedSearchesFunc) SetDefaultReturn(r0 database.SavedSearchStore) {
+	f.SetDefaultHook(func() database.SavedSearchStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBQueryRowContextFunc) PushReturn(r0 *sql.Row) {
-	f.PushHook(func(context.Context, string, ...interface{}) *sql.Row {
+func (f *EnterpriseDBSavedSearchesFunc) PushReturn(r0 database.SavedSearchStore) {
+	f.PushHook(func() database.SavedSearchStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBQueryRowContextFunc) nextHook() func(context.Context, string, ...interface{}) *sql.Row {
+func (f *EnterpriseDBSavedSearchesFunc) nextHook() func() database.SavedSearchStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -11928,88 +14688,72 @@ func (f *EnterpriseDBQueryRowContextFunc) nextHook() func(context.Context, strin
 	return hook
 }
 
-func (f *EnterpriseDBQueryRowContextFunc) appendCall(r0 EnterpriseDBQueryRowContextFuncCall) {
+func (f *EnterpriseDBSavedSearchesFunc) appendCall(r0 EnterpriseDBSavedSearchesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBQueryRowContextFuncCall objects
+// History returns a sequence of EnterpriseDBSavedSearchesFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBQueryRowContextFunc) History() []EnterpriseDBQueryRowContextFuncCall {
+func (f *EnterpriseDBSavedSearchesFunc) History() []EnterpriseDBSavedSearchesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBQueryRowContextFuncCall, len(f.history))
+	history := make([]EnterpriseDBSavedSearchesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBQueryRowContextFuncCall is an object that describes an
-// invocation of method QueryRowContext on an instance of MockEnterpriseDB.
-type EnterpriseDBQueryRowContextFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Arg2 is a slice containing the values of the variadic arguments
-	// passed to this method invocation.
-	Arg2 []interface{}
+// EnterpriseDBSavedSearchesFuncCall is an object that describes an
+// invocation of method SavedSearches on an instance of MockEnterpriseDB.
+type EnterpriseDBSavedSearchesFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *sql.Row
+	Result0 database.SavedSearchStore
 }
 
 // Args returns an interface slice containing the arguments of this
-// invocation. The variadic slice argument is flattened in this array such
-// that one positional argument and three variadic arguments would result in
-// a slice of four, not two.
-func (c EnterpriseDBQueryRowContextFuncCall) Args() []interface{} {
-	trailing := []interface{}{}
-	for _, val := range c.Arg2 {
-		trailing = append(trailing, val)
-	}
-
-	return append([]interface{}{c.Arg0, c.Arg1}, trailing...)
+// invocation.
+func (c EnterpriseDBSavedSearchesFuncCall) Args() []interface{} {
+	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBQueryRowContextFuncCall) Results() []interface{} {
+func (c EnterpriseDBSavedSearchesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBRedisKeyValueFunc describes the behavior when the
-// RedisKeyValue method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBRedisKeyValueFunc struct {
-	defaultHook func() database.RedisKeyValueStore
-	hooks       []func() database.RedisKeyValueStore
-	history     []EnterpriseDBRedisKeyValueFuncCall
+// EnterpriseDBSearchContextsFunc describes the behavior when the
+// SearchContexts method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBSearchContextsFunc struct {
+	defaultHook func() database.SearchContextsStore
+	hooks       []func() database.SearchContextsStore
+	history     []EnterpriseDBSearchContextsFuncCall
 	mutex       sync.Mutex
 }
 
-// RedisKeyValue delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) RedisKeyValue() database.RedisKeyValueStore {
-	r0 := m.RedisKeyValueFunc.nextHook()()
-	m.RedisKeyValueFunc.appendCall(EnterpriseDBRedisKeyValueFuncCall{r0})
+// SearchContexts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) SearchContexts() database.SearchContextsStore {
+	r0 := m.SearchContextsFunc.nextHook()()
+	m.SearchContextsFunc.appendCall(EnterpriseDBSearchContextsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RedisKeyValue method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBRedisKeyValueFunc) SetDefaultHook(hook func() database.RedisKeyValueStore) {
+// SetDefaultHook sets function that is called when the SearchContexts
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBSearchContextsFunc) SetDefaultHook(hook func() database.SearchContextsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RedisKeyValue method of the parent MockEnterpriseDB instance invokes the
+// SearchContexts method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBRedisKeyValueFunc) PushHook(hook func() database.RedisKeyValueStore) {
+func (f *EnterpriseDBSearchContextsFunc) PushHook(hook func() database.SearchContextsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12017,20 +14761,20 @@ func (f *EnterpriseDBRedisKeyValueFunc) PushHook(hook func() database.RedisKeyVa
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBRedisKeyValueFunc) SetDefaultReturn(r0 database.RedisKeyValueStore) {
-	f.SetDefaultHook(func() database.RedisKeyValueStore {
+func (f *EnterpriseDBSearchContextsFunc) SetDefaultReturn(r0 database.SearchContextsStore) {
+	f.SetDefaultHook(func() database.SearchContextsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBRedisKeyValueFunc) PushReturn(r0 database.RedisKeyValueStore) {
-	f.PushHook(func() database.RedisKeyValueStore {
+func (f *EnterpriseDBSearchContextsFunc) PushReturn(r0 database.SearchContextsStore) {
+	f.PushHook(func() database.SearchContextsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBRedisKeyValueFunc) nextHook() func() database.RedisKeyValueStore {
+func (f *EnterpriseDBSearchContextsFunc) nextHook() func() database.SearchContextsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12043,72 +14787,73 @@ func (f *EnterpriseDBRedisKeyValueFunc) nextHook() func() database.RedisKeyValue
 	return hook
 }
 
-func (f *EnterpriseDBRedisKeyValueFunc) appendCall(r0 EnterpriseDBRedisKeyValueFuncCall) {
+func (f *EnterpriseDBSearchContextsFunc) appendCall(r0 EnterpriseDBSearchContextsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBRedisKeyValueFuncCall objects
+// History returns a sequence of EnterpriseDBSearchContextsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBRedisKeyValueFunc) History() []EnterpriseDBRedisKeyValueFuncCall {
+func (f *EnterpriseDBSearchContextsFunc) History() []EnterpriseDBSearchContextsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBRedisKeyValueFuncCall, len(f.history))
+	history := make([]EnterpriseDBSearchContextsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBRedisKeyValueFuncCall is an object that describes an
-// invocation of method RedisKeyValue on an instance of MockEnterpriseDB.
-type EnterpriseDBRedisKeyValueFuncCall struct {
+// EnterpriseDBSearchContextsFuncCall is an object that describes an
+// invocation of method SearchContexts on an instance of MockEnterpriseDB.
+type EnterpriseDBSearchContextsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RedisKeyValueStore
+	Result0 database.SearchContextsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBRedisKeyValueFuncCall) Args() []interface{} {
+func (c EnterpriseDBSearchContextsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBRedisKeyValueFuncCall) Results() []interface{} {
+func (c EnterpriseDBSearchContextsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBRepoKVPsFunc describes the behavior when the RepoKVPs method
-// of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBRepoKVPsFunc struct {
-	defaultHook func() database.RepoKVPStore
-	hooks       []func() database.RepoKVPStore
-	history     []EnterpriseDBRepoKVPsFuncCall
+// EnterpriseDBSecurityEventLogsFunc describes the behavior when the
+// SecurityEventLogs method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBSecurityEventLogsFunc struct {
+	defaultHook func() database.SecurityEventLogsStore
+	hooks       []func() database.SecurityEventLogsStore
+	history     []EnterpriseDBSecurityEventLogsFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoKVPs delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) RepoKVPs() database.RepoKVPStore {
-	r0 := m.RepoKVPsFunc.nextHook()()
-	m.RepoKVPsFunc.appendCall(EnterpriseDBRepoKVPsFuncCall{r0})
+// SecurityEventLogs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) SecurityEventLogs() database.SecurityEventLogsStore {
+	r0 := m.SecurityEventLogsFunc.nextHook()()
+	m.SecurityEventLogsFunc.appendCall(EnterpriseDBSecurityEventLogsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoKVPs method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBRepoKVPsFunc) SetDefaultHook(hook func() database.RepoKVPStore) {
+// SetDefaultHook sets function that is called when the SecurityEventLogs
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBSecurityEventLogsFunc) SetDefaultHook(hook func() database.SecurityEventLogsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoKVPs method of the parent MockEnterpriseDB instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBRepoKVPsFunc) PushHook(hook func() database.RepoKVPStore) {
+// SecurityEventLogs method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBSecurityEventLogsFunc) PushHook(hook func() database.SecurityEventLogsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12116,20 +14861,20 @@ func (f *EnterpriseDBRepoKVPsFunc) PushHook(hook func() database.RepoKVPStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBRepoKVPsFunc) SetDefaultReturn(r0 database.RepoKVPStore) {
-	f.SetDefaultHook(func() database.RepoKVPStore {
+func (f *EnterpriseDBSecurityEventLogsFunc) SetDefaultReturn(r0 database.SecurityEventLogsStore) {
+	f.SetDefaultHook(func() database.SecurityEventLogsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBRepoKVPsFunc) PushReturn(r0 database.RepoKVPStore) {
-	f.PushHook(func() database.RepoKVPStore {
+func (f *EnterpriseDBSecurityEventLogsFunc) PushReturn(r0 database.SecurityEventLogsStore) {
+	f.PushHook(func() database.SecurityEventLogsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBRepoKVPsFunc) nextHook() func() database.RepoKVPStore {
+func (f *EnterpriseDBSecurityEventLogsFunc) nextHook() func() database.SecurityEventLogsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12142,72 +14887,73 @@ func (f *EnterpriseDBRepoKVPsFunc) nextHook() func() database.RepoKVPStore {
 	return hook
 }
 
-func (f *EnterpriseDBRepoKVPsFunc) appendCall(r0 EnterpriseDBRepoKVPsFuncCall) {
+func (f *EnterpriseDBSecurityEventLogsFunc) appendCall(r0 EnterpriseDBSecurityEventLogsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBRepoKVPsFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBRepoKVPsFunc) History() []EnterpriseDBRepoKVPsFuncCall {
+// History returns a sequence of EnterpriseDBSecurityEventLogsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBSecurityEventLogsFunc) History() []EnterpriseDBSecurityEventLogsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBRepoKVPsFuncCall, len(f.history))
+	history := make([]EnterpriseDBSecurityEventLogsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBRepoKVPsFuncCall is an object that describes an invocation of
-// method RepoKVPs on an instance of MockEnterpriseDB.
-type EnterpriseDBRepoKVPsFuncCall struct {
+// EnterpriseDBSecurityEventLogsFuncCall is an object that describes an
+// invocation of method SecurityEventLogs on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBSecurityEventLogsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoKVPStore
+	Result0 database.SecurityEventLogsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBRepoKVPsFuncCall) Args() []interface{} {
+func (c EnterpriseDBSecurityEventLogsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBRepoKVPsFuncCall) Results() []interface{} {
+func (c EnterpriseDBSecurityEventLogsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBRepoStatisticsFunc describes the behavior when the
-// RepoStatistics method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBRepoStatisticsFunc struct {
-	defaultHook func() database.RepoStatisticsStore
-	hooks       []func() database.RepoStatisticsStore
-	history     []EnterpriseDBRepoStatisticsFuncCall
+// EnterpriseDBSettingsFunc describes the behavior when the Settings method
+// of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBSettingsFunc struct {
+	defaultHook func() database.SettingsStore
+	hooks       []func() database.SettingsStore
+	history     []EnterpriseDBSettingsFuncCall
 	mutex       sync.Mutex
 }
 
-// RepoStatistics delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) RepoStatistics() database.RepoStatisticsStore {
-	r0 := m.RepoStatisticsFunc.nextHook()()
-	m.RepoStatisticsFunc.appendCall(EnterpriseDBRepoStatisticsFuncCall{r0})
+// Settings delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Settings() database.SettingsStore {
+	r0 := m.SettingsFunc.nextHook()()
+	m.SettingsFunc.appendCall(EnterpriseDBSettingsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RepoStatistics
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBRepoStatisticsFunc) SetDefaultHook(hook func() database.RepoStatisticsStore) {
+// SetDefaultHook sets function that is called when the Settings method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBSettingsFunc) SetDefaultHook(hook func() database.SettingsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RepoStatistics method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBRepoStatisticsFunc) PushHook(hook func() database.RepoStatisticsStore) {
+// Settings method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBSettingsFunc) PushHook(hook func() database.SettingsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12215,20 +14961,20 @@ func (f *EnterpriseDBRepoStatisticsFunc) PushHook(hook func() database.RepoStati
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBRepoStatisticsFunc) SetDefaultReturn(r0 database.RepoStatisticsStore) {
-	f.SetDefaultHook(func() database.RepoStatisticsStore {
+func (f *EnterpriseDBSettingsFunc) SetDefaultReturn(r0 database.SettingsStore) {
+	f.SetDefaultHook(func() database.SettingsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBRepoStatisticsFunc) PushReturn(r0 database.RepoStatisticsStore) {
-	f.PushHook(func() database.RepoStatisticsStore {
+func (f *EnterpriseDBSettingsFunc) PushReturn(r0 database.SettingsStore) {
+	f.PushHook(func() database.SettingsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBRepoStatisticsFunc) nextHook() func() database.RepoStatisticsStore {
+func (f *EnterpriseDBSettingsFunc) nextHook() func() database.SettingsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12241,71 +14987,72 @@ func (f *EnterpriseDBRepoStatisticsFunc) nextHook() func() database.RepoStatisti
 	return hook
 }
 
-func (f *EnterpriseDBRepoStatisticsFunc) appendCall(r0 EnterpriseDBRepoStatisticsFuncCall) {
+func (f *EnterpriseDBSettingsFunc) appendCall(r0 EnterpriseDBSettingsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBRepoStatisticsFuncCall objects
+// History returns a sequence of EnterpriseDBSettingsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBRepoStatisticsFunc) History() []EnterpriseDBRepoStatisticsFuncCall {
+func (f *EnterpriseDBSettingsFunc) History() []EnterpriseDBSettingsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBRepoStatisticsFuncCall, len(f.history))
+	history := make([]EnterpriseDBSettingsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBRepoStatisticsFuncCall is an object that describes an
-// invocation of method RepoStatistics on an instance of MockEnterpriseDB.
-type EnterpriseDBRepoStatisticsFuncCall struct {
+// EnterpriseDBSettingsFuncCall is an object that describes an invocation of
+// method Settings on an instance of MockEnterpriseDB.
+type EnterpriseDBSettingsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStatisticsStore
+	Result0 database.SettingsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBRepoStatisticsFuncCall) Args() []interface{} {
+func (c EnterpriseDBSettingsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBRepoStatisticsFuncCall) Results() []interface{} {
+func (c EnterpriseDBSettingsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBReposFunc describes the behavior when the Repos method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBReposFunc struct {
-	defaultHook func() database.RepoStore
-	hooks       []func() database.RepoStore
-	history     []EnterpriseDBReposFuncCall
+// EnterpriseDBSubRepoPermsFunc describes the behavior when the SubRepoPerms
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBSubRepoPermsFunc struct {
+	defaultHook func() SubRepoPermsStore
+	hooks       []func() SubRepoPermsStore
+	history     []EnterpriseDBSubRepoPermsFuncCall
 	mutex       sync.Mutex
 }
 
-// Repos delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Repos() database.RepoStore {
-	r0 := m.ReposFunc.nextHook()()
-	m.ReposFunc.appendCall(EnterpriseDBReposFuncCall{r0})
+// SubRepoPerms delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) SubRepoPerms() SubRepoPermsStore {
+	r0 := m.SubRepoPermsFunc.nextHook()()
+	m.SubRepoPermsFunc.appendCall(EnterpriseDBSubRepoPermsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Repos method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBReposFunc) SetDefaultHook(hook func() database.RepoStore) {
+// SetDefaultHook sets function that is called when the SubRepoPerms method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBSubRepoPermsFunc) SetDefaultHook(hook func() SubRepoPermsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Repos method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBReposFunc) PushHook(hook func() database.RepoStore) {
+// SubRepoPerms method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBSubRepoPermsFunc) PushHook(hook func() SubRepoPermsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12313,20 +15060,20 @@ func (f *EnterpriseDBReposFunc) PushHook(hook func() database.RepoStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBReposFunc) SetDefaultReturn(r0 database.RepoStore) {
-	f.SetDefaultHook(func() database.RepoStore {
+func (f *EnterpriseDBSubRepoPermsFunc) SetDefaultReturn(r0 SubRepoPermsStore) {
+	f.SetDefaultHook(func() SubRepoPermsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBReposFunc) PushReturn(r0 database.RepoStore) {
-	f.PushHook(func() database.RepoStore {
+func (f *EnterpriseDBSubRepoPermsFunc) PushReturn(r0 SubRepoPermsStore) {
+	f.PushHook(func() SubRepoPermsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBReposFunc) nextHook() func() database.RepoStore {
+func (f *EnterpriseDBSubRepoPermsFunc) nextHook() func() SubRepoPermsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12339,73 +15086,71 @@ func (f *EnterpriseDBReposFunc) nextHook() func() database.RepoStore {
 	return hook
 }
 
-func (f *EnterpriseDBReposFunc) appendCall(r0 EnterpriseDBReposFuncCall) {
+func (f *EnterpriseDBSubRepoPermsFunc) appendCall(r0 EnterpriseDBSubRepoPermsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBReposFuncCall objects
+// History returns a sequence of EnterpriseDBSubRepoPermsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBReposFunc) History() []EnterpriseDBReposFuncCall {
+func (f *EnterpriseDBSubRepoPermsFunc) History() []EnterpriseDBSubRepoPermsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBReposFuncCall, len(f.history))
+	history := make([]EnterpriseDBSubRepoPermsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBReposFuncCall is an object that describes an invocation of
-// method Repos on an instance of MockEnterpriseDB.
-type EnterpriseDBReposFuncCall struct {
+// EnterpriseDBSubRepoPermsFuncCall is an object that describes an
+// invocation of method SubRepoPerms on an instance of MockEnterpriseDB.
+type EnterpriseDBSubRepoPermsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RepoStore
+	Result0 SubRepoPermsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBReposFuncCall) Args() []interface{} {
+func (c EnterpriseDBSubRepoPermsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBReposFuncCall) Results() []interface{} {
+func (c EnterpriseDBSubRepoPermsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBRolePermissionsFunc describes the behavior when the
-// RolePermissions method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBRolePermissionsFunc struct {
-	defaultHook func() database.RolePermissionStore
-	hooks       []func() database.RolePermissionStore
-	history     []EnterpriseDBRolePermissionsFuncCall
+// EnterpriseDBTeamsFunc describes the behavior when the Teams method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBTeamsFunc struct {
+	defaultHook func() database.TeamStore
+	hooks       []func() database.TeamStore
+	history     []EnterpriseDBTeamsFuncCall
 	mutex       sync.Mutex
 }
 
-// RolePermissions delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) RolePermissions() database.RolePermissionStore {
-	r0 := m.RolePermissionsFunc.nextHook()()
-	m.RolePermissionsFunc.appendCall(EnterpriseDBRolePermissionsFuncCall{r0})
+// Teams delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Teams() database.TeamStore {
+	r0 := m.TeamsFunc.nextHook()()
+	m.TeamsFunc.appendCall(EnterpriseDBTeamsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the RolePermissions
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBRolePermissionsFunc) SetDefaultHook(hook func() database.RolePermissionStore) {
+// SetDefaultHook sets function that is called when the Teams method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBTeamsFunc) SetDefaultHook(hook func() database.TeamStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// RolePermissions method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBRolePermissionsFunc) PushHook(hook func() database.RolePermissionStore) {
+// Teams method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBTeamsFunc) PushHook(hook func() database.TeamStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12413,20 +15158,20 @@ func (f *EnterpriseDBRolePermissionsFunc) PushHook(hook func() database.RolePerm
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBRolePermissionsFunc) SetDefaultReturn(r0 database.RolePermissionStore) {
-	f.SetDefaultHook(func() database.RolePermissionStore {
+func (f *EnterpriseDBTeamsFunc) SetDefaultReturn(r0 database.TeamStore) {
+	f.SetDefaultHook(func() database.TeamStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBRolePermissionsFunc) PushReturn(r0 database.RolePermissionStore) {
-	f.PushHook(func() database.RolePermissionStore {
+func (f *EnterpriseDBTeamsFunc) PushReturn(r0 database.TeamStore) {
+	f.PushHook(func() database.TeamStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBRolePermissionsFunc) nextHook() func() database.RolePermissionStore {
+func (f *EnterpriseDBTeamsFunc) nextHook() func() database.TeamStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12439,71 +15184,73 @@ func (f *EnterpriseDBRolePermissionsFunc) nextHook() func() database.RolePermiss
 	return hook
 }
 
-func (f *EnterpriseDBRolePermissionsFunc) appendCall(r0 EnterpriseDBRolePermissionsFuncCall) {
+func (f *EnterpriseDBTeamsFunc) appendCall(r0 EnterpriseDBTeamsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBRolePermissionsFuncCall objects
+// History returns a sequence of EnterpriseDBTeamsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBRolePermissionsFunc) History() []EnterpriseDBRolePermissionsFuncCall {
+func (f *EnterpriseDBTeamsFunc) History() []EnterpriseDBTeamsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBRolePermissionsFuncCall, len(f.history))
+	history := make([]EnterpriseDBTeamsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBRolePermissionsFuncCall is an object that describes an
-// invocation of method RolePermissions on an instance of MockEnterpriseDB.
-type EnterpriseDBRolePermissionsFuncCall struct {
+// EnterpriseDBTeamsFuncCall is an object that describes an invocation of
+// method Teams on an instance of MockEnterpriseDB.
+type EnterpriseDBTeamsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RolePermissionStore
+	Result0 database.TeamStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBRolePermissionsFuncCall) Args() []interface{} {
+func (c EnterpriseDBTeamsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBRolePermissionsFuncCall) Results() []interface{} {
+func (c EnterpriseDBTeamsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBRolesFunc describes the behavior when the Roles method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBRolesFunc struct {
-	defaultHook func() database.RoleStore
-	hooks       []func() database.RoleStore
-	history     []EnterpriseDBRolesFuncCall
+// EnterpriseDBTemporarySettingsFunc describes the behavior when the
+// TemporarySettings method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBTemporarySettingsFunc struct {
+	defaultHook func() database.TemporarySettingsStore
+	hooks       []func() database.TemporarySettingsStore
+	history     []EnterpriseDBTemporarySettingsFuncCall
 	mutex       sync.Mutex
 }
 
-// Roles delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Roles() database.RoleStore {
-	r0 := m.RolesFunc.nextHook()()
-	m.RolesFunc.appendCall(EnterpriseDBRolesFuncCall{r0})
+// TemporarySettings delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) TemporarySettings() database.TemporarySettingsStore {
+	r0 := m.TemporarySettingsFunc.nextHook()()
+	m.TemporarySettingsFunc.appendCall(EnterpriseDBTemporarySettingsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Roles method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBRolesFunc) SetDefaultHook(hook func() database.RoleStore) {
+// SetDefaultHook sets function that is called when the TemporarySettings
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBTemporarySettingsFunc) SetDefaultHook(hook func() database.TemporarySettingsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Roles method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBRolesFunc) PushHook(hook func() database.RoleStore) {
+// TemporarySettings method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBTemporarySettingsFunc) PushHook(hook func() database.TemporarySettingsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12511,20 +15258,20 @@ func (f *EnterpriseDBRolesFunc) PushHook(hook func() database.RoleStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBRolesFunc) SetDefaultReturn(r0 database.RoleStore) {
-	f.SetDefaultHook(func() database.RoleStore {
+func (f *EnterpriseDBTemporarySettingsFunc) SetDefaultReturn(r0 database.TemporarySettingsStore) {
+	f.SetDefaultHook(func() database.TemporarySettingsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBRolesFunc) PushReturn(r0 database.RoleStore) {
-	f.PushHook(func() database.RoleStore {
+func (f *EnterpriseDBTemporarySettingsFunc) PushReturn(r0 database.TemporarySettingsStore) {
+	f.PushHook(func() database.TemporarySettingsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBRolesFunc) nextHook() func() database.RoleStore {
+func (f *EnterpriseDBTemporarySettingsFunc) nextHook() func() database.TemporarySettingsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12537,72 +15284,74 @@ func (f *EnterpriseDBRolesFunc) nextHook() func() database.RoleStore {
 	return hook
 }
 
-func (f *EnterpriseDBRolesFunc) appendCall(r0 EnterpriseDBRolesFuncCall) {
+func (f *EnterpriseDBTemporarySettingsFunc) appendCall(r0 EnterpriseDBTemporarySettingsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBRolesFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBRolesFunc) History() []EnterpriseDBRolesFuncCall {
+// History returns a sequence of EnterpriseDBTemporarySettingsFuncCall
+// objects describing the invocations of this function.
+func (f *EnterpriseDBTemporarySettingsFunc) History() []EnterpriseDBTemporarySettingsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBRolesFuncCall, len(f.history))
+	history := make([]EnterpriseDBTemporarySettingsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBRolesFuncCall is an object that describes an invocation of
-// method Roles on an instance of MockEnterpriseDB.
-type EnterpriseDBRolesFuncCall struct {
+// EnterpriseDBTemporarySettingsFuncCall is an object that describes an
+// invocation of method TemporarySettings on an instance of
+// MockEnterpriseDB.
+type EnterpriseDBTemporarySettingsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.RoleStore
+	Result0 database.TemporarySettingsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBRolesFuncCall) Args() []interface{} {
+func (c EnterpriseDBTemporarySettingsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBRolesFuncCall) Results() []interface{} {
+func (c EnterpriseDBTemporarySettingsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBSavedSearchesFunc describes the behavior when the
-// SavedSearches method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBSavedSearchesFunc struct {
-	defaultHook func() database.SavedSearchStore
-	hooks       []func() database.SavedSearchStore
-	history     []EnterpriseDBSavedSearchesFuncCall
+// EnterpriseDBUserCredentialsFunc describes the behavior when the
+// UserCredentials method of the parent MockEnterpriseDB instance is
+// invoked.
+type EnterpriseDBUserCredentialsFunc struct {
+	defaultHook func(encryption.Key) database.UserCredentialsStore
+	hooks       []func(encryption.Key) database.UserCredentialsStore
+	history     []EnterpriseDBUserCredentialsFuncCall
 	mutex       sync.Mutex
 }
 
-// SavedSearches delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) SavedSearches() database.SavedSearchStore {
-	r0 := m.SavedSearchesFunc.nextHook()()
-	m.SavedSearchesFunc.appendCall(EnterpriseDBSavedSearchesFuncCall{r0})
+// UserCredentials delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) UserCredentials(v0 encryption.Key) database.UserCredentialsStore {
+	r0 := m.UserCredentialsFunc.nextHook()(v0)
+	m.UserCredentialsFunc.appendCall(EnterpriseDBUserCredentialsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the SavedSearches method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBSavedSearchesFunc) SetDefaultHook(hook func() database.SavedSearchStore) {
+// SetDefaultHook sets function that is called when the UserCredentials
+// method of the parent MockEnterpriseDB instance is invoked and the hook
+// queue is empty.
+func (f *EnterpriseDBUserCredentialsFunc) SetDefaultHook(hook func(encryption.Key) database.UserCredentialsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SavedSearches method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBSavedSearchesFunc) PushHook(hook func() database.SavedSearchStore) {
+// UserCredentials method of the parent MockEnterpriseDB instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *EnterpriseDBUserCredentialsFunc) PushHook(hook func(encryption.Key) database.UserCredentialsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12610,20 +15359,20 @@ func (f *EnterpriseDBSavedSearchesFunc) PushHook(hook func() database.SavedSearc
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBSavedSearchesFunc) SetDefaultReturn(r0 database.SavedSearchStore) {
-	f.SetDefaultHook(func() database.SavedSearchStore {
+func (f *EnterpriseDBUserCredentialsFunc) SetDefaultReturn(r0 database.UserCredentialsStore) {
+	f.SetDefaultHook(func(encryption.Key) database.UserCredentialsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBSavedSearchesFunc) PushReturn(r0 database.SavedSearchStore) {
-	f.PushHook(func() database.SavedSearchStore {
+func (f *EnterpriseDBUserCredentialsFunc) PushReturn(r0 database.UserCredentialsStore) {
+	f.PushHook(func(encryption.Key) database.UserCredentialsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBSavedSearchesFunc) nextHook() func() database.SavedSearchStore {
+func (f *EnterpriseDBUserCredentialsFunc) nextHook() func(encryption.Key) database.UserCredentialsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12636,72 +15385,75 @@ func (f *EnterpriseDBSavedSearchesFunc) nextHook() func() database.SavedSearchSt
 	return hook
 }
 
-func (f *EnterpriseDBSavedSearchesFunc) appendCall(r0 EnterpriseDBSavedSearchesFuncCall) {
+func (f *EnterpriseDBUserCredentialsFunc) appendCall(r0 EnterpriseDBUserCredentialsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBSavedSearchesFuncCall objects
+// History returns a sequence of EnterpriseDBUserCredentialsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBSavedSearchesFunc) History() []EnterpriseDBSavedSearchesFuncCall {
+func (f *EnterpriseDBUserCredentialsFunc) History() []EnterpriseDBUserCredentialsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBSavedSearchesFuncCall, len(f.history))
+	history := make([]EnterpriseDBUserCredentialsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBSavedSearchesFuncCall is an object that describes an
-// invocation of method SavedSearches on an instance of MockEnterpriseDB.
-type EnterpriseDBSavedSearchesFuncCall struct {
+// EnterpriseDBUserCredentialsFuncCall is an object that describes an
+// invocation of method UserCredentials on an instance of MockEnterpriseDB.
+type EnterpriseDBUserCredentialsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SavedSearchStore
+	Result0 database.UserCredentialsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBSavedSearchesFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBUserCredentialsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBSavedSearchesFuncCall) Results() []interface{} {
+func (c EnterpriseDBUserCredentialsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBSearchContextsFunc describes the behavior when the
-// SearchContexts method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBSearchContextsFunc struct {
-	defaultHook func() database.SearchContextsStore
-	hooks       []func() database.SearchContextsStore
-	history     []EnterpriseDBSearchContextsFuncCall
+// EnterpriseDBUserEmailsFunc describes the behavior when the UserEmails
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBUserEmailsFunc struct {
+	defaultHook func() database.UserEmailsStore
+	hooks       []func() database.UserEmailsStore
+	history     []EnterpriseDBUserEmailsFuncCall
 	mutex       sync.Mutex
 }
 
-// SearchContexts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) SearchContexts() database.SearchContextsStore {
-	r0 := m.SearchContextsFunc.nextHook()()
-	m.SearchContextsFunc.appendCall(EnterpriseDBSearchContextsFuncCall{r0})
+// UserEmails delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) UserEmails() database.UserEmailsStore {
+	r0 := m.UserEmailsFunc.nextHook()()
+	m.UserEmailsFunc.appendCall(EnterpriseDBUserEmailsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the SearchContexts
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBSearchContextsFunc) SetDefaultHook(hook func() database.SearchContextsStore) {
+// SetDefaultHook sets function that is called when the UserEmails method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBUserEmailsFunc) SetDefaultHook(hook func() database.UserEmailsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SearchContexts method of the parent MockEnterpriseDB instance invokes the
+// UserEmails method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBSearchContextsFunc) PushHook(hook func() database.SearchContextsStore) {
+func (f *EnterpriseDBUserEmailsFunc) PushHook(hook func() database.UserEmailsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12709,20 +15461,20 @@ func (f *EnterpriseDBSearchContextsFunc) PushHook(hook func() database.SearchCon
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBSearchContextsFunc) SetDefaultReturn(r0 database.SearchContextsStore) {
-	f.SetDefaultHook(func() database.SearchContextsStore {
+func (f *EnterpriseDBUserEmailsFunc) SetDefaultReturn(r0 database.UserEmailsStore) {
+	f.SetDefaultHook(func() database.UserEmailsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBSearchContextsFunc) PushReturn(r0 database.SearchContextsStore) {
-	f.PushHook(func() database.SearchContextsStore {
+func (f *EnterpriseDBUserEmailsFunc) PushReturn(r0 database.UserEmailsStore) {
+	f.PushHook(func() database.UserEmailsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBSearchContextsFunc) nextHook() func() database.SearchContextsStore {
+func (f *EnterpriseDBUserEmailsFunc) nextHook() func() database.UserEmailsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12735,73 +15487,74 @@ func (f *EnterpriseDBSearchContextsFunc) nextHook() func() database.SearchContex
 	return hook
 }
 
-func (f *EnterpriseDBSearchContextsFunc) appendCall(r0 EnterpriseDBSearchContextsFuncCall) {
+func (f *EnterpriseDBUserEmailsFunc) appendCall(r0 EnterpriseDBUserEmailsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBSearchContextsFuncCall objects
+// History returns a sequence of EnterpriseDBUserEmailsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBSearchContextsFunc) History() []EnterpriseDBSearchContextsFuncCall {
+func (f *EnterpriseDBUserEmailsFunc) History() []EnterpriseDBUserEmailsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBSearchContextsFuncCall, len(f.history))
+	history := make([]EnterpriseDBUserEmailsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBSearchContextsFuncCall is an object that describes an
-// invocation of method SearchContexts on an instance of MockEnterpriseDB.
-type EnterpriseDBSearchContextsFuncCall struct {
+// EnterpriseDBUserEmailsFuncCall is an object that describes an invocation
+// of method UserEmails on an instance of MockEnterpriseDB.
+type EnterpriseDBUserEmailsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SearchContextsStore
+	Result0 database.UserEmailsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBSearchContextsFuncCall) Args() []interface{} {
+func (c EnterpriseDBUserEmailsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBSearchContextsFuncCall) Results() []interface{} {
+func (c EnterpriseDBUserEmailsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBSecurityEventLogsFunc describes the behavior when the
-// SecurityEventLogs method of the parent MockEnterpriseDB instance is
+// EnterpriseDBUserExternalAccountsFunc describes the behavior when the
+// UserExternalAccounts method of the parent MockEnterpriseDB instance is
 // invoked.
-type EnterpriseDBSecurityEventLogsFunc struct {
-	defaultHook func() database.SecurityEventLogsStore
-	hooks       []func() database.SecurityEventLogsStore
-	history     []EnterpriseDBSecurityEventLogsFuncCall
+type EnterpriseDBUserExternalAccountsFunc struct {
+	defaultHook func() database.UserExternalAccountsStore
+	hooks       []func() database.UserExternalAccountsStore
+	history     []EnterpriseDBUserExternalAccountsFuncCall
 	mutex       sync.Mutex
 }
 
-// SecurityEventLogs delegates to the next hook function in the queue and
+// UserExternalAccounts delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) SecurityEventLogs() database.SecurityEventLogsStore {
-	r0 := m.SecurityEventLogsFunc.nextHook()()
-	m.SecurityEventLogsFunc.appendCall(EnterpriseDBSecurityEventLogsFuncCall{r0})
+func (m *MockEnterpriseDB) UserExternalAccounts() database.UserExternalAccountsStore {
+	r0 := m.UserExternalAccountsFunc.nextHook()()
+	m.UserExternalAccountsFunc.appendCall(EnterpriseDBUserExternalAccountsFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the SecurityEventLogs
+// SetDefaultHook sets function that is called when the UserExternalAccounts
 // method of the parent MockEnterpriseDB instance is invoked and the hook
 // queue is empty.
-func (f *EnterpriseDBSecurityEventLogsFunc) SetDefaultHook(hook func() database.SecurityEventLogsStore) {
+func (f *EnterpriseDBUserExternalAccountsFunc) SetDefaultHook(hook func() database.UserExternalAccountsStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SecurityEventLogs method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBSecurityEventLogsFunc) PushHook(hook func() database.SecurityEventLogsStore) {
+// UserExternalAccounts method of the parent MockEnterpriseDB instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *EnterpriseDBUserExternalAccountsFunc) PushHook(hook func() database.UserExternalAccountsStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12809,20 +15562,20 @@ func (f *EnterpriseDBSecurityEventLogsFunc) PushHook(hook func() database.Securi
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBSecurityEventLogsFunc) SetDefaultReturn(r0 database.SecurityEventLogsStore) {
-	f.SetDefaultHook(func() database.SecurityEventLogsStore {
+func (f *EnterpriseDBUserExternalAccountsFunc) SetDefaultReturn(r0 database.UserExternalAccountsStore) {
+	f.SetDefaultHook(func() database.UserExternalAccountsStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBSecurityEventLogsFunc) PushReturn(r0 database.SecurityEventLogsStore) {
-	f.PushHook(func() database.SecurityEventLogsStore {
+func (f *EnterpriseDBUserExternalAccountsFunc) PushReturn(r0 database.UserExternalAccountsStore) {
+	f.PushHook(func() database.UserExternalAccountsStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBSecurityEventLogsFunc) nextHook() func() database.SecurityEventLogsStore {
+func (f *EnterpriseDBUserExternalAccountsFunc) nextHook() func() database.UserExternalAccountsStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12835,73 +15588,73 @@ func (f *EnterpriseDBSecurityEventLogsFunc) nextHook() func() database.SecurityE
 	return hook
 }
 
-func (f *EnterpriseDBSecurityEventLogsFunc) appendCall(r0 EnterpriseDBSecurityEventLogsFuncCall) {
+func (f *EnterpriseDBUserExternalAccountsFunc) appendCall(r0 EnterpriseDBUserExternalAccountsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBSecurityEventLogsFuncCall
+// History returns a sequence of EnterpriseDBUserExternalAccountsFuncCall
 // objects describing the invocations of this function.
-func (f *EnterpriseDBSecurityEventLogsFunc) History() []EnterpriseDBSecurityEventLogsFuncCall {
+func (f *EnterpriseDBUserExternalAccountsFunc) History() []EnterpriseDBUserExternalAccountsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBSecurityEventLogsFuncCall, len(f.history))
+	history := make([]EnterpriseDBUserExternalAccountsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBSecurityEventLogsFuncCall is an object that describes an
-// invocation of method SecurityEventLogs on an instance of
+// EnterpriseDBUserExternalAccountsFuncCall is an object that describes an
+// invocation of method UserExternalAccounts on an instance of
 // MockEnterpriseDB.
-type EnterpriseDBSecurityEventLogsFuncCall struct {
+type EnterpriseDBUserExternalAccountsFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SecurityEventLogsStore
+	Result0 database.UserExternalAccountsStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBSecurityEventLogsFuncCall) Args() []interface{} {
+func (c EnterpriseDBUserExternalAccountsFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBSecurityEventLogsFuncCall) Results() []interface{} {
+func (c EnterpriseDBUserExternalAccountsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBSettingsFunc describes the behavior when the Settings method
-// of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBSettingsFunc struct {
-	defaultHook func() database.SettingsStore
-	hooks       []func() database.SettingsStore
-	history     []EnterpriseDBSettingsFuncCall
+// EnterpriseDBUserRolesFunc describes the behavior when the UserRoles
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBUserRolesFunc struct {
+	defaultHook func() database.UserRoleStore
+	hooks       []func() database.UserRoleStore
+	history     []EnterpriseDBUserRolesFuncCall
 	mutex       sync.Mutex
 }
 
-// Settings delegates to the next hook function in the queue and stores the
+// UserRoles delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Settings() database.SettingsStore {
-	r0 := m.SettingsFunc.nextHook()()
-	m.SettingsFunc.appendCall(EnterpriseDBSettingsFuncCall{r0})
+func (m *MockEnterpriseDB) UserRoles() database.UserRoleStore {
+	r0 := m.UserRolesFunc.nextHook()()
+	m.UserRolesFunc.appendCall(EnterpriseDBUserRolesFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Settings method of
+// SetDefaultHook sets function that is called when the UserRoles method of
 // the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBSettingsFunc) SetDefaultHook(hook func() database.SettingsStore) {
+func (f *EnterpriseDBUserRolesFunc) SetDefaultHook(hook func() database.UserRoleStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Settings method of the parent MockEnterpriseDB instance invokes the hook
+// UserRoles method of the parent MockEnterpriseDB instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *EnterpriseDBSettingsFunc) PushHook(hook func() database.SettingsStore) {
+func (f *EnterpriseDBUserRolesFunc) PushHook(hook func() database.UserRoleStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -12909,20 +15662,20 @@ func (f *EnterpriseDBSettingsFunc) PushHook(hook func() database.SettingsStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBSettingsFunc) SetDefaultReturn(r0 database.SettingsStore) {
-	f.SetDefaultHook(func() database.SettingsStore {
+func (f *EnterpriseDBUserRolesFunc) SetDefaultReturn(r0 database.UserRoleStore) {
+	f.SetDefaultHook(func() database.UserRoleStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBSettingsFunc) PushReturn(r0 database.SettingsStore) {
-	f.PushHook(func() database.SettingsStore {
+func (f *EnterpriseDBUserRolesFunc) PushReturn(r0 database.UserRoleStore) {
+	f.PushHook(func() database.UserRoleStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBSettingsFunc) nextHook() func() database.SettingsStore {
+func (f *EnterpriseDBUserRolesFunc) nextHook() func() database.UserRoleStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -12935,72 +15688,71 @@ func (f *EnterpriseDBSettingsFunc) nextHook() func() database.SettingsStore {
 	return hook
 }
 
-func (f *EnterpriseDBSettingsFunc) appendCall(r0 EnterpriseDBSettingsFuncCall) {
+func (f *EnterpriseDBUserRolesFunc) appendCall(r0 EnterpriseDBUserRolesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBSettingsFuncCall objects
+// History returns a sequence of EnterpriseDBUserRolesFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBSettingsFunc) History() []EnterpriseDBSettingsFuncCall {
+func (f *EnterpriseDBUserRolesFunc) History() []EnterpriseDBUserRolesFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBSettingsFuncCall, len(f.history))
+	history := make([]EnterpriseDBUserRolesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBSettingsFuncCall is an object that describes an invocation of
-// method Settings on an instance of MockEnterpriseDB.
-type EnterpriseDBSettingsFuncCall struct {
+// EnterpriseDBUserRolesFuncCall is an object that describes an invocation
+// of method UserRoles on an instance of MockEnterpriseDB.
+type EnterpriseDBUserRolesFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.SettingsStore
+	Result0 database.UserRoleStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBSettingsFuncCall) Args() []interface{} {
+func (c EnterpriseDBUserRolesFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBSettingsFuncCall) Results() []interface{} {
+func (c EnterpriseDBUserRolesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBSubRepoPermsFunc describes the behavior when the SubRepoPerms
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBSubRepoPermsFunc struct {
-	defaultHook func() SubRepoPermsStore
-	hooks       []func() SubRepoPermsStore
-	history     []EnterpriseDBSubRepoPermsFuncCall
+// EnterpriseDBUsersFunc describes the behavior when the Users method of the
+// parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBUsersFunc struct {
+	defaultHook func() database.UserStore
+	hooks       []func() database.UserStore
+	history     []EnterpriseDBUsersFuncCall
 	mutex       sync.Mutex
 }
 
-// SubRepoPerms delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) SubRepoPerms() SubRepoPermsStore {
-	r0 := m.SubRepoPermsFunc.nextHook()()
-	m.SubRepoPermsFunc.appendCall(EnterpriseDBSubRepoPermsFuncCall{r0})
+// Users delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Users() database.UserStore {
+	r0 := m.UsersFunc.nextHook()()
+	m.UsersFunc.appendCall(EnterpriseDBUsersFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the SubRepoPerms method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBSubRepoPermsFunc) SetDefaultHook(hook func() SubRepoPermsStore) {
+// SetDefaultHook sets function that is called when the Users method of the
+// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
+func (f *EnterpriseDBUsersFunc) SetDefaultHook(hook func() database.UserStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SubRepoPerms method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBSubRepoPermsFunc) PushHook(hook func() SubRepoPermsStore) {
+// Users method of the parent MockEnterpriseDB instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBUsersFunc) PushHook(hook func() database.UserStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13008,20 +15760,20 @@ func (f *EnterpriseDBSubRepoPermsFunc) PushHook(hook func() SubRepoPermsStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBSubRepoPermsFunc) SetDefaultReturn(r0 SubRepoPermsStore) {
-	f.SetDefaultHook(func() SubRepoPermsStore {
+func (f *EnterpriseDBUsersFunc) SetDefaultReturn(r0 database.UserStore) {
+	f.SetDefaultHook(func() database.UserStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBSubRepoPermsFunc) PushReturn(r0 SubRepoPermsStore) {
-	f.PushHook(func() SubRepoPermsStore {
+func (f *EnterpriseDBUsersFunc) PushReturn(r0 database.UserStore) {
+	f.PushHook(func() database.UserStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBSubRepoPermsFunc) nextHook() func() SubRepoPermsStore {
+func (f *EnterpriseDBUsersFunc) nextHook() func() database.UserStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13034,71 +15786,72 @@ func (f *EnterpriseDBSubRepoPermsFunc) nextHook() func() SubRepoPermsStore {
 	return hook
 }
 
-func (f *EnterpriseDBSubRepoPermsFunc) appendCall(r0 EnterpriseDBSubRepoPermsFuncCall) {
+func (f *EnterpriseDBUsersFunc) appendCall(r0 EnterpriseDBUsersFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBSubRepoPermsFuncCall objects
+// History returns a sequence of EnterpriseDBUsersFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBSubRepoPermsFunc) History() []EnterpriseDBSubRepoPermsFuncCall {
+func (f *EnterpriseDBUsersFunc) History() []EnterpriseDBUsersFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBSubRepoPermsFuncCall, len(f.history))
+	history := make([]EnterpriseDBUsersFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBSubRepoPermsFuncCall is an object that describes an
-// invocation of method SubRepoPerms on an instance of MockEnterpriseDB.
-type EnterpriseDBSubRepoPermsFuncCall struct {
+// EnterpriseDBUsersFuncCall is an object that describes an invocation of
+// method Users on an instance of MockEnterpriseDB.
+type EnterpriseDBUsersFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 SubRepoPermsStore
+	Result0 database.UserStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBSubRepoPermsFuncCall) Args() []interface{} {
+func (c EnterpriseDBUsersFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBSubRepoPermsFuncCall) Results() []interface{} {
+func (c EnterpriseDBUsersFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBTeamsFunc describes the behavior when the Teams method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBTeamsFunc struct {
-	defaultHook func() database.TeamStore
-	hooks       []func() database.TeamStore
-	history     []EnterpriseDBTeamsFuncCall
+// EnterpriseDBWebhookLogsFunc describes the behavior when the WebhookLogs
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBWebhookLogsFunc struct {
+	defaultHook func(encryption.Key) database.WebhookLogStore
+	hooks       []func(encryption.Key) database.WebhookLogStore
+	history     []EnterpriseDBWebhookLogsFuncCall
 	mutex       sync.Mutex
 }
 
-// Teams delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Teams() database.TeamStore {
-	r0 := m.TeamsFunc.nextHook()()
-	m.TeamsFunc.appendCall(EnterpriseDBTeamsFuncCall{r0})
+// WebhookLogs delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) WebhookLogs(v0 encryption.Key) database.WebhookLogStore {
+	r0 := m.WebhookLogsFunc.nextHook()(v0)
+	m.WebhookLogsFunc.appendCall(EnterpriseDBWebhookLogsFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the Teams method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBTeamsFunc) SetDefaultHook(hook func() database.TeamStore) {
+// SetDefaultHook sets function that is called when the WebhookLogs method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBWebhookLogsFunc) SetDefaultHook(hook func(encryption.Key) database.WebhookLogStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Teams method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBTeamsFunc) PushHook(hook func() database.TeamStore) {
+// WebhookLogs method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBWebhookLogsFunc) PushHook(hook func(encryption.Key) database.WebhookLogStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13106,20 +15859,20 @@ func (f *EnterpriseDBTeamsFunc) PushHook(hook func() database.TeamStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBTeamsFunc) SetDefaultReturn(r0 database.TeamStore) {
-	f.SetDefaultHook(func() database.TeamStore {
+func (f *EnterpriseDBWebhookLogsFunc) SetDefaultReturn(r0 database.WebhookLogStore) {
+	f.SetDefaultHook(func(encryption.Key) database.WebhookLogStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBTeamsFunc) PushReturn(r0 database.TeamStore) {
-	f.PushHook(func() database.TeamStore {
+func (f *EnterpriseDBWebhookLogsFunc) PushReturn(r0 database.WebhookLogStore) {
+	f.PushHook(func(encryption.Key) database.WebhookLogStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBTeamsFunc) nextHook() func() database.TeamStore {
+func (f *EnterpriseDBWebhookLogsFunc) nextHook() func(encryption.Key) database.WebhookLogStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13132,73 +15885,75 @@ func (f *EnterpriseDBTeamsFunc) nextHook() func() database.TeamStore {
 	return hook
 }
 
-func (f *EnterpriseDBTeamsFunc) appendCall(r0 EnterpriseDBTeamsFuncCall) {
+func (f *EnterpriseDBWebhookLogsFunc) appendCall(r0 EnterpriseDBWebhookLogsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBTeamsFuncCall objects
+// History returns a sequence of EnterpriseDBWebhookLogsFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBTeamsFunc) History() []EnterpriseDBTeamsFuncCall {
+func (f *EnterpriseDBWebhookLogsFunc) History() []EnterpriseDBWebhookLogsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBTeamsFuncCall, len(f.history))
+	history := make([]EnterpriseDBWebhookLogsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBTeamsFuncCall is an object that describes an invocation of
-// method Teams on an instance of MockEnterpriseDB.
-type EnterpriseDBTeamsFuncCall struct {
+// EnterpriseDBWebhookLogsFuncCall is an object that describes an invocation
+// of method WebhookLogs on an instance of MockEnterpriseDB.
+type EnterpriseDBWebhookLogsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.TeamStore
+	Result0 database.WebhookLogStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBTeamsFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBWebhookLogsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBTeamsFuncCall) Results() []interface{} {
+func (c EnterpriseDBWebhookLogsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBTemporarySettingsFunc describes the behavior when the
-// TemporarySettings method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBTemporarySettingsFunc struct {
-	defaultHook func() database.TemporarySettingsStore
-	hooks       []func() database.TemporarySettingsStore
-	history     []EnterpriseDBTemporarySettingsFuncCall
+// EnterpriseDBWebhooksFunc describes the behavior when the Webhooks method
+// of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBWebhooksFunc struct {
+	defaultHook func(encryption.Key) database.WebhookStore
+	hooks       []func(encryption.Key) database.WebhookStore
+	history     []EnterpriseDBWebhooksFuncCall
 	mutex       sync.Mutex
 }
 
-// TemporarySettings delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) TemporarySettings() database.TemporarySettingsStore {
-	r0 := m.TemporarySettingsFunc.nextHook()()
-	m.TemporarySettingsFunc.appendCall(EnterpriseDBTemporarySettingsFuncCall{r0})
+// Webhooks delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockEnterpriseDB) Webhooks(v0 encryption.Key) database.WebhookStore {
+	r0 := m.WebhooksFunc.nextHook()(v0)
+	m.WebhooksFunc.appendCall(EnterpriseDBWebhooksFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the TemporarySettings
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBTemporarySettingsFunc) SetDefaultHook(hook func() database.TemporarySettingsStore) {
+// SetDefaultHook sets function that is called when the Webhooks method of
+// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBWebhooksFunc) SetDefaultHook(hook func(encryption.Key) database.WebhookStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// TemporarySettings method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBTemporarySettingsFunc) PushHook(hook func() database.TemporarySettingsStore) {
+// Webhooks method of the parent MockEnterpriseDB instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *EnterpriseDBWebhooksFunc) PushHook(hook func(encryption.Key) database.WebhookStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13206,20 +15961,20 @@ func (f *EnterpriseDBTemporarySettingsFunc) PushHook(hook func() database.Tempor
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBTemporarySettingsFunc) SetDefaultReturn(r0 database.TemporarySettingsStore) {
-	f.SetDefaultHook(func() database.TemporarySettingsStore {
+func (f *EnterpriseDBWebhooksFunc) SetDefaultReturn(r0 database.WebhookStore) {
+	f.SetDefaultHook(func(encryption.Key) database.WebhookStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBTemporarySettingsFunc) PushReturn(r0 database.TemporarySettingsStore) {
-	f.PushHook(func() database.TemporarySettingsStore {
+func (f *EnterpriseDBWebhooksFunc) PushReturn(r0 database.WebhookStore) {
+	f.PushHook(func(encryption.Key) database.WebhookStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBTemporarySettingsFunc) nextHook() func() database.TemporarySettingsStore {
+func (f *EnterpriseDBWebhooksFunc) nextHook() func(encryption.Key) database.WebhookStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13232,74 +15987,75 @@ func (f *EnterpriseDBTemporarySettingsFunc) nextHook() func() database.Temporary
 	return hook
 }
 
-func (f *EnterpriseDBTemporarySettingsFunc) appendCall(r0 EnterpriseDBTemporarySettingsFuncCall) {
+func (f *EnterpriseDBWebhooksFunc) appendCall(r0 EnterpriseDBWebhooksFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBTemporarySettingsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBTemporarySettingsFunc) History() []EnterpriseDBTemporarySettingsFuncCall {
+// History returns a sequence of EnterpriseDBWebhooksFuncCall objects
+// describing the invocations of this function.
+func (f *EnterpriseDBWebhooksFunc) History() []EnterpriseDBWebhooksFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBTemporarySettingsFuncCall, len(f.history))
+	history := make([]EnterpriseDBWebhooksFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBTemporarySettingsFuncCall is an object that describes an
-// invocation of method TemporarySettings on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBTemporarySettingsFuncCall struct {
+// EnterpriseDBWebhooksFuncCall is an object that describes an invocation of
+// method Webhooks on an instance of MockEnterpriseDB.
+type EnterpriseDBWebhooksFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 encryption.Key
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.TemporarySettingsStore
+	Result0 database.WebhookStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBTemporarySettingsFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c EnterpriseDBWebhooksFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBTemporarySettingsFuncCall) Results() []interface{} {
+func (c EnterpriseDBWebhooksFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBUserCredentialsFunc describes the behavior when the
-// UserCredentials method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBUserCredentialsFunc struct {
-	defaultHook func(encryption.Key) database.UserCredentialsStore
-	hooks       []func(encryption.Key) database.UserCredentialsStore
-	history     []EnterpriseDBUserCredentialsFuncCall
+// EnterpriseDBWithTransactFunc describes the behavior when the WithTransact
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBWithTransactFunc struct {
+	defaultHook func(context.Context, func(tx database.DB) error) error
+	hooks       []func(context.Context, func(tx database.DB) error) error
+	history     []EnterpriseDBWithTransactFuncCall
 	mutex       sync.Mutex
 }
 
-// UserCredentials delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) UserCredentials(v0 encryption.Key) database.UserCredentialsStore {
-	r0 := m.UserCredentialsFunc.nextHook()(v0)
-	m.UserCredentialsFunc.appendCall(EnterpriseDBUserCredentialsFuncCall{v0, r0})
+// WithTransact delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockEnterpriseDB) WithTransact(v0 context.Context, v1 func(tx database.DB) error) error {
+	r0 := m.WithTransactFunc.nextHook()(v0, v1)
+	m.WithTransactFunc.appendCall(EnterpriseDBWithTransactFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the UserCredentials
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBUserCredentialsFunc) SetDefaultHook(hook func(encryption.Key) database.UserCredentialsStore) {
+// SetDefaultHook sets function that is called when the WithTransact method
+// of the parent MockEnterpriseDB instance is invoked and the hook queue is
+// empty.
+func (f *EnterpriseDBWithTransactFunc) SetDefaultHook(hook func(context.Context, func(tx database.DB) error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UserCredentials method of the parent MockEnterpriseDB instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *EnterpriseDBUserCredentialsFunc) PushHook(hook func(encryption.Key) database.UserCredentialsStore) {
+// WithTransact method of the parent MockEnterpriseDB instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *EnterpriseDBWithTransactFunc) PushHook(hook func(context.Context, func(tx database.DB) error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13307,20 +16063,20 @@ func (f *EnterpriseDBUserCredentialsFunc) PushHook(hook func(encryption.Key) dat
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBUserCredentialsFunc) SetDefaultReturn(r0 database.UserCredentialsStore) {
-	f.SetDefaultHook(func(encryption.Key) database.UserCredentialsStore {
+func (f *EnterpriseDBWithTransactFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, func(tx database.DB) error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBUserCredentialsFunc) PushReturn(r0 database.UserCredentialsStore) {
-	f.PushHook(func(encryption.Key) database.UserCredentialsStore {
+func (f *EnterpriseDBWithTransactFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, func(tx database.DB) error) error {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBUserCredentialsFunc) nextHook() func(encryption.Key) database.UserCredentialsStore {
+func (f *EnterpriseDBWithTransactFunc) nextHook() func(context.Context, func(tx database.DB) error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13333,75 +16089,78 @@ func (f *EnterpriseDBUserCredentialsFunc) nextHook() func(encryption.Key) databa
 	return hook
 }
 
-func (f *EnterpriseDBUserCredentialsFunc) appendCall(r0 EnterpriseDBUserCredentialsFuncCall) {
+func (f *EnterpriseDBWithTransactFunc) appendCall(r0 EnterpriseDBWithTransactFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBUserCredentialsFuncCall objects
+// History returns a sequence of EnterpriseDBWithTransactFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBUserCredentialsFunc) History() []EnterpriseDBUserCredentialsFuncCall {
+func (f *EnterpriseDBWithTransactFunc) History() []EnterpriseDBWithTransactFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBUserCredentialsFuncCall, len(f.history))
+	history := make([]EnterpriseDBWithTransactFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBUserCredentialsFuncCall is an object that describes an
-// invocation of method UserCredentials on an instance of MockEnterpriseDB.
-type EnterpriseDBUserCredentialsFuncCall struct {
+// EnterpriseDBWithTransactFuncCall is an object that describes an
+// invocation of method WithTransact on an instance of MockEnterpriseDB.
+type EnterpriseDBWithTransactFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 encryption.Key
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 func(tx database.DB) error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.UserCredentialsStore
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBUserCredentialsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c EnterpriseDBWithTransactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBUserCredentialsFuncCall) Results() []interface{} {
+func (c EnterpriseDBWithTransactFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
-
-// EnterpriseDBUserEmailsFunc describes the behavior when the UserEmails
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBUserEmailsFunc struct {
-	defaultHook func() database.UserEmailsStore
-	hooks       []func() database.UserEmailsStore
-	history     []EnterpriseDBUserEmailsFuncCall
+
+// EnterpriseDBZoektReposFunc describes the behavior when the ZoektRepos
+// method of the parent MockEnterpriseDB instance is invoked.
+type EnterpriseDBZoektReposFunc struct {
+	defaultHook func() database.ZoektReposStore
+	hooks       []func() database.ZoektReposStore
+	history     []EnterpriseDBZoektReposFuncCall
 	mutex       sync.Mutex
 }
 
-// UserEmails delegates to the next hook function in the queue and stores
+// ZoektRepos delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) UserEmails() database.UserEmailsStore {
-	r0 := m.UserEmailsFunc.nextHook()()
-	m.UserEmailsFunc.appendCall(EnterpriseDBUserEmailsFuncCall{r0})
+func (m *MockEnterpriseDB) ZoektRepos() database.ZoektReposStore {
+	r0 := m.ZoektReposFunc.nextHook()()
+	m.ZoektReposFunc.appendCall(EnterpriseDBZoektReposFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the UserEmails method of
+// SetDefaultHook sets function that is called when the ZoektRepos method of
 // the parent MockEnterpriseDB instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBUserEmailsFunc) SetDefaultHook(hook func() database.UserEmailsStore) {
+func (f *EnterpriseDBZoektReposFunc) SetDefaultHook(hook func() database.ZoektReposStore) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UserEmails method of the parent MockEnterpriseDB instance invokes the
+// ZoektRepos method of the parent MockEnterpriseDB instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *EnterpriseDBUserEmailsFunc) PushHook(hook func() database.UserEmailsStore) {
+func (f *EnterpriseDBZoektReposFunc) PushHook(hook func() database.ZoektReposStore) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13409,20 +16168,20 @@ func (f *EnterpriseDBUserEmailsFunc) PushHook(hook func() database.UserEmailsSto
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBUserEmailsFunc) SetDefaultReturn(r0 database.UserEmailsStore) {
-	f.SetDefaultHook(func() database.UserEmailsStore {
+func (f *EnterpriseDBZoektReposFunc) SetDefaultReturn(r0 database.ZoektReposStore) {
+	f.SetDefaultHook(func() database.ZoektReposStore {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBUserEmailsFunc) PushReturn(r0 database.UserEmailsStore) {
-	f.PushHook(func() database.UserEmailsStore {
+func (f *EnterpriseDBZoektReposFunc) PushReturn(r0 database.ZoektReposStore) {
+	f.PushHook(func() database.ZoektReposStore {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBUserEmailsFunc) nextHook() func() database.UserEmailsStore {
+func (f *EnterpriseDBZoektReposFunc) nextHook() func() database.ZoektReposStore {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13435,74 +16194,214 @@ func (f *EnterpriseDBUserEmailsFunc) nextHook() func() database.UserEmailsStore
 	return hook
 }
 
-func (f *EnterpriseDBUserEmailsFunc) appendCall(r0 EnterpriseDBUserEmailsFuncCall) {
+func (f *EnterpriseDBZoektReposFunc) appendCall(r0 EnterpriseDBZoektReposFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBUserEmailsFuncCall objects
+// History returns a sequence of EnterpriseDBZoektReposFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBUserEmailsFunc) History() []EnterpriseDBUserEmailsFuncCall {
+func (f *EnterpriseDBZoektReposFunc) History() []EnterpriseDBZoektReposFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBUserEmailsFuncCall, len(f.history))
+	history := make([]EnterpriseDBZoektReposFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBUserEmailsFuncCall is an object that describes an invocation
-// of method UserEmails on an instance of MockEnterpriseDB.
-type EnterpriseDBUserEmailsFuncCall struct {
+// EnterpriseDBZoektReposFuncCall is an object that describes an invocation
+// of method ZoektRepos on an instance of MockEnterpriseDB.
+type EnterpriseDBZoektReposFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.UserEmailsStore
+	Result0 database.ZoektReposStore
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBUserEmailsFuncCall) Args() []interface{} {
+func (c EnterpriseDBZoektReposFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBUserEmailsFuncCall) Results() []interface{} {
+func (c EnterpriseDBZoektReposFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBUserExternalAccountsFunc describes the behavior when the
-// UserExternalAccounts method of the parent MockEnterpriseDB instance is
-// invoked.
-type EnterpriseDBUserExternalAccountsFunc struct {
-	defaultHook func() database.UserExternalAccountsStore
-	hooks       []func() database.UserExternalAccountsStore
-	history     []EnterpriseDBUserExternalAccountsFuncCall
+// MockOwnSignalStore is a mock implementation of the OwnSignalStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/enterprise/internal/database) used for
+// unit testing.
+type MockOwnSignalStore struct {
+	// DoneFunc is an instance of a mock function object controlling the
+	// behavior of the method Done.
+	DoneFunc *OwnSignalStoreDoneFunc
+	// HandleFunc is an instance of a mock function object controlling the
+	// behavior of the method Handle.
+	HandleFunc *OwnSignalStoreHandleFunc
+	// ListHistorySignalsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListHistorySignals.
+	ListHistorySignalsFunc *OwnSignalStoreListHistorySignalsFunc
+	// ListHistorySignalsForPathsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListHistorySignalsForPaths.
+	ListHistorySignalsForPathsFunc *OwnSignalStoreListHistorySignalsForPathsFunc
+	// ListReposForHistorySignalsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListReposForHistorySignals.
+	ListReposForHistorySignalsFunc *OwnSignalStoreListReposForHistorySignalsFunc
+	// RecordHistorySignalsFailureFunc is an instance of a mock function object
+	// controlling the behavior of the method RecordHistorySignalsFailure.
+	RecordHistorySignalsFailureFunc *OwnSignalStoreRecordHistorySignalsFailureFunc
+	// ReplaceHistorySignalsFunc is an instance of a mock function object
+	// controlling the behavior of the method ReplaceHistorySignals.
+	ReplaceHistorySignalsFunc *OwnSignalStoreReplaceHistorySignalsFunc
+}
+
+// NewMockOwnSignalStore creates a new mock of the OwnSignalStore interface.
+// All methods return zero values for all results, unless overwritten.
+func NewMockOwnSignalStore() *MockOwnSignalStore {
+	return &MockOwnSignalStore{
+		DoneFunc: &OwnSignalStoreDoneFunc{
+			defaultHook: func(error) (r0 error) {
+				return
+			},
+		},
+		HandleFunc: &OwnSignalStoreHandleFunc{
+			defaultHook: func() (r0 basestore.TransactableHandle) {
+				return
+			},
+		},
+		ListHistorySignalsFunc: &OwnSignalStoreListHistorySignalsFunc{
+			defaultHook: func(context.Context, api.RepoID, string) (r0 []*types.HistorySignal, r1 error) {
+				return
+			},
+		},
+		ListHistorySignalsForPathsFunc: &OwnSignalStoreListHistorySignalsForPathsFunc{
+			defaultHook: func(context.Context, api.RepoID, []string) (r0 []*types.HistorySignal, r1 error) {
+				return
+			},
+		},
+		ListReposForHistorySignalsFunc: &OwnSignalStoreListReposForHistorySignalsFunc{
+			defaultHook: func(context.Context, time.Time, int) (r0 []api.RepoName, r1 error) {
+				return
+			},
+		},
+		RecordHistorySignalsFailureFunc: &OwnSignalStoreRecordHistorySignalsFailureFunc{
+			defaultHook: func(context.Context, api.RepoID) (r0 error) {
+				return
+			},
+		},
+		ReplaceHistorySignalsFunc: &OwnSignalStoreReplaceHistorySignalsFunc{
+			defaultHook: func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) (r0 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockOwnSignalStore creates a new mock of the OwnSignalStore
+// interface. All methods panic on invocation, unless overwritten.
+func NewStrictMockOwnSignalStore() *MockOwnSignalStore {
+	return &MockOwnSignalStore{
+		DoneFunc: &OwnSignalStoreDoneFunc{
+			defaultHook: func(error) error {
+				panic("unexpected invocation of MockOwnSignalStore.Done")
+			},
+		},
+		HandleFunc: &OwnSignalStoreHandleFunc{
+			defaultHook: func() basestore.TransactableHandle {
+				panic("unexpected invocation of MockOwnSignalStore.Handle")
+			},
+		},
+		ListHistorySignalsFunc: &OwnSignalStoreListHistorySignalsFunc{
+			defaultHook: func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error) {
+				panic("unexpected invocation of MockOwnSignalStore.ListHistorySignals")
+			},
+		},
+		ListHistorySignalsForPathsFunc: &OwnSignalStoreListHistorySignalsForPathsFunc{
+			defaultHook: func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error) {
+				panic("unexpected invocation of MockOwnSignalStore.ListHistorySignalsForPaths")
+			},
+		},
+		ListReposForHistorySignalsFunc: &OwnSignalStoreListReposForHistorySignalsFunc{
+			defaultHook: func(context.Context, time.Time, int) ([]api.RepoName, error) {
+				panic("unexpected invocation of MockOwnSignalStore.ListReposForHistorySignals")
+			},
+		},
+		RecordHistorySignalsFailureFunc: &OwnSignalStoreRecordHistorySignalsFailureFunc{
+			defaultHook: func(context.Context, api.RepoID) error {
+				panic("unexpected invocation of MockOwnSignalStore.RecordHistorySignalsFailure")
+			},
+		},
+		ReplaceHistorySignalsFunc: &OwnSignalStoreReplaceHistorySignalsFunc{
+			defaultHook: func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error {
+				panic("unexpected invocation of MockOwnSignalStore.ReplaceHistorySignals")
+			},
+		},
+	}
+}
+
+// NewMockOwnSignalStoreFrom creates a new mock of the MockOwnSignalStore
+// interface. All methods delegate to the given implementation, unless
+// overwritten.
+func NewMockOwnSignalStoreFrom(i OwnSignalStore) *MockOwnSignalStore {
+	return &MockOwnSignalStore{
+		DoneFunc: &OwnSignalStoreDoneFunc{
+			defaultHook: i.Done,
+		},
+		HandleFunc: &OwnSignalStoreHandleFunc{
+			defaultHook: i.Handle,
+		},
+		ListHistorySignalsFunc: &OwnSignalStoreListHistorySignalsFunc{
+			defaultHook: i.ListHistorySignals,
+		},
+		ListHistorySignalsForPathsFunc: &OwnSignalStoreListHistorySignalsForPathsFunc{
+			defaultHook: i.ListHistorySignalsForPaths,
+		},
+		ListReposForHistorySignalsFunc: &OwnSignalStoreListReposForHistorySignalsFunc{
+			defaultHook: i.ListReposForHistorySignals,
+		},
+		RecordHistorySignalsFailureFunc: &OwnSignalStoreRecordHistorySignalsFailureFunc{
+			defaultHook: i.RecordHistorySignalsFailure,
+		},
+		ReplaceHistorySignalsFunc: &OwnSignalStoreReplaceHistorySignalsFunc{
+			defaultHook: i.ReplaceHistorySignals,
+		},
+	}
+}
+
+// OwnSignalStoreDoneFunc describes the behavior when the Done method of the
+// parent MockOwnSignalStore instance is invoked.
+type OwnSignalStoreDoneFunc struct {
+	defaultHook func(error) error
+	hooks       []func(error) error
+	history     []OwnSignalStoreDoneFuncCall
 	mutex       sync.Mutex
 }
 
-// UserExternalAccounts delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) UserExternalAccounts() database.UserExternalAccountsStore {
-	r0 := m.UserExternalAccountsFunc.nextHook()()
-	m.UserExternalAccountsFunc.appendCall(EnterpriseDBUserExternalAccountsFuncCall{r0})
+// Done delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockOwnSignalStore) Done(v0 error) error {
+	r0 := m.DoneFunc.nextHook()(v0)
+	m.DoneFunc.appendCall(OwnSignalStoreDoneFuncCall{v0, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the UserExternalAccounts
-// method of the parent MockEnterpriseDB instance is invoked and the hook
-// queue is empty.
-func (f *EnterpriseDBUserExternalAccountsFunc) SetDefaultHook(hook func() database.UserExternalAccountsStore) {
+// SetDefaultHook sets function that is called when the Done method of the
+// parent MockOwnSignalStore instance is invoked and the hook queue is
+// empty.
+func (f *OwnSignalStoreDoneFunc) SetDefaultHook(hook func(error) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UserExternalAccounts method of the parent MockEnterpriseDB instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *EnterpriseDBUserExternalAccountsFunc) PushHook(hook func() database.UserExternalAccountsStore) {
+// Done method of the parent MockOwnSignalStore instance invokes the hook at
+// the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *OwnSignalStoreDoneFunc) PushHook(hook func(error) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13510,20 +16409,20 @@ func (f *EnterpriseDBUserExternalAccountsFunc) PushHook(hook func() database.Use
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBUserExternalAccountsFunc) SetDefaultReturn(r0 database.UserExternalAccountsStore) {
-	f.SetDefaultHook(func() database.UserExternalAccountsStore {
+func (f *OwnSignalStoreDoneFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(error) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBUserExternalAccountsFunc) PushReturn(r0 database.UserExternalAccountsStore) {
-	f.PushHook(func() database.UserExternalAccountsStore {
+func (f *OwnSignalStoreDoneFunc) PushReturn(r0 error) {
+	f.PushHook(func(error) error {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBUserExternalAccountsFunc) nextHook() func() database.UserExternalAccountsStore {
+func (f *OwnSignalStoreDoneFunc) nextHook() func(error) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13536,73 +16435,75 @@ func (f *EnterpriseDBUserExternalAccountsFunc) nextHook() func() database.UserEx
 	return hook
 }
 
-func (f *EnterpriseDBUserExternalAccountsFunc) appendCall(r0 EnterpriseDBUserExternalAccountsFuncCall) {
+func (f *OwnSignalStoreDoneFunc) appendCall(r0 OwnSignalStoreDoneFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBUserExternalAccountsFuncCall
-// objects describing the invocations of this function.
-func (f *EnterpriseDBUserExternalAccountsFunc) History() []EnterpriseDBUserExternalAccountsFuncCall {
+// History returns a sequence of OwnSignalStoreDoneFuncCall objects
+// describing the invocations of this function.
+func (f *OwnSignalStoreDoneFunc) History() []OwnSignalStoreDoneFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBUserExternalAccountsFuncCall, len(f.history))
+	history := make([]OwnSignalStoreDoneFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBUserExternalAccountsFuncCall is an object that describes an
-// invocation of method UserExternalAccounts on an instance of
-// MockEnterpriseDB.
-type EnterpriseDBUserExternalAccountsFuncCall struct {
+// OwnSignalStoreDoneFuncCall is an object that describes an invocation of
+// method Done on an instance of MockOwnSignalStore.
+type OwnSignalStoreDoneFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 error
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.UserExternalAccountsStore
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBUserExternalAccountsFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c OwnSignalStoreDoneFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBUserExternalAccountsFuncCall) Results() []interface{} {
+func (c OwnSignalStoreDoneFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBUserRolesFunc describes the behavior when the UserRoles
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBUserRolesFunc struct {
-	defaultHook func() database.UserRoleStore
-	hooks       []func() database.UserRoleStore
-	history     []EnterpriseDBUserRolesFuncCall
+// OwnSignalStoreHandleFunc describes the behavior when the Handle method of
+// the parent MockOwnSignalStore instance is invoked.
+type OwnSignalStoreHandleFunc struct {
+	defaultHook func() basestore.TransactableHandle
+	hooks       []func() basestore.TransactableHandle
+	history     []OwnSignalStoreHandleFuncCall
 	mutex       sync.Mutex
 }
 
-// UserRoles delegates to the next hook function in the queue and stores the
+// Handle delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockEnterpriseDB) UserRoles() database.UserRoleStore {
-	r0 := m.UserRolesFunc.nextHook()()
-	m.UserRolesFunc.appendCall(EnterpriseDBUserRolesFuncCall{r0})
+func (m *MockOwnSignalStore) Handle() basestore.TransactableHandle {
+	r0 := m.HandleFunc.nextHook()()
+	m.HandleFunc.appendCall(OwnSignalStoreHandleFuncCall{r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the UserRoles method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
+// SetDefaultHook sets function that is called when the Handle method of the
+// parent MockOwnSignalStore instance is invoked and the hook queue is
 // empty.
-func (f *EnterpriseDBUserRolesFunc) SetDefaultHook(hook func() database.UserRoleStore) {
+func (f *OwnSignalStoreHandleFunc) SetDefaultHook(hook func() basestore.TransactableHandle) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UserRoles method of the parent MockEnterpriseDB instance invokes the hook
+// Handle method of the parent MockOwnSignalStore instance invokes the hook
 // at the front of the queue and discards it. After the queue is empty, the
 // default hook function is invoked for any future action.
-func (f *EnterpriseDBUserRolesFunc) PushHook(hook func() database.UserRoleStore) {
+func (f *OwnSignalStoreHandleFunc) PushHook(hook func() basestore.TransactableHandle) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13610,20 +16511,20 @@ func (f *EnterpriseDBUserRolesFunc) PushHook(hook func() database.UserRoleStore)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBUserRolesFunc) SetDefaultReturn(r0 database.UserRoleStore) {
-	f.SetDefaultHook(func() database.UserRoleStore {
+func (f *OwnSignalStoreHandleFunc) SetDefaultReturn(r0 basestore.TransactableHandle) {
+	f.SetDefaultHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBUserRolesFunc) PushReturn(r0 database.UserRoleStore) {
-	f.PushHook(func() database.UserRoleStore {
+func (f *OwnSignalStoreHandleFunc) PushReturn(r0 basestore.TransactableHandle) {
+	f.PushHook(func() basestore.TransactableHandle {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBUserRolesFunc) nextHook() func() database.UserRoleStore {
+func (f *OwnSignalStoreHandleFunc) nextHook() func() basestore.TransactableHandle {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13636,71 +16537,74 @@ func (f *EnterpriseDBUserRolesFunc) nextHook() func() database.UserRoleStore {
 	return hook
 }
 
-func (f *EnterpriseDBUserRolesFunc) appendCall(r0 EnterpriseDBUserRolesFuncCall) {
+func (f *OwnSignalStoreHandleFunc) appendCall(r0 OwnSignalStoreHandleFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBUserRolesFuncCall objects
+// History returns a sequence of OwnSignalStoreHandleFuncCall objects
 // describing the invocations of this function.
-func (f *EnterpriseDBUserRolesFunc) History() []EnterpriseDBUserRolesFuncCall {
+func (f *OwnSignalStoreHandleFunc) History() []OwnSignalStoreHandleFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBUserRolesFuncCall, len(f.history))
+	history := make([]OwnSignalStoreHandleFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBUserRolesFuncCall is an object that describes an invocation
-// of method UserRoles on an instance of MockEnterpriseDB.
-type EnterpriseDBUserRolesFuncCall struct {
+// OwnSignalStoreHandleFuncCall is an object that describes an invocation of
+// method Handle on an instance of MockOwnSignalStore.
+type OwnSignalStoreHandleFuncCall struct {
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.UserRoleStore
+	Result0 basestore.TransactableHandle
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBUserRolesFuncCall) Args() []interface{} {
+func (c OwnSignalStoreHandleFuncCall) Args() []interface{} {
 	return []interface{}{}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBUserRolesFuncCall) Results() []interface{} {
+func (c OwnSignalStoreHandleFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBUsersFunc describes the behavior when the Users method of the
-// parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBUsersFunc struct {
-	defaultHook func() database.UserStore
-	hooks       []func() database.UserStore
-	history     []EnterpriseDBUsersFuncCall
+// OwnSignalStoreListHistorySignalsFunc describes the behavior when the
+// ListHistorySignals method of the parent MockOwnSignalStore instance is
+// invoked.
+type OwnSignalStoreListHistorySignalsFunc struct {
+	defaultHook func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error)
+	hooks       []func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error)
+	history     []OwnSignalStoreListHistorySignalsFuncCall
 	mutex       sync.Mutex
 }
 
-// Users delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Users() database.UserStore {
-	r0 := m.UsersFunc.nextHook()()
-	m.UsersFunc.appendCall(EnterpriseDBUsersFuncCall{r0})
-	return r0
+// ListHistorySignals delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockOwnSignalStore) ListHistorySignals(v0 context.Context, v1 api.RepoID, v2 string) ([]*types.HistorySignal, error) {
+	r0, r1 := m.ListHistorySignalsFunc.nextHook()(v0, v1, v2)
+	m.ListHistorySignalsFunc.appendCall(OwnSignalStoreListHistorySignalsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Users method of the
-// parent MockEnterpriseDB instance is invoked and the hook queue is empty.
-func (f *EnterpriseDBUsersFunc) SetDefaultHook(hook func() database.UserStore) {
+// SetDefaultHook sets function that is called when the ListHistorySignals
+// method of the parent MockOwnSignalStore instance is invoked and the hook
+// queue is empty.
+func (f *OwnSignalStoreListHistorySignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Users method of the parent MockEnterpriseDB instance invokes the hook at
-// the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBUsersFunc) PushHook(hook func() database.UserStore) {
+// ListHistorySignals method of the parent MockOwnSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnSignalStoreListHistorySignalsFunc) PushHook(hook func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13708,20 +16612,20 @@ func (f *EnterpriseDBUsersFunc) PushHook(hook func() database.UserStore) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBUsersFunc) SetDefaultReturn(r0 database.UserStore) {
-	f.SetDefaultHook(func() database.UserStore {
-		return r0
+func (f *OwnSignalStoreListHistorySignalsFunc) SetDefaultReturn(r0 []*types.HistorySignal, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBUsersFunc) PushReturn(r0 database.UserStore) {
-	f.PushHook(func() database.UserStore {
-		return r0
+func (f *OwnSignalStoreListHistorySignalsFunc) PushReturn(r0 []*types.HistorySignal, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnterpriseDBUsersFunc) nextHook() func() database.UserStore {
+func (f *OwnSignalStoreListHistorySignalsFunc) nextHook() func(context.Context, api.RepoID, string) ([]*types.HistorySignal, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13734,72 +16638,87 @@ func (f *EnterpriseDBUsersFunc) nextHook() func() database.UserStore {
 	return hook
 }
 
-func (f *EnterpriseDBUsersFunc) appendCall(r0 EnterpriseDBUsersFuncCall) {
+func (f *OwnSignalStoreListHistorySignalsFunc) appendCall(r0 OwnSignalStoreListHistorySignalsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
-}
-
-// History returns a sequence of EnterpriseDBUsersFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBUsersFunc) History() []EnterpriseDBUsersFuncCall {
+}
+
+// History returns a sequence of OwnSignalStoreListHistorySignalsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnSignalStoreListHistorySignalsFunc) History() []OwnSignalStoreListHistorySignalsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBUsersFuncCall, len(f.history))
+	history := make([]OwnSignalStoreListHistorySignalsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBUsersFuncCall is an object that describes an invocation of
-// method Users on an instance of MockEnterpriseDB.
-type EnterpriseDBUsersFuncCall struct {
+// OwnSignalStoreListHistorySignalsFuncCall is an object that describes an
+// invocation of method ListHistorySignals on an instance of
+// MockOwnSignalStore.
+type OwnSignalStoreListHistorySignalsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.UserStore
+	Result0 []*types.HistorySignal
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBUsersFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c OwnSignalStoreListHistorySignalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBUsersFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OwnSignalStoreListHistorySignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnterpriseDBWebhookLogsFunc describes the behavior when the WebhookLogs
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBWebhookLogsFunc struct {
-	defaultHook func(encryption.Key) database.WebhookLogStore
-	hooks       []func(encryption.Key) database.WebhookLogStore
-	history     []EnterpriseDBWebhookLogsFuncCall
+// OwnSignalStoreListHistorySignalsForPathsFunc describes the behavior when
+// the ListHistorySignalsForPaths method of the parent MockOwnSignalStore
+// instance is invoked.
+type OwnSignalStoreListHistorySignalsForPathsFunc struct {
+	defaultHook func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error)
+	hooks       []func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error)
+	history     []OwnSignalStoreListHistorySignalsForPathsFuncCall
 	mutex       sync.Mutex
 }
 
-// WebhookLogs delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) WebhookLogs(v0 encryption.Key) database.WebhookLogStore {
-	r0 := m.WebhookLogsFunc.nextHook()(v0)
-	m.WebhookLogsFunc.appendCall(EnterpriseDBWebhookLogsFuncCall{v0, r0})
-	return r0
+// ListHistorySignalsForPaths delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockOwnSignalStore) ListHistorySignalsForPaths(v0 context.Context, v1 api.RepoID, v2 []string) ([]*types.HistorySignal, error) {
+	r0, r1 := m.ListHistorySignalsForPathsFunc.nextHook()(v0, v1, v2)
+	m.ListHistorySignalsForPathsFunc.appendCall(OwnSignalStoreListHistorySignalsForPathsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the WebhookLogs method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBWebhookLogsFunc) SetDefaultHook(hook func(encryption.Key) database.WebhookLogStore) {
+// SetDefaultHook sets function that is called when the
+// ListHistorySignalsForPaths method of the parent MockOwnSignalStore
+// instance is invoked and the hook queue is empty.
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WebhookLogs method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBWebhookLogsFunc) PushHook(hook func(encryption.Key) database.WebhookLogStore) {
+// ListHistorySignalsForPaths method of the parent MockOwnSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) PushHook(hook func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13807,20 +16726,20 @@ func (f *EnterpriseDBWebhookLogsFunc) PushHook(hook func(encryption.Key) databas
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBWebhookLogsFunc) SetDefaultReturn(r0 database.WebhookLogStore) {
-	f.SetDefaultHook(func(encryption.Key) database.WebhookLogStore {
-		return r0
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) SetDefaultReturn(r0 []*types.HistorySignal, r1 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBWebhookLogsFunc) PushReturn(r0 database.WebhookLogStore) {
-	f.PushHook(func(encryption.Key) database.WebhookLogStore {
-		return r0
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) PushReturn(r0 []*types.HistorySignal, r1 error) {
+	f.PushHook(func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnterpriseDBWebhookLogsFunc) nextHook() func(encryption.Key) database.WebhookLogStore {
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) nextHook() func(context.Context, api.RepoID, []string) ([]*types.HistorySignal, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13833,75 +16752,85 @@ func (f *EnterpriseDBWebhookLogsFunc) nextHook() func(encryption.Key) database.W
 	return hook
 }
 
-func (f *EnterpriseDBWebhookLogsFunc) appendCall(r0 EnterpriseDBWebhookLogsFuncCall) {
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) appendCall(r0 OwnSignalStoreListHistorySignalsForPathsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBWebhookLogsFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBWebhookLogsFunc) History() []EnterpriseDBWebhookLogsFuncCall {
+// History returns a sequence of
+// OwnSignalStoreListHistorySignalsForPathsFuncCall objects describing the
+// invocations of this function.
+func (f *OwnSignalStoreListHistorySignalsForPathsFunc) History() []OwnSignalStoreListHistorySignalsForPathsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBWebhookLogsFuncCall, len(f.history))
+	history := make([]OwnSignalStoreListHistorySignalsForPathsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBWebhookLogsFuncCall is an object that describes an invocation
-// of method WebhookLogs on an instance of MockEnterpriseDB.
-type EnterpriseDBWebhookLogsFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
-	Arg0 encryption.Key
+// OwnSignalStoreListHistorySignalsForPathsFuncCall is an object that
+// describes an invocation of method ListHistorySignalsForPaths on an
+// instance of MockOwnSignalStore.
+type OwnSignalStoreListHistorySignalsForPathsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method invocation.
+	Arg2 []string
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.WebhookLogStore
+	Result0 []*types.HistorySignal
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBWebhookLogsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c OwnSignalStoreListHistorySignalsForPathsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBWebhookLogsFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OwnSignalStoreListHistorySignalsForPathsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnterpriseDBWebhooksFunc describes the behavior when the Webhooks method
-// of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBWebhooksFunc struct {
-	defaultHook func(encryption.Key) database.WebhookStore
-	hooks       []func(encryption.Key) database.WebhookStore
-	history     []EnterpriseDBWebhooksFuncCall
+// OwnSignalStoreListReposForHistorySignalsFunc describes the behavior when
+// the ListReposForHistorySignals method of the parent MockOwnSignalStore
+// instance is invoked.
+type OwnSignalStoreListReposForHistorySignalsFunc struct {
+	defaultHook func(context.Context, time.Time, int) ([]api.RepoName, error)
+	hooks       []func(context.Context, time.Time, int) ([]api.RepoName, error)
+	history     []OwnSignalStoreListReposForHistorySignalsFuncCall
 	mutex       sync.Mutex
 }
 
-// Webhooks delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockEnterpriseDB) Webhooks(v0 encryption.Key) database.WebhookStore {
-	r0 := m.WebhooksFunc.nextHook()(v0)
-	m.WebhooksFunc.appendCall(EnterpriseDBWebhooksFuncCall{v0, r0})
-	return r0
+// ListReposForHistorySignals delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockOwnSignalStore) ListReposForHistorySignals(v0 context.Context, v1 time.Time, v2 int) ([]api.RepoName, error) {
+	r0, r1 := m.ListReposForHistorySignalsFunc.nextHook()(v0, v1, v2)
+	m.ListReposForHistorySignalsFunc.appendCall(OwnSignalStoreListReposForHistorySignalsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the Webhooks method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBWebhooksFunc) SetDefaultHook(hook func(encryption.Key) database.WebhookStore) {
+// SetDefaultHook sets function that is called when the
+// ListReposForHistorySignals method of the parent MockOwnSignalStore
+// instance is invoked and the hook queue is empty.
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) SetDefaultHook(hook func(context.Context, time.Time, int) ([]api.RepoName, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Webhooks method of the parent MockEnterpriseDB instance invokes the hook
-// at the front of the queue and discards it. After the queue is empty, the
-// default hook function is invoked for any future action.
-func (f *EnterpriseDBWebhooksFunc) PushHook(hook func(encryption.Key) database.WebhookStore) {
+// ListReposForHistorySignals method of the parent MockOwnSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) PushHook(hook func(context.Context, time.Time, int) ([]api.RepoName, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -13909,20 +16838,20 @@ func (f *EnterpriseDBWebhooksFunc) PushHook(hook func(encryption.Key) database.W
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBWebhooksFunc) SetDefaultReturn(r0 database.WebhookStore) {
-	f.SetDefaultHook(func(encryption.Key) database.WebhookStore {
-		return r0
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) SetDefaultReturn(r0 []api.RepoName, r1 error) {
+	f.SetDefaultHook(func(context.Context, time.Time, int) ([]api.RepoName, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBWebhooksFunc) PushReturn(r0 database.WebhookStore) {
-	f.PushHook(func(encryption.Key) database.WebhookStore {
-		return r0
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) PushReturn(r0 []api.RepoName, r1 error) {
+	f.PushHook(func(context.Context, time.Time, int) ([]api.RepoName, error) {
+		return r0, r1
 	})
 }
 
-func (f *EnterpriseDBWebhooksFunc) nextHook() func(encryption.Key) database.WebhookStore {
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) nextHook() func(context.Context, time.Time, int) ([]api.RepoName, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -13935,75 +16864,88 @@ func (f *EnterpriseDBWebhooksFunc) nextHook() func(encryption.Key) database.Webh
 	return hook
 }
 
-func (f *EnterpriseDBWebhooksFunc) appendCall(r0 EnterpriseDBWebhooksFuncCall) {
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) appendCall(r0 OwnSignalStoreListReposForHistorySignalsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBWebhooksFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBWebhooksFunc) History() []EnterpriseDBWebhooksFuncCall {
+// History returns a sequence of
+// OwnSignalStoreListReposForHistorySignalsFuncCall objects describing the
+// invocations of this function.
+func (f *OwnSignalStoreListReposForHistorySignalsFunc) History() []OwnSignalStoreListReposForHistorySignalsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBWebhooksFuncCall, len(f.history))
+	history := make([]OwnSignalStoreListReposForHistorySignalsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBWebhooksFuncCall is an object that describes an invocation of
-// method Webhooks on an instance of MockEnterpriseDB.
-type EnterpriseDBWebhooksFuncCall struct {
+// OwnSignalStoreListReposForHistorySignalsFuncCall is an object that
+// describes an invocation of method ListReposForHistorySignals on an
+// instance of MockOwnSignalStore.
+type OwnSignalStoreListReposForHistorySignalsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 encryption.Key
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 time.Time
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.WebhookStore
+	Result0 []api.RepoName
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBWebhooksFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c OwnSignalStoreListReposForHistorySignalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBWebhooksFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c OwnSignalStoreListReposForHistorySignalsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// EnterpriseDBWithTransactFunc describes the behavior when the WithTransact
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBWithTransactFunc struct {
-	defaultHook func(context.Context, func(tx database.DB) error) error
-	hooks       []func(context.Context, func(tx database.DB) error) error
-	history     []EnterpriseDBWithTransactFuncCall
+// OwnSignalStoreRecordHistorySignalsFailureFunc describes the behavior when
+// the RecordHistorySignalsFailure method of the parent MockOwnSignalStore
+// instance is invoked.
+type OwnSignalStoreRecordHistorySignalsFailureFunc struct {
+	defaultHook func(context.Context, api.RepoID) error
+	hooks       []func(context.Context, api.RepoID) error
+	history     []OwnSignalStoreRecordHistorySignalsFailureFuncCall
 	mutex       sync.Mutex
 }
 
-// WithTransact delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) WithTransact(v0 context.Context, v1 func(tx database.DB) error) error {
-	r0 := m.WithTransactFunc.nextHook()(v0, v1)
-	m.WithTransactFunc.appendCall(EnterpriseDBWithTransactFuncCall{v0, v1, r0})
+// RecordHistorySignalsFailure delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockOwnSignalStore) RecordHistorySignalsFailure(v0 context.Context, v1 api.RepoID) error {
+	r0 := m.RecordHistorySignalsFailureFunc.nextHook()(v0, v1)
+	m.RecordHistorySignalsFailureFunc.appendCall(OwnSignalStoreRecordHistorySignalsFailureFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the WithTransact method
-// of the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBWithTransactFunc) SetDefaultHook(hook func(context.Context, func(tx database.DB) error) error) {
+// SetDefaultHook sets function that is called when the
+// RecordHistorySignalsFailure method of the parent MockOwnSignalStore
+// instance is invoked and the hook queue is empty.
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) SetDefaultHook(hook func(context.Context, api.RepoID) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// WithTransact method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBWithTransactFunc) PushHook(hook func(context.Context, func(tx database.DB) error) error) {
+// RecordHistorySignalsFailure method of the parent MockOwnSignalStore
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) PushHook(hook func(context.Context, api.RepoID) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -14011,20 +16953,20 @@ func (f *EnterpriseDBWithTransactFunc) PushHook(hook func(context.Context, func(
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBWithTransactFunc) SetDefaultReturn(r0 error) {
-	f.SetDefaultHook(func(context.Context, func(tx database.DB) error) error {
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBWithTransactFunc) PushReturn(r0 error) {
-	f.PushHook(func(context.Context, func(tx database.DB) error) error {
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID) error {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBWithTransactFunc) nextHook() func(context.Context, func(tx database.DB) error) error {
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) nextHook() func(context.Context, api.RepoID) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -14037,32 +16979,32 @@ func (f *EnterpriseDBWithTransactFunc) nextHook() func(context.Context, func(tx
 	return hook
 }
 
-func (f *EnterpriseDBWithTransactFunc) appendCall(r0 EnterpriseDBWithTransactFuncCall) {
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) appendCall(r0 OwnSignalStoreRecordHistorySignalsFailureFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBWithTransactFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBWithTransactFunc) History() []EnterpriseDBWithTransactFuncCall {
+// History returns a sequence of
+// OwnSignalStoreRecordHistorySignalsFailureFuncCall objects describing the
+// invocations of this function.
+func (f *OwnSignalStoreRecordHistorySignalsFailureFunc) History() []OwnSignalStoreRecordHistorySignalsFailureFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBWithTransactFuncCall, len(f.history))
+	history := make([]OwnSignalStoreRecordHistorySignalsFailureFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBWithTransactFuncCall is an object that describes an
-// invocation of method WithTransact on an instance of MockEnterpriseDB.
-type EnterpriseDBWithTransactFuncCall struct {
-	// Arg0 is the value of the 1st argument passed to this method
-	// invocation.
+// OwnSignalStoreRecordHistorySignalsFailureFuncCall is an object that
+// describes an invocation of method RecordHistorySignalsFailure on an
+// instance of MockOwnSignalStore.
+type OwnSignalStoreRecordHistorySignalsFailureFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method invocation.
 	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 func(tx database.DB) error
+	// Arg1 is the value of the 2nd argument passed to this method invocation.
+	Arg1 api.RepoID
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 error
@@ -14070,45 +17012,47 @@ type EnterpriseDBWithTransactFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBWithTransactFuncCall) Args() []interface{} {
+func (c OwnSignalStoreRecordHistorySignalsFailureFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBWithTransactFuncCall) Results() []interface{} {
+func (c OwnSignalStoreRecordHistorySignalsFailureFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// EnterpriseDBZoektReposFunc describes the behavior when the ZoektRepos
-// method of the parent MockEnterpriseDB instance is invoked.
-type EnterpriseDBZoektReposFunc struct {
-	defaultHook func() database.ZoektReposStore
-	hooks       []func() database.ZoektReposStore
-	history     []EnterpriseDBZoektReposFuncCall
+// OwnSignalStoreReplaceHistorySignalsFunc describes the behavior when the
+// ReplaceHistorySignals method of the parent MockOwnSignalStore instance is
+// invoked.
+type OwnSignalStoreReplaceHistorySignalsFunc struct {
+	defaultHook func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error
+	hooks       []func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error
+	history     []OwnSignalStoreReplaceHistorySignalsFuncCall
 	mutex       sync.Mutex
 }
 
-// ZoektRepos delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockEnterpriseDB) ZoektRepos() database.ZoektReposStore {
-	r0 := m.ZoektReposFunc.nextHook()()
-	m.ZoektReposFunc.appendCall(EnterpriseDBZoektReposFuncCall{r0})
+// ReplaceHistorySignals delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockOwnSignalStore) ReplaceHistorySignals(v0 context.Context, v1 api.RepoID, v2 api.CommitID, v3 []*types.HistorySignal) error {
+	r0 := m.ReplaceHistorySignalsFunc.nextHook()(v0, v1, v2, v3)
+	m.ReplaceHistorySignalsFunc.appendCall(OwnSignalStoreReplaceHistorySignalsFuncCall{v0, v1, v2, v3, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the ZoektRepos method of
-// the parent MockEnterpriseDB instance is invoked and the hook queue is
-// empty.
-func (f *EnterpriseDBZoektReposFunc) SetDefaultHook(hook func() database.ZoektReposStore) {
+// SetDefaultHook sets function that is called when the
+// ReplaceHistorySignals method of the parent MockOwnSignalStore instance is
+// invoked and the hook queue is empty.
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) SetDefaultHook(hook func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ZoektRepos method of the parent MockEnterpriseDB instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *EnterpriseDBZoektReposFunc) PushHook(hook func() database.ZoektReposStore) {
+// ReplaceHistorySignals method of the parent MockOwnSignalStore instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) PushHook(hook func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -14116,20 +17060,20 @@ func (f *EnterpriseDBZoektReposFunc) PushHook(hook func() database.ZoektReposSto
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *EnterpriseDBZoektReposFunc) SetDefaultReturn(r0 database.ZoektReposStore) {
-	f.SetDefaultHook(func() database.ZoektReposStore {
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *EnterpriseDBZoektReposFunc) PushReturn(r0 database.ZoektReposStore) {
-	f.PushHook(func() database.ZoektReposStore {
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error {
 		return r0
 	})
 }
 
-func (f *EnterpriseDBZoektReposFunc) nextHook() func() database.ZoektReposStore {
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) nextHook() func(context.Context, api.RepoID, api.CommitID, []*types.HistorySignal) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -14142,40 +17086,53 @@ func (f *EnterpriseDBZoektReposFunc) nextHook() func() database.ZoektReposStore
 	return hook
 }
 
-func (f *EnterpriseDBZoektReposFunc) appendCall(r0 EnterpriseDBZoektReposFuncCall) {
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) appendCall(r0 OwnSignalStoreReplaceHistorySignalsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of EnterpriseDBZoektReposFuncCall objects
-// describing the invocations of this function.
-func (f *EnterpriseDBZoektReposFunc) History() []EnterpriseDBZoektReposFuncCall {
+// History returns a sequence of OwnSignalStoreReplaceHistorySignalsFuncCall
+// objects describing the invocations of this function.
+func (f *OwnSignalStoreReplaceHistorySignalsFunc) History() []OwnSignalStoreReplaceHistorySignalsFuncCall {
 	f.mutex.Lock()
-	history := make([]EnterpriseDBZoektReposFuncCall, len(f.history))
+	history := make([]OwnSignalStoreReplaceHistorySignalsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// EnterpriseDBZoektReposFuncCall is an object that describes an invocation
-// of method ZoektRepos on an instance of MockEnterpriseDB.
-type EnterpriseDBZoektReposFuncCall struct {
+// OwnSignalStoreReplaceHistorySignalsFuncCall is an object that describes
+// an invocation of method ReplaceHistorySignals on an instance of
+// MockOwnSignalStore.
+type OwnSignalStoreReplaceHistorySignalsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 api.RepoID
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 api.CommitID
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 []*types.HistorySignal
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 database.ZoektReposStore
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c EnterpriseDBZoektReposFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c OwnSignalStoreReplaceHistorySignalsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c EnterpriseDBZoektReposFuncCall) Results() []interface{} {
+func (c OwnSignalStoreReplaceHistorySignalsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
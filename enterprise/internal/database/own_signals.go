@@ -0,0 +1,249 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/batch"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+)
+
+// OwnSignalStore stores ownership signals derived from the git history of
+// repositories.
+type OwnSignalStore interface {
+	basestore.ShareableStore
+	Done(error) error
+
+	// ReplaceHistorySignals replaces all the history signals of the given repo
+	// with the given signals, which were computed at the given commit.
+	ReplaceHistorySignals(ctx context.Context, repoID api.RepoID, commitID api.CommitID, signals []*types.HistorySignal) error
+	// ListHistorySignals lists the history signals for a single path of the
+	// given repo, with the most significant contributors first.
+	ListHistorySignals(ctx context.Context, repoID api.RepoID, path string) ([]*types.HistorySignal, error)
+	// ListHistorySignalsForPaths lists the history signals for several paths of
+	// the given repo, ordered by path and then like ListHistorySignals.
+	ListHistorySignalsForPaths(ctx context.Context, repoID api.RepoID, paths []string) ([]*types.HistorySignal, error)
+	// RecordHistorySignalsFailure records that computing the history signals of
+	// the given repo failed, so that it is backed off by ListReposForHistorySignals.
+	// The previously computed signals of the repo are kept.
+	RecordHistorySignalsFailure(ctx context.Context, repoID api.RepoID) error
+	// ListReposForHistorySignals returns the cloned repos for which history
+	// signals have never been computed, or were last computed before the given
+	// time. Repos that were never attempted are returned first, followed by the
+	// least recently attempted ones. Repos for which the last attempt failed
+	// are skipped until their exponential backoff has elapsed.
+	ListReposForHistorySignals(ctx context.Context, computedBefore time.Time, limit int) ([]api.RepoName, error)
+}
+
+type ownSignalStore struct {
+	*basestore.Store
+}
+
+func OwnSignalsWith(other basestore.ShareableStore) OwnSignalStore {
+	return &ownSignalStore{
+		Store: basestore.NewWithHandle(other.Handle()),
+	}
+}
+
+func (s *ownSignalStore) With(other basestore.ShareableStore) OwnSignalStore {
+	return &ownSignalStore{
+		Store: s.Store.With(other),
+	}
+}
+
+func (s *ownSignalStore) WithTransact(ctx context.Context, f func(store OwnSignalStore) error) error {
+	return s.Store.WithTransact(ctx, func(tx *basestore.Store) error {
+		return f(&ownSignalStore{
+			Store: tx,
+		})
+	})
+}
+
+var historySignalsColumns = []string{
+	"repo_id",
+	"path",
+	"author_name",
+	"author_email",
+	"recent_commit_count",
+	"last_commit_at",
+	"blame_line_share",
+	"updated_at",
+}
+
+func (s *ownSignalStore) ReplaceHistorySignals(ctx context.Context, repoID api.RepoID, commitID api.CommitID, signals []*types.HistorySignal) error {
+	return s.WithTransact(ctx, func(tx OwnSignalStore) error {
+		store := tx.(*ownSignalStore)
+		now := timeutil.Now()
+
+		if err := store.Exec(ctx, sqlf.Sprintf(deleteHistorySignalsQueryFmtStr, repoID)); err != nil {
+			return err
+		}
+
+		if err := batch.WithInserter(
+			ctx,
+			store.Handle(),
+			"own_history_signals",
+			batch.MaxNumPostgresParameters,
+			historySignalsColumns,
+			func(inserter *batch.Inserter) error {
+				for _, signal := range signals {
+					if err := inserter.Insert(
+						ctx,
+						repoID,
+						signal.Path,
+						signal.AuthorName,
+						signal.AuthorEmail,
+						signal.RecentCommitCount,
+						dbutil.NullTime{Time: signal.LastCommitAt},
+						signal.BlameLineShare,
+						now,
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		); err != nil {
+			return err
+		}
+
+		return store.Exec(ctx, sqlf.Sprintf(upsertHistorySignalsRepoQueryFmtStr, repoID, commitID, now))
+	})
+}
+
+const deleteHistorySignalsQueryFmtStr = `
+DELETE FROM own_history_signals
+WHERE repo_id = %s
+`
+
+const upsertHistorySignalsRepoQueryFmtStr = `
+INSERT INTO own_history_signals_repos (repo_id, commit_id, computed_at)
+VALUES (%s, %s, %s)
+ON CONFLICT (repo_id) DO UPDATE SET
+    commit_id = EXCLUDED.commit_id,
+    computed_at = EXCLUDED.computed_at,
+    failure_count = 0,
+    last_failure_at = NULL
+`
+
+func (s *ownSignalStore) RecordHistorySignalsFailure(ctx context.Context, repoID api.RepoID) error {
+	return s.Exec(ctx, sqlf.Sprintf(recordHistorySignalsFailureQueryFmtStr, repoID, timeutil.Now()))
+}
+
+const recordHistorySignalsFailureQueryFmtStr = `
+INSERT INTO own_history_signals_repos (repo_id, failure_count, last_failure_at)
+VALUES (%s, 1, %s)
+ON CONFLICT (repo_id) DO UPDATE SET
+    failure_count = own_history_signals_repos.failure_count + 1,
+    last_failure_at = EXCLUDED.last_failure_at
+`
+
+func (s *ownSignalStore) ListHistorySignals(ctx context.Context, repoID api.RepoID, path string) ([]*types.HistorySignal, error) {
+	q := sqlf.Sprintf(
+		listHistorySignalsQueryFmtStr,
+		sqlf.Join(historySignalsColumnsQueries(), ", "),
+		repoID,
+		path,
+	)
+	return scanHistorySignals(s.Query(ctx, q))
+}
+
+const listHistorySignalsQueryFmtStr = `
+SELECT %s
+FROM own_history_signals
+WHERE repo_id = %s AND path = %s
+ORDER BY
+    recent_commit_count DESC,
+    blame_line_share DESC,
+    author_email ASC
+`
+
+func (s *ownSignalStore) ListHistorySignalsForPaths(ctx context.Context, repoID api.RepoID, paths []string) ([]*types.HistorySignal, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	q := sqlf.Sprintf(
+		listHistorySignalsForPathsQueryFmtStr,
+		sqlf.Join(historySignalsColumnsQueries(), ", "),
+		repoID,
+		pq.Array(paths),
+	)
+	return scanHistorySignals(s.Query(ctx, q))
+}
+
+const listHistorySignalsForPathsQueryFmtStr = `
+SELECT %s
+FROM own_history_signals
+WHERE repo_id = %s AND path = ANY(%s)
+ORDER BY
+    path ASC,
+    recent_commit_count DESC,
+    blame_line_share DESC,
+    author_email ASC
+`
+
+func historySignalsColumnsQueries() []*sqlf.Query {
+	qs := make([]*sqlf.Query, 0, len(historySignalsColumns))
+	for _, c := range historySignalsColumns {
+		qs = append(qs, sqlf.Sprintf(c))
+	}
+	return qs
+}
+
+func (s *ownSignalStore) ListReposForHistorySignals(ctx context.Context, computedBefore time.Time, limit int) ([]api.RepoName, error) {
+	q := sqlf.Sprintf(listReposForHistorySignalsQueryFmtStr, computedBefore, limit)
+	names, err := basestore.ScanStrings(s.Query(ctx, q))
+	if err != nil {
+		return nil, err
+	}
+	repoNames := make([]api.RepoName, 0, len(names))
+	for _, name := range names {
+		repoNames = append(repoNames, api.RepoName(name))
+	}
+	return repoNames, nil
+}
+
+const listReposForHistorySignalsQueryFmtStr = `
+SELECT r.name
+FROM repo r
+JOIN gitserver_repos gr ON gr.repo_id = r.id
+LEFT JOIN own_history_signals_repos ohsr ON ohsr.repo_id = r.id
+WHERE
+    r.deleted_at IS NULL
+    AND r.blocked IS NULL
+    AND gr.clone_status = 'cloned'
+    AND (ohsr.computed_at IS NULL OR ohsr.computed_at < %s)
+    -- Back off failing repos exponentially, from 2 minutes up to 2^10 minutes,
+    -- so that they don't starve the others.
+    AND (ohsr.last_failure_at IS NULL OR ohsr.last_failure_at + POWER(2, LEAST(ohsr.failure_count, 10)) * INTERVAL '1 minute' < NOW())
+ORDER BY GREATEST(ohsr.computed_at, ohsr.last_failure_at) ASC NULLS FIRST, r.id ASC
+LIMIT %s
+`
+
+var scanHistorySignals = basestore.NewSliceScanner(func(s dbutil.Scanner) (*types.HistorySignal, error) {
+	var signal types.HistorySignal
+	var lastCommitAt time.Time
+	if err := s.Scan(
+		&signal.RepoID,
+		&signal.Path,
+		&signal.AuthorName,
+		&signal.AuthorEmail,
+		&signal.RecentCommitCount,
+		&dbutil.NullTime{Time: &lastCommitAt},
+		&signal.BlameLineShare,
+		&signal.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if !lastCommitAt.IsZero() {
+		signal.LastCommitAt = &lastCommitAt
+	}
+	return &signal, nil
+})
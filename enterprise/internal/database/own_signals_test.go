@@ -0,0 +1,150 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
+
+func TestOwnSignals_ReplaceListHistorySignals(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+
+	createRepos(t, ctx, db.Repos(), 1)
+	store := db.OwnSignals()
+	repoID := api.RepoID(1)
+
+	lastCommitAt := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	signals := []*owntypes.HistorySignal{
+		{Path: "README.md", AuthorName: "Alice", AuthorEmail: "alice@example.com", BlameLineShare: 0.25},
+		{Path: "README.md", AuthorName: "Bob", AuthorEmail: "bob@example.com", RecentCommitCount: 3, LastCommitAt: &lastCommitAt, BlameLineShare: 0.75},
+		{Path: "main.go", AuthorName: "Alice", AuthorEmail: "alice@example.com", RecentCommitCount: 1, LastCommitAt: &lastCommitAt},
+	}
+	require.NoError(t, store.ReplaceHistorySignals(ctx, repoID, "deadbeef", signals))
+
+	got, err := store.ListHistorySignals(ctx, repoID, "README.md")
+	require.NoError(t, err)
+	want := []*owntypes.HistorySignal{
+		{RepoID: repoID, Path: "README.md", AuthorName: "Bob", AuthorEmail: "bob@example.com", RecentCommitCount: 3, LastCommitAt: &lastCommitAt, BlameLineShare: 0.75},
+		{RepoID: repoID, Path: "README.md", AuthorName: "Alice", AuthorEmail: "alice@example.com", BlameLineShare: 0.25},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(owntypes.HistorySignal{}, "UpdatedAt")); diff != "" {
+		t.Errorf("unexpected signals (-want +got):\n%s", diff)
+	}
+
+	// Replacing the signals removes the previous ones.
+	require.NoError(t, store.ReplaceHistorySignals(ctx, repoID, "cafebabe", signals[2:]))
+	got, err = store.ListHistorySignals(ctx, repoID, "README.md")
+	require.NoError(t, err)
+	require.Empty(t, got)
+	got, err = store.ListHistorySignals(ctx, repoID, "main.go")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+}
+
+func TestOwnSignals_ListHistorySignalsForPaths(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+
+	createRepos(t, ctx, db.Repos(), 1)
+	store := db.OwnSignals()
+	repoID := api.RepoID(1)
+
+	signals := []*owntypes.HistorySignal{
+		{Path: "main.go", AuthorName: "Alice", AuthorEmail: "alice@example.com", RecentCommitCount: 1},
+		{Path: "README.md", AuthorName: "Alice", AuthorEmail: "alice@example.com", BlameLineShare: 0.25},
+		{Path: "README.md", AuthorName: "Bob", AuthorEmail: "bob@example.com", BlameLineShare: 0.75},
+		{Path: "go.mod", AuthorName: "Bob", AuthorEmail: "bob@example.com", RecentCommitCount: 2},
+	}
+	require.NoError(t, store.ReplaceHistorySignals(ctx, repoID, "deadbeef", signals))
+
+	got, err := store.ListHistorySignalsForPaths(ctx, repoID, []string{"main.go", "README.md", "unknown"})
+	require.NoError(t, err)
+	want := []*owntypes.HistorySignal{
+		{RepoID: repoID, Path: "README.md", AuthorName: "Bob", AuthorEmail: "bob@example.com", BlameLineShare: 0.75},
+		{RepoID: repoID, Path: "README.md", AuthorName: "Alice", AuthorEmail: "alice@example.com", BlameLineShare: 0.25},
+		{RepoID: repoID, Path: "main.go", AuthorName: "Alice", AuthorEmail: "alice@example.com", RecentCommitCount: 1},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(owntypes.HistorySignal{}, "UpdatedAt")); diff != "" {
+		t.Errorf("unexpected signals (-want +got):\n%s", diff)
+	}
+
+	got, err = store.ListHistorySignalsForPaths(ctx, repoID, nil)
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestOwnSignals_ListReposForHistorySignals(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	ctx := context.Background()
+	logger := logtest.NoOp(t)
+	db := NewEnterpriseDB(database.NewDB(logger, dbtest.NewDB(logger, t)))
+
+	createRepos(t, ctx, db.Repos(), 3)
+	// Repo "2" is not cloned, so it is never returned.
+	for _, name := range []api.RepoName{"0", "1"} {
+		require.NoError(t, db.GitserverRepos().SetCloneStatus(ctx, name, types.CloneStatusCloned, "shard"))
+	}
+	store := db.OwnSignals()
+
+	got, err := store.ListReposForHistorySignals(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"0", "1"}, got)
+
+	// Repos for which signals were computed recently are not returned, and
+	// the remaining ones are returned least recently computed first.
+	require.NoError(t, store.ReplaceHistorySignals(ctx, api.RepoID(1), "deadbeef", nil))
+	got, err = store.ListReposForHistorySignals(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"1"}, got)
+
+	got, err = store.ListReposForHistorySignals(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"1", "0"}, got)
+
+	// Repos for which the last attempt failed are backed off, even if they
+	// were never computed successfully.
+	require.NoError(t, store.RecordHistorySignalsFailure(ctx, api.RepoID(2)))
+	got, err = store.ListReposForHistorySignals(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"0"}, got)
+
+	// Once the backoff elapsed, they are returned after the repos that were
+	// attempted before them.
+	_, err = db.ExecContext(ctx, "UPDATE own_history_signals_repos SET last_failure_at = NOW() - INTERVAL '1 hour' WHERE repo_id = 2")
+	require.NoError(t, err)
+	got, err = store.ListReposForHistorySignals(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"1", "0"}, got)
+
+	// A successful computation resets the backoff.
+	require.NoError(t, store.RecordHistorySignalsFailure(ctx, api.RepoID(2)))
+	require.NoError(t, store.ReplaceHistorySignals(ctx, api.RepoID(2), "deadbeef", nil))
+	got, err = store.ListReposForHistorySignals(ctx, time.Now().Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Equal(t, []api.RepoName{"0", "1"}, got)
+}
@@ -0,0 +1,306 @@
+package embeddings
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+
+	"github.com/sourcegraph/conc"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// IVFIndex is an inverted file index over the rows of an EmbeddingIndex. The
+// rows are partitioned into clusters using (spherical) k-means. At search time
+// the query is compared against the cluster centroids first, and only the rows
+// in the closest clusters are scored. This trades a bit of recall for a large
+// reduction in the number of rows that have to be scored.
+type IVFIndex struct {
+	// Centroids contains the normalized centroid of each cluster. It is laid
+	// out the same way as EmbeddingIndex.Embeddings.
+	Centroids []float32
+	// ClusterOffsets[c]:ClusterOffsets[c+1] is the range of RowIDs that
+	// belong to cluster c.
+	ClusterOffsets []int32
+	// RowIDs contains the row indexes of the embedding index, grouped by
+	// cluster.
+	RowIDs []int32
+}
+
+func (ivf *IVFIndex) numClusters() int {
+	return len(ivf.ClusterOffsets) - 1
+}
+
+type ApproximateSearchOptions struct {
+	// MinRows is the minimum number of rows an embedding index must have for an
+	// approximate index to be built and used.
+	MinRows int
+	// NumClusters is the number of clusters to partition the rows into. If
+	// zero, the square root of the number of rows is used.
+	NumClusters int
+	// Probes is the number of clusters searched for each query.
+	Probes int
+}
+
+const (
+	defaultApproximateSearchMinRows = 20_000
+	defaultApproximateSearchProbes  = 8
+
+	// kMeansIterations is the number of refinement iterations when computing
+	// the cluster centroids.
+	kMeansIterations = 10
+	// kMeansMaxTrainingRowsPerCluster bounds the number of rows used to compute
+	// the centroids, so that building the index of a very large repository
+	// remains cheap. All rows are assigned to a cluster afterwards.
+	kMeansMaxTrainingRowsPerCluster = 64
+	// kMeansSeed makes building the index deterministic.
+	kMeansSeed = 42
+)
+
+// ApproximateSearchOptionsFromConfig returns the approximate search options
+// from the site configuration, and whether approximate search is enabled at
+// all.
+func ApproximateSearchOptionsFromConfig(c *schema.Embeddings) (ApproximateSearchOptions, bool) {
+	if c == nil || c.ApproximateSearch == nil || !c.ApproximateSearch.Enabled {
+		return ApproximateSearchOptions{}, false
+	}
+
+	opts := ApproximateSearchOptions{
+		MinRows:     c.ApproximateSearch.MinRows,
+		NumClusters: c.ApproximateSearch.NumClusters,
+		Probes:      c.ApproximateSearch.Probes,
+	}
+	if opts.MinRows <= 0 {
+		opts.MinRows = defaultApproximateSearchMinRows
+	}
+	if opts.Probes <= 0 {
+		opts.Probes = defaultApproximateSearchProbes
+	}
+	return opts, true
+}
+
+// BuildApproximateIndex builds the IVF index for the embedding index. Indexes
+// with fewer than opts.MinRows rows do not get an approximate index, and are
+// searched exhaustively.
+func (index *EmbeddingIndex) BuildApproximateIndex(opts ApproximateSearchOptions, workerOptions WorkerOptions) {
+	numRows := len(index.RowMetadata)
+	if numRows == 0 || numRows < opts.MinRows || index.ColumnDimension == 0 {
+		index.IVF = nil
+		return
+	}
+
+	numClusters := opts.NumClusters
+	if numClusters <= 0 {
+		numClusters = int(math.Sqrt(float64(numRows)))
+	}
+	numClusters = max(1, min(numClusters, numRows))
+
+	centroids := index.trainCentroids(numClusters, workerOptions)
+	assignments := index.assignClusters(centroids, workerOptions)
+
+	clusterSizes := make([]int32, numClusters)
+	for _, c := range assignments {
+		clusterSizes[c]++
+	}
+	offsets := make([]int32, numClusters+1)
+	for c := 0; c < numClusters; c++ {
+		offsets[c+1] = offsets[c] + clusterSizes[c]
+	}
+	next := make([]int32, numClusters)
+	copy(next, offsets[:numClusters])
+	rowIDs := make([]int32, numRows)
+	for row, c := range assignments {
+		rowIDs[next[c]] = int32(row)
+		next[c]++
+	}
+
+	index.IVF = &IVFIndex{
+		Centroids:      centroids,
+		ClusterOffsets: offsets,
+		RowIDs:         rowIDs,
+	}
+}
+
+// trainCentroids computes numClusters centroids with spherical k-means on a
+// sample of the rows.
+func (index *EmbeddingIndex) trainCentroids(numClusters int, workerOptions WorkerOptions) []float32 {
+	dim := index.ColumnDimension
+	numRows := len(index.RowMetadata)
+	rng := rand.New(rand.NewSource(kMeansSeed))
+
+	sample := rng.Perm(numRows)
+	if maxTrainingRows := numClusters * kMeansMaxTrainingRowsPerCluster; len(sample) > maxTrainingRows {
+		sample = sample[:maxTrainingRows]
+	}
+
+	// Initialize the centroids with distinct sampled rows.
+	centroids := make([]float32, numClusters*dim)
+	for c := 0; c < numClusters; c++ {
+		copy(centroids[c*dim:(c+1)*dim], index.row(sample[c%len(sample)]))
+	}
+
+	assignments := make([]int, len(sample))
+	for iter := 0; iter < kMeansIterations; iter++ {
+		forEachRowRange(len(sample), workerOptions, func(start, end int) {
+			for i := start; i < end; i++ {
+				assignments[i] = nearestCentroid(centroids, dim, index.row(sample[i]))
+			}
+		})
+
+		sums := make([]float32, numClusters*dim)
+		counts := make([]int, numClusters)
+		for i, c := range assignments {
+			counts[c]++
+			sum := sums[c*dim : (c+1)*dim]
+			for j, v := range index.row(sample[i]) {
+				sum[j] += v
+			}
+		}
+
+		for c := 0; c < numClusters; c++ {
+			if counts[c] == 0 {
+				// Keep the previous centroid for empty clusters.
+				continue
+			}
+			sum := sums[c*dim : (c+1)*dim]
+			normalize(sum)
+			copy(centroids[c*dim:(c+1)*dim], sum)
+		}
+	}
+
+	return centroids
+}
+
+// assignClusters assigns each row of the index to its nearest centroid.
+func (index *EmbeddingIndex) assignClusters(centroids []float32, workerOptions WorkerOptions) []int32 {
+	numRows := len(index.RowMetadata)
+	assignments := make([]int32, numRows)
+	forEachRowRange(numRows, workerOptions, func(start, end int) {
+		for i := start; i < end; i++ {
+			assignments[i] = int32(nearestCentroid(centroids, index.ColumnDimension, index.row(i)))
+		}
+	})
+	return assignments
+}
+
+// ApproximateSimilaritySearch finds approximately the `numResults` most
+// similar rows to a query vector using the IVF index. It only scores the rows
+// of the `probes` clusters closest to the query. If the embedding index has no
+// IVF index, it falls back to an exhaustive SimilaritySearch.
+func (index *EmbeddingIndex) ApproximateSimilaritySearch(query []float32, numResults int, probes int, workerOptions WorkerOptions, opts SearchOptions) []EmbeddingSearchResult {
+	if index.IVF == nil || index.IVF.numClusters() <= 0 {
+		return index.SimilaritySearch(query, numResults, workerOptions, opts)
+	}
+	if numResults == 0 {
+		return []EmbeddingSearchResult{}
+	}
+
+	clusters := index.IVF.closestClusters(query, index.ColumnDimension, max(1, probes))
+
+	var rowIDs []int32
+	for _, c := range clusters {
+		rowIDs = append(rowIDs, index.IVF.RowIDs[index.IVF.ClusterOffsets[c]:index.IVF.ClusterOffsets[c+1]]...)
+	}
+
+	// Score the rows of the probed clusters concurrently, keeping the best
+	// neighbors of each range of rows.
+	var mu sync.Mutex
+	var neighbors []nearestNeighbor
+	forEachRowRange(len(rowIDs), workerOptions, func(start, end int) {
+		nnHeap := newNearestNeighborsHeap()
+		for _, rowID := range rowIDs[start:end] {
+			i := int(rowID)
+			score, debugInfo := index.score(query, i, opts)
+			if nnHeap.Len() < numResults {
+				heap.Push(nnHeap, nearestNeighbor{index: i, score: score, debug: debugInfo})
+			} else if score > nnHeap.Peek().score {
+				heap.Pop(nnHeap)
+				heap.Push(nnHeap, nearestNeighbor{index: i, score: score, debug: debugInfo})
+			}
+		}
+
+		mu.Lock()
+		neighbors = append(neighbors, nnHeap.neighbors...)
+		mu.Unlock()
+	})
+
+	sort.Slice(neighbors, func(i, j int) bool { return neighbors[i].score > neighbors[j].score })
+	neighbors = neighbors[:min(numResults, len(neighbors))]
+
+	results := make([]EmbeddingSearchResult, len(neighbors))
+	for idx, neighbor := range neighbors {
+		results[idx] = EmbeddingSearchResult{
+			RepoEmbeddingRowMetadata: index.RowMetadata[neighbor.index],
+			Debug:                    neighbor.debug,
+		}
+	}
+	return results
+}
+
+// closestClusters returns the IDs of the n clusters whose centroids are the
+// most similar to the query.
+func (ivf *IVFIndex) closestClusters(query []float32, dim int, n int) []int {
+	numClusters := ivf.numClusters()
+	n = min(n, numClusters)
+
+	similarities := make([]float32, numClusters)
+	ids := make([]int, numClusters)
+	for c := 0; c < numClusters; c++ {
+		similarities[c] = CosineSimilarity(ivf.Centroids[c*dim:(c+1)*dim], query)
+		ids[c] = c
+	}
+	sort.Slice(ids, func(i, j int) bool { return similarities[ids[i]] > similarities[ids[j]] })
+	return ids[:n]
+}
+
+// row returns the embedding of the i-th row. Rows of quantized indexes are
+// dequantized into a new slice.
+func (index *EmbeddingIndex) row(i int) []float32 {
+	if index.IsQuantized() {
+		return index.dequantizeRow(i)
+	}
+	return index.Embeddings[i*index.ColumnDimension : (i+1)*index.ColumnDimension]
+}
+
+func nearestCentroid(centroids []float32, dim int, row []float32) int {
+	best, bestSimilarity := 0, float32(math.Inf(-1))
+	for c := 0; c < len(centroids)/dim; c++ {
+		if similarity := CosineSimilarity(centroids[c*dim:(c+1)*dim], row); similarity > bestSimilarity {
+			best, bestSimilarity = c, similarity
+		}
+	}
+	return best
+}
+
+func normalize(v []float32) {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range v {
+		v[i] *= scale
+	}
+}
+
+// forEachRowRange splits numRows among the workers and calls fn for each range
+// of rows concurrently.
+func forEachRowRange(numRows int, workerOptions WorkerOptions, fn func(start, end int)) {
+	rowsPerWorker := splitRows(numRows, max(1, workerOptions.NumWorkers), workerOptions.MinRowsToSplit)
+	if len(rowsPerWorker) == 1 {
+		fn(rowsPerWorker[0].start, rowsPerWorker[0].end)
+		return
+	}
+
+	var wg conc.WaitGroup
+	for _, rows := range rowsPerWorker {
+		rows := rows
+		wg.Go(func() { fn(rows.start, rows.end) })
+	}
+	wg.Wait()
+}
@@ -0,0 +1,141 @@
+package embeddings
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func getNormalizedMockEmbeddingIndex(prng *rand.Rand, numRows int, columnDimension int) *EmbeddingIndex {
+	index := &EmbeddingIndex{
+		Embeddings:      make([]float32, 0, numRows*columnDimension),
+		ColumnDimension: columnDimension,
+		RowMetadata:     make([]RepoEmbeddingRowMetadata, numRows),
+	}
+	for i := 0; i < numRows; i++ {
+		row := getRandomEmbeddings(prng, columnDimension)
+		normalize(row)
+		index.Embeddings = append(index.Embeddings, row...)
+		index.RowMetadata[i] = RepoEmbeddingRowMetadata{FileName: fmt.Sprintf("%d", i)}
+	}
+	return index
+}
+
+func TestApproximateSearchOptionsFromConfig(t *testing.T) {
+	_, ok := ApproximateSearchOptionsFromConfig(nil)
+	require.False(t, ok)
+
+	_, ok = ApproximateSearchOptionsFromConfig(&schema.Embeddings{ApproximateSearch: &schema.EmbeddingsApproximateSearch{Enabled: false}})
+	require.False(t, ok)
+
+	opts, ok := ApproximateSearchOptionsFromConfig(&schema.Embeddings{ApproximateSearch: &schema.EmbeddingsApproximateSearch{Enabled: true}})
+	require.True(t, ok)
+	require.Equal(t, ApproximateSearchOptions{MinRows: defaultApproximateSearchMinRows, Probes: defaultApproximateSearchProbes}, opts)
+
+	opts, ok = ApproximateSearchOptionsFromConfig(&schema.Embeddings{ApproximateSearch: &schema.EmbeddingsApproximateSearch{Enabled: true, MinRows: 10, NumClusters: 4, Probes: 2}})
+	require.True(t, ok)
+	require.Equal(t, ApproximateSearchOptions{MinRows: 10, NumClusters: 4, Probes: 2}, opts)
+}
+
+func TestBuildApproximateIndex(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	numRows := 500
+
+	t.Run("below min rows", func(t *testing.T) {
+		index := getNormalizedMockEmbeddingIndex(prng, numRows, 8)
+		index.BuildApproximateIndex(ApproximateSearchOptions{MinRows: numRows + 1}, WorkerOptions{NumWorkers: 2})
+		require.Nil(t, index.IVF)
+	})
+
+	t.Run("every row is assigned to exactly one cluster", func(t *testing.T) {
+		index := getNormalizedMockEmbeddingIndex(prng, numRows, 8)
+		index.BuildApproximateIndex(ApproximateSearchOptions{NumClusters: 10}, WorkerOptions{NumWorkers: 3})
+		require.NotNil(t, index.IVF)
+		require.Equal(t, 10, index.IVF.numClusters())
+		require.Len(t, index.IVF.Centroids, 10*8)
+		require.Equal(t, int32(numRows), index.IVF.ClusterOffsets[10])
+
+		rowIDs := make([]int, len(index.IVF.RowIDs))
+		for i, id := range index.IVF.RowIDs {
+			rowIDs[i] = int(id)
+		}
+		sort.Ints(rowIDs)
+		for i, id := range rowIDs {
+			require.Equal(t, i, id)
+		}
+	})
+}
+
+func TestApproximateSimilaritySearch(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	numRows, columnDimension, numResults := 2000, 16, 10
+	workerOptions := WorkerOptions{NumWorkers: 4, MinRowsToSplit: 100}
+
+	index := getNormalizedMockEmbeddingIndex(prng, numRows, columnDimension)
+	query := getRandomEmbeddings(prng, columnDimension)
+	normalize(query)
+
+	exact := index.SimilaritySearch(query, numResults, workerOptions, SearchOptions{})
+
+	t.Run("falls back to exhaustive search without approximate index", func(t *testing.T) {
+		require.Equal(t, exact, index.ApproximateSimilaritySearch(query, numResults, 1, workerOptions, SearchOptions{}))
+	})
+
+	index.BuildApproximateIndex(ApproximateSearchOptions{NumClusters: 20}, workerOptions)
+	require.NotNil(t, index.IVF)
+
+	t.Run("probing all clusters is exhaustive", func(t *testing.T) {
+		require.Equal(t, exact, index.ApproximateSimilaritySearch(query, numResults, 20, workerOptions, SearchOptions{}))
+	})
+
+	t.Run("probing a subset of clusters", func(t *testing.T) {
+		results := index.ApproximateSimilaritySearch(query, numResults, 5, workerOptions, SearchOptions{})
+		require.Len(t, results, numResults)
+
+		expected := map[string]struct{}{}
+		for _, r := range exact {
+			expected[r.FileName] = struct{}{}
+		}
+		found := 0
+		for _, r := range results {
+			if _, ok := expected[r.FileName]; ok {
+				found++
+			}
+		}
+		// Searching a quarter of the clusters should still find most of the
+		// nearest neighbors.
+		require.GreaterOrEqual(t, found, numResults/2)
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		require.Empty(t, index.ApproximateSimilaritySearch(query, 0, 5, workerOptions, SearchOptions{}))
+	})
+}
+
+func BenchmarkApproximateSimilaritySearch(b *testing.B) {
+	prng := rand.New(rand.NewSource(0))
+
+	numRows := 100_000
+	numResults := 100
+	columnDimension := 1536
+	index := getNormalizedMockEmbeddingIndex(prng, numRows, columnDimension)
+	query := getRandomEmbeddings(prng, columnDimension)
+	normalize(query)
+
+	index.BuildApproximateIndex(ApproximateSearchOptions{}, WorkerOptions{NumWorkers: 8})
+
+	b.ResetTimer()
+
+	for _, probes := range []int{1, 8, 32} {
+		b.Run(fmt.Sprintf("probes=%d", probes), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_ = index.ApproximateSimilaritySearch(query, numResults, probes, WorkerOptions{NumWorkers: 1}, SearchOptions{})
+			}
+		})
+	}
+}
@@ -57,17 +57,19 @@ func scanContextDetectionEmbeddingJob(s dbutil.Scanner) (*ContextDetectionEmbedd
 	return &job, nil
 }
 
+var ContextDetectionEmbeddingJobWorkerStoreOptions = dbworkerstore.Options[*ContextDetectionEmbeddingJob]{
+	Name:              "context_detection_embedding_job_worker",
+	TableName:         "context_detection_embedding_jobs",
+	ColumnExpressions: repoEmbeddingJobsColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanContextDetectionEmbeddingJob),
+	OrderByExpression: sqlf.Sprintf("context_detection_embedding_jobs.queued_at, context_detection_embedding_jobs.id"),
+	StalledMaxAge:     time.Second * 60,
+	MaxNumResets:      5,
+	MaxNumRetries:     1,
+}
+
 func NewContextDetectionEmbeddingJobWorkerStore(observationCtx *observation.Context, dbHandle basestore.TransactableHandle) dbworkerstore.Store[*ContextDetectionEmbeddingJob] {
-	return dbworkerstore.New(observationCtx, dbHandle, dbworkerstore.Options[*ContextDetectionEmbeddingJob]{
-		Name:              "context_detection_embedding_job_worker",
-		TableName:         "context_detection_embedding_jobs",
-		ColumnExpressions: repoEmbeddingJobsColumns,
-		Scan:              dbworkerstore.BuildWorkerScan(scanContextDetectionEmbeddingJob),
-		OrderByExpression: sqlf.Sprintf("context_detection_embedding_jobs.queued_at, context_detection_embedding_jobs.id"),
-		StalledMaxAge:     time.Second * 60,
-		MaxNumResets:      5,
-		MaxNumRetries:     1,
-	})
+	return dbworkerstore.New(observationCtx, dbHandle, ContextDetectionEmbeddingJobWorkerStoreOptions)
 }
 
 type ContextDetectionEmbeddingJobsStore interface {
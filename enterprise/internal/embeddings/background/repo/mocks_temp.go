@@ -47,6 +47,10 @@ type MockRepoEmbeddingJobsStore struct {
 	// ListRepoEmbeddingJobsFunc is an instance of a mock function object
 	// controlling the behavior of the method ListRepoEmbeddingJobs.
 	ListRepoEmbeddingJobsFunc *RepoEmbeddingJobsStoreListRepoEmbeddingJobsFunc
+	// MarkRepoEmbeddingJobIncrementalFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// MarkRepoEmbeddingJobIncremental.
+	MarkRepoEmbeddingJobIncrementalFunc *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc
 	// TransactFunc is an instance of a mock function object controlling the
 	// behavior of the method Transact.
 	TransactFunc *RepoEmbeddingJobsStoreTransactFunc
@@ -97,6 +101,11 @@ func NewMockRepoEmbeddingJobsStore() *MockRepoEmbeddingJobsStore {
 				return
 			},
 		},
+		MarkRepoEmbeddingJobIncrementalFunc: &RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc{
+			defaultHook: func(context.Context, int) (r0 error) {
+				return
+			},
+		},
 		TransactFunc: &RepoEmbeddingJobsStoreTransactFunc{
 			defaultHook: func(context.Context) (r0 RepoEmbeddingJobsStore, r1 error) {
 				return
@@ -150,6 +159,11 @@ func NewStrictMockRepoEmbeddingJobsStore() *MockRepoEmbeddingJobsStore {
 				panic("unexpected invocation of MockRepoEmbeddingJobsStore.ListRepoEmbeddingJobs")
 			},
 		},
+		MarkRepoEmbeddingJobIncrementalFunc: &RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc{
+			defaultHook: func(context.Context, int) error {
+				panic("unexpected invocation of MockRepoEmbeddingJobsStore.MarkRepoEmbeddingJobIncremental")
+			},
+		},
 		TransactFunc: &RepoEmbeddingJobsStoreTransactFunc{
 			defaultHook: func(context.Context) (RepoEmbeddingJobsStore, error) {
 				panic("unexpected invocation of MockRepoEmbeddingJobsStore.Transact")
@@ -187,6 +201,9 @@ func NewMockRepoEmbeddingJobsStoreFrom(i RepoEmbeddingJobsStore) *MockRepoEmbedd
 		ListRepoEmbeddingJobsFunc: &RepoEmbeddingJobsStoreListRepoEmbeddingJobsFunc{
 			defaultHook: i.ListRepoEmbeddingJobs,
 		},
+		MarkRepoEmbeddingJobIncrementalFunc: &RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc{
+			defaultHook: i.MarkRepoEmbeddingJobIncremental,
+		},
 		TransactFunc: &RepoEmbeddingJobsStoreTransactFunc{
 			defaultHook: i.Transact,
 		},
@@ -1066,6 +1083,117 @@ func (c RepoEmbeddingJobsStoreListRepoEmbeddingJobsFuncCall) Results() []interfa
 	return []interface{}{c.Result0, c.Result1}
 }
 
+// RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc describes the
+// behavior when the MarkRepoEmbeddingJobIncremental method of the parent
+// MockRepoEmbeddingJobsStore instance is invoked.
+type RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc struct {
+	defaultHook func(context.Context, int) error
+	hooks       []func(context.Context, int) error
+	history     []RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall
+	mutex       sync.Mutex
+}
+
+// MarkRepoEmbeddingJobIncremental delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockRepoEmbeddingJobsStore) MarkRepoEmbeddingJobIncremental(v0 context.Context, v1 int) error {
+	r0 := m.MarkRepoEmbeddingJobIncrementalFunc.nextHook()(v0, v1)
+	m.MarkRepoEmbeddingJobIncrementalFunc.appendCall(RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// MarkRepoEmbeddingJobIncremental method of the parent
+// MockRepoEmbeddingJobsStore instance is invoked and the hook queue is
+// empty.
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) SetDefaultHook(hook func(context.Context, int) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// MarkRepoEmbeddingJobIncremental method of the parent
+// MockRepoEmbeddingJobsStore instance invokes the hook at the front of the
+// queue and discards it. After the queue is empty, the default hook
+// function is invoked for any future action.
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) PushHook(hook func(context.Context, int) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, int) error {
+		return r0
+	})
+}
+
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) nextHook() func(context.Context, int) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) appendCall(r0 RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall objects
+// describing the invocations of this function.
+func (f *RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFunc) History() []RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall {
+	f.mutex.Lock()
+	history := make([]RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall is an
+// object that describes an invocation of method
+// MarkRepoEmbeddingJobIncremental on an instance of
+// MockRepoEmbeddingJobsStore.
+type RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c RepoEmbeddingJobsStoreMarkRepoEmbeddingJobIncrementalFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
 // RepoEmbeddingJobsStoreTransactFunc describes the behavior when the
 // Transact method of the parent MockRepoEmbeddingJobsStore instance is
 // invoked.
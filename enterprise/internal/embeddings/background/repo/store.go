@@ -46,6 +46,7 @@ var repoEmbeddingJobsColumns = []*sqlf.Query{
 	sqlf.Sprintf("repo_embedding_jobs.cancel"),
 	sqlf.Sprintf("repo_embedding_jobs.repo_id"),
 	sqlf.Sprintf("repo_embedding_jobs.revision"),
+	sqlf.Sprintf("repo_embedding_jobs.is_incremental"),
 }
 
 func scanRepoEmbeddingJob(s dbutil.Scanner) (*RepoEmbeddingJob, error) {
@@ -68,6 +69,7 @@ func scanRepoEmbeddingJob(s dbutil.Scanner) (*RepoEmbeddingJob, error) {
 		&job.Cancel,
 		&job.RepoID,
 		&job.Revision,
+		&job.IsIncremental,
 	); err != nil {
 		return nil, err
 	}
@@ -75,17 +77,19 @@ func scanRepoEmbeddingJob(s dbutil.Scanner) (*RepoEmbeddingJob, error) {
 	return &job, nil
 }
 
+var RepoEmbeddingJobWorkerStoreOptions = dbworkerstore.Options[*RepoEmbeddingJob]{
+	Name:              "repo_embedding_job_worker",
+	TableName:         "repo_embedding_jobs",
+	ColumnExpressions: repoEmbeddingJobsColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanRepoEmbeddingJob),
+	OrderByExpression: sqlf.Sprintf("repo_embedding_jobs.queued_at, repo_embedding_jobs.id"),
+	StalledMaxAge:     time.Second * 60,
+	MaxNumResets:      5,
+	MaxNumRetries:     1,
+}
+
 func NewRepoEmbeddingJobWorkerStore(observationCtx *observation.Context, dbHandle basestore.TransactableHandle) dbworkerstore.Store[*RepoEmbeddingJob] {
-	return dbworkerstore.New(observationCtx, dbHandle, dbworkerstore.Options[*RepoEmbeddingJob]{
-		Name:              "repo_embedding_job_worker",
-		TableName:         "repo_embedding_jobs",
-		ColumnExpressions: repoEmbeddingJobsColumns,
-		Scan:              dbworkerstore.BuildWorkerScan(scanRepoEmbeddingJob),
-		OrderByExpression: sqlf.Sprintf("repo_embedding_jobs.queued_at, repo_embedding_jobs.id"),
-		StalledMaxAge:     time.Second * 60,
-		MaxNumResets:      5,
-		MaxNumRetries:     1,
-	})
+	return dbworkerstore.New(observationCtx, dbHandle, RepoEmbeddingJobWorkerStoreOptions)
 }
 
 type RepoEmbeddingJobsStore interface {
@@ -98,6 +102,7 @@ type RepoEmbeddingJobsStore interface {
 	CreateRepoEmbeddingJob(ctx context.Context, repoID api.RepoID, revision api.CommitID) (int, error)
 	GetLastCompletedRepoEmbeddingJob(ctx context.Context, repoID api.RepoID) (*RepoEmbeddingJob, error)
 	GetLastRepoEmbeddingJobForRevision(ctx context.Context, repoID api.RepoID, revision api.CommitID) (*RepoEmbeddingJob, error)
+	MarkRepoEmbeddingJobIncremental(ctx context.Context, id int) error
 	ListRepoEmbeddingJobs(ctx context.Context, args *database.PaginationArgs) ([]*RepoEmbeddingJob, error)
 	CountRepoEmbeddingJobs(ctx context.Context) (int, error)
 }
@@ -162,6 +167,12 @@ func (s *repoEmbeddingJobsStore) GetLastRepoEmbeddingJobForRevision(ctx context.
 	return job, nil
 }
 
+const markRepoEmbeddingJobIncrementalFmtStr = `UPDATE repo_embedding_jobs SET is_incremental = TRUE WHERE id = %s`
+
+func (s *repoEmbeddingJobsStore) MarkRepoEmbeddingJobIncremental(ctx context.Context, id int) error {
+	return s.Exec(ctx, sqlf.Sprintf(markRepoEmbeddingJobIncrementalFmtStr, id))
+}
+
 const countRepoEmbeddingJobsQuery = `
 SELECT COUNT(*)
 FROM repo_embedding_jobs
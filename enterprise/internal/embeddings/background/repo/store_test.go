@@ -70,4 +70,13 @@ func TestRepoEmbeddingJobsStore(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, id2, lastCompletedJob.ID)
+	require.False(t, lastCompletedJob.IsIncremental)
+
+	// Mark the second job as incremental.
+	err = store.MarkRepoEmbeddingJobIncremental(ctx, id2)
+	require.NoError(t, err)
+	lastCompletedJob, err = store.GetLastCompletedRepoEmbeddingJob(ctx, createdRepo.ID)
+	require.NoError(t, err)
+
+	require.True(t, lastCompletedJob.IsIncremental)
 }
@@ -24,6 +24,9 @@ type RepoEmbeddingJob struct {
 
 	RepoID   api.RepoID
 	Revision api.CommitID
+	// IsIncremental is true if the job updated the index of a previous revision
+	// instead of embedding the whole repository.
+	IsIncremental bool
 }
 
 func (j *RepoEmbeddingJob) RecordID() int {
@@ -1,54 +1,40 @@
 package embed
 
 import (
-	"bytes"
-	"encoding/json"
-	"io"
 	"math"
-	"net/http"
-	"sort"
-	"strings"
 	"sync"
 	"time"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client/openai"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client/selfhosted"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/sourcegraph/sourcegraph/schema"
 )
 
-type EmbeddingAPIRequest struct {
-	Model string   `json:"model"`
-	Input []string `json:"input"`
-}
-
-type EmbeddingAPIResponse struct {
-	Data []struct {
-		Index     int       `json:"index"`
-		Embedding []float32 `json:"embedding"`
-	} `json:"data"`
-}
-
 type EmbeddingsClient interface {
 	GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error)
 	GetDimensions() (int, error)
 }
 
 func NewEmbeddingsClient() EmbeddingsClient {
-	client := &embeddingsClient{config: conf.Get().Embeddings}
+	c := &embeddingsClient{}
+	c.setConfig(conf.Get().Embeddings)
 
-	mu := sync.Mutex{}
 	conf.Watch(func() {
-		mu.Lock()
-		defer mu.Unlock()
-		client.setConfig(conf.Get().Embeddings)
+		c.setConfig(conf.Get().Embeddings)
 	})
 
-	return client
+	return c
 }
 
 type embeddingsClient struct {
+	mu     sync.RWMutex
 	config *schema.Embeddings
+	client client.Client
+	err    error
 }
 
 func (c *embeddingsClient) isDisabled() bool {
@@ -56,10 +42,35 @@ func (c *embeddingsClient) isDisabled() bool {
 }
 
 func (c *embeddingsClient) setConfig(config *schema.Embeddings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.config = config
+	c.client, c.err = nil, nil
+	if !c.isDisabled() {
+		c.client, c.err = GetProviderClient(httpcli.ExternalDoer, config)
+	}
+}
+
+// GetProviderClient returns the client for the embeddings API configured in
+// the embeddings site configuration.
+func GetProviderClient(cli httpcli.Doer, config *schema.Embeddings) (client.Client, error) {
+	switch config.Provider {
+	case "openai", "":
+		return openai.NewClient(cli, config), nil
+	case "azure-openai":
+		return openai.NewAzureClient(cli, config), nil
+	case "self-hosted":
+		return selfhosted.NewClient(cli, config), nil
+	default:
+		return nil, errors.Newf("unknown embeddings provider: %s", config.Provider)
+	}
 }
 
 func (c *embeddingsClient) GetDimensions() (int, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
 	if c.isDisabled() {
 		return -1, errors.New("embeddings are not configured or disabled")
 	}
@@ -70,17 +81,27 @@ func (c *embeddingsClient) GetDimensions() (int, error) {
 // In case of failure, it retries the embedding procedure up to maxRetries. This due to the OpenAI API which
 // often hangs up when downloading large embedding responses.
 func (c *embeddingsClient) GetEmbeddingsWithRetries(texts []string, maxRetries int) ([]float32, error) {
-	if c.isDisabled() {
+	c.mu.RLock()
+	isDisabled, providerClient, clientErr, dimensions := c.isDisabled(), c.client, c.err, 0
+	if !isDisabled {
+		dimensions = c.config.Dimensions
+	}
+	c.mu.RUnlock()
+
+	if isDisabled {
 		return nil, errors.New("embeddings are not configured or disabled")
 	}
+	if clientErr != nil {
+		return nil, clientErr
+	}
 
-	embeddings, err := getEmbeddings(texts, c.config)
+	embeddings, err := getEmbeddings(providerClient, texts, dimensions)
 	if err == nil {
 		return embeddings, nil
 	}
 
 	for i := 0; i < maxRetries; i++ {
-		embeddings, err = getEmbeddings(texts, c.config)
+		embeddings, err = getEmbeddings(providerClient, texts, dimensions)
 		if err == nil {
 			return embeddings, nil
 		} else {
@@ -93,51 +114,15 @@ func (c *embeddingsClient) GetEmbeddingsWithRetries(texts []string, maxRetries i
 	return nil, err
 }
 
-func getEmbeddings(texts []string, config *schema.Embeddings) ([]float32, error) {
-	// Replace newlines, which can negatively affect performance.
-	augmentedTexts := make([]string, len(texts))
-	for idx, text := range texts {
-		augmentedTexts[idx] = strings.ReplaceAll(text, "\n", " ")
-	}
-
-	request := EmbeddingAPIRequest{Model: config.Model, Input: augmentedTexts}
-
-	bodyBytes, err := json.Marshal(request)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", config.Url, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+config.AccessToken)
-
-	resp, err := httpcli.ExternalDoer.Do(req)
+func getEmbeddings(providerClient client.Client, texts []string, dimensions int) ([]float32, error) {
+	embeddings, err := providerClient.GetEmbeddings(texts)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, errors.Errorf("embeddings: %s %q: failed with status %d: %s", req.Method, req.URL.String(), resp.StatusCode, string(respBody))
-	}
-
-	var response EmbeddingAPIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, err
-	}
-
-	// Ensure embedding responses are sorted in the original order.
-	sort.Slice(response.Data, func(i, j int) bool {
-		return response.Data[i].Index < response.Data[j].Index
-	})
 
-	embeddings := make([]float32, 0, len(response.Data)*config.Dimensions)
-	for _, embedding := range response.Data {
-		embeddings = append(embeddings, embedding.Embedding...)
+	// Guard against a misconfigured number of dimensions, which would corrupt the index.
+	if len(embeddings) != len(texts)*dimensions {
+		return nil, errors.Errorf("embeddings: expected %d values for %d texts with %d dimensions, got %d", len(texts)*dimensions, len(texts), dimensions, len(embeddings))
 	}
 	return embeddings, nil
 }
@@ -0,0 +1,40 @@
+package embed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+func TestGetProviderClient(t *testing.T) {
+	for _, provider := range []string{"", "openai", "azure-openai", "self-hosted"} {
+		t.Run(provider, func(t *testing.T) {
+			client, err := GetProviderClient(httpcli.ExternalDoer, &schema.Embeddings{Provider: provider})
+			require.NoError(t, err)
+			require.NotNil(t, client)
+		})
+	}
+
+	_, err := GetProviderClient(httpcli.ExternalDoer, &schema.Embeddings{Provider: "unknown"})
+	require.Error(t, err)
+}
+
+type mockProviderClient struct {
+	embeddings []float32
+}
+
+func (c *mockProviderClient) GetEmbeddings(texts []string) ([]float32, error) {
+	return c.embeddings, nil
+}
+
+func TestGetEmbeddingsChecksDimensions(t *testing.T) {
+	embeddings, err := getEmbeddings(&mockProviderClient{embeddings: []float32{1, 0, 0, 1}}, []string{"a", "b"}, 2)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 0, 0, 1}, embeddings)
+
+	_, err = getEmbeddings(&mockProviderClient{embeddings: []float32{1, 0, 0}}, []string{"a", "b"}, 2)
+	require.Error(t, err)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Client fetches embeddings from an embeddings API.
+type Client interface {
+	// GetEmbeddings returns the embeddings of the given texts, concatenated into
+	// a single slice in the order of the texts.
+	GetEmbeddings(texts []string) ([]float32, error)
+}
+
+// CheckResponseStatus returns an error including the response body if the
+// request to the embeddings API failed.
+func CheckResponseStatus(req *http.Request, resp *http.Response) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	return errors.Errorf("embeddings: %s %q: failed with status %d: %s", req.Method, req.URL.String(), resp.StatusCode, string(respBody))
+}
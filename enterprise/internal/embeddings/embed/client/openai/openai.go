@@ -0,0 +1,178 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type EmbeddingAPIRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type EmbeddingAPIResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// defaultModel is the OpenAI embeddings model used if none is configured.
+const defaultModel = "text-embedding-ada-002"
+
+// defaultAzureAPIVersion is the Azure OpenAI API version used if none is
+// configured.
+const defaultAzureAPIVersion = "2023-05-15"
+
+// azureMaxInputsPerRequest is the maximum number of texts Azure OpenAI
+// deployments accept in a single embeddings request.
+const azureMaxInputsPerRequest = 16
+
+type openAIEmbeddingsClient struct {
+	cli         httpcli.Doer
+	url         string
+	accessToken string
+	model       string
+	dimensions  int
+}
+
+// NewClient returns a client for the OpenAI embeddings API.
+func NewClient(cli httpcli.Doer, config *schema.Embeddings) client.Client {
+	model := config.Model
+	if model == "" {
+		model = defaultModel
+	}
+	return &openAIEmbeddingsClient{
+		cli:         cli,
+		url:         config.Url,
+		accessToken: config.AccessToken,
+		model:       model,
+		dimensions:  config.Dimensions,
+	}
+}
+
+func (c *openAIEmbeddingsClient) GetEmbeddings(texts []string) ([]float32, error) {
+	return getEmbeddings(c.cli, EmbeddingAPIRequest{Model: c.model, Input: replaceNewlines(texts)}, c.dimensions, func(body []byte) (*http.Request, error) {
+		req, err := http.NewRequest("POST", c.url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+		return req, nil
+	})
+}
+
+type azureOpenAIEmbeddingsClient struct {
+	cli         httpcli.Doer
+	url         string
+	accessToken string
+	apiVersion  string
+	dimensions  int
+}
+
+// NewAzureClient returns a client for an Azure OpenAI embeddings deployment. The
+// configured URL is the URL of the embeddings endpoint of the deployment, e.g.
+// https://<resource>.openai.azure.com/openai/deployments/<deployment>/embeddings.
+func NewAzureClient(cli httpcli.Doer, config *schema.Embeddings) client.Client {
+	apiVersion := config.ApiVersion
+	if apiVersion == "" {
+		apiVersion = defaultAzureAPIVersion
+	}
+	return &azureOpenAIEmbeddingsClient{
+		cli:         cli,
+		url:         config.Url,
+		accessToken: config.AccessToken,
+		apiVersion:  apiVersion,
+		dimensions:  config.Dimensions,
+	}
+}
+
+func (c *azureOpenAIEmbeddingsClient) GetEmbeddings(texts []string) ([]float32, error) {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing Azure OpenAI deployment URL")
+	}
+	q := u.Query()
+	q.Set("api-version", c.apiVersion)
+	u.RawQuery = q.Encode()
+
+	embeddings := make([]float32, 0, len(texts)*c.dimensions)
+	for start := 0; start < len(texts); start += azureMaxInputsPerRequest {
+		end := start + azureMaxInputsPerRequest
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		// The model is determined by the deployment, so it is not part of the request.
+		batchEmbeddings, err := getEmbeddings(c.cli, EmbeddingAPIRequest{Input: replaceNewlines(texts[start:end])}, c.dimensions, func(body []byte) (*http.Request, error) {
+			req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("api-key", c.accessToken)
+			return req, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, batchEmbeddings...)
+	}
+	return embeddings, nil
+}
+
+// replaceNewlines replaces newlines, which can negatively affect performance.
+func replaceNewlines(texts []string) []string {
+	augmentedTexts := make([]string, len(texts))
+	for idx, text := range texts {
+		augmentedTexts[idx] = strings.ReplaceAll(text, "\n", " ")
+	}
+	return augmentedTexts
+}
+
+func getEmbeddings(cli httpcli.Doer, request EmbeddingAPIRequest, dimensions int, newRequest func(body []byte) (*http.Request, error)) ([]float32, error) {
+	bodyBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := newRequest(bodyBytes)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := client.CheckResponseStatus(req, resp); err != nil {
+		return nil, err
+	}
+
+	var response EmbeddingAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+
+	// Ensure embedding responses are sorted in the original order.
+	sort.Slice(response.Data, func(i, j int) bool {
+		return response.Data[i].Index < response.Data[j].Index
+	})
+
+	embeddings := make([]float32, 0, len(response.Data)*dimensions)
+	for _, embedding := range response.Data {
+		embeddings = append(embeddings, embedding.Embedding...)
+	}
+	return embeddings, nil
+}
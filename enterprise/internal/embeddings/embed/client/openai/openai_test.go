@@ -0,0 +1,117 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type mockDoer struct {
+	do func(*http.Request) (*http.Response, error)
+}
+
+func (c *mockDoer) Do(r *http.Request) (*http.Response, error) {
+	return c.do(r)
+}
+
+// newMockDoer returns a doer that responds with a one-dimensional embedding
+// for each input, whose value is the index of the input in the request. The
+// response data is returned in reverse order.
+func newMockDoer(t *testing.T, requests *[]*http.Request) *mockDoer {
+	return &mockDoer{func(r *http.Request) (*http.Response, error) {
+		*requests = append(*requests, r)
+
+		var request EmbeddingAPIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		var response EmbeddingAPIResponse
+		for i := len(request.Input) - 1; i >= 0; i-- {
+			response.Data = append(response.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Index: i, Embedding: []float32{float32(i)}})
+		}
+		body, err := json.Marshal(response)
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	}}
+}
+
+func TestOpenAIClient(t *testing.T) {
+	var requests []*http.Request
+	client := NewClient(newMockDoer(t, &requests), &schema.Embeddings{
+		Url:         "https://api.openai.com/v1/embeddings",
+		AccessToken: "token",
+		Model:       "text-embedding-ada-002",
+		Dimensions:  1,
+	})
+
+	embeddings, err := client.GetEmbeddings([]string{"a", "b\nc", "d"})
+	require.NoError(t, err)
+	require.Equal(t, []float32{0, 1, 2}, embeddings)
+
+	require.Len(t, requests, 1)
+	require.Equal(t, "https://api.openai.com/v1/embeddings", requests[0].URL.String())
+	require.Equal(t, "Bearer token", requests[0].Header.Get("Authorization"))
+}
+
+func TestOpenAIClientDefaultModel(t *testing.T) {
+	var models []string
+	client := NewClient(&mockDoer{func(r *http.Request) (*http.Response, error) {
+		var request EmbeddingAPIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		models = append(models, request.Model)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader([]byte(`{"data":[{"index":0,"embedding":[1]}]}`)))}, nil
+	}}, &schema.Embeddings{Url: "https://api.openai.com/v1/embeddings", Dimensions: 1})
+
+	_, err := client.GetEmbeddings([]string{"a"})
+	require.NoError(t, err)
+	require.Equal(t, []string{defaultModel}, models)
+}
+
+func TestAzureOpenAIClient(t *testing.T) {
+	var requests []*http.Request
+	client := NewAzureClient(newMockDoer(t, &requests), &schema.Embeddings{
+		Url:         "https://example.openai.azure.com/openai/deployments/embeddings/embeddings",
+		AccessToken: "key",
+		Dimensions:  1,
+	})
+
+	texts := make([]string, azureMaxInputsPerRequest+4)
+	for i := range texts {
+		texts[i] = fmt.Sprintf("text %d", i)
+	}
+	embeddings, err := client.GetEmbeddings(texts)
+	require.NoError(t, err)
+
+	// The texts are sent in two batches, and the indexes restart in each batch.
+	expected := []float32{}
+	for i := 0; i < azureMaxInputsPerRequest; i++ {
+		expected = append(expected, float32(i))
+	}
+	expected = append(expected, 0, 1, 2, 3)
+	require.Equal(t, expected, embeddings)
+
+	require.Len(t, requests, 2)
+	for _, r := range requests {
+		require.Equal(t, "https://example.openai.azure.com/openai/deployments/embeddings/embeddings?api-version="+defaultAzureAPIVersion, r.URL.String())
+		require.Equal(t, "key", r.Header.Get("api-key"))
+		require.Empty(t, r.Header.Get("Authorization"))
+	}
+}
+
+func TestFailedRequest(t *testing.T) {
+	client := NewClient(&mockDoer{func(r *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Body: io.NopCloser(bytes.NewReader([]byte("slow down")))}, nil
+	}}, &schema.Embeddings{Url: "https://api.openai.com/v1/embeddings", Model: "model", Dimensions: 1})
+
+	_, err := client.GetEmbeddings([]string{"a"})
+	require.ErrorContains(t, err, "failed with status 429: slow down")
+}
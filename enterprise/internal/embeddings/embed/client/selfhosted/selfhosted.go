@@ -0,0 +1,98 @@
+package selfhosted
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"net/http"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/embeddings/embed/client"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// EmbeddingAPIRequest is the request body sent to the self-hosted endpoint. It
+// matches the API of text-embeddings-inference and similar sentence-transformers
+// servers.
+type EmbeddingAPIRequest struct {
+	Inputs []string `json:"inputs"`
+}
+
+// EmbeddingAPIResponse is the response of the self-hosted endpoint: one
+// embedding per input, in the order of the inputs.
+type EmbeddingAPIResponse [][]float32
+
+type selfHostedEmbeddingsClient struct {
+	cli         httpcli.Doer
+	url         string
+	accessToken string
+	dimensions  int
+}
+
+// NewClient returns a client for a self-hosted embeddings endpoint.
+func NewClient(cli httpcli.Doer, config *schema.Embeddings) client.Client {
+	return &selfHostedEmbeddingsClient{
+		cli:         cli,
+		url:         config.Url,
+		accessToken: config.AccessToken,
+		dimensions:  config.Dimensions,
+	}
+}
+
+func (c *selfHostedEmbeddingsClient) GetEmbeddings(texts []string) ([]float32, error) {
+	bodyBytes, err := json.Marshal(EmbeddingAPIRequest{Inputs: texts})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+
+	resp, err := c.cli.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := client.CheckResponseStatus(req, resp); err != nil {
+		return nil, err
+	}
+
+	var response EmbeddingAPIResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, err
+	}
+	if len(response) != len(texts) {
+		return nil, errors.Errorf("embeddings: expected %d embeddings, got %d", len(texts), len(response))
+	}
+
+	embeddings := make([]float32, 0, len(response)*c.dimensions)
+	for _, embedding := range response {
+		// Similarity search requires normalized embeddings, which not all
+		// models produce.
+		normalize(embedding)
+		embeddings = append(embeddings, embedding...)
+	}
+	return embeddings, nil
+}
+
+func normalize(v []float32) {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range v {
+		v[i] *= scale
+	}
+}
@@ -0,0 +1,77 @@
+package selfhosted
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+type mockDoer struct {
+	do func(*http.Request) (*http.Response, error)
+}
+
+func (c *mockDoer) Do(r *http.Request) (*http.Response, error) {
+	return c.do(r)
+}
+
+func newMockDoer(t *testing.T, requests *[]*http.Request, response EmbeddingAPIResponse) *mockDoer {
+	return &mockDoer{func(r *http.Request) (*http.Response, error) {
+		*requests = append(*requests, r)
+
+		var request EmbeddingAPIRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, []string{"a", "b"}, request.Inputs)
+
+		body, err := json.Marshal(response)
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}, nil
+	}}
+}
+
+func TestSelfHostedClient(t *testing.T) {
+	var requests []*http.Request
+	client := NewClient(newMockDoer(t, &requests, EmbeddingAPIResponse{{3, 4}, {0, 2}}), &schema.Embeddings{
+		Url:        "http://embeddings.internal:8080/embed",
+		Dimensions: 2,
+	})
+
+	embeddings, err := client.GetEmbeddings([]string{"a", "b"})
+	require.NoError(t, err)
+	// Embeddings are normalized.
+	require.Equal(t, []float32{0.6, 0.8, 0, 1}, embeddings)
+
+	require.Len(t, requests, 1)
+	require.Equal(t, "http://embeddings.internal:8080/embed", requests[0].URL.String())
+	// No access token is configured.
+	require.Empty(t, requests[0].Header.Get("Authorization"))
+}
+
+func TestSelfHostedClientAccessToken(t *testing.T) {
+	var requests []*http.Request
+	client := NewClient(newMockDoer(t, &requests, EmbeddingAPIResponse{{1}, {1}}), &schema.Embeddings{
+		Url:         "http://embeddings.internal:8080/embed",
+		AccessToken: "token",
+		Dimensions:  1,
+	})
+
+	_, err := client.GetEmbeddings([]string{"a", "b"})
+	require.NoError(t, err)
+	require.Equal(t, "Bearer token", requests[0].Header.Get("Authorization"))
+}
+
+func TestSelfHostedClientMissingEmbeddings(t *testing.T) {
+	var requests []*http.Request
+	client := NewClient(newMockDoer(t, &requests, EmbeddingAPIResponse{{1}}), &schema.Embeddings{
+		Url:        "http://embeddings.internal:8080/embed",
+		Dimensions: 1,
+	})
+
+	_, err := client.GetEmbeddings([]string{"a", "b"})
+	require.ErrorContains(t, err, "expected 2 embeddings, got 1")
+}
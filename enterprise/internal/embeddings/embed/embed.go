@@ -37,18 +37,7 @@ func EmbedRepo(
 	readFile readFile,
 	getDocumentRanks ranksGetter,
 ) (*embeddings.RepoEmbeddingIndex, error) {
-	codeFileNames, textFileNames := []string{}, []string{}
-	for _, fileName := range fileNames {
-		if isExcludedFilePath(fileName, excludedFilePathPatterns) {
-			continue
-		}
-
-		if isValidTextFile(fileName) {
-			textFileNames = append(textFileNames, fileName)
-		} else {
-			codeFileNames = append(codeFileNames, fileName)
-		}
-	}
+	codeFileNames, textFileNames := splitCodeAndTextFiles(fileNames, excludedFilePathPatterns)
 
 	ranks, err := getDocumentRanks(ctx, string(repoName))
 	if err != nil {
@@ -68,6 +57,87 @@ func EmbedRepo(
 	return &embeddings.RepoEmbeddingIndex{RepoName: repoName, Revision: revision, CodeIndex: codeIndex, TextIndex: textIndex}, nil
 }
 
+// EmbedRepoIncrementally updates the embedding index of a previous revision of
+// a repository to the given revision. Rows of changed and removed files are
+// dropped from the previous index, and only the changed files are embedded
+// again. The document ranks of all rows are refreshed. The returned index has no
+// approximate index, and shares memory with previousIndex, which must not be
+// used afterwards.
+func EmbedRepoIncrementally(
+	ctx context.Context,
+	previousIndex *embeddings.RepoEmbeddingIndex,
+	revision api.CommitID,
+	changedFileNames []string,
+	removedFileNames []string,
+	excludedFilePathPatterns []*paths.GlobPattern,
+	client EmbeddingsClient,
+	splitOptions split.SplitOptions,
+	readFile readFile,
+	getDocumentRanks ranksGetter,
+) (*embeddings.RepoEmbeddingIndex, error) {
+	staleFiles := make(map[string]struct{}, len(changedFileNames)+len(removedFileNames))
+	for _, fileName := range changedFileNames {
+		staleFiles[fileName] = struct{}{}
+	}
+	for _, fileName := range removedFileNames {
+		staleFiles[fileName] = struct{}{}
+	}
+	isFresh := func(row embeddings.RepoEmbeddingRowMetadata) bool {
+		_, ok := staleFiles[row.FileName]
+		return !ok
+	}
+
+	codeIndex, textIndex := previousIndex.CodeIndex, previousIndex.TextIndex
+	codeIndex.Filter(isFresh)
+	textIndex.Filter(isFresh)
+
+	codeFileNames, textFileNames := splitCodeAndTextFiles(changedFileNames, excludedFilePathPatterns)
+
+	ranks, err := getDocumentRanks(ctx, string(previousIndex.RepoName))
+	if err != nil {
+		return nil, err
+	}
+
+	changedCodeIndex, err := embedFiles(codeFileNames, client, splitOptions, readFile, MAX_CODE_EMBEDDING_VECTORS-len(codeIndex.RowMetadata), ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	changedTextIndex, err := embedFiles(textFileNames, client, splitOptions, readFile, MAX_TEXT_EMBEDDING_VECTORS-len(textIndex.RowMetadata), ranks)
+	if err != nil {
+		return nil, err
+	}
+
+	codeIndex.Append(changedCodeIndex)
+	textIndex.Append(changedTextIndex)
+
+	for _, index := range []*embeddings.EmbeddingIndex{&codeIndex, &textIndex} {
+		for i, row := range index.RowMetadata {
+			index.Ranks[i] = float32(ranks.Paths[row.FileName])
+		}
+	}
+
+	return &embeddings.RepoEmbeddingIndex{RepoName: previousIndex.RepoName, Revision: revision, CodeIndex: codeIndex, TextIndex: textIndex}, nil
+}
+
+// splitCodeAndTextFiles separates the file names into code files and text
+// files, skipping excluded files.
+func splitCodeAndTextFiles(fileNames []string, excludedFilePathPatterns []*paths.GlobPattern) (codeFileNames, textFileNames []string) {
+	codeFileNames, textFileNames = []string{}, []string{}
+	for _, fileName := range fileNames {
+		if isExcludedFilePath(fileName, excludedFilePathPatterns) {
+			continue
+		}
+
+		if isValidTextFile(fileName) {
+			textFileNames = append(textFileNames, fileName)
+		} else {
+			codeFileNames = append(codeFileNames, fileName)
+		}
+	}
+	return codeFileNames, textFileNames
+}
+
 func createEmptyEmbeddingIndex(columnDimension int) embeddings.EmbeddingIndex {
 	return embeddings.EmbeddingIndex{
 		Embeddings:      []float32{},
@@ -125,6 +125,30 @@ func TestEmbedRepo(t *testing.T) {
 		require.Len(t, index.TextIndex.RowMetadata, 2)
 		require.Len(t, index.TextIndex.Ranks, 2)
 	})
+
+	t.Run("incremental update", func(t *testing.T) {
+		files := []string{"a.go", "b.md", "c.java"}
+		previousIndex, err := EmbedRepo(ctx, repoName, revision, files, excludedGlobPatterns, client, splitOptions, readFile, getDocumentRanks)
+		require.NoError(t, err)
+		require.Len(t, previousIndex.CodeIndex.RowMetadata, 5)
+
+		// a.go was changed, c.java was removed, and b.md is unchanged.
+		index, err := EmbedRepoIncrementally(ctx, previousIndex, "coffee", []string{"a.go"}, []string{"c.java"}, excludedGlobPatterns, client, splitOptions, readFile, getDocumentRanks)
+		require.NoError(t, err)
+		require.Equal(t, api.CommitID("coffee"), index.Revision)
+		require.Equal(t, repoName, index.RepoName)
+
+		require.Len(t, index.CodeIndex.Embeddings, 6)
+		require.Len(t, index.CodeIndex.RowMetadata, 2)
+		require.Equal(t, []float32{0.1, 0.1}, index.CodeIndex.Ranks)
+		for _, row := range index.CodeIndex.RowMetadata {
+			require.Equal(t, "a.go", row.FileName)
+		}
+
+		require.Len(t, index.TextIndex.Embeddings, 6)
+		require.Len(t, index.TextIndex.RowMetadata, 2)
+		require.Equal(t, []float32{0.2, 0.2}, index.TextIndex.Ranks)
+	})
 }
 
 func NewMockEmbeddingsClient() EmbeddingsClient {
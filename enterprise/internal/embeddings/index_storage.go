@@ -124,6 +124,61 @@ func encodeRepoEmbeddingIndex(enc *gob.Encoder, rei *RepoEmbeddingIndex, chunkSi
 		}
 	}
 
+	// The approximate indexes are encoded last, so that indexes encoded before
+	// they were introduced can still be decoded.
+	for _, ei := range []EmbeddingIndex{rei.CodeIndex, rei.TextIndex} {
+		if err := enc.Encode(ei.IVF != nil); err != nil {
+			return err
+		}
+
+		if ei.IVF != nil {
+			if err := enc.Encode(ei.IVF); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Quantized embeddings are encoded after the approximate indexes, for the
+	// same reason. The float embeddings of a quantized index are empty.
+	for _, ei := range []EmbeddingIndex{rei.CodeIndex, rei.TextIndex} {
+		if err := enc.Encode(ei.IsQuantized()); err != nil {
+			return err
+		}
+
+		if !ei.IsQuantized() {
+			continue
+		}
+
+		if err := enc.Encode(ei.QuantizationScales); err != nil {
+			return err
+		}
+
+		numChunks := (len(ei.QuantizedEmbeddings) + chunkSize - 1) / chunkSize
+		if err := enc.Encode(numChunks); err != nil {
+			return err
+		}
+
+		// Gob encodes byte slices much more compactly than int8 slices, so the
+		// chunks are reinterpreted as bytes.
+		chunk := make([]byte, 0, chunkSize)
+		for i := 0; i < numChunks; i++ {
+			start := i * chunkSize
+			end := start + chunkSize
+
+			if end > len(ei.QuantizedEmbeddings) {
+				end = len(ei.QuantizedEmbeddings)
+			}
+
+			chunk = chunk[:0]
+			for _, v := range ei.QuantizedEmbeddings[start:end] {
+				chunk = append(chunk, byte(v))
+			}
+			if err := enc.Encode(chunk); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -166,5 +221,59 @@ func decodeRepoEmbeddingIndex(dec *gob.Decoder) (*RepoEmbeddingIndex, error) {
 		}
 	}
 
+	for _, ei := range []*EmbeddingIndex{&rei.CodeIndex, &rei.TextIndex} {
+		var hasIVF bool
+		if err := dec.Decode(&hasIVF); err != nil {
+			if err == io.EOF {
+				// The index was encoded before approximate indexes were introduced.
+				return rei, nil
+			}
+			return nil, err
+		}
+
+		if hasIVF {
+			ei.IVF = &IVFIndex{}
+			if err := dec.Decode(ei.IVF); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, ei := range []*EmbeddingIndex{&rei.CodeIndex, &rei.TextIndex} {
+		var isQuantized bool
+		if err := dec.Decode(&isQuantized); err != nil {
+			if err == io.EOF {
+				// The index was encoded before quantization was introduced.
+				return rei, nil
+			}
+			return nil, err
+		}
+
+		if !isQuantized {
+			continue
+		}
+
+		if err := dec.Decode(&ei.QuantizationScales); err != nil {
+			return nil, err
+		}
+
+		var numChunks int
+		if err := dec.Decode(&numChunks); err != nil {
+			return nil, err
+		}
+
+		ei.Embeddings = nil
+		ei.QuantizedEmbeddings = make([]int8, 0, len(ei.QuantizationScales)*ei.ColumnDimension)
+		for i := 0; i < numChunks; i++ {
+			var chunk []byte
+			if err := dec.Decode(&chunk); err != nil {
+				return nil, err
+			}
+			for _, v := range chunk {
+				ei.QuantizedEmbeddings = append(ei.QuantizedEmbeddings, int8(v))
+			}
+		}
+	}
+
 	return rei, nil
 }
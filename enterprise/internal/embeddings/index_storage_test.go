@@ -3,6 +3,7 @@ package embeddings
 import (
 	"bytes"
 	"context"
+	"encoding/gob"
 	"fmt"
 	"io"
 	"math/rand"
@@ -128,6 +129,72 @@ func TestRepoEmbeddingIndexStorage(t *testing.T) {
 	require.Equal(t, index, downloadedIndex)
 }
 
+func TestRepoEmbeddingIndexStorageWithApproximateIndex(t *testing.T) {
+	index := &RepoEmbeddingIndex{
+		RepoName: api.RepoName("repo"),
+		Revision: api.CommitID("commit"),
+		CodeIndex: EmbeddingIndex{
+			Embeddings:      []float32{0.0, 0.1, 0.2, 0.3, 0.4, 0.5},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "a.go", StartLine: 0, EndLine: 1}, {FileName: "b.go", StartLine: 0, EndLine: 1}},
+			IVF: &IVFIndex{
+				Centroids:      []float32{0.0, 0.1, 0.2},
+				ClusterOffsets: []int32{0, 2},
+				RowIDs:         []int32{1, 0},
+			},
+		},
+		TextIndex: EmbeddingIndex{
+			Embeddings:      []float32{1.0, 2.1, 3.2},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "b.py", StartLine: 0, EndLine: 1}},
+		},
+	}
+
+	ctx := context.Background()
+	uploadStore := newMockUploadStore()
+
+	err := UploadRepoEmbeddingIndex(ctx, uploadStore, "index", index)
+	require.NoError(t, err)
+
+	downloadedIndex, err := DownloadRepoEmbeddingIndex(ctx, uploadStore, "index")
+	require.NoError(t, err)
+
+	require.Equal(t, index, downloadedIndex)
+}
+
+func TestRepoEmbeddingIndexStorageQuantized(t *testing.T) {
+	index := &RepoEmbeddingIndex{
+		RepoName: api.RepoName("repo"),
+		Revision: api.CommitID("commit"),
+		CodeIndex: EmbeddingIndex{
+			Embeddings:      []float32{0.0, 0.1, 0.2, 0.3, 0.4, 0.5},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "a.go", StartLine: 0, EndLine: 1}, {FileName: "b.go", StartLine: 0, EndLine: 1}},
+		},
+		TextIndex: EmbeddingIndex{
+			Embeddings:      []float32{1.0, 2.1, 3.2},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "b.py", StartLine: 0, EndLine: 1}},
+		},
+	}
+	index.CodeIndex.Quantize()
+
+	ctx := context.Background()
+	uploadStore := newMockUploadStore()
+
+	// Use a small chunk size to exercise decoding quantized embeddings from multiple chunks.
+	buffer := bytes.NewBuffer(nil)
+	err := encodeRepoEmbeddingIndex(gob.NewEncoder(buffer), index, 4)
+	require.NoError(t, err)
+	_, err = uploadStore.Upload(ctx, "index", buffer)
+	require.NoError(t, err)
+
+	downloadedIndex, err := DownloadRepoEmbeddingIndex(ctx, uploadStore, "index")
+	require.NoError(t, err)
+
+	require.Equal(t, index, downloadedIndex)
+}
+
 func TestRepoEmbeddingVersionMismatch(t *testing.T) {
 	index := &RepoEmbeddingIndex{
 		RepoName: api.RepoName("repo"),
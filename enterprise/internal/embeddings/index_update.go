@@ -0,0 +1,70 @@
+package embeddings
+
+// Filter removes the rows of the index for which keep returns false. The
+// approximate index is dropped, since it refers to the removed rows.
+func (index *EmbeddingIndex) Filter(keep func(row RepoEmbeddingRowMetadata) bool) {
+	dim := index.ColumnDimension
+	hasRanks := len(index.Ranks) == len(index.RowMetadata)
+
+	kept := 0
+	for i, row := range index.RowMetadata {
+		if !keep(row) {
+			continue
+		}
+
+		if kept != i {
+			index.RowMetadata[kept] = row
+			if hasRanks {
+				index.Ranks[kept] = index.Ranks[i]
+			}
+			if index.IsQuantized() {
+				copy(index.QuantizedEmbeddings[kept*dim:(kept+1)*dim], index.QuantizedEmbeddings[i*dim:(i+1)*dim])
+				index.QuantizationScales[kept] = index.QuantizationScales[i]
+			} else {
+				copy(index.Embeddings[kept*dim:(kept+1)*dim], index.Embeddings[i*dim:(i+1)*dim])
+			}
+		}
+		kept++
+	}
+
+	index.RowMetadata = index.RowMetadata[:kept]
+	if hasRanks {
+		index.Ranks = index.Ranks[:kept]
+	} else {
+		index.Ranks = nil
+	}
+	if index.IsQuantized() {
+		index.QuantizedEmbeddings = index.QuantizedEmbeddings[:kept*dim]
+		index.QuantizationScales = index.QuantizationScales[:kept]
+	} else {
+		index.Embeddings = index.Embeddings[:kept*dim]
+	}
+	index.IVF = nil
+}
+
+// Append appends the rows of other to the index. If either index is
+// quantized, the result is quantized. The approximate index is dropped, since
+// it doesn't cover the appended rows.
+func (index *EmbeddingIndex) Append(other EmbeddingIndex) {
+	// Rows without a rank have rank 0.
+	for len(index.Ranks) < len(index.RowMetadata) {
+		index.Ranks = append(index.Ranks, 0)
+	}
+	for len(other.Ranks) < len(other.RowMetadata) {
+		other.Ranks = append(other.Ranks, 0)
+	}
+
+	if index.IsQuantized() || other.IsQuantized() {
+		index.Quantize()
+		other.Quantize()
+		index.Embeddings = nil
+		index.QuantizedEmbeddings = append(index.QuantizedEmbeddings, other.QuantizedEmbeddings...)
+		index.QuantizationScales = append(index.QuantizationScales, other.QuantizationScales...)
+	} else {
+		index.Embeddings = append(index.Embeddings, other.Embeddings...)
+	}
+
+	index.RowMetadata = append(index.RowMetadata, other.RowMetadata...)
+	index.Ranks = append(index.Ranks, other.Ranks[:len(other.RowMetadata)]...)
+	index.IVF = nil
+}
@@ -0,0 +1,70 @@
+package embeddings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmbeddingIndexFilterAndAppend(t *testing.T) {
+	newIndex := func() EmbeddingIndex {
+		return EmbeddingIndex{
+			Embeddings:      []float32{1, 0, 0, 0, 1, 0, 0, 0, 1},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "a.go"}, {FileName: "b.go"}, {FileName: "c.go"}},
+			Ranks:           []float32{1, 2, 3},
+			IVF:             &IVFIndex{},
+		}
+	}
+	notB := func(row RepoEmbeddingRowMetadata) bool { return row.FileName != "b.go" }
+
+	t.Run("filter", func(t *testing.T) {
+		index := newIndex()
+		index.Filter(notB)
+		require.Equal(t, EmbeddingIndex{
+			Embeddings:      []float32{1, 0, 0, 0, 0, 1},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "a.go"}, {FileName: "c.go"}},
+			Ranks:           []float32{1, 3},
+		}, index)
+	})
+
+	t.Run("filter quantized", func(t *testing.T) {
+		index := newIndex()
+		index.Quantize()
+		index.Filter(notB)
+		require.Equal(t, []int8{127, 0, 0, 0, 0, 127}, index.QuantizedEmbeddings)
+		require.Equal(t, []float32{1.0 / 127, 1.0 / 127}, index.QuantizationScales)
+		require.Len(t, index.RowMetadata, 2)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		index := newIndex()
+		index.Append(EmbeddingIndex{
+			Embeddings:      []float32{0, 1, 0},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "d.go"}},
+		})
+		require.Equal(t, []float32{1, 0, 0, 0, 1, 0, 0, 0, 1, 0, 1, 0}, index.Embeddings)
+		require.Len(t, index.RowMetadata, 4)
+		// Rows without ranks get rank 0.
+		require.Equal(t, []float32{1, 2, 3, 0}, index.Ranks)
+		require.Nil(t, index.IVF)
+	})
+
+	t.Run("append to quantized index", func(t *testing.T) {
+		index := newIndex()
+		index.Quantize()
+		index.Append(EmbeddingIndex{
+			Embeddings:      []float32{0, 0.5, 0},
+			ColumnDimension: 3,
+			RowMetadata:     []RepoEmbeddingRowMetadata{{FileName: "d.go"}},
+			Ranks:           []float32{4},
+		})
+		require.True(t, index.IsQuantized())
+		require.Nil(t, index.Embeddings)
+		require.Equal(t, []int8{0, 127, 0}, index.QuantizedEmbeddings[9:])
+		require.Len(t, index.QuantizationScales, 4)
+		require.Equal(t, []float32{1, 2, 3, 4}, index.Ranks)
+	})
+}
@@ -0,0 +1,87 @@
+package embeddings
+
+import (
+	"math"
+)
+
+// quantizationLevels is the largest magnitude of a quantized value. Values are
+// quantized symmetrically to [-quantizationLevels, quantizationLevels].
+const quantizationLevels = 127
+
+// Quantize converts the embeddings of the index to 8-bit integers, which cuts
+// the memory used by the index roughly by a factor of four. Each row is scaled
+// separately, so that rows with small components don't lose all of their
+// precision. After quantization, Embeddings is nil and the index is scored using
+// QuantizedEmbeddings and QuantizationScales instead.
+func (index *EmbeddingIndex) Quantize() {
+	if index.IsQuantized() || index.ColumnDimension == 0 || len(index.Embeddings) == 0 {
+		return
+	}
+
+	dim := index.ColumnDimension
+	numRows := len(index.Embeddings) / dim
+
+	quantized := make([]int8, numRows*dim)
+	scales := make([]float32, numRows)
+	for i := 0; i < numRows; i++ {
+		scales[i] = quantizeRow(index.Embeddings[i*dim:(i+1)*dim], quantized[i*dim:(i+1)*dim])
+	}
+
+	index.QuantizedEmbeddings = quantized
+	index.QuantizationScales = scales
+	index.Embeddings = nil
+}
+
+// IsQuantized returns true if the embeddings of the index are stored as 8-bit
+// integers.
+func (index *EmbeddingIndex) IsQuantized() bool {
+	return len(index.QuantizedEmbeddings) > 0
+}
+
+// quantizeRow quantizes row into dst and returns the scale that maps the
+// quantized values back to the original values.
+func quantizeRow(row []float32, dst []int8) float32 {
+	var maxAbs float32
+	for _, v := range row {
+		if abs := float32(math.Abs(float64(v))); abs > maxAbs {
+			maxAbs = abs
+		}
+	}
+	if maxAbs == 0 {
+		for j := range dst {
+			dst[j] = 0
+		}
+		return 0
+	}
+
+	scale := maxAbs / quantizationLevels
+	for j, v := range row {
+		q := math.Round(float64(v / scale))
+		// Guard against rounding errors pushing values out of range.
+		q = math.Max(-quantizationLevels, math.Min(quantizationLevels, q))
+		dst[j] = int8(q)
+	}
+	return scale
+}
+
+// dequantizeRow returns the approximate original values of the i-th row of a
+// quantized index.
+func (index *EmbeddingIndex) dequantizeRow(i int) []float32 {
+	dim := index.ColumnDimension
+	row := make([]float32, dim)
+	scale := index.QuantizationScales[i]
+	for j, v := range index.QuantizedEmbeddings[i*dim : (i+1)*dim] {
+		row[j] = float32(v) * scale
+	}
+	return row
+}
+
+// QuantizedCosineSimilarity computes the cosine similarity between a quantized
+// row and a query, without dequantizing the row first.
+func QuantizedCosineSimilarity(row []int8, scale float32, query []float32) float32 {
+	similarity := float32(0.0)
+	for i := 0; i < len(row); i++ {
+		similarity += float32(row[i]) * query[i]
+	}
+	return similarity * scale
+}
@@ -0,0 +1,81 @@
+package embeddings
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuantize(t *testing.T) {
+	prng := rand.New(rand.NewSource(0))
+	numRows, columnDimension := 100, 64
+
+	index := getNormalizedMockEmbeddingIndex(prng, numRows, columnDimension)
+	original := make([]float32, len(index.Embeddings))
+	copy(original, index.Embeddings)
+
+	index.Quantize()
+	require.True(t, index.IsQuantized())
+	require.Nil(t, index.Embeddings)
+	require.Len(t, index.QuantizedEmbeddings, numRows*columnDimension)
+	require.Len(t, index.QuantizationScales, numRows)
+
+	// Quantizing twice is a no-op.
+	index.Quantize()
+	require.Len(t, index.QuantizedEmbeddings, numRows*columnDimension)
+
+	query := getRandomEmbeddings(prng, columnDimension)
+	normalize(query)
+
+	for i := 0; i < numRows; i++ {
+		expected := CosineSimilarity(original[i*columnDimension:(i+1)*columnDimension], query)
+		got := index.similarity(query, i)
+		if math.Abs(float64(expected-got)) > 0.01 {
+			t.Fatalf("row %d: expected similarity %.4f, but got %.4f", i, expected, got)
+		}
+	}
+}
+
+func TestQuantizeZeroRow(t *testing.T) {
+	index := &EmbeddingIndex{
+		Embeddings:      []float32{0, 0, 0, 0.5, -1, 0.25},
+		ColumnDimension: 3,
+		RowMetadata:     make([]RepoEmbeddingRowMetadata, 2),
+	}
+
+	index.Quantize()
+	require.Equal(t, []int8{0, 0, 0, 64, -127, 32}, index.QuantizedEmbeddings)
+	require.Equal(t, []float32{0, 1.0 / 127}, index.QuantizationScales)
+}
+
+func TestQuantizedSimilaritySearch(t *testing.T) {
+	numRows, numQueries, columnDimension := 16, 3, 3
+	index := EmbeddingIndex{
+		Embeddings:      append([]float32{}, embeddings...),
+		ColumnDimension: columnDimension,
+		RowMetadata:     []RepoEmbeddingRowMetadata{},
+	}
+	for i := 0; i < numRows; i++ {
+		index.RowMetadata = append(index.RowMetadata, RepoEmbeddingRowMetadata{FileName: fmt.Sprintf("%d", i)})
+	}
+
+	// Quantization doesn't change the nearest neighbors of the test data.
+	expected := make([][]EmbeddingSearchResult, numQueries)
+	for q := 0; q < numQueries; q++ {
+		expected[q] = index.SimilaritySearch(queries[q*columnDimension:(q+1)*columnDimension], 3, WorkerOptions{NumWorkers: 1}, SearchOptions{})
+	}
+
+	index.Quantize()
+
+	for _, numWorkers := range []int{1, 2, 5} {
+		for q := 0; q < numQueries; q++ {
+			t.Run(fmt.Sprintf("query=%d numWorkers=%d", q, numWorkers), func(t *testing.T) {
+				results := index.SimilaritySearch(queries[q*columnDimension:(q+1)*columnDimension], 3, WorkerOptions{NumWorkers: numWorkers}, SearchOptions{})
+				require.Equal(t, expected[q], results)
+			})
+		}
+	}
+}
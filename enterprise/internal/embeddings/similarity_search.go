@@ -175,10 +175,7 @@ func (index *EmbeddingIndex) score(query []float32, i int, opts SearchOptions) (
 		}
 	}
 
-	similarity := CosineSimilarity(
-		index.Embeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension],
-		query,
-	)
+	similarity := index.similarity(query, i)
 
 	addScore("similarity", scoreSimilarityWeight*similarity)
 
@@ -202,6 +199,21 @@ func (index *EmbeddingIndex) score(query []float32, i int, opts SearchOptions) (
 	return score, debugInfo
 }
 
+func (index *EmbeddingIndex) similarity(query []float32, i int) float32 {
+	if index.IsQuantized() {
+		return QuantizedCosineSimilarity(
+			index.QuantizedEmbeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension],
+			index.QuantizationScales[i],
+			query,
+		)
+	}
+
+	return CosineSimilarity(
+		index.Embeddings[i*index.ColumnDimension:(i+1)*index.ColumnDimension],
+		query,
+	)
+}
+
 func CosineSimilarity(row []float32, query []float32) float32 {
 	similarity := float32(0.0)
 	for i := 0; i < len(row); i++ {
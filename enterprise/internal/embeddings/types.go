@@ -5,10 +5,20 @@ import (
 )
 
 type EmbeddingIndex struct {
-	Embeddings      []float32
-	ColumnDimension int
-	RowMetadata     []RepoEmbeddingRowMetadata
-	Ranks           []float32
+	Embeddings []float32
+	// QuantizedEmbeddings and QuantizationScales hold the embeddings of a
+	// quantized index, in which case Embeddings is nil. The i-th row is
+	// approximately QuantizedEmbeddings[i*ColumnDimension:(i+1)*ColumnDimension]
+	// multiplied by QuantizationScales[i].
+	QuantizedEmbeddings []int8
+	QuantizationScales  []float32
+	ColumnDimension     int
+	RowMetadata         []RepoEmbeddingRowMetadata
+	Ranks               []float32
+	// IVF is an optional approximate nearest neighbor index over the rows of
+	// the embedding index. It is nil for small indexes, which are always
+	// searched exhaustively.
+	IVF *IVFIndex
 }
 
 type RepoEmbeddingRowMetadata struct {
@@ -28,15 +28,17 @@ type EmbeddingsUploadStoreConfig struct {
 	GCSProjectID               string
 	GCSCredentialsFile         string
 	GCSCredentialsFileContents string
+
+	FilesystemDir string
 }
 
 func (c *EmbeddingsUploadStoreConfig) Load() {
-	c.Backend = strings.ToLower(c.Get("EMBEDDINGS_UPLOAD_BACKEND", "blobstore", "The target file service for embeddings. S3, GCS, and Blobstore are supported."))
+	c.Backend = strings.ToLower(c.Get("EMBEDDINGS_UPLOAD_BACKEND", "blobstore", "The target file service for embeddings. S3, GCS, Blobstore, and Filesystem are supported."))
 	c.ManageBucket = c.GetBool("EMBEDDINGS_UPLOAD_MANAGE_BUCKET", "false", "Whether or not the client should manage the target bucket configuration.")
 	c.Bucket = c.Get("EMBEDDINGS_UPLOAD_BUCKET", "embeddings", "The name of the bucket to store embeddings in.")
 
-	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" {
-		c.AddError(errors.Errorf("invalid backend %q for EMBEDDINGS_UPLOAD_BACKEND: must be S3, GCS, or Blobstore", c.Backend))
+	if c.Backend != "blobstore" && c.Backend != "s3" && c.Backend != "gcs" && c.Backend != "filesystem" {
+		c.AddError(errors.Errorf("invalid backend %q for EMBEDDINGS_UPLOAD_BACKEND: must be S3, GCS, Blobstore, or Filesystem", c.Backend))
 	}
 
 	if c.Backend == "blobstore" || c.Backend == "s3" {
@@ -54,6 +56,8 @@ func (c *EmbeddingsUploadStoreConfig) Load() {
 		c.GCSProjectID = c.Get("EMBEDDINGS_UPLOAD_GCP_PROJECT_ID", "", "The project containing the GCS bucket.")
 		c.GCSCredentialsFile = c.GetOptional("EMBEDDINGS_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE", "The path to a service account key file with access to GCS.")
 		c.GCSCredentialsFileContents = c.GetOptional("EMBEDDINGS_UPLOAD_GOOGLE_APPLICATION_CREDENTIALS_FILE_CONTENT", "The contents of a service account key file with access to GCS.")
+	} else if c.Backend == "filesystem" {
+		c.FilesystemDir = c.Get("EMBEDDINGS_UPLOAD_FILESYSTEM_DIR", "/var/opt/sourcegraph/uploadstore", "The directory to store objects in. It must be shared by all services using the upload store.")
 	}
 }
 
@@ -77,6 +81,9 @@ func NewEmbeddingsUploadStore(ctx context.Context, observationCtx *observation.C
 			CredentialsFile:         conf.GCSCredentialsFile,
 			CredentialsFileContents: conf.GCSCredentialsFileContents,
 		},
+		Filesystem: uploadstore.FilesystemConfig{
+			Dir: conf.FilesystemDir,
+		},
 	}
 	return uploadstore.CreateLazy(ctx, c, uploadstore.NewOperations(observationCtx, "embeddings", "uploadstore"))
 }
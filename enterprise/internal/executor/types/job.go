@@ -67,6 +67,16 @@ type Job struct {
 	// takes precedence over a potentially configured EXECUTOR_DOCKER_AUTH_CONFIG environment
 	// variable.
 	DockerAuthConfig DockerAuthConfig `json:"dockerAuthConfig,omitempty"`
+
+	// ArtifactsDirectory is an optional relative path of a directory in the workspace.
+	// The files that the steps write into this directory are uploaded to the
+	// ArtifactsBucket of the files store after the steps ran, keyed by their file
+	// names. This is done even if a step failed.
+	ArtifactsDirectory string `json:"artifactsDirectory,omitempty"`
+
+	// ArtifactsBucket is the bucket in the files store the files in the
+	// ArtifactsDirectory are uploaded to.
+	ArtifactsBucket string `json:"artifactsBucket,omitempty"`
 }
 
 func (j Job) MarshalJSON() ([]byte, error) {
@@ -85,6 +95,8 @@ func (j Job) MarshalJSON() ([]byte, error) {
 			CliSteps:            j.CliSteps,
 			RedactedValues:      j.RedactedValues,
 			DockerAuthConfig:    j.DockerAuthConfig,
+			ArtifactsDirectory:  j.ArtifactsDirectory,
+			ArtifactsBucket:     j.ArtifactsBucket,
 		}
 		v2.VirtualMachineFiles = make(map[string]v2VirtualMachineFile, len(j.VirtualMachineFiles))
 		for k, v := range j.VirtualMachineFiles {
@@ -144,6 +156,8 @@ func (j *Job) UnmarshalJSON(data []byte) error {
 		j.CliSteps = v2.CliSteps
 		j.RedactedValues = v2.RedactedValues
 		j.DockerAuthConfig = v2.DockerAuthConfig
+		j.ArtifactsDirectory = v2.ArtifactsDirectory
+		j.ArtifactsBucket = v2.ArtifactsBucket
 		return nil
 	}
 	var v1 v1Job
@@ -192,6 +206,8 @@ type v2Job struct {
 	CliSteps            []CliStep                       `json:"cliSteps"`
 	RedactedValues      map[string]string               `json:"redactedValues"`
 	DockerAuthConfig    DockerAuthConfig                `json:"dockerAuthConfig,omitempty"`
+	ArtifactsDirectory  string                          `json:"artifactsDirectory,omitempty"`
+	ArtifactsBucket     string                          `json:"artifactsBucket,omitempty"`
 }
 
 type v1Job struct {
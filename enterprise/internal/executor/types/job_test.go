@@ -57,6 +57,8 @@ func TestJob_MarshalJSON(t *testing.T) {
 				RedactedValues: map[string]string{
 					"password": "foo",
 				},
+				ArtifactsDirectory: "artifacts",
+				ArtifactsBucket:    "my-artifacts",
 			},
 			expected: `{
 		"version": 2,
@@ -93,7 +95,9 @@ func TestJob_MarshalJSON(t *testing.T) {
 		}],
 		"redactedValues": {
 			"password": "foo"
-		}
+		},
+		"artifactsDirectory": "artifacts",
+		"artifactsBucket": "my-artifacts"
 	}`,
 		},
 		{
@@ -223,7 +227,9 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 	}],
 	"redactedValues": {
 		"password": "foo"
-	}
+	},
+	"artifactsDirectory": "artifacts",
+	"artifactsBucket": "my-artifacts"
 }`,
 			expected: Job{
 				Version:             2,
@@ -262,6 +268,8 @@ func TestJob_UnmarshalJSON(t *testing.T) {
 				RedactedValues: map[string]string{
 					"password": "foo",
 				},
+				ArtifactsDirectory: "artifacts",
+				ArtifactsBucket:    "my-artifacts",
 			},
 		},
 		{
@@ -0,0 +1,212 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/api/internalapi"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/txemail"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+type AlertRuleStore interface {
+	GetAlertRules(ctx context.Context, args store.AlertRuleQueryArgs) ([]types.InsightSeriesAlertRule, error)
+	UpdateAlertRuleState(ctx context.Context, id int, state types.AlertRuleState, value *float64, firedAt *time.Time) error
+}
+
+type SeriesTotalsStore interface {
+	GetRecentSeriesTotals(ctx context.Context, insightSeriesID int, n int) ([]store.SeriesTotal, error)
+}
+
+// Alerter evaluates the alert rules of insight series and notifies through outbound webhooks and email when a
+// rule starts firing.
+type Alerter struct {
+	alertRuleStore AlertRuleStore
+	totalsStore    SeriesTotalsStore
+	webhooks       outbound.OutboundWebhookService
+	sendEmail      func(ctx context.Context, source string, message txtypes.Message) error
+	now            func() time.Time
+	logger         log.Logger
+}
+
+// NewAlerter returns an Alerter reading rules and series values from the insights DB. Webhook jobs are enqueued
+// in mainAppDB.
+func NewAlerter(logger log.Logger, insightsStore *store.Store, mainAppDB basestore.ShareableStore) *Alerter {
+	return &Alerter{
+		alertRuleStore: store.NewAlertRuleStoreWith(insightsStore),
+		totalsStore:    insightsStore,
+		webhooks:       outbound.NewOutboundWebhookService(mainAppDB, nil),
+		sendEmail:      internalapi.Client.SendEmail,
+		now:            time.Now,
+		logger:         logger,
+	}
+}
+
+// EvaluateSeries evaluates all alert rules of series against its recorded values and persists the resulting rule
+// states. Notifications are only sent when a rule transitions from OK to FIRING, so a series that stays above a
+// threshold notifies once.
+func (a *Alerter) EvaluateSeries(ctx context.Context, series *types.InsightSeries) (err error) {
+	rules, err := a.alertRuleStore.GetAlertRules(ctx, store.AlertRuleQueryArgs{InsightSeriesID: series.ID})
+	if err != nil {
+		return errors.Wrap(err, "GetAlertRules")
+	}
+
+	for _, rule := range rules {
+		if evalErr := a.evaluateRule(ctx, series, rule); evalErr != nil {
+			err = errors.Append(err, errors.Wrapf(evalErr, "evaluating alert rule %d", rule.ID))
+		}
+	}
+	return err
+}
+
+func (a *Alerter) evaluateRule(ctx context.Context, series *types.InsightSeries, rule types.InsightSeriesAlertRule) error {
+	windowSize := rule.WindowSize
+	if windowSize <= 0 {
+		windowSize = 1
+	}
+	totals, err := a.totalsStore.GetRecentSeriesTotals(ctx, series.ID, windowSize+1)
+	if err != nil {
+		return errors.Wrap(err, "GetRecentSeriesTotals")
+	}
+
+	evaluation, ok := Evaluate(rule, totals)
+	if !ok {
+		return nil
+	}
+
+	var firedAt *time.Time
+	if evaluation.State == types.AlertRuleFiring && rule.State != types.AlertRuleFiring {
+		now := a.now()
+		firedAt = &now
+		a.notify(ctx, series, rule, evaluation)
+	}
+
+	return a.alertRuleStore.UpdateAlertRuleState(ctx, rule.ID, evaluation.State, &evaluation.Value, firedAt)
+}
+
+// notify sends the webhook and emails for a rule that started firing. Like other outbound webhooks, notifications
+// are fire and forget, so failures are logged rather than failing the evaluation.
+func (a *Alerter) notify(ctx context.Context, series *types.InsightSeries, rule types.InsightSeriesAlertRule, evaluation Evaluation) {
+	logger := a.logger.With(
+		log.Int("ruleID", rule.ID),
+		log.String("seriesID", series.SeriesID),
+	)
+
+	payload, err := json.Marshal(newAlertPayload(series, rule, evaluation))
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+	} else if err := a.webhooks.Enqueue(ctx, InsightSeriesAlertFiring, nil, payload); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+	}
+
+	if len(rule.EmailRecipients) == 0 {
+		return
+	}
+	if err := a.sendEmail(ctx, "code-insights-alert", txtypes.Message{
+		To:       rule.EmailRecipients,
+		Template: alertEmailTemplates,
+		Data: &alertEmailData{
+			SeriesID:  series.SeriesID,
+			Query:     series.Query,
+			Condition: describeCondition(rule),
+			Value:     evaluation.Value,
+			Time:      evaluation.RecordingTime.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		logger.Error("error sending alert email", log.Error(err))
+	}
+}
+
+type alertPayload struct {
+	Rule          alertPayloadRule   `json:"rule"`
+	Series        alertPayloadSeries `json:"series"`
+	RecordingTime time.Time          `json:"recordingTime"`
+	Value         float64            `json:"value"`
+	PreviousValue *float64           `json:"previousValue,omitempty"`
+}
+
+type alertPayloadRule struct {
+	ID         int                       `json:"id"`
+	Kind       types.AlertRuleKind       `json:"kind"`
+	Comparator types.AlertRuleComparator `json:"comparator"`
+	Value      float64                   `json:"value"`
+	WindowSize int                       `json:"windowSize"`
+}
+
+type alertPayloadSeries struct {
+	SeriesID string `json:"seriesId"`
+	Query    string `json:"query"`
+}
+
+func newAlertPayload(series *types.InsightSeries, rule types.InsightSeriesAlertRule, evaluation Evaluation) alertPayload {
+	return alertPayload{
+		Rule: alertPayloadRule{
+			ID:         rule.ID,
+			Kind:       rule.Kind,
+			Comparator: rule.Comparator,
+			Value:      rule.Value,
+			WindowSize: rule.WindowSize,
+		},
+		Series: alertPayloadSeries{
+			SeriesID: series.SeriesID,
+			Query:    series.Query,
+		},
+		RecordingTime: evaluation.RecordingTime.UTC(),
+		Value:         evaluation.Value,
+		PreviousValue: evaluation.PreviousValue,
+	}
+}
+
+// describeCondition returns a human readable description of the condition of rule, such as "went above 10" or
+// "changed by more than 5 over the last 3 recordings".
+func describeCondition(rule types.InsightSeriesAlertRule) string {
+	if rule.Kind == types.TrendAlertRule {
+		period := "since the previous recording"
+		if rule.WindowSize > 1 {
+			period = fmt.Sprintf("over the last %d recordings", rule.WindowSize)
+		}
+		if rule.Comparator == types.AlertBelow {
+			return fmt.Sprintf("changed by less than %g %s", rule.Value, period)
+		}
+		return fmt.Sprintf("changed by more than %g %s", rule.Value, period)
+	}
+	if rule.Comparator == types.AlertBelow {
+		return fmt.Sprintf("went below %g", rule.Value)
+	}
+	return fmt.Sprintf("went above %g", rule.Value)
+}
+
+type alertEmailData struct {
+	SeriesID  string
+	Query     string
+	Condition string
+	Value     float64
+	Time      string
+}
+
+var alertEmailTemplates = txemail.MustValidate(txtypes.Templates{
+	Subject: `Sourcegraph code insight series {{.SeriesID}} {{.Condition}}`,
+	Text: `
+The code insight series {{.SeriesID}} {{.Condition}}.
+
+Query: {{.Query}}
+Value: {{.Value}} (recorded at {{.Time}})
+`,
+	HTML: `
+<p>The code insight series <strong>{{.SeriesID}}</strong> {{.Condition}}.</p>
+
+<p>
+  Query: <code>{{.Query}}</code><br>
+  Value: <strong>{{.Value}}</strong> (recorded at {{.Time}})
+</p>
+`,
+})
@@ -0,0 +1,210 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/txemail/txtypes"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	totals := func(values ...float64) []store.SeriesTotal {
+		result := make([]store.SeriesTotal, 0, len(values))
+		for i, v := range values {
+			result = append(result, store.SeriesTotal{RecordingTime: now.AddDate(0, -i, 0), Value: v})
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name     string
+		rule     types.InsightSeriesAlertRule
+		totals   []store.SeriesTotal
+		want     types.AlertRuleState
+		observed float64
+		ok       bool
+	}{
+		{
+			name: "no recordings",
+			rule: types.InsightSeriesAlertRule{Kind: types.ThresholdAlertRule, Comparator: types.AlertAbove, Value: 10},
+		},
+		{
+			name:     "threshold above firing",
+			rule:     types.InsightSeriesAlertRule{Kind: types.ThresholdAlertRule, Comparator: types.AlertAbove, Value: 10},
+			totals:   totals(11, 3),
+			want:     types.AlertRuleFiring,
+			observed: 11,
+			ok:       true,
+		},
+		{
+			name:     "threshold above at value is ok",
+			rule:     types.InsightSeriesAlertRule{Kind: types.ThresholdAlertRule, Comparator: types.AlertAbove, Value: 10},
+			totals:   totals(10),
+			want:     types.AlertRuleOK,
+			observed: 10,
+			ok:       true,
+		},
+		{
+			name:     "threshold below firing",
+			rule:     types.InsightSeriesAlertRule{Kind: types.ThresholdAlertRule, Comparator: types.AlertBelow, Value: 5},
+			totals:   totals(4),
+			want:     types.AlertRuleFiring,
+			observed: 4,
+			ok:       true,
+		},
+		{
+			name:     "trend went up",
+			rule:     types.InsightSeriesAlertRule{Kind: types.TrendAlertRule, Comparator: types.AlertAbove, Value: 0, WindowSize: 1},
+			totals:   totals(12, 10),
+			want:     types.AlertRuleFiring,
+			observed: 2,
+			ok:       true,
+		},
+		{
+			name:     "trend over window",
+			rule:     types.InsightSeriesAlertRule{Kind: types.TrendAlertRule, Comparator: types.AlertBelow, Value: -5, WindowSize: 2},
+			totals:   totals(10, 20, 12),
+			want:     types.AlertRuleOK,
+			observed: -2,
+			ok:       true,
+		},
+		{
+			name:   "trend without enough recordings",
+			rule:   types.InsightSeriesAlertRule{Kind: types.TrendAlertRule, Comparator: types.AlertAbove, Value: 0, WindowSize: 2},
+			totals: totals(10, 20),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := Evaluate(tc.rule, tc.totals)
+			require.Equal(t, tc.ok, ok)
+			if !ok {
+				return
+			}
+			require.Equal(t, tc.want, got.State)
+			require.Equal(t, tc.observed, got.Observed)
+			require.Equal(t, tc.totals[0].Value, got.Value)
+		})
+	}
+}
+
+type fakeAlertRuleStore struct {
+	rules   []types.InsightSeriesAlertRule
+	updated map[int]types.AlertRuleState
+	fired   map[int]bool
+}
+
+func (s *fakeAlertRuleStore) GetAlertRules(_ context.Context, _ store.AlertRuleQueryArgs) ([]types.InsightSeriesAlertRule, error) {
+	return s.rules, nil
+}
+
+func (s *fakeAlertRuleStore) UpdateAlertRuleState(_ context.Context, id int, state types.AlertRuleState, _ *float64, firedAt *time.Time) error {
+	s.updated[id] = state
+	s.fired[id] = firedAt != nil
+	return nil
+}
+
+type fakeTotalsStore []store.SeriesTotal
+
+func (s fakeTotalsStore) GetRecentSeriesTotals(_ context.Context, _ int, n int) ([]store.SeriesTotal, error) {
+	if n > len(s) {
+		n = len(s)
+	}
+	return s[:n], nil
+}
+
+type fakeWebhooks struct {
+	payloads [][]byte
+}
+
+func (w *fakeWebhooks) Enqueue(_ context.Context, eventType string, _ *string, payload []byte) error {
+	if eventType != InsightSeriesAlertFiring {
+		return nil
+	}
+	w.payloads = append(w.payloads, payload)
+	return nil
+}
+
+func TestAlerterEvaluateSeries(t *testing.T) {
+	now := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	rules := &fakeAlertRuleStore{
+		rules: []types.InsightSeriesAlertRule{
+			// Starts firing and notifies.
+			{ID: 1, Kind: types.ThresholdAlertRule, Comparator: types.AlertAbove, Value: 10, State: types.AlertRuleOK, EmailRecipients: []string{"alerts@example.com"}},
+			// Already firing, does not notify again.
+			{ID: 2, Kind: types.ThresholdAlertRule, Comparator: types.AlertAbove, Value: 5, State: types.AlertRuleFiring},
+			// Recovers without notifying.
+			{ID: 3, Kind: types.TrendAlertRule, Comparator: types.AlertBelow, Value: 0, WindowSize: 1, State: types.AlertRuleFiring},
+		},
+		updated: map[int]types.AlertRuleState{},
+		fired:   map[int]bool{},
+	}
+	webhooks := &fakeWebhooks{}
+	var emails []txtypes.Message
+
+	alerter := &Alerter{
+		alertRuleStore: rules,
+		totalsStore: fakeTotalsStore{
+			{RecordingTime: now, Value: 12},
+			{RecordingTime: now.AddDate(0, -1, 0), Value: 8},
+		},
+		webhooks: webhooks,
+		sendEmail: func(_ context.Context, _ string, message txtypes.Message) error {
+			emails = append(emails, message)
+			return nil
+		},
+		now:    func() time.Time { return now },
+		logger: logtest.Scoped(t),
+	}
+
+	err := alerter.EvaluateSeries(context.Background(), &types.InsightSeries{ID: 1, SeriesID: "series1", Query: "deprecatedFunc"})
+	require.NoError(t, err)
+
+	require.Equal(t, map[int]types.AlertRuleState{1: types.AlertRuleFiring, 2: types.AlertRuleFiring, 3: types.AlertRuleOK}, rules.updated)
+	require.Equal(t, map[int]bool{1: true, 2: false, 3: false}, rules.fired)
+
+	require.Len(t, webhooks.payloads, 1)
+	var payload alertPayload
+	require.NoError(t, json.Unmarshal(webhooks.payloads[0], &payload))
+	require.Equal(t, 1, payload.Rule.ID)
+	require.Equal(t, "series1", payload.Series.SeriesID)
+	require.Equal(t, 12.0, payload.Value)
+
+	require.Len(t, emails, 1)
+	require.Equal(t, []string{"alerts@example.com"}, emails[0].To)
+}
+
+func TestValidateRule(t *testing.T) {
+	valid := types.InsightSeriesAlertRule{
+		Kind:            types.TrendAlertRule,
+		Comparator:      types.AlertAbove,
+		WindowSize:      3,
+		EmailRecipients: []string{"alerts@example.com"},
+	}
+	require.NoError(t, ValidateRule(valid))
+
+	invalidKind := valid
+	invalidKind.Kind = "SOMETIMES"
+	require.Error(t, ValidateRule(invalidKind))
+
+	invalidWindow := valid
+	invalidWindow.WindowSize = maxWindowSize + 1
+	require.Error(t, ValidateRule(invalidWindow))
+
+	emptyWindow := valid
+	emptyWindow.WindowSize = 0
+	require.Error(t, ValidateRule(emptyWindow))
+
+	invalidRecipient := valid
+	invalidRecipient.EmailRecipients = []string{"not an email"}
+	require.Error(t, ValidateRule(invalidRecipient))
+}
@@ -0,0 +1,93 @@
+package alerts
+
+import (
+	"net/mail"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Evaluation is the outcome of evaluating an alert rule against the recent recordings of its series.
+type Evaluation struct {
+	State         types.AlertRuleState
+	RecordingTime time.Time
+	// Value is the latest recorded value of the series.
+	Value float64
+	// PreviousValue is the value WindowSize recordings before the latest one, and is only set for trend rules.
+	PreviousValue *float64
+	// Observed is the value compared by the rule: Value for threshold rules and the change from PreviousValue
+	// for trend rules.
+	Observed float64
+}
+
+// Evaluate evaluates rule against totals, which must be ordered newest first as returned by
+// store.GetRecentSeriesTotals. It returns false if there are not enough recordings to evaluate the rule yet.
+func Evaluate(rule types.InsightSeriesAlertRule, totals []store.SeriesTotal) (Evaluation, bool) {
+	if len(totals) == 0 {
+		return Evaluation{}, false
+	}
+
+	latest := totals[0]
+	evaluation := Evaluation{
+		RecordingTime: latest.RecordingTime,
+		Value:         latest.Value,
+		Observed:      latest.Value,
+	}
+
+	if rule.Kind == types.TrendAlertRule {
+		windowSize := rule.WindowSize
+		if windowSize <= 0 {
+			windowSize = 1
+		}
+		if len(totals) <= windowSize {
+			return Evaluation{}, false
+		}
+		previous := totals[windowSize].Value
+		evaluation.PreviousValue = &previous
+		evaluation.Observed = latest.Value - previous
+	}
+
+	evaluation.State = types.AlertRuleOK
+	if compare(rule.Comparator, evaluation.Observed, rule.Value) {
+		evaluation.State = types.AlertRuleFiring
+	}
+	return evaluation, true
+}
+
+func compare(comparator types.AlertRuleComparator, observed, value float64) bool {
+	switch comparator {
+	case types.AlertAbove:
+		return observed > value
+	case types.AlertBelow:
+		return observed < value
+	}
+	return false
+}
+
+// maxWindowSize bounds how many recordings a trend rule can look back over.
+const maxWindowSize = 100
+
+// ValidateRule returns an error if rule cannot be evaluated.
+func ValidateRule(rule types.InsightSeriesAlertRule) error {
+	switch rule.Kind {
+	case types.ThresholdAlertRule, types.TrendAlertRule:
+	default:
+		return errors.Newf("invalid alert rule kind %q", rule.Kind)
+	}
+	switch rule.Comparator {
+	case types.AlertAbove, types.AlertBelow:
+	default:
+		return errors.Newf("invalid alert rule comparator %q", rule.Comparator)
+	}
+	if rule.WindowSize < 1 || rule.WindowSize > maxWindowSize {
+		return errors.Newf("alert rule window size must be between 1 and %d", maxWindowSize)
+	}
+	for _, recipient := range rule.EmailRecipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return errors.Wrapf(err, "invalid email recipient %q", recipient)
+		}
+	}
+	return nil
+}
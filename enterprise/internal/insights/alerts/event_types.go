@@ -0,0 +1,14 @@
+package alerts
+
+import "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+
+const (
+	InsightSeriesAlertFiring = "insight_series:alert_firing"
+)
+
+func init() {
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         InsightSeriesAlertFiring,
+		Description: "sent when an insight series alert rule starts firing",
+	})
+}
@@ -0,0 +1,128 @@
+package queryrunner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/cmd/frontend/backend"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/query/querybuilder"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// languageInventoryProvider returns the language inventory of a repository at the given revision. An empty
+// revision refers to the default branch.
+type languageInventoryProvider func(ctx context.Context, repo api.RepoName, revision string) (*inventory.Inventory, error)
+
+// newGitserverLanguageInventory returns a languageInventoryProvider that computes the inventory of a repository
+// at a revision with the same inventory context, and therefore the same cache, as the repository language stats.
+func newGitserverLanguageInventory(client gitserver.Client, logger log.Logger) languageInventoryProvider {
+	return func(ctx context.Context, repo api.RepoName, revision string) (*inventory.Inventory, error) {
+		if revision == "" {
+			revision = "HEAD"
+		}
+		commitID, err := client.ResolveRevision(ctx, repo, revision, gitserver.ResolveRevisionOptions{NoEnsureRevision: true})
+		if err != nil {
+			return nil, err
+		}
+		root, err := client.Stat(ctx, authz.DefaultSubRepoPermsChecker, repo, commitID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		// Line counts are only computed with enhanced language detection, so it is always enabled.
+		invCtx, err := backend.InventoryContext(logger, repo, client, commitID, true)
+		if err != nil {
+			return nil, err
+		}
+		inv, err := invCtx.Entries(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		return &inv, nil
+	}
+}
+
+// generateLanguageCompositionRecordings records the number of lines of code per language for every repository
+// matched by the job query. Each language is recorded as a capture, so the series expands into one line per
+// language in the same way as capture group series.
+func generateLanguageCompositionRecordings(ctx context.Context, job *SearchJob, recordTime time.Time, provider streamSearchProvider, inventories languageInventoryProvider, logger log.Logger) ([]store.RecordSeriesPointArgs, error) {
+	// Historical jobs are pinned to the revision nearest to the record time, while
+	// recording jobs run against the default branch.
+	revision, err := querybuilder.RepoRevision(job.SearchQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "RepoRevision")
+	}
+
+	tabulationResult, err := provider(ctx, job.SearchQuery)
+	if err != nil {
+		return nil, err
+	}
+	tr := *tabulationResult
+	if len(tr.SkippedReasons) > 0 {
+		logger.Error("language composition search encountered skipped events", log.String("seriesID", job.SeriesID), log.String("reasons", fmt.Sprintf("%v", tr.SkippedReasons)), log.String("query", job.SearchQuery))
+	}
+	if len(tr.Errors) > 0 {
+		return nil, classifiedError(tr.Errors, types.LanguageComposition)
+	}
+	if tr.DidTimeout {
+		return nil, SearchTimeoutError
+	}
+	if len(tr.Alerts) > 0 {
+		return nil, errors.Errorf("streaming search: alerts: %v", tr.Alerts)
+	}
+
+	checker := authz.DefaultSubRepoPermsChecker
+	var recordings []store.RecordSeriesPointArgs
+
+	for _, match := range tr.RepoCounts {
+		// The inventory covers every file in the repository, so repositories with sub-repo
+		// permissions are excluded entirely.
+		repoID := api.RepoID(match.RepositoryID)
+		subRepoEnabled, subRepoErr := authz.SubRepoEnabledForRepoID(ctx, checker, repoID)
+		if subRepoErr != nil {
+			logger.Error("sub-repo permissions check errored", log.String("seriesID", job.SeriesID), log.String("repo", match.RepositoryName), log.Error(subRepoErr))
+			continue
+		}
+		if subRepoEnabled {
+			continue
+		}
+
+		inv, err := inventories(ctx, api.RepoName(match.RepositoryName), revision)
+		if err != nil {
+			if errors.HasType(err, &gitdomain.RevisionNotFoundError{}) || gitdomain.IsRepoNotExist(err) {
+				// The repository may not be cloned yet, there is nothing to record.
+				continue
+			}
+			return nil, errors.Wrapf(err, "language inventory for %s", match.RepositoryName)
+		}
+		for _, lang := range inv.Languages {
+			if lang.Name == "" || lang.TotalLines == 0 {
+				continue
+			}
+			capture := lang.Name
+			recordings = append(recordings, toRecording(job, float64(lang.TotalLines), recordTime, match.RepositoryName, repoID, &capture)...)
+		}
+	}
+
+	return recordings, nil
+}
+
+func makeLanguageCompositionHandler(provider streamSearchProvider, inventories languageInventoryProvider) InsightsHandler {
+	return func(ctx context.Context, job *SearchJob, series *types.InsightSeries, recordTime time.Time) ([]store.RecordSeriesPointArgs, error) {
+		recordings, err := generateLanguageCompositionRecordings(ctx, job, recordTime, provider, inventories, log.Scoped("LanguageCompositionRecordingsGenerator", ""))
+		if err != nil {
+			return nil, errors.Wrapf(err, "languageCompositionHandler")
+		}
+		return recordings, nil
+	}
+}
@@ -0,0 +1,121 @@
+package queryrunner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/sourcegraph/log/logtest"
+
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/query/streaming"
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver/gitdomain"
+	"github.com/sourcegraph/sourcegraph/internal/inventory"
+)
+
+func TestGenerateLanguageCompositionRecordings(t *testing.T) {
+	date := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	mockedSearch := func(context.Context, string) (*streaming.TabulationResult, error) {
+		return &streaming.TabulationResult{
+			RepoCounts: map[string]*streaming.SearchMatch{
+				"github.com/sourcegraph/sourcegraph": {
+					RepositoryID:   11,
+					RepositoryName: "github.com/sourcegraph/sourcegraph",
+					MatchCount:     1,
+				},
+				"github.com/sourcegraph/not-cloned": {
+					RepositoryID:   12,
+					RepositoryName: "github.com/sourcegraph/not-cloned",
+					MatchCount:     1,
+				},
+			},
+			TotalCount: 2,
+		}, nil
+	}
+
+	var revisions []string
+	mockedInventory := func(_ context.Context, repo api.RepoName, revision string) (*inventory.Inventory, error) {
+		if repo == "github.com/sourcegraph/not-cloned" {
+			return nil, &gitdomain.RevisionNotFoundError{Repo: repo, Spec: revision}
+		}
+		revisions = append(revisions, revision)
+		return &inventory.Inventory{Languages: []inventory.Lang{
+			{Name: "Java", TotalBytes: 1000, TotalLines: 120},
+			{Name: "Kotlin", TotalBytes: 500, TotalLines: 40},
+			{Name: "Markdown", TotalBytes: 20},
+		}}, nil
+	}
+
+	t.Run("historical job uses the pinned revision", func(t *testing.T) {
+		revisions = nil
+		job := SearchJob{
+			SeriesID:        "testseries1",
+			SearchQuery:     "fork:no archived:no patterntype:literal count:all repo:^github\\.com/sourcegraph/sourcegraph$@7667bb15aa",
+			RecordTime:      &date,
+			PersistMode:     "record",
+			DependentFrames: []time.Time{date.AddDate(0, -1, 0)},
+		}
+
+		recordings, err := generateLanguageCompositionRecordings(context.Background(), &job, date, mockedSearch, mockedInventory, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{
+			"github.com/sourcegraph/sourcegraph 11 2021-11-01 00:00:00 +0000 UTC Java 120.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-11-01 00:00:00 +0000 UTC Kotlin 40.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC Java 120.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC Kotlin 40.000000",
+		}).Equal(t, stringify(recordings))
+		autogold.Expect([]string{"7667bb15aa"}).Equal(t, revisions)
+	})
+
+	t.Run("recording job uses the default branch", func(t *testing.T) {
+		revisions = nil
+		job := SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: "fork:no archived:no patterntype:literal count:all repo:^(github\\.com/sourcegraph/sourcegraph|github\\.com/sourcegraph/not-cloned)$",
+			RecordTime:  &date,
+			PersistMode: "record",
+		}
+
+		recordings, err := generateLanguageCompositionRecordings(context.Background(), &job, date, mockedSearch, mockedInventory, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		autogold.Expect([]string{
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC Java 120.000000",
+			"github.com/sourcegraph/sourcegraph 11 2021-12-01 00:00:00 +0000 UTC Kotlin 40.000000",
+		}).Equal(t, stringify(recordings))
+		autogold.Expect([]string{""}).Equal(t, revisions)
+	})
+
+	t.Run("repositories with sub-repo permissions are excluded", func(t *testing.T) {
+		job := SearchJob{
+			SeriesID:    "testseries1",
+			SearchQuery: "repo:^github\\.com/sourcegraph/sourcegraph$",
+			RecordTime:  &date,
+			PersistMode: "record",
+		}
+
+		checker := authz.NewMockSubRepoPermissionChecker()
+		checker.EnabledFunc.SetDefaultHook(func() bool {
+			return true
+		})
+		checker.EnabledForRepoIDFunc.SetDefaultHook(func(ctx context.Context, id api.RepoID) (bool, error) {
+			return true, nil
+		})
+		authz.DefaultSubRepoPermsChecker = checker
+		t.Cleanup(func() { authz.DefaultSubRepoPermsChecker = nil })
+
+		recordings, err := generateLanguageCompositionRecordings(context.Background(), &job, date, mockedSearch, mockedInventory, logtest.Scoped(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(recordings) != 0 {
+			t.Errorf("expected no recordings, got %d", len(recordings))
+		}
+	})
+}
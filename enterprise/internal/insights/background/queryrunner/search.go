@@ -15,6 +15,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 
 	"github.com/sourcegraph/sourcegraph/internal/trace"
@@ -56,10 +57,13 @@ func GetSearchHandlers() map[types.GenerationMethod]InsightsHandler {
 		return streamResults, nil
 	}
 
+	languageInventory := newGitserverLanguageInventory(gitserver.NewClient(), log.Scoped("LanguageInventory", "computes the language composition of repositories"))
+
 	return map[types.GenerationMethod]InsightsHandler{
-		types.MappingCompute: makeMappingComputeHandler(computeTextExtraSearch),
-		types.SearchCompute:  makeComputeHandler(computeSearchStream),
-		types.Search:         makeSearchHandler(searchStream),
+		types.MappingCompute:      makeMappingComputeHandler(computeTextExtraSearch),
+		types.SearchCompute:       makeComputeHandler(computeSearchStream),
+		types.Search:              makeSearchHandler(searchStream),
+		types.LanguageComposition: makeLanguageCompositionHandler(searchStream, languageInventory),
 	}
 
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/alerts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/discovery"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/store"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
@@ -30,6 +31,7 @@ type workHandler struct {
 	repoStore       discovery.RepoStore
 	metadadataStore *store.InsightStore
 	limiter         *ratelimit.InstrumentedLimiter
+	alerter         *alerts.Alerter
 	logger          log.Logger
 
 	mu          sync.RWMutex
@@ -142,7 +144,20 @@ func (r *workHandler) Handle(ctx context.Context, logger log.Logger, record *Job
 		return err
 	}
 
-	return r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime)
+	if err := r.persistRecordings(ctx, &job.SearchJob, series, recordings, recordTime); err != nil {
+		return err
+	}
+
+	// Alert rules are only evaluated against new recordings of the current value of a series. They are evaluated
+	// on a best-effort basis, so an evaluation failure does not fail (and retry) a recording that already succeeded.
+	if r.alerter != nil && isGlobal && job.PersistMode == string(store.RecordMode) {
+		if alertErr := r.alerter.EvaluateSeries(ctx, series); alertErr != nil {
+			logger.Warn("failed to evaluate insight series alert rules",
+				log.Int("seriesId", series.ID), log.String("seriesUniqueId", series.SeriesID),
+				log.Error(alertErr))
+		}
+	}
+	return nil
 }
 
 func TranslateIncompleteReasons(err error) store.IncompleteReason {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/sourcegraph/log"
 
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/alerts"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/compression"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/discovery"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/priority"
@@ -73,6 +74,7 @@ func NewWorker(ctx context.Context, logger log.Logger, workerStore *workerStoreE
 		insightsStore:   insightsStore,
 		repoStore:       repoStore,
 		limiter:         limiter,
+		alerter:         alerts.NewAlerter(log.Scoped("insights.queryRunner.Alerter", ""), insightsStore, basestore.NewWithHandle(workerStore.Handle())),
 		metadadataStore: store.NewInsightStoreWith(insightsStore),
 		seriesCache:     sharedCache,
 		searchHandlers:  GetSearchHandlers(),
@@ -95,22 +97,24 @@ func NewResetter(ctx context.Context, logger log.Logger, workerStore dbworkersto
 //
 // See internal/workerutil/dbworker for more information about dbworkers.
 func CreateDBWorkerStore(observationContext *observation.Context, s *basestore.Store) *workerStoreExtra {
-	options := dbworkerstore.Options[*Job]{
-		Name:              "insights_query_runner_jobs_store",
-		TableName:         "insights_query_runner_jobs",
-		ColumnExpressions: jobsColumns,
-		Scan:              dbworkerstore.BuildWorkerScan(scanJob),
-
-		// If you change this, be sure to adjust the interval that work is enqueued in
-		// enterprise/internal/insights/background:newInsightEnqueuer.
-		StalledMaxAge:     60 * time.Second,
-		RetryAfter:        30 * time.Minute,
-		MaxNumRetries:     10,
-		MaxNumResets:      10,
-		OrderByExpression: sqlf.Sprintf("priority, id"),
-	}
-	inner := dbworkerstore.New(observationContext, s.Handle(), options)
-	return &workerStoreExtra{Store: inner, options: options}
+	inner := dbworkerstore.New(observationContext, s.Handle(), WorkerStoreOptions)
+	return &workerStoreExtra{Store: inner, options: WorkerStoreOptions}
+}
+
+// WorkerStoreOptions are the options of the dbworker store for the query runner worker.
+var WorkerStoreOptions = dbworkerstore.Options[*Job]{
+	Name:              "insights_query_runner_jobs_store",
+	TableName:         "insights_query_runner_jobs",
+	ColumnExpressions: jobsColumns,
+	Scan:              dbworkerstore.BuildWorkerScan(scanJob),
+
+	// If you change this, be sure to adjust the interval that work is enqueued in
+	// enterprise/internal/insights/background:newInsightEnqueuer.
+	StalledMaxAge:     60 * time.Second,
+	RetryAfter:        30 * time.Minute,
+	MaxNumRetries:     10,
+	MaxNumResets:      10,
+	OrderByExpression: sqlf.Sprintf("priority, id"),
 }
 
 type workerStoreExtra struct {
@@ -72,6 +72,35 @@ func ContainsField(rawQuery, field string) (bool, error) {
 	return false, nil
 }
 
+// RepoRevision returns the revision the repository filters of a query are pinned to, such as the revision of
+// a query generated by SingleRepoQuery. An empty string is returned if the query doesn't pin a revision.
+func RepoRevision(rawQuery string) (string, error) {
+	plan, err := ParseQuery(rawQuery, "literal")
+	if err != nil {
+		return "", errors.Wrap(err, "ParseQuery")
+	}
+	var revision string
+	for _, parameter := range ParametersFromQueryPlan(plan) {
+		if parameter.Field != query.FieldRepo || parameter.Negated {
+			continue
+		}
+		repoRevs, err := query.ParseRepositoryRevisions(parameter.Value)
+		if err != nil {
+			return "", errors.Wrap(err, "ParseRepositoryRevisions")
+		}
+		for _, rev := range repoRevs.Revs {
+			if rev.RevSpec == "" {
+				continue
+			}
+			if revision != "" && revision != rev.RevSpec {
+				return "", errors.Newf("query contains more than one revision: %q and %q", revision, rev.RevSpec)
+			}
+			revision = rev.RevSpec
+		}
+	}
+	return revision, nil
+}
+
 // Possible reasons that a scope query is invalid.
 const containsPattern = "the query cannot be used for scoping because it contains a pattern: `%s`."
 const containsDisallowedFilter = "the query cannot be used for scoping because it contains a disallowed filter: `%s`."
@@ -217,6 +217,58 @@ func TestContainsField(t *testing.T) {
 	}
 }
 
+func TestRepoRevision(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		revision string
+		wantErr  bool
+	}{
+		{
+			name:     "no repo filter",
+			query:    "fork:no count:all",
+			revision: "",
+		},
+		{
+			name:     "repo filter without revision",
+			query:    "repo:^(github\\.com/a/b|github\\.com/c/d)$ count:all",
+			revision: "",
+		},
+		{
+			name:     "single repo query",
+			query:    "fork:no archived:no patterntype:literal count:all repo:^github\\.com/sourcegraph/sourcegraph$@7667bb15aa",
+			revision: "7667bb15aa",
+		},
+		{
+			name:     "negated repo filter is ignored",
+			query:    "repo:^a$@main -repo:^b$@dev",
+			revision: "main",
+		},
+		{
+			name:    "multiple revisions",
+			query:   "repo:^a$@main repo:^b$@dev",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			revision, err := RepoRevision(tc.query)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if revision != tc.revision {
+				t.Errorf("expected revision %q, got %q", tc.revision, revision)
+			}
+		})
+	}
+}
+
 func TestIsValidScopeQuery(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -134,10 +134,7 @@ func (h *newBackfillHandler) Handle(ctx context.Context, logger log.Logger, job
 		return errors.Wrap(err, "backfill.SetScope")
 	}
 
-	sampleTimes := timeseries.BuildSampleTimes(12, timeseries.TimeInterval{
-		Unit:  types.IntervalUnit(series.SampleIntervalUnit),
-		Value: series.SampleIntervalValue,
-	}, series.CreatedAt.Truncate(time.Minute))
+	sampleTimes := backfillSampleTimes(series)
 
 	if err := h.timeseriesStore.SetInsightSeriesRecordingTimes(ctx, []types.InsightSeriesRecordingTimes{
 		{
@@ -169,8 +166,27 @@ func (h *newBackfillHandler) Handle(ctx context.Context, logger log.Logger, job
 	return err
 }
 
+// Language composition series chart slow moving trends, such as a migration from one language to another,
+// so they are backfilled further into the past than other series.
+const (
+	defaultBackfillSamples             = 12
+	languageCompositionBackfillSamples = 60
+)
+
+// backfillSampleTimes returns the times at which historical points are generated for a series.
+func backfillSampleTimes(series *types.InsightSeries) []time.Time {
+	numSamples := defaultBackfillSamples
+	if series.GenerationMethod == types.LanguageComposition {
+		numSamples = languageCompositionBackfillSamples
+	}
+	return timeseries.BuildSampleTimes(numSamples, timeseries.TimeInterval{
+		Unit:  types.IntervalUnit(series.SampleIntervalUnit),
+		Value: series.SampleIntervalValue,
+	}, series.CreatedAt.Truncate(time.Minute))
+}
+
 func parseQuery(series types.InsightSeries) (query.Plan, error) {
-	if series.GeneratedFromCaptureGroups {
+	if series.GeneratedFromCaptureGroups && series.GenerationMethod != types.LanguageComposition {
 		seriesQuery, err := compute.Parse(series.Query)
 		if err != nil {
 			return nil, errors.Wrap(err, "compute.Parse")
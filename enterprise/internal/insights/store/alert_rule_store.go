@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AlertRuleStore persists insight series alert rules and their evaluation state.
+type AlertRuleStore struct {
+	*basestore.Store
+	Now func() time.Time
+}
+
+// NewAlertRuleStore returns a new AlertRuleStore backed by the given Postgres db.
+func NewAlertRuleStore(db edb.InsightsDB) *AlertRuleStore {
+	return &AlertRuleStore{Store: basestore.NewWithHandle(db.Handle()), Now: time.Now}
+}
+
+// NewAlertRuleStoreWith returns a new AlertRuleStore sharing the underlying store of other.
+func NewAlertRuleStoreWith(other basestore.ShareableStore) *AlertRuleStore {
+	return &AlertRuleStore{Store: basestore.NewWithHandle(other.Handle()), Now: time.Now}
+}
+
+func (s *AlertRuleStore) With(other basestore.ShareableStore) *AlertRuleStore {
+	return &AlertRuleStore{Store: s.Store.With(other), Now: s.Now}
+}
+
+func (s *AlertRuleStore) Transact(ctx context.Context) (*AlertRuleStore, error) {
+	txBase, err := s.Store.Transact(ctx)
+	return &AlertRuleStore{Store: txBase, Now: s.Now}, err
+}
+
+type AlertRuleQueryArgs struct {
+	ID              int
+	InsightSeriesID int
+	SeriesID        string
+}
+
+// GetAlertRules returns the alert rules matching the given arguments, ordered by ID.
+func (s *AlertRuleStore) GetAlertRules(ctx context.Context, args AlertRuleQueryArgs) ([]types.InsightSeriesAlertRule, error) {
+	preds := []*sqlf.Query{sqlf.Sprintf("TRUE")}
+	if args.ID > 0 {
+		preds = append(preds, sqlf.Sprintf("r.id = %s", args.ID))
+	}
+	if args.InsightSeriesID > 0 {
+		preds = append(preds, sqlf.Sprintf("r.series_id = %s", args.InsightSeriesID))
+	}
+	if args.SeriesID != "" {
+		preds = append(preds, sqlf.Sprintf("s.series_id = %s", args.SeriesID))
+	}
+	return scanAlertRules(s.Query(ctx, sqlf.Sprintf(getAlertRulesSql, sqlf.Join(preds, "\n AND"))))
+}
+
+// CreateAlertRule stores a new alert rule in the OK state.
+func (s *AlertRuleStore) CreateAlertRule(ctx context.Context, rule types.InsightSeriesAlertRule) (types.InsightSeriesAlertRule, error) {
+	if rule.WindowSize <= 0 {
+		rule.WindowSize = 1
+	}
+	if rule.EmailRecipients == nil {
+		rule.EmailRecipients = []string{}
+	}
+	rule.State = types.AlertRuleOK
+	rule.CreatedAt = s.Now().UTC()
+
+	row := s.QueryRow(ctx, sqlf.Sprintf(createAlertRuleSql,
+		rule.InsightSeriesID,
+		rule.Kind,
+		rule.Comparator,
+		rule.Value,
+		rule.WindowSize,
+		pq.Array(rule.EmailRecipients),
+		rule.State,
+		rule.CreatedAt,
+	))
+	if err := row.Scan(&rule.ID); err != nil {
+		return types.InsightSeriesAlertRule{}, errors.Wrap(err, "CreateAlertRule")
+	}
+	return rule, nil
+}
+
+func (s *AlertRuleStore) DeleteAlertRule(ctx context.Context, id int) error {
+	err := s.Exec(ctx, sqlf.Sprintf(deleteAlertRuleSql, id))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete alert rule with id: %d", id)
+	}
+	return nil
+}
+
+// UpdateAlertRuleState records the result of evaluating an alert rule. firedAt is only written when non-nil, so
+// the time the rule last fired is retained while it stays in the FIRING state or returns to OK.
+func (s *AlertRuleStore) UpdateAlertRuleState(ctx context.Context, id int, state types.AlertRuleState, value *float64, firedAt *time.Time) error {
+	err := s.Exec(ctx, sqlf.Sprintf(updateAlertRuleStateSql, state, value, s.Now().UTC(), firedAt, id))
+	if err != nil {
+		return errors.Wrapf(err, "failed to update state of alert rule with id: %d", id)
+	}
+	return nil
+}
+
+func scanAlertRules(rows *sql.Rows, queryErr error) (_ []types.InsightSeriesAlertRule, err error) {
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	defer func() { err = basestore.CloseRows(rows, err) }()
+
+	var results []types.InsightSeriesAlertRule
+	for rows.Next() {
+		var temp types.InsightSeriesAlertRule
+		if err := rows.Scan(
+			&temp.ID,
+			&temp.InsightSeriesID,
+			&temp.Kind,
+			&temp.Comparator,
+			&temp.Value,
+			&temp.WindowSize,
+			pq.Array(&temp.EmailRecipients),
+			&temp.State,
+			&temp.LastValue,
+			&temp.LastEvaluatedAt,
+			&temp.LastFiredAt,
+			&temp.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		results = append(results, temp)
+	}
+	return results, nil
+}
+
+const getAlertRulesSql = `
+SELECT r.id, r.series_id, r.kind, r.comparator, r.value, r.window_size, r.email_recipients, r.state,
+	r.last_value, r.last_evaluated_at, r.last_fired_at, r.created_at
+FROM insight_series_alert_rules r
+JOIN insight_series s ON s.id = r.series_id
+WHERE %s
+ORDER BY r.id
+`
+
+const createAlertRuleSql = `
+INSERT INTO insight_series_alert_rules (series_id, kind, comparator, value, window_size, email_recipients, state, created_at)
+VALUES (%s, %s, %s, %s, %s, %s, %s, %s)
+RETURNING id
+`
+
+const deleteAlertRuleSql = `
+DELETE FROM insight_series_alert_rules WHERE id = %s
+`
+
+const updateAlertRuleStateSql = `
+UPDATE insight_series_alert_rules
+SET state = %s, last_value = %s, last_evaluated_at = %s, last_fired_at = COALESCE(%s, last_fired_at)
+WHERE id = %s
+`
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/log/logtest"
+	"github.com/stretchr/testify/require"
+
+	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
+	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
+	"github.com/sourcegraph/sourcegraph/internal/database"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbtest"
+)
+
+func TestAlertRuleStore(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	insightsdb := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+	insightStore := NewInsightStore(insightsdb)
+	alertStore := NewAlertRuleStore(insightsdb)
+	alertStore.Now = func() time.Time { return now }
+
+	series, err := insightStore.CreateSeries(ctx, types.InsightSeries{
+		SeriesID:           "series1",
+		Query:              "query-1",
+		OldestHistoricalAt: now.Add(-time.Hour * 24 * 365),
+		LastRecordedAt:     now.Add(-time.Hour * 24 * 365),
+		NextRecordingAfter: now,
+		LastSnapshotAt:     now,
+		NextSnapshotAfter:  now,
+		Enabled:            true,
+		SampleIntervalUnit: string(types.Month),
+		GenerationMethod:   types.Search,
+	})
+	require.NoError(t, err)
+
+	created, err := alertStore.CreateAlertRule(ctx, types.InsightSeriesAlertRule{
+		InsightSeriesID: series.ID,
+		Kind:            types.ThresholdAlertRule,
+		Comparator:      types.AlertAbove,
+		Value:           10,
+		EmailRecipients: []string{"alerts@example.com"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.AlertRuleOK, created.State)
+	require.Equal(t, 1, created.WindowSize)
+
+	got, err := alertStore.GetAlertRules(ctx, AlertRuleQueryArgs{SeriesID: "series1"})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, created.ID, got[0].ID)
+	require.Equal(t, []string{"alerts@example.com"}, got[0].EmailRecipients)
+	require.Nil(t, got[0].LastEvaluatedAt)
+
+	value := 12.0
+	require.NoError(t, alertStore.UpdateAlertRuleState(ctx, created.ID, types.AlertRuleFiring, &value, &now))
+	// Returning to OK keeps the time the rule last fired.
+	require.NoError(t, alertStore.UpdateAlertRuleState(ctx, created.ID, types.AlertRuleOK, &value, nil))
+
+	got, err = alertStore.GetAlertRules(ctx, AlertRuleQueryArgs{ID: created.ID})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, types.AlertRuleOK, got[0].State)
+	require.Equal(t, &value, got[0].LastValue)
+	require.NotNil(t, got[0].LastFiredAt)
+	require.True(t, now.Equal(*got[0].LastFiredAt))
+
+	require.NoError(t, alertStore.DeleteAlertRule(ctx, created.ID))
+	got, err = alertStore.GetAlertRules(ctx, AlertRuleQueryArgs{InsightSeriesID: series.ID})
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestGetRecentSeriesTotals(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	logger := logtest.Scoped(t)
+	ctx := context.Background()
+	insightsdb := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+	postgres := database.NewDB(logger, dbtest.NewDB(logger, t))
+	insightStore := NewInsightStore(insightsdb)
+	timeseriesStore := New(insightsdb, NewInsightPermissionStore(postgres))
+
+	series, err := insightStore.CreateSeries(ctx, types.InsightSeries{
+		SeriesID:           "series1",
+		Query:              "query-1",
+		OldestHistoricalAt: now.Add(-time.Hour * 24 * 365),
+		LastRecordedAt:     now.Add(-time.Hour * 24 * 365),
+		NextRecordingAfter: now,
+		LastSnapshotAt:     now,
+		NextSnapshotAfter:  now,
+		Enabled:            true,
+		SampleIntervalUnit: string(types.Month),
+		GenerationMethod:   types.Search,
+	})
+	require.NoError(t, err)
+
+	older := now.AddDate(0, -1, 0)
+	oldest := now.AddDate(0, -2, 0)
+	_, err = insightsdb.ExecContext(ctx, `
+INSERT INTO series_points (series_id, time, value, repo_id) VALUES
+	('series1', $1, 3, 1),
+	('series1', $1, 4, 2),
+	('series1', $2, 5, 1);`, now, oldest)
+	require.NoError(t, err)
+	err = timeseriesStore.SetInsightSeriesRecordingTimes(ctx, []types.InsightSeriesRecordingTimes{{
+		InsightSeriesID: series.ID,
+		RecordingTimes: []types.RecordingTime{
+			{Timestamp: now},
+			{Timestamp: older},
+			{Timestamp: oldest},
+		},
+	}})
+	require.NoError(t, err)
+
+	got, err := timeseriesStore.GetRecentSeriesTotals(ctx, series.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.True(t, now.Equal(got[0].RecordingTime))
+	require.Equal(t, 7.0, got[0].Value)
+	require.True(t, older.Equal(got[1].RecordingTime))
+	require.Equal(t, 0.0, got[1].Value)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/RoaringBitmap/roaring"
 	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
 
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/insights/types"
@@ -990,3 +991,103 @@ from %s isrt
 	where iv.unique_id = %s and %s
     order by iv.title, isrt.recording_time, ivs.label, sp.capture;
 `
+
+// LatestSeriesValue is the value of a series at its most recent recording time, summed over all repositories.
+type LatestSeriesValue struct {
+	SeriesID      string
+	Capture       *string
+	RecordingTime time.Time
+	Value         float64
+}
+
+// GetLatestSeriesValues returns the latest value of each of the given series. Capture group series return one
+// value per capture.
+func (s *Store) GetLatestSeriesValues(ctx context.Context, seriesIDs []string) ([]LatestSeriesValue, error) {
+	if len(seriesIDs) == 0 {
+		return nil, nil
+	}
+	// 🚨 SECURITY: the caller is responsible for only passing series that are visible to the user. Repository
+	// permissions are enforced here, in the same way as in SeriesPoints.
+	denylist, err := s.permStore.GetUnauthorizedRepoIDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUnauthorizedRepoIDs")
+	}
+	preds := []*sqlf.Query{sqlf.Sprintf("true")}
+	if len(denylist) > 0 {
+		excludedRepoIDs := make([]*sqlf.Query, 0, len(denylist))
+		for _, repoID := range denylist {
+			excludedRepoIDs = append(excludedRepoIDs, sqlf.Sprintf("%d", repoID))
+		}
+		preds = append(preds, sqlf.Sprintf("repo_id NOT IN (%s)", sqlf.Join(excludedRepoIDs, ",")))
+	}
+
+	formattedPreds := sqlf.Join(preds, "AND")
+
+	var results []LatestSeriesValue
+	err = s.query(ctx, sqlf.Sprintf(latestSeriesValuesSql, pq.Array(seriesIDs), formattedPreds, pq.Array(seriesIDs), formattedPreds), func(sc scanner) error {
+		var v LatestSeriesValue
+		if err := sc.Scan(&v.SeriesID, &v.Capture, &v.RecordingTime, &v.Value); err != nil {
+			return err
+		}
+		results = append(results, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+const latestSeriesValuesSql = `
+WITH points AS (
+	SELECT series_id, time, value, capture FROM series_points WHERE series_id = ANY(%s) AND %s
+	UNION ALL
+	SELECT series_id, time, value, capture FROM series_points_snapshots WHERE series_id = ANY(%s) AND %s
+),
+latest AS (
+	SELECT series_id, MAX(time) AS time FROM points GROUP BY series_id
+)
+SELECT p.series_id, p.capture, p.time, SUM(p.value)
+FROM points p
+JOIN latest l ON l.series_id = p.series_id AND l.time = p.time
+GROUP BY p.series_id, p.capture, p.time
+ORDER BY p.series_id, p.capture
+`
+
+// SeriesTotal is the value of a series at a recording time, summed over all repositories and captures.
+type SeriesTotal struct {
+	RecordingTime time.Time
+	Value         float64
+}
+
+// GetRecentSeriesTotals returns the totals of the n most recent non-snapshot recordings of a series, newest first.
+// Recordings without any points have a total of zero.
+//
+// 🚨 SECURITY: repository permissions are not enforced, this is only intended for background evaluation of alert
+// rules.
+func (s *Store) GetRecentSeriesTotals(ctx context.Context, insightSeriesID int, n int) ([]SeriesTotal, error) {
+	var results []SeriesTotal
+	err := s.query(ctx, sqlf.Sprintf(recentSeriesTotalsSql, insightSeriesID, n), func(sc scanner) error {
+		var t SeriesTotal
+		if err := sc.Scan(&t.RecordingTime, &t.Value); err != nil {
+			return err
+		}
+		results = append(results, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+const recentSeriesTotalsSql = `
+SELECT rt.recording_time, COALESCE(SUM(sp.value), 0)
+FROM insight_series_recording_times rt
+JOIN insight_series s ON s.id = rt.insight_series_id
+LEFT JOIN series_points sp ON sp.series_id = s.series_id AND sp.time = rt.recording_time
+WHERE rt.insight_series_id = %s AND rt.snapshot IS FALSE
+GROUP BY rt.recording_time
+ORDER BY rt.recording_time DESC
+LIMIT %s
+`
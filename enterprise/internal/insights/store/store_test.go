@@ -1178,6 +1178,68 @@ SELECT recording_time,
 	})
 }
 
+func TestGetLatestSeriesValues(t *testing.T) {
+	ctx := context.Background()
+	logger := logtest.Scoped(t)
+	insightsDB := edb.NewInsightsDB(dbtest.NewInsightsDB(logger, t), logger)
+
+	permissionStore := NewMockInsightPermissionStore()
+	permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil)
+	seriesStore := New(insightsDB, permissionStore)
+
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	latest := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := insightsDB.ExecContext(ctx, `INSERT INTO repo_names(name) VALUES ('github.com/a/a'), ('github.com/b/b')`); err != nil {
+		t.Fatal(err)
+	}
+	_, err := insightsDB.ExecContext(ctx, `
+INSERT INTO series_points(time, series_id, value, repo_id, repo_name_id, original_repo_name_id, capture)
+VALUES
+	($1, 'series1', 1, 1, 1, 1, NULL),
+	($2, 'series1', 5, 1, 1, 1, NULL),
+	($2, 'series1', 7, 2, 2, 2, NULL),
+	($2, 'series2', 3, 1, 1, 1, 'Java'),
+	($2, 'series2', 4, 2, 2, 2, 'Java'),
+	($2, 'series2', 9, 2, 2, 2, 'Kotlin'),
+	($2, 'series3', 9, 2, 2, 2, NULL);
+`, older, latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("sums the latest points per capture", func(t *testing.T) {
+		got, err := seriesStore.GetLatestSeriesValues(ctx, []string{"series1", "series2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		java, kotlin := "Java", "Kotlin"
+		want := []LatestSeriesValue{
+			{SeriesID: "series1", RecordingTime: latest, Value: 12},
+			{SeriesID: "series2", Capture: &java, RecordingTime: latest, Value: 7},
+			{SeriesID: "series2", Capture: &kotlin, RecordingTime: latest, Value: 9},
+		}
+		if diff := cmp.Diff(want, got, cmp.Transformer("UTC", func(t time.Time) time.Time { return t.UTC() })); diff != "" {
+			t.Errorf("unexpected values (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("respects repo permissions", func(t *testing.T) {
+		permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn([]api.RepoID{2}, nil)
+		t.Cleanup(func() { permissionStore.GetUnauthorizedRepoIDsFunc.SetDefaultReturn(nil, nil) })
+
+		got, err := seriesStore.GetLatestSeriesValues(ctx, []string{"series1", "series3"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := []LatestSeriesValue{
+			{SeriesID: "series1", RecordingTime: latest, Value: 5},
+		}
+		if diff := cmp.Diff(want, got, cmp.Transformer("UTC", func(t time.Time) time.Time { return t.UTC() })); diff != "" {
+			t.Errorf("unexpected values (-want +got):\n%s", diff)
+		}
+	})
+}
+
 func setupSeries(ctx context.Context, tx *InsightStore, t *testing.T) types.InsightSeries {
 	now := time.Now()
 	series := types.InsightSeries{
@@ -127,10 +127,11 @@ const (
 type GenerationMethod string
 
 const (
-	Search         GenerationMethod = "search"
-	SearchCompute  GenerationMethod = "search-compute"
-	LanguageStats  GenerationMethod = "language-stats"
-	MappingCompute GenerationMethod = "mapping-compute"
+	Search              GenerationMethod = "search"
+	SearchCompute       GenerationMethod = "search-compute"
+	LanguageStats       GenerationMethod = "language-stats"
+	MappingCompute      GenerationMethod = "mapping-compute"
+	LanguageComposition GenerationMethod = "language-composition"
 )
 
 type Dashboard struct {
@@ -206,6 +207,48 @@ type RecordingTime struct {
 	Snapshot  bool
 }
 
+// AlertRuleKind is the kind of condition an insight series alert rule evaluates.
+type AlertRuleKind string
+
+const (
+	// ThresholdAlertRule compares the latest recorded value of a series against a fixed value.
+	ThresholdAlertRule AlertRuleKind = "THRESHOLD"
+	// TrendAlertRule compares the change between the latest recorded value of a series and the value
+	// recorded WindowSize recordings earlier against a fixed value.
+	TrendAlertRule AlertRuleKind = "TREND"
+)
+
+type AlertRuleComparator string
+
+const (
+	AlertAbove AlertRuleComparator = "ABOVE"
+	AlertBelow AlertRuleComparator = "BELOW"
+)
+
+type AlertRuleState string
+
+const (
+	AlertRuleOK     AlertRuleState = "OK"
+	AlertRuleFiring AlertRuleState = "FIRING"
+)
+
+// InsightSeriesAlertRule is a threshold or trend rule attached to an insight series. Rules are evaluated each time
+// the series is recorded, and notify when they transition from OK to FIRING.
+type InsightSeriesAlertRule struct {
+	ID              int
+	InsightSeriesID int // references insight_series(id)
+	Kind            AlertRuleKind
+	Comparator      AlertRuleComparator
+	Value           float64
+	WindowSize      int
+	EmailRecipients []string
+	State           AlertRuleState
+	LastValue       *float64
+	LastEvaluatedAt *time.Time
+	LastFiredAt     *time.Time
+	CreatedAt       time.Time
+}
+
 type SearchAggregationMode string
 
 const (
@@ -66,6 +66,8 @@ func TestCreateAndGetNotebook(t *testing.T) {
 			SymbolContainerName: "container",
 			SymbolKind:          "FUNCTION",
 		}},
+		{ID: "5", Type: NotebookComputeBlockType, ComputeInput: &NotebookComputeBlockInput{"content:output(go (\\d+) -> $1)"}},
+		{ID: "6", Type: NotebookInsightBlockType, InsightInput: &NotebookInsightBlockInput{"2MHIU8WbNNlvYtPELnVHIY3qmLO"}},
 	}
 	notebook := notebookByUser(&Notebook{Title: "Notebook Title", Blocks: blocks, Public: true}, user.ID)
 	createdNotebook, err := n.CreateNotebook(ctx, notebook)
@@ -11,6 +11,8 @@ const (
 	NotebookMarkdownBlockType NotebookBlockType = "md"
 	NotebookFileBlockType     NotebookBlockType = "file"
 	NotebookSymbolBlockType   NotebookBlockType = "symbol"
+	NotebookComputeBlockType  NotebookBlockType = "compute"
+	NotebookInsightBlockType  NotebookBlockType = "insight"
 )
 
 type NotebookQueryBlockInput struct {
@@ -46,6 +48,15 @@ type NotebookSymbolBlockInput struct {
 	SymbolKind          string  `json:"symbolKind"`
 }
 
+type NotebookComputeBlockInput struct {
+	Text string `json:"text"`
+}
+
+type NotebookInsightBlockInput struct {
+	// SeriesID is the ID of the code insight series to embed.
+	SeriesID string `json:"seriesID"`
+}
+
 type NotebookBlock struct {
 	ID            string                      `json:"id"`
 	Type          NotebookBlockType           `json:"type"`
@@ -53,6 +64,8 @@ type NotebookBlock struct {
 	MarkdownInput *NotebookMarkdownBlockInput `json:"markdownInput,omitempty"`
 	FileInput     *NotebookFileBlockInput     `json:"fileInput,omitempty"`
 	SymbolInput   *NotebookSymbolBlockInput   `json:"symbolInput,omitempty"`
+	ComputeInput  *NotebookComputeBlockInput  `json:"computeInput,omitempty"`
+	InsightInput  *NotebookInsightBlockInput  `json:"insightInput,omitempty"`
 }
 
 type NotebookBlocks []NotebookBlock
@@ -22,6 +22,11 @@ func TestNotebookBlocksValidation(t *testing.T) {
 		{blocks: NotebookBlocks{
 			{ID: "id1", SymbolInput: &NotebookSymbolBlockInput{LineContext: -10}, Type: NotebookSymbolBlockType},
 		}, wantErr: "symbol block line context cannot be negative, block id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookComputeBlockType}}, wantErr: "invalid compute block with id: id1"},
+		{blocks: NotebookBlocks{{ID: "id1", Type: NotebookInsightBlockType}}, wantErr: "invalid insight block with id: id1"},
+		{blocks: NotebookBlocks{
+			{ID: "id1", InsightInput: &NotebookInsightBlockInput{SeriesID: " "}, Type: NotebookInsightBlockType},
+		}, wantErr: "insight block series ID cannot be empty, block id: id1"},
 	}
 
 	for _, tt := range tests {
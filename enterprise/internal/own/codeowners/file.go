@@ -31,19 +31,33 @@ type IngestedRulesetSource struct {
 
 func (IngestedRulesetSource) rulesetSource() {}
 
+// RepoPatternRulesetSource describes the codeowners file was taken from data
+// ingested for all the repositories matching a repo name pattern.
+type RepoPatternRulesetSource struct {
+	ID          int32
+	RepoPattern string
+}
+
+func (RepoPatternRulesetSource) rulesetSource() {}
+
 type Ruleset struct {
-	proto  *codeownerspb.File
-	rules  []*CompiledRule
-	source RulesetSource
+	proto    *codeownerspb.File
+	rules    []*CompiledRule
+	sections map[string]*codeownerspb.Section
+	source   RulesetSource
 }
 
 func NewRuleset(source RulesetSource, proto *codeownerspb.File) *Ruleset {
 	f := &Ruleset{
-		proto:  proto,
-		source: source,
+		proto:    proto,
+		sections: make(map[string]*codeownerspb.Section),
+		source:   source,
 	}
 	for _, r := range proto.GetRule() {
-		f.rules = append(f.rules, &CompiledRule{proto: r})
+		f.rules = append(f.rules, &CompiledRule{proto: r, source: source})
+	}
+	for _, s := range proto.GetSection() {
+		f.sections[s.GetName()] = s
 	}
 	return f
 }
@@ -52,10 +66,52 @@ func (r *Ruleset) GetFile() *codeownerspb.File {
 	return r.proto
 }
 
+// GetSource returns the source of the ruleset. For merged rulesets, this is
+// the source of the ruleset that was merged last.
 func (r *Ruleset) GetSource() RulesetSource {
 	return r.source
 }
 
+// GetRuleSource returns the source the given rule of the ruleset was taken from,
+// or nil if the rule is not part of the ruleset.
+func (r *Ruleset) GetRuleSource(rule *codeownerspb.Rule) RulesetSource {
+	for _, cr := range r.rules {
+		if cr.proto == rule {
+			return cr.source
+		}
+	}
+	return nil
+}
+
+// Merge returns a new ruleset that evaluates the rules of other after the rules
+// of r. As the last matching rule of a section wins, the rules of other take
+// precedence for the paths that are matched by both. Sections of other also take
+// precedence over the sections of r with the same name.
+func (r *Ruleset) Merge(other *Ruleset) *Ruleset {
+	merged := &Ruleset{
+		proto:    &codeownerspb.File{},
+		rules:    make([]*CompiledRule, 0, len(r.rules)+len(other.rules)),
+		sections: make(map[string]*codeownerspb.Section, len(r.sections)+len(other.sections)),
+		source:   other.source,
+	}
+	for _, x := range []*Ruleset{r, other} {
+		for _, cr := range x.rules {
+			merged.proto.Rule = append(merged.proto.Rule, cr.proto)
+			merged.rules = append(merged.rules, &CompiledRule{proto: cr.proto, source: cr.source})
+		}
+		for name, section := range x.sections {
+			merged.sections[name] = section
+		}
+	}
+	for _, section := range r.proto.GetSection() {
+		if merged.sections[section.GetName()] == section {
+			merged.proto.Section = append(merged.proto.Section, section)
+		}
+	}
+	merged.proto.Section = append(merged.proto.Section, other.proto.GetSection()...)
+	return merged
+}
+
 // Match returns the rule matching the given path as per this CODEOWNERS ruleset.
 // Rules are evaluated in order: The returned rule is the rule which pattern matches
 // the given path that is the furthest down the input file.
@@ -74,8 +130,66 @@ func (x *Ruleset) Match(path string) *codeownerspb.Rule {
 	return nil
 }
 
+// MatchSections returns the rules matching the given path, one per section.
+// Every section is evaluated separately: Within a section, the returned rule
+// is the matching rule that is the furthest down the input file. Rules that
+// are not part of any section are evaluated together as one section.
+// The rules are returned in the order of their sections' first appearance in
+// the file.
+func (x *Ruleset) MatchSections(path string) []*codeownerspb.Rule {
+	if path[0] != '/' {
+		path = "/" + path
+	}
+	var sectionOrder []string
+	matches := map[string]*codeownerspb.Rule{}
+	for _, rule := range x.rules {
+		name := rule.proto.GetSectionName()
+		if _, ok := matches[name]; !ok {
+			sectionOrder = append(sectionOrder, name)
+			matches[name] = nil
+		}
+		if rule.match(path) {
+			matches[name] = rule.proto
+		}
+	}
+	var rules []*codeownerspb.Rule
+	for _, name := range sectionOrder {
+		if rule := matches[name]; rule != nil {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// MatchOwners returns the owners of the given path across all sections,
+// as per MatchSections. Owners listed in several sections are returned once.
+func (x *Ruleset) MatchOwners(path string) []*codeownerspb.Owner {
+	type ownerKey struct{ handle, email string }
+	seen := map[ownerKey]bool{}
+	var owners []*codeownerspb.Owner
+	for _, rule := range x.MatchSections(path) {
+		for _, o := range rule.GetOwner() {
+			k := ownerKey{o.GetHandle(), o.GetEmail()}
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			owners = append(owners, o)
+		}
+	}
+	return owners
+}
+
+// GetSection returns the section with the given name, or nil if the section
+// is not defined in the file. Rules that are not part of any section have an
+// empty section name, for which nil is returned.
+func (x *Ruleset) GetSection(name string) *codeownerspb.Section {
+	return x.sections[name]
+}
+
 type CompiledRule struct {
 	proto       *codeownerspb.Rule
+	source      RulesetSource
 	glob        *paths.GlobPattern
 	compileOnce sync.Once
 }
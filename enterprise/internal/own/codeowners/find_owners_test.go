@@ -255,6 +255,93 @@ func TestFileOwnersOrder(t *testing.T) {
 	assert.Equal(t, wantOwner, got.GetOwner())
 }
 
+func TestFileOwnersMatchSections(t *testing.T) {
+	rs := codeowners.NewRuleset(
+		codeowners.IngestedRulesetSource{},
+		&codeownerspb.File{
+			Rule: []*codeownerspb.Rule{
+				{
+					Pattern: "*.go",
+					Owner:   []*codeownerspb.Owner{{Handle: "go-owner"}},
+				},
+				{
+					Pattern:     "/docs/",
+					SectionName: "docs",
+					Owner:       []*codeownerspb.Owner{{Handle: "docs-owner"}},
+				},
+				{
+					Pattern:     "/src/",
+					SectionName: "eng",
+					Owner:       []*codeownerspb.Owner{{Handle: "eng-owner"}},
+				},
+				// Within a section, the last matching rule is picked.
+				{
+					Pattern:     "/src/internal/",
+					SectionName: "eng",
+					Owner:       []*codeownerspb.Owner{{Handle: "internal-owner"}, {Handle: "go-owner"}},
+				},
+			},
+			Section: []*codeownerspb.Section{
+				{Name: "docs"},
+				{Name: "eng", Approvals: 2},
+			},
+		})
+
+	got := rs.MatchSections("/src/internal/main.go")
+	var gotPatterns []string
+	for _, r := range got {
+		gotPatterns = append(gotPatterns, r.GetPattern())
+	}
+	assert.Equal(t, []string{"*.go", "/src/internal/"}, gotPatterns)
+
+	// The owners listed in several sections are only returned once.
+	wantOwners := []*codeownerspb.Owner{{Handle: "go-owner"}, {Handle: "internal-owner"}}
+	assert.Equal(t, wantOwners, rs.MatchOwners("src/internal/main.go"))
+
+	assert.Empty(t, rs.MatchSections("/README.md"))
+	assert.Equal(t, int32(2), rs.GetSection("eng").GetApprovals())
+	assert.Nil(t, rs.GetSection(""))
+}
+
+func TestRulesetMerge(t *testing.T) {
+	gitSource := codeowners.GitRulesetSource{Repo: 1, Commit: "SHA", Path: "CODEOWNERS"}
+	git := codeowners.NewRuleset(gitSource, &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "*.go", Owner: []*codeownerspb.Owner{{Handle: "go-owner"}}},
+			{Pattern: "/docs/", SectionName: "docs", Owner: []*codeownerspb.Owner{{Handle: "docs-owner"}}},
+		},
+		Section: []*codeownerspb.Section{{Name: "docs"}},
+	})
+	patternSource := codeowners.RepoPatternRulesetSource{ID: 1, RepoPattern: "github.com/sourcegraph/*"}
+	pattern := codeowners.NewRuleset(patternSource, &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "/internal/", Owner: []*codeownerspb.Owner{{Handle: "internal-owner"}}},
+			{Pattern: "/docs/api/", SectionName: "docs", Owner: []*codeownerspb.Owner{{Handle: "api-owner"}}},
+		},
+		Section: []*codeownerspb.Section{{Name: "docs", Approvals: 2}},
+	})
+
+	merged := git.Merge(pattern)
+
+	// Rules of the merged ruleset take precedence for the paths matched by both.
+	assert.Equal(t, "internal-owner", merged.Match("internal/main.go").GetOwner()[0].GetHandle())
+	assert.Equal(t, "go-owner", merged.Match("cmd/main.go").GetOwner()[0].GetHandle())
+	assert.Equal(t, "api-owner", merged.Match("docs/api/README.md").GetOwner()[0].GetHandle())
+	assert.Equal(t, "docs-owner", merged.Match("docs/README.md").GetOwner()[0].GetHandle())
+
+	// Every rule keeps track of the file it comes from.
+	assert.Equal(t, gitSource, merged.GetRuleSource(merged.Match("cmd/main.go")))
+	assert.Equal(t, patternSource, merged.GetRuleSource(merged.Match("internal/main.go")))
+	assert.Nil(t, merged.GetRuleSource(&codeownerspb.Rule{}))
+
+	assert.Equal(t, int32(2), merged.GetSection("docs").GetApprovals())
+	assert.Len(t, merged.GetFile().GetSection(), 1)
+
+	// The merged rulesets are left untouched.
+	assert.Equal(t, "go-owner", git.Match("internal/main.go").GetOwner()[0].GetHandle())
+	assert.Equal(t, int32(0), git.GetSection("docs").GetApprovals())
+}
+
 func BenchmarkOwnersMatchLiteral(b *testing.B) {
 	pattern := "/main/src/foo/bar/README.md"
 	paths := []string{
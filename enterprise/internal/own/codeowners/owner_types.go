@@ -1,6 +1,9 @@
 package codeowners
 
-import "github.com/sourcegraph/sourcegraph/internal/types"
+import (
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+)
 
 type ResolvedOwner interface {
 	Type() OwnerType
@@ -29,6 +32,10 @@ type Person struct {
 	// Original proto fields.
 	Handle string
 	Email  string
+
+	// HistorySignals are the ownership signals derived from git history
+	// that associate this person with the path they own.
+	HistorySignals []*owntypes.HistorySignal
 }
 
 func (p *Person) Type() OwnerType {
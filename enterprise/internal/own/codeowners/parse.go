@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"io"
 	"net/mail"
+	"strconv"
 	"strings"
 
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
@@ -17,6 +18,10 @@ import (
 func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 	scanner := bufio.NewScanner(codeownersFile)
 	var rs []*codeownerspb.Rule
+	var sections []*codeownerspb.Section
+	// Sections with the same name are combined, so only the first header
+	// defining a section yields a Section.
+	seenSections := map[string]bool{}
 	p := new(parsing)
 	lineNumber := int32(0)
 	for scanner.Scan() {
@@ -25,7 +30,12 @@ func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 		if p.isBlank() {
 			continue
 		}
-		if p.matchSection() {
+		if section, ok := p.matchSection(); ok {
+			if !seenSections[section.Name] {
+				seenSections[section.Name] = true
+				section.LineNumber = lineNumber
+				sections = append(sections, section)
+			}
 			continue
 		}
 		pattern, owners, ok := p.matchRule()
@@ -35,21 +45,25 @@ func Parse(codeownersFile io.Reader) (*codeownerspb.File, error) {
 		// Need to handle this error once, codeownerspb.File supports
 		// error metadata.
 		r := codeownerspb.Rule{
-			Pattern: unescape(pattern),
-			// Section names are case-insensitive, so we lowercase it.
-			SectionName: strings.TrimSpace(strings.ToLower(p.section)),
+			Pattern:     unescape(pattern),
+			SectionName: p.section,
 			LineNumber:  lineNumber,
 		}
 		for _, ownerText := range owners {
 			o := ParseOwner(ownerText)
 			r.Owner = append(r.Owner, o)
 		}
+		// Rules without owners inherit the default owners listed
+		// on the section header, if any.
+		if len(r.Owner) == 0 {
+			r.Owner = p.sectionDefaultOwners
+		}
 		rs = append(rs, &r)
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, err
 	}
-	return &codeownerspb.File{Rule: rs}, nil
+	return &codeownerspb.File{Rule: rs, Section: sections}, nil
 }
 
 func ParseOwner(ownerText string) *codeownerspb.Owner {
@@ -71,8 +85,10 @@ type parsing struct {
 	// in such a way that for syntactic purposes, every line can be considered
 	// in isolation.
 	line string
-	// The most recently defined section, or "" if none.
+	// The name of the most recently defined section, or "" if none.
 	section string
+	// The default owners listed on the most recent section header.
+	sectionDefaultOwners []*codeownerspb.Owner
 }
 
 // nextLine advances parsing to focus on the next line.
@@ -113,18 +129,42 @@ func (p *parsing) matchRule() (string, []string, bool) {
 	return filePattern, owners, true
 }
 
-var sectionPattern = lazyregexp.New(`^\s*\^?\s*\[([^\]]+)\]\s*(?:\[[0-9]+\])?\s*$`)
+// sectionPattern is expected to match a section header like:
+// `^[Section name][2] @default-owner owner@example.com`.
+//
+// The first capturing group matches the optional `^` marker, the second one
+// the section name, the third one the optional number of required approvals
+// and the fourth one the default owners separated by whitespace.
+var sectionPattern = lazyregexp.New(`^\s*(\^)?\s*\[([^\]]+)\]\s*(?:\[([0-9]+)\])?((?:\s+\S+)*)\s*$`)
 
 // matchSection tries to extract a section which looks like `[section name]`.
 // A section can also be defined as `^[Section]`, meaning it is optional for approval.
 // It can also be `[Section][2]`, meaning two approvals are required.
-func (p *parsing) matchSection() bool {
+// The header can be followed by default owners for the rules in the section
+// that list no owners, like `[Section] @owner`.
+func (p *parsing) matchSection() (*codeownerspb.Section, bool) {
 	match := sectionPattern.FindStringSubmatch(p.lineWithoutComments())
-	if len(match) != 2 {
-		return false
+	if len(match) != 5 {
+		return nil, false
+	}
+	section := &codeownerspb.Section{
+		// Section names are case-insensitive, so we lowercase it.
+		Name:     strings.TrimSpace(strings.ToLower(match[2])),
+		Optional: match[1] != "",
+	}
+	if match[3] != "" {
+		approvals, err := strconv.ParseInt(match[3], 10, 32)
+		if err != nil {
+			return nil, false
+		}
+		section.Approvals = int32(approvals)
+	}
+	for _, ownerText := range strings.Fields(match[4]) {
+		section.DefaultOwner = append(section.DefaultOwner, ParseOwner(ownerText))
 	}
-	p.section = match[1]
-	return true
+	p.section = section.Name
+	p.sectionDefaultOwners = section.DefaultOwner
+	return section, true
 }
 
 // isBlank returns true if the current line has no semantically relevant
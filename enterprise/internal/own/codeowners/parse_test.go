@@ -362,7 +362,11 @@ README.md  @docs
 			LineNumber:  69,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "documentation", LineNumber: 59},
+		{Name: "database", LineNumber: 63},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseAtHandle(t *testing.T) {
@@ -482,7 +486,12 @@ own/codeowners/* @own-pms
 			},
 			LineNumber: 14,
 		}}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "pm", LineNumber: 1},
+		// The properties of the first header are kept for combined sections.
+		{Name: "eng", Optional: true, LineNumber: 5},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseManySections(t *testing.T) {
@@ -518,7 +527,11 @@ func TestParseManySections(t *testing.T) {
 			LineNumber: 5,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "pm", LineNumber: 2},
+		{Name: "docs", LineNumber: 4},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
 }
 
 func TestParseEmptyString(t *testing.T) {
@@ -571,5 +584,83 @@ func TestParseSectionWithComment(t *testing.T) {
 			LineNumber: 2,
 		},
 	}
-	assert.Equal(t, &codeownerspb.File{Rule: want}, got)
+	wantSections := []*codeownerspb.Section{
+		{Name: "section", LineNumber: 1},
+	}
+	assert.Equal(t, &codeownerspb.File{Rule: want, Section: wantSections}, got)
+}
+
+func TestParseSectionProperties(t *testing.T) {
+	got, err := codeowners.Parse(strings.NewReader(
+		`[Docs][2] @docs-team docs@example.com
+docs/
+docs/internal/ @internal-docs
+
+^[Optional Reviewers] @reviewers
+*.go
+
+^[Eng][3]
+own/ @own-engs`))
+	require.NoError(t, err)
+	defaultDocsOwners := []*codeownerspb.Owner{
+		{Handle: "docs-team"},
+		{Email: "docs@example.com"},
+	}
+	want := &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{
+				Pattern:     "docs/",
+				SectionName: "docs",
+				Owner:       defaultDocsOwners,
+				LineNumber:  2,
+			},
+			{
+				Pattern:     "docs/internal/",
+				SectionName: "docs",
+				Owner: []*codeownerspb.Owner{
+					{Handle: "internal-docs"},
+				},
+				LineNumber: 3,
+			},
+			{
+				Pattern:     "*.go",
+				SectionName: "optional reviewers",
+				Owner: []*codeownerspb.Owner{
+					{Handle: "reviewers"},
+				},
+				LineNumber: 6,
+			},
+			{
+				Pattern:     "own/",
+				SectionName: "eng",
+				Owner: []*codeownerspb.Owner{
+					{Handle: "own-engs"},
+				},
+				LineNumber: 9,
+			},
+		},
+		Section: []*codeownerspb.Section{
+			{
+				Name:         "docs",
+				Approvals:    2,
+				DefaultOwner: defaultDocsOwners,
+				LineNumber:   1,
+			},
+			{
+				Name:     "optional reviewers",
+				Optional: true,
+				DefaultOwner: []*codeownerspb.Owner{
+					{Handle: "reviewers"},
+				},
+				LineNumber: 5,
+			},
+			{
+				Name:       "eng",
+				Optional:   true,
+				Approvals:  3,
+				LineNumber: 8,
+			},
+		},
+	}
+	assert.Equal(t, want, got)
 }
@@ -3,6 +3,8 @@ package codeowners
 import (
 	"fmt"
 	"strings"
+
+	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
 )
 
 // Repr returns a string representation that resembles the syntax
@@ -15,19 +17,35 @@ func (f *Ruleset) Repr() string {
 	var lastSeenSection string
 	for _, r := range f.proto.GetRule() {
 		if s := r.SectionName; s != lastSeenSection {
-			fmt.Fprintf(w, "[%s]\n", s)
+			reprSectionHeader(w, s, f.GetSection(s))
 			lastSeenSection = s
 		}
 		fmt.Fprint(w, r.Pattern)
-		for _, o := range r.GetOwner() {
-			if h := o.GetHandle(); h != "" {
-				fmt.Fprintf(w, " @%s", h)
-			}
-			if e := o.GetEmail(); e != "" {
-				fmt.Fprintf(w, " %s", e)
-			}
-		}
+		reprOwners(w, r.GetOwner())
 		fmt.Fprintln(w)
 	}
 	return w.String()
 }
+
+func reprSectionHeader(w *strings.Builder, name string, section *codeownerspb.Section) {
+	if section.GetOptional() {
+		fmt.Fprint(w, "^")
+	}
+	fmt.Fprintf(w, "[%s]", name)
+	if a := section.GetApprovals(); a > 0 {
+		fmt.Fprintf(w, "[%d]", a)
+	}
+	reprOwners(w, section.GetDefaultOwner())
+	fmt.Fprintln(w)
+}
+
+func reprOwners(w *strings.Builder, owners []*codeownerspb.Owner) {
+	for _, o := range owners {
+		if h := o.GetHandle(); h != "" {
+			fmt.Fprintf(w, " @%s", h)
+		}
+		if e := o.GetEmail(); e != "" {
+			fmt.Fprintf(w, " %s", e)
+		}
+	}
+}
@@ -40,6 +40,9 @@ type File struct {
 	unknownFields protoimpl.UnknownFields
 
 	Rule []*Rule `protobuf:"bytes,1,rep,name=rule,proto3" json:"rule,omitempty"`
+	// Sections lists all the sections defined in the file, in the order
+	// they first appear in. Rules reference a section by its name.
+	Section []*Section `protobuf:"bytes,2,rep,name=section,proto3" json:"section,omitempty"`
 }
 
 func (x *File) Reset() {
@@ -81,6 +84,119 @@ func (x *File) GetRule() []*Rule {
 	return nil
 }
 
+func (x *File) GetSection() []*Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+// Section groups rules that are evaluated independently of rules in
+// other sections, as supported by GitLab. In the text representation
+// a section starts with a header line like `[Section name]`, and all
+// the rules that follow belong to that section until the next header.
+// Headers can define additional properties of a section:
+//   - `^[Section]` marks the section as optional. Approval from the
+//     owners in an optional section is not required.
+//   - `[Section][2]` requires the given number of approvals from
+//     the owners in the section.
+//   - `[Section] @owner` sets default owners for the section. Rules
+//     within the section that list no owners inherit the default
+//     owners.
+//
+// Sections with the same name (compared case-insensitively) are combined,
+// in which case the properties of the first header are kept.
+type Section struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name is the lowercase name of the section. It is referenced
+	// by `Rule.section_name`.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Optional indicates that approval from the owners of this section
+	// is not required.
+	Optional bool `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+	// Approvals is the number of approvals required from the owners of
+	// this section. Zero means that the number was not specified,
+	// in which case a single approval is required.
+	Approvals int32 `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	// Default owners listed on the header that first defined this section.
+	// Rules that do not list any owners inherit the default owners
+	// of the header they appear under.
+	DefaultOwner []*Owner `protobuf:"bytes,4,rep,name=default_owner,json=defaultOwner,proto3" json:"default_owner,omitempty"`
+	// The line number of the header that first defined this section
+	// in the input data.
+	LineNumber int32 `protobuf:"varint,5,opt,name=line_number,json=lineNumber,proto3" json:"line_number,omitempty"`
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_codeowners_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_codeowners_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_codeowners_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Section) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Section) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+func (x *Section) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *Section) GetDefaultOwner() []*Owner {
+	if x != nil {
+		return x.DefaultOwner
+	}
+	return nil
+}
+
+func (x *Section) GetLineNumber() int32 {
+	if x != nil {
+		return x.LineNumber
+	}
+	return 0
+}
+
 // Rule associates a single pattern to match a path with an owner.
 type Rule struct {
 	state         protoimpl.MessageState
@@ -139,7 +255,7 @@ type Rule struct {
 func (x *Rule) Reset() {
 	*x = Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_codeowners_proto_msgTypes[1]
+		mi := &file_codeowners_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +268,7 @@ func (x *Rule) String() string {
 func (*Rule) ProtoMessage() {}
 
 func (x *Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_codeowners_proto_msgTypes[1]
+	mi := &file_codeowners_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +281,7 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rule.ProtoReflect.Descriptor instead.
 func (*Rule) Descriptor() ([]byte, []int) {
-	return file_codeowners_proto_rawDescGZIP(), []int{1}
+	return file_codeowners_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Rule) GetPattern() string {
@@ -216,7 +332,7 @@ type Owner struct {
 func (x *Owner) Reset() {
 	*x = Owner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_codeowners_proto_msgTypes[2]
+		mi := &file_codeowners_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -229,7 +345,7 @@ func (x *Owner) String() string {
 func (*Owner) ProtoMessage() {}
 
 func (x *Owner) ProtoReflect() protoreflect.Message {
-	mi := &file_codeowners_proto_msgTypes[2]
+	mi := &file_codeowners_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +358,7 @@ func (x *Owner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Owner.ProtoReflect.Descriptor instead.
 func (*Owner) Descriptor() ([]byte, []int) {
-	return file_codeowners_proto_rawDescGZIP(), []int{2}
+	return file_codeowners_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Owner) GetHandle() string {
@@ -264,10 +380,25 @@ var File_codeowners_proto protoreflect.FileDescriptor
 var file_codeowners_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x63, 0x6f, 0x64, 0x65, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x11, 0x6f, 0x77, 0x6e, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x6f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x33, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x69, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2b, 0x0a,
 	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x77,
 	0x6e, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x04, 0x52,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x77,
+	0x6e, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xb7, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x77, 0x6e, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x0c, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6c, 0x69, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x04, 0x52,
 	0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x2e, 0x0a,
 	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
@@ -300,20 +431,23 @@ func file_codeowners_proto_rawDescGZIP() []byte {
 	return file_codeowners_proto_rawDescData
 }
 
-var file_codeowners_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_codeowners_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_codeowners_proto_goTypes = []interface{}{
-	(*File)(nil),  // 0: own.codeowners.v1.File
-	(*Rule)(nil),  // 1: own.codeowners.v1.Rule
-	(*Owner)(nil), // 2: own.codeowners.v1.Owner
+	(*File)(nil),    // 0: own.codeowners.v1.File
+	(*Section)(nil), // 1: own.codeowners.v1.Section
+	(*Rule)(nil),    // 2: own.codeowners.v1.Rule
+	(*Owner)(nil),   // 3: own.codeowners.v1.Owner
 }
 var file_codeowners_proto_depIdxs = []int32{
-	1, // 0: own.codeowners.v1.File.rule:type_name -> own.codeowners.v1.Rule
-	2, // 1: own.codeowners.v1.Rule.owner:type_name -> own.codeowners.v1.Owner
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 0: own.codeowners.v1.File.rule:type_name -> own.codeowners.v1.Rule
+	1, // 1: own.codeowners.v1.File.section:type_name -> own.codeowners.v1.Section
+	3, // 2: own.codeowners.v1.Section.default_owner:type_name -> own.codeowners.v1.Owner
+	3, // 3: own.codeowners.v1.Rule.owner:type_name -> own.codeowners.v1.Owner
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_codeowners_proto_init() }
@@ -335,7 +469,7 @@ func file_codeowners_proto_init() {
 			}
 		}
 		file_codeowners_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rule); i {
+			switch v := v.(*Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -347,6 +481,18 @@ func file_codeowners_proto_init() {
 			}
 		}
 		file_codeowners_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_codeowners_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Owner); i {
 			case 0:
 				return &v.state
@@ -365,7 +511,7 @@ func file_codeowners_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_codeowners_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
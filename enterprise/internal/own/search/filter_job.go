@@ -110,6 +110,13 @@ func applyCodeOwnershipFiltering(
 ) ([]result.Match, error) {
 	var errs error
 
+	// The history signals of all the matched files are looked up at once,
+	// instead of once per match.
+	signalsByRepo, err := rules.historySignalsForMatches(ctx, matches)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+
 	filtered := matches[:0]
 
 matchesLoop:
@@ -125,11 +132,11 @@ matchesLoop:
 			errs = errors.Append(errs, err)
 			continue matchesLoop
 		}
-		rule := file.Match(mm.File.Path)
-		var owners []*codeownerspb.Owner
-		// If match.
-		if rule != nil {
-			owners = rule.GetOwner()
+		// Owners from all the sections of the file are considered.
+		owners := file.MatchOwners(mm.File.Path)
+		// So are the recent contributors from the git history of the file.
+		for _, s := range signalsByRepo[mm.Repo.ID][mm.File.Path] {
+			owners = append(owners, &codeownerspb.Owner{Email: s.AuthorEmail})
 		}
 		for _, owner := range includeOwners {
 			if !containsOwner(owners, owner) {
@@ -13,10 +13,13 @@ import (
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
+	"github.com/sourcegraph/sourcegraph/internal/conf"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	"github.com/sourcegraph/sourcegraph/internal/search"
 	"github.com/sourcegraph/sourcegraph/internal/search/job"
 	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 func TestFeatureFlaggedFileHasOwnerJob(t *testing.T) {
@@ -312,3 +315,38 @@ func TestApplyCodeOwnershipFiltering(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyCodeOwnershipFilteringHistorySignalsError(t *testing.T) {
+	conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{OwnHistorySignals: &schema.OwnHistorySignals{Enabled: true}}})
+	defer conf.Mock(nil)
+
+	ctx := context.Background()
+
+	gitserverClient := gitserver.NewMockClient()
+	gitserverClient.ReadFileFunc.SetDefaultHook(func(_ context.Context, _ authz.SubRepoPermissionChecker, _ api.RepoName, _ api.CommitID, file string) ([]byte, error) {
+		if file != "CODEOWNERS" {
+			return nil, fs.ErrNotExist
+		}
+		return []byte("README.md @test"), nil
+	})
+
+	codeownersStore := edb.NewMockCodeownersStore()
+	codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(nil, nil)
+	ownSignalStore := edb.NewMockOwnSignalStore()
+	ownSignalStore.ListHistorySignalsForPathsFunc.SetDefaultReturn(nil, errors.New("history signals unavailable"))
+	db := edb.NewMockEnterpriseDB()
+	db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+	db.OwnSignalsFunc.SetDefaultReturn(ownSignalStore)
+
+	rules := NewRulesCache(gitserverClient, db)
+
+	readme := &result.FileMatch{File: result.File{Path: "README.md"}}
+	matches, err := applyCodeOwnershipFiltering(ctx, &rules, []string{"@test"}, nil, []result.Match{
+		readme,
+		&result.FileMatch{File: result.File{Path: "main.go"}},
+	})
+
+	// The error is reported, but the matches are still filtered by their CODEOWNERS owners.
+	require.Error(t, err)
+	require.Equal(t, []result.Match{readme}, matches)
+}
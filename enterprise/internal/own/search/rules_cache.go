@@ -7,9 +7,12 @@ import (
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/database"
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
+	"github.com/sourcegraph/sourcegraph/internal/search/result"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
 type RulesKey struct {
@@ -55,3 +58,34 @@ func (c *RulesCache) GetFromCacheOrFetch(ctx context.Context, repoName api.RepoN
 	}
 	return c.rules[key], nil
 }
+
+// historySignalsForMatches looks up the history signals of the files of the given
+// file matches, with a single lookup per repository. The signals are keyed by
+// repository and then by path. Repositories for which the lookup failed are
+// missing from the result, so their matches only have CODEOWNERS owners.
+func (c *RulesCache) historySignalsForMatches(ctx context.Context, matches []result.Match) (map[api.RepoID]map[string][]*owntypes.HistorySignal, error) {
+	var repoIDs []api.RepoID
+	pathsByRepo := make(map[api.RepoID][]string)
+	for _, m := range matches {
+		mm, ok := m.(*result.FileMatch)
+		if !ok {
+			continue
+		}
+		if _, ok := pathsByRepo[mm.Repo.ID]; !ok {
+			repoIDs = append(repoIDs, mm.Repo.ID)
+		}
+		pathsByRepo[mm.Repo.ID] = append(pathsByRepo[mm.Repo.ID], mm.File.Path)
+	}
+
+	var errs error
+	signalsByRepo := make(map[api.RepoID]map[string][]*owntypes.HistorySignal, len(repoIDs))
+	for _, repoID := range repoIDs {
+		signals, err := c.ownService.HistorySignalsForPaths(ctx, repoID, pathsByRepo[repoID])
+		if err != nil {
+			errs = errors.Append(errs, err)
+			continue
+		}
+		signalsByRepo[repoID] = signals
+	}
+	return signalsByRepo, errs
+}
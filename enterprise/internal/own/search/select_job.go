@@ -112,6 +112,13 @@ func getCodeOwnersFromMatches(
 		hasResultWithNoOwners bool
 	)
 
+	// The history signals of all the matched files are looked up at once,
+	// instead of once per match.
+	signalsByRepo, err := rules.historySignalsForMatches(ctx, matches)
+	if err != nil {
+		errs = errors.Append(errs, err)
+	}
+
 	for _, m := range matches {
 		mm, ok := m.(*result.FileMatch)
 		if !ok {
@@ -122,14 +129,15 @@ func getCodeOwnersFromMatches(
 			errs = errors.Append(errs, err)
 			continue
 		}
-		rule := rs.Match(mm.File.Path)
+		owners := rs.MatchOwners(mm.File.Path)
+		signals := signalsByRepo[mm.Repo.ID][mm.File.Path]
 		// No match.
-		if rule == nil || len(rule.GetOwner()) == 0 {
+		if len(owners) == 0 && len(signals) == 0 {
 			hasResultWithNoOwners = true
 			continue
 		}
 
-		resolvedOwners, err := rules.ownService.ResolveOwnersWithType(ctx, rule.GetOwner())
+		resolvedOwners, err := rules.ownService.ResolveOwnersWithType(ctx, owners, signals)
 		if err != nil {
 			errs = errors.Append(errs, err)
 			continue
@@ -11,6 +11,7 @@ import (
 	edb "github.com/sourcegraph/sourcegraph/enterprise/internal/database"
 	"github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners"
 	codeownerspb "github.com/sourcegraph/sourcegraph/enterprise/internal/own/codeowners/v1"
+	owntypes "github.com/sourcegraph/sourcegraph/enterprise/internal/own/types"
 	"github.com/sourcegraph/sourcegraph/internal/api"
 	"github.com/sourcegraph/sourcegraph/internal/authz"
 	"github.com/sourcegraph/sourcegraph/internal/conf"
@@ -24,13 +25,23 @@ import (
 // At this point only data from CODEOWNERS file is presented, if available.
 type Service interface {
 	// RulesetForRepo returns a CODEOWNERS file ruleset from a given repository at given commit ID.
-	// If a CODEOWNERS file has been manually ingested for the repository, it will prioritise returning that file.
-	// In the case the file cannot be found, `nil` `*codeownerspb.File` and `nil` `error` is returned.
+	// CODEOWNERS files that have been manually ingested for the repository, or for repo name patterns
+	// matching it, either override the committed file or are merged with it, as per their mode.
+	// In the case no file can be found, `nil` `*codeownerspb.File` and `nil` `error` is returned.
 	RulesetForRepo(context.Context, api.RepoName, api.RepoID, api.CommitID) (*codeowners.Ruleset, error)
 
-	// ResolveOwnersWithType takes a list of codeownerspb.Owner and attempts to retrieve more information about the
-	// owner from the users and teams databases.
-	ResolveOwnersWithType(context.Context, []*codeownerspb.Owner) ([]codeowners.ResolvedOwner, error)
+	// ResolveOwnersWithType takes a list of codeownerspb.Owner and ownership signals derived from git history,
+	// and attempts to retrieve more information about the owners from the users and teams databases.
+	// A person that is both listed as an owner and has history signals is returned once, with the signals attached.
+	ResolveOwnersWithType(context.Context, []*codeownerspb.Owner, []*owntypes.HistorySignal) ([]codeowners.ResolvedOwner, error)
+
+	// HistorySignalsForPath returns the ownership signals derived from git history for a path in a given repository.
+	// If history signals are disabled in the site configuration, no signals are returned.
+	HistorySignalsForPath(context.Context, api.RepoID, string) ([]*owntypes.HistorySignal, error)
+
+	// HistorySignalsForPaths is like HistorySignalsForPath, but looks up the signals of several paths of
+	// the same repository at once. The returned signals are keyed by path, as given.
+	HistorySignalsForPaths(context.Context, api.RepoID, []string) (map[string][]*owntypes.HistorySignal, error)
 }
 
 var _ Service = &service{}
@@ -69,15 +80,72 @@ var codeownersLocations = []string{
 }
 
 // RulesetForRepo makes a best effort attempt to return a CODEOWNERS file ruleset
-// from one of the possible codeownersLocations, or the ingested codeowners files. It returns nil if no match is found.
+// from one of the possible codeownersLocations, combined with the ingested codeowners
+// files for the repository. It returns nil if no match is found.
+//
+// Ingested files are layered on top of the committed file, from the least to the
+// most specific: the files for matching repo name patterns in the order they were
+// created, and then the file for the repository itself. Every layer either overrides
+// all the layers below, or is merged with them, taking precedence for the paths
+// matched by both.
 func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
+	type layer struct {
+		ruleset *codeowners.Ruleset
+		mode    owntypes.CodeownersMode
+	}
+	var layers []layer
+	repoPatterns, err := s.db.Codeowners().ListCodeownersRepoPatternsForRepo(ctx, repoName)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range repoPatterns {
+		layers = append(layers, layer{
+			ruleset: codeowners.NewRuleset(codeowners.RepoPatternRulesetSource{ID: p.ID, RepoPattern: p.RepoPattern}, p.Proto),
+			mode:    p.Mode,
+		})
+	}
 	ingestedCodeowners, err := s.db.Codeowners().GetCodeownersForRepo(ctx, repoID)
 	if err != nil && !errcode.IsNotFound(err) {
 		return nil, err
 	}
 	if ingestedCodeowners != nil {
-		return codeowners.NewRuleset(codeowners.IngestedRulesetSource{ID: int32(ingestedCodeowners.RepoID)}, ingestedCodeowners.Proto), nil
+		layers = append(layers, layer{
+			ruleset: codeowners.NewRuleset(codeowners.IngestedRulesetSource{ID: int32(ingestedCodeowners.RepoID)}, ingestedCodeowners.Proto),
+			mode:    ingestedCodeowners.Mode,
+		})
+	}
+
+	// Only the layers from the most specific overriding one up apply. If no
+	// layer overrides, the committed file applies too.
+	var rs *codeowners.Ruleset
+	start := -1
+	for i := len(layers) - 1; i >= 0; i-- {
+		if layers[i].mode != owntypes.CodeownersModeMerge {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		rs, err = s.committedRuleset(ctx, repoName, repoID, commitID)
+		if err != nil {
+			return nil, err
+		}
+		start = 0
 	}
+	for _, l := range layers[start:] {
+		if rs == nil {
+			rs = l.ruleset
+			continue
+		}
+		rs = rs.Merge(l.ruleset)
+	}
+	return rs, nil
+}
+
+// committedRuleset returns the ruleset of the CODEOWNERS file committed to the
+// repository, from the first of the codeownersLocations that exists. It returns
+// nil if no file exists.
+func (s *service) committedRuleset(ctx context.Context, repoName api.RepoName, repoID api.RepoID, commitID api.CommitID) (*codeowners.Ruleset, error) {
 	for _, path := range codeownersLocations {
 		content, err := s.gitserverClient.ReadFile(
 			ctx,
@@ -100,23 +168,14 @@ func (s *service) RulesetForRepo(ctx context.Context, repoName api.RepoName, rep
 	return nil, nil
 }
 
-func (s *service) ResolveOwnersWithType(ctx context.Context, protoOwners []*codeownerspb.Owner) ([]codeowners.ResolvedOwner, error) {
-	resolved := make([]codeowners.ResolvedOwner, 0, len(protoOwners))
+func (s *service) ResolveOwnersWithType(ctx context.Context, protoOwners []*codeownerspb.Owner, signals []*owntypes.HistorySignal) ([]codeowners.ResolvedOwner, error) {
+	resolved := make([]codeowners.ResolvedOwner, 0, len(protoOwners)+len(signals))
 
 	// We have to look up owner by owner because of the branching conditions:
 	// We first try to find a user given the owner information. If we cannot find a user, we try to match a team.
 	// If all fails, we return an unknown owner type with the information we have from the proto.
 	for _, po := range protoOwners {
-		ownerIdentifier := ownerKey{po.Handle, po.Email}
-		s.mu.Lock()
-		cached, ok := s.ownerCache[ownerIdentifier]
-		s.mu.Unlock()
-		if ok {
-			resolved = append(resolved, cached)
-			continue
-		}
-
-		resolvedOwner, err := s.resolveOwner(ctx, po.Handle, po.Email)
+		resolvedOwner, err := s.resolveOwnerWithCache(ctx, po.Handle, po.Email)
 		if err != nil {
 			return nil, err
 		}
@@ -125,14 +184,110 @@ func (s *service) ResolveOwnersWithType(ctx context.Context, protoOwners []*code
 			continue
 		}
 		resolved = append(resolved, resolvedOwner)
-		s.mu.Lock()
-		s.ownerCache[ownerIdentifier] = resolvedOwner
-		s.mu.Unlock()
+	}
+
+	// Authors from the git history are identified by email, so they always resolve to a person.
+	for _, signal := range signals {
+		resolvedOwner, err := s.resolveOwnerWithCache(ctx, "", signal.AuthorEmail)
+		if err != nil {
+			return nil, err
+		}
+		person, ok := resolvedOwner.(*codeowners.Person)
+		if !ok {
+			continue
+		}
+		// Resolved owners are cached and shared, so signals are only ever
+		// attached to copies.
+		if i := indexOfPerson(resolved, person); i >= 0 {
+			merged := *resolved[i].(*codeowners.Person)
+			merged.HistorySignals = append(merged.HistorySignals, signal)
+			resolved[i] = &merged
+			continue
+		}
+		withSignal := *person
+		withSignal.HistorySignals = []*owntypes.HistorySignal{signal}
+		resolved = append(resolved, &withSignal)
 	}
 
 	return resolved, nil
 }
 
+func (s *service) resolveOwnerWithCache(ctx context.Context, handle, email string) (codeowners.ResolvedOwner, error) {
+	ownerIdentifier := ownerKey{handle, email}
+	s.mu.Lock()
+	cached, ok := s.ownerCache[ownerIdentifier]
+	s.mu.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	resolvedOwner, err := s.resolveOwner(ctx, handle, email)
+	if err != nil || resolvedOwner == nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.ownerCache[ownerIdentifier] = resolvedOwner
+	s.mu.Unlock()
+	return resolvedOwner, nil
+}
+
+// indexOfPerson returns the index of the resolved owner that is the same person
+// as the given one, or -1 if there is none. Persons are the same if they resolve
+// to the same user, or otherwise if they have the same email.
+func indexOfPerson(resolved []codeowners.ResolvedOwner, person *codeowners.Person) int {
+	for i, ro := range resolved {
+		other, ok := ro.(*codeowners.Person)
+		if !ok {
+			continue
+		}
+		if other.User != nil && person.User != nil {
+			if other.User.ID == person.User.ID {
+				return i
+			}
+			continue
+		}
+		if other.GetEmail() != "" && strings.EqualFold(other.GetEmail(), person.GetEmail()) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *service) HistorySignalsForPath(ctx context.Context, repoID api.RepoID, path string) ([]*owntypes.HistorySignal, error) {
+	if c := conf.Get().OwnHistorySignals; c == nil || !c.Enabled {
+		return nil, nil
+	}
+	return s.db.OwnSignals().ListHistorySignals(ctx, repoID, strings.TrimPrefix(path, "/"))
+}
+
+func (s *service) HistorySignalsForPaths(ctx context.Context, repoID api.RepoID, paths []string) (map[string][]*owntypes.HistorySignal, error) {
+	if c := conf.Get().OwnHistorySignals; c == nil || !c.Enabled {
+		return nil, nil
+	}
+	// Signals are stored without a leading slash, so map them back to the
+	// paths as given by the caller.
+	byStoredPath := make(map[string][]string, len(paths))
+	storedPaths := make([]string, 0, len(paths))
+	for _, path := range paths {
+		storedPath := strings.TrimPrefix(path, "/")
+		if _, ok := byStoredPath[storedPath]; !ok {
+			storedPaths = append(storedPaths, storedPath)
+		}
+		byStoredPath[storedPath] = append(byStoredPath[storedPath], path)
+	}
+	signals, err := s.db.OwnSignals().ListHistorySignalsForPaths(ctx, repoID, storedPaths)
+	if err != nil {
+		return nil, err
+	}
+	signalsByPath := make(map[string][]*owntypes.HistorySignal, len(paths))
+	for _, signal := range signals {
+		for _, path := range byStoredPath[signal.Path] {
+			signalsByPath[path] = append(signalsByPath[path], signal)
+		}
+	}
+	return signalsByPath, nil
+}
+
 func (s *service) resolveOwner(ctx context.Context, handle, email string) (codeowners.ResolvedOwner, error) {
 	var resolvedOwner codeowners.ResolvedOwner
 	var err error
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/hexops/autogold/v2"
@@ -21,6 +22,7 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/gitserver"
 	itypes "github.com/sourcegraph/sourcegraph/internal/types"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
 )
 
 type repoPath struct {
@@ -73,6 +75,37 @@ func TestOwnersServesFilesAtVariousLocations(t *testing.T) {
 	}
 }
 
+func TestOwnersServesFileWithSections(t *testing.T) {
+	codeownersText := `*.go @go-owner
+^[docs][2] @docs-owner
+/docs/
+[eng]
+/docs/internal/ @eng-owner
+`
+	git := gitserver.NewMockClient()
+	git.ReadFileFunc.SetDefaultHook(repoFiles{{"repo", "SHA", ".gitlab/CODEOWNERS"}: codeownersText}.ReadFile)
+
+	codeownersStore := edb.NewMockCodeownersStore()
+	codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(nil, nil)
+	db := edb.NewMockEnterpriseDB()
+	db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+
+	got, err := NewService(git, db).RulesetForRepo(context.Background(), "repo", 1, "SHA")
+	require.NoError(t, err)
+	// Rules without owners are printed with the default owners of the section.
+	assert.Equal(t, strings.Replace(codeownersText, "/docs/\n", "/docs/ @docs-owner\n", 1), got.Repr())
+
+	docs := got.GetSection("docs")
+	assert.True(t, docs.GetOptional())
+	assert.Equal(t, int32(2), docs.GetApprovals())
+
+	var owners []string
+	for _, o := range got.MatchOwners("docs/internal/main.go") {
+		owners = append(owners, o.GetHandle())
+	}
+	assert.Equal(t, []string{"go-owner", "docs-owner", "eng-owner"}, owners)
+}
+
 func TestOwnersCannotFindFile(t *testing.T) {
 	codeownersFile := codeowners.NewRuleset(
 		codeowners.IngestedRulesetSource{},
@@ -141,10 +174,78 @@ func TestOwnersServesIngestedFile(t *testing.T) {
 	})
 }
 
+func TestOwnersLayersRepoPatternFiles(t *testing.T) {
+	committedText := "*.go @go-owner\n/docs/ @docs-owner\n"
+	patternProto := &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "/docs/", Owner: []*codeownerspb.Owner{{Handle: "pattern-owner"}}},
+		},
+	}
+	ingestedProto := &codeownerspb.File{
+		Rule: []*codeownerspb.Rule{
+			{Pattern: "README.md", Owner: []*codeownerspb.Owner{{Handle: "readme-owner"}}},
+		},
+	}
+	ownerOf := func(t *testing.T, rs *codeowners.Ruleset, path string) string {
+		t.Helper()
+		var handles []string
+		for _, o := range rs.MatchOwners(path) {
+			handles = append(handles, o.GetHandle())
+		}
+		return strings.Join(handles, ",")
+	}
+
+	for _, tc := range []struct {
+		name         string
+		patternMode  types.CodeownersMode
+		ingestedMode types.CodeownersMode
+		want         map[string]string
+	}{
+		{
+			name:         "all layers merged",
+			patternMode:  types.CodeownersModeMerge,
+			ingestedMode: types.CodeownersModeMerge,
+			want:         map[string]string{"main.go": "go-owner", "docs/a.md": "pattern-owner", "README.md": "readme-owner"},
+		},
+		{
+			name:         "repo pattern overrides committed file",
+			patternMode:  types.CodeownersModeOverride,
+			ingestedMode: types.CodeownersModeMerge,
+			want:         map[string]string{"main.go": "", "docs/a.md": "pattern-owner", "README.md": "readme-owner"},
+		},
+		{
+			name:         "ingested file overrides everything",
+			patternMode:  types.CodeownersModeMerge,
+			ingestedMode: types.CodeownersModeOverride,
+			want:         map[string]string{"main.go": "", "docs/a.md": "", "README.md": "readme-owner"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			git := gitserver.NewMockClient()
+			git.ReadFileFunc.SetDefaultHook(repoFiles{{"repo", "SHA", "CODEOWNERS"}: committedText}.ReadFile)
+
+			codeownersStore := edb.NewMockCodeownersStore()
+			codeownersStore.ListCodeownersRepoPatternsForRepoFunc.SetDefaultHook(func(_ context.Context, name api.RepoName) ([]*types.CodeownersRepoPattern, error) {
+				assert.Equal(t, api.RepoName("repo"), name)
+				return []*types.CodeownersRepoPattern{{ID: 1, RepoPattern: "re*", Proto: patternProto, Mode: tc.patternMode}}, nil
+			})
+			codeownersStore.GetCodeownersForRepoFunc.SetDefaultReturn(&types.CodeownersFile{RepoID: 1, Proto: ingestedProto, Mode: tc.ingestedMode}, nil)
+			db := edb.NewMockEnterpriseDB()
+			db.CodeownersFunc.SetDefaultReturn(codeownersStore)
+
+			got, err := NewService(git, db).RulesetForRepo(context.Background(), "repo", 1, "SHA")
+			require.NoError(t, err)
+			for path, want := range tc.want {
+				assert.Equal(t, want, ownerOf(t, got, path), path)
+			}
+		})
+	}
+}
+
 func TestResolveOwnersWithType(t *testing.T) {
 	t.Run("no owners returns empty", func(t *testing.T) {
 		git := gitserver.NewMockClient()
-		got, err := NewService(git, database.NewMockDB()).ResolveOwnersWithType(context.Background(), nil)
+		got, err := NewService(git, database.NewMockDB()).ResolveOwnersWithType(context.Background(), nil, nil)
 		require.NoError(t, err)
 		assert.Empty(t, got)
 	})
@@ -164,7 +265,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Handle: "unknown"},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			newTestUnknownOwner("unknown", ""),
@@ -188,7 +289,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Handle: handle},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			&codeowners.Person{
@@ -213,7 +314,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Email: email},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			&codeowners.Person{
@@ -239,7 +340,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Handle: handle},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			&codeowners.Team{
@@ -269,7 +370,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 		}
 		t.Run("best effort matching", func(t *testing.T) {
 			ownService := NewService(gitserver.NewMockClient(), db)
-			got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+			got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 			require.NoError(t, err)
 			assert.Equal(t, []codeowners.ResolvedOwner{
 				&codeowners.Team{
@@ -285,7 +386,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			t.Cleanup(func() {
 				conf.Get().OwnBestEffortTeamMatching = nil
 			})
-			got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+			got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 			require.NoError(t, err)
 			assert.Equal(t, []codeowners.ResolvedOwner{
 				newTestUnknownOwner(handle, ""),
@@ -307,7 +408,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Email: email},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			newTestUnknownOwner("", email),
@@ -358,7 +459,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Handle: teamHandle},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		want := []codeowners.ResolvedOwner{
 			&codeowners.Person{User: testUserWithHandle, Handle: userHandle},
@@ -391,7 +492,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Email: email},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			&codeowners.Person{
@@ -400,7 +501,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			},
 		}, got)
 		// do it again
-		got, err = ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err = ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.NoError(t, err)
 		assert.Equal(t, []codeowners.ResolvedOwner{
 			&codeowners.Person{
@@ -425,7 +526,7 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{Email: email},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, myError)
 		assert.Empty(t, got)
@@ -443,9 +544,148 @@ func TestResolveOwnersWithType(t *testing.T) {
 			{},
 		}
 
-		got, err := ownService.ResolveOwnersWithType(context.Background(), owners)
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestResolveOwnersWithHistorySignals(t *testing.T) {
+	t.Run("signal of a listed owner is attached to the owner", func(t *testing.T) {
+		mockUserStore := database.NewMockUserStore()
+		db := database.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(mockUserStore)
+		db.UserEmailsFunc.SetDefaultReturn(database.NewMockUserEmailsStore())
+		db.TeamsFunc.SetDefaultReturn(database.NewMockTeamStore())
+		ownService := NewService(gitserver.NewMockClient(), db)
+
+		handle := "person"
+		email := "person@sourcegraph.com"
+		testUser := newTestUser(handle)
+		mockUserStore.GetByUsernameFunc.SetDefaultReturn(testUser, nil)
+		mockUserStore.GetByVerifiedEmailFunc.SetDefaultReturn(testUser, nil)
+		owners := []*codeownerspb.Owner{
+			{Handle: handle},
+		}
+		signal := &types.HistorySignal{Path: "README.md", AuthorEmail: email, RecentCommitCount: 3}
+
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, []*types.HistorySignal{signal})
+		require.NoError(t, err)
+		assert.Equal(t, []codeowners.ResolvedOwner{
+			&codeowners.Person{
+				User:           testUser,
+				Handle:         handle,
+				HistorySignals: []*types.HistorySignal{signal},
+			},
+		}, got)
+
+		// The cached owner must not have the signal attached.
+		got, err = ownService.ResolveOwnersWithType(context.Background(), owners, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []codeowners.ResolvedOwner{
+			&codeowners.Person{
+				User:   testUser,
+				Handle: handle,
+			},
+		}, got)
+	})
+	t.Run("signal of an unknown author returns person owner", func(t *testing.T) {
+		mockUserStore := database.NewMockUserStore()
+		db := database.NewMockDB()
+		db.UsersFunc.SetDefaultReturn(mockUserStore)
+		db.UserEmailsFunc.SetDefaultReturn(database.NewMockUserEmailsStore())
+		db.TeamsFunc.SetDefaultReturn(database.NewMockTeamStore())
+		ownService := NewService(gitserver.NewMockClient(), db)
+
+		mockUserStore.GetByVerifiedEmailFunc.SetDefaultReturn(nil, database.MockUserNotFoundErr)
+		owners := []*codeownerspb.Owner{
+			{Email: "owner@sourcegraph.com"},
+		}
+		signals := []*types.HistorySignal{
+			{Path: "README.md", AuthorEmail: "Author@sourcegraph.com", RecentCommitCount: 2},
+			{Path: "README.md", AuthorEmail: "owner@sourcegraph.com", BlameLineShare: 0.5},
+		}
+
+		got, err := ownService.ResolveOwnersWithType(context.Background(), owners, signals)
+		require.NoError(t, err)
+		assert.Equal(t, []codeowners.ResolvedOwner{
+			&codeowners.Person{
+				Email:          "owner@sourcegraph.com",
+				HistorySignals: []*types.HistorySignal{signals[1]},
+			},
+			&codeowners.Person{
+				Email:          "Author@sourcegraph.com",
+				HistorySignals: []*types.HistorySignal{signals[0]},
+			},
+		}, got)
+	})
+}
+
+func TestHistorySignalsForPath(t *testing.T) {
+	signals := []*types.HistorySignal{
+		{RepoID: 1, Path: "README.md", AuthorEmail: "person@sourcegraph.com", RecentCommitCount: 3},
+	}
+	signalStore := edb.NewMockOwnSignalStore()
+	signalStore.ListHistorySignalsFunc.SetDefaultReturn(signals, nil)
+	db := edb.NewMockEnterpriseDB()
+	db.OwnSignalsFunc.SetDefaultReturn(signalStore)
+	ownService := NewService(gitserver.NewMockClient(), db)
+
+	t.Run("disabled", func(t *testing.T) {
+		conf.Mock(&conf.Unified{})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		got, err := ownService.HistorySignalsForPath(context.Background(), 1, "README.md")
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+	t.Run("enabled", func(t *testing.T) {
+		conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			OwnHistorySignals: &schema.OwnHistorySignals{Enabled: true},
+		}})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		got, err := ownService.HistorySignalsForPath(context.Background(), 1, "/README.md")
+		require.NoError(t, err)
+		assert.Equal(t, signals, got)
+		assert.Equal(t, "README.md", signalStore.ListHistorySignalsFunc.History()[0].Arg2)
+	})
+}
+
+func TestHistorySignalsForPaths(t *testing.T) {
+	readme := &types.HistorySignal{RepoID: 1, Path: "README.md", AuthorEmail: "person@sourcegraph.com", RecentCommitCount: 3}
+	main := &types.HistorySignal{RepoID: 1, Path: "cmd/main.go", AuthorEmail: "other@sourcegraph.com", RecentCommitCount: 1}
+	signalStore := edb.NewMockOwnSignalStore()
+	signalStore.ListHistorySignalsForPathsFunc.SetDefaultReturn([]*types.HistorySignal{main, readme}, nil)
+	db := edb.NewMockEnterpriseDB()
+	db.OwnSignalsFunc.SetDefaultReturn(signalStore)
+	ownService := NewService(gitserver.NewMockClient(), db)
+
+	t.Run("disabled", func(t *testing.T) {
+		conf.Mock(&conf.Unified{})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		got, err := ownService.HistorySignalsForPaths(context.Background(), 1, []string{"README.md"})
 		require.NoError(t, err)
 		assert.Empty(t, got)
+		assert.Empty(t, signalStore.ListHistorySignalsForPathsFunc.History())
+	})
+	t.Run("enabled", func(t *testing.T) {
+		conf.Mock(&conf.Unified{SiteConfiguration: schema.SiteConfiguration{
+			OwnHistorySignals: &schema.OwnHistorySignals{Enabled: true},
+		}})
+		t.Cleanup(func() { conf.Mock(nil) })
+
+		got, err := ownService.HistorySignalsForPaths(context.Background(), 1, []string{"/README.md", "cmd/main.go", "README.md", "go.mod"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]*types.HistorySignal{
+			"/README.md":  {readme},
+			"README.md":   {readme},
+			"cmd/main.go": {main},
+		}, got)
+		// The signals of all the paths are looked up at once.
+		require.Len(t, signalStore.ListHistorySignalsForPathsFunc.History(), 1)
+		assert.Equal(t, []string{"README.md", "cmd/main.go", "go.mod"}, signalStore.ListHistorySignalsForPathsFunc.History()[0].Arg2)
 	})
 }
 
@@ -14,4 +14,53 @@ type CodeownersFile struct {
 	RepoID   api.RepoID
 	Contents string
 	Proto    *codeownerspb.File
+	Mode     CodeownersMode
+}
+
+// CodeownersRepoPattern is a manually ingested Codeowners file that applies to
+// all the repositories which name matches a glob pattern, such as
+// `github.com/sourcegraph/*`.
+type CodeownersRepoPattern struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	ID          int32
+	RepoPattern string
+	Contents    string
+	Proto       *codeownerspb.File
+	Mode        CodeownersMode
+}
+
+// CodeownersMode describes how manually ingested Codeowners rules are combined
+// with the CODEOWNERS file committed to a repository.
+type CodeownersMode string
+
+const (
+	// CodeownersModeOverride ignores the rules of the committed file, and of
+	// any less specific ingested rules.
+	CodeownersModeOverride CodeownersMode = "override"
+	// CodeownersModeMerge evaluates the ingested rules after the rules of the
+	// committed file, so that ingested rules take precedence for the paths
+	// matched by both.
+	CodeownersModeMerge CodeownersMode = "merge"
+)
+
+// HistorySignal is an ownership signal for a single file of a repository,
+// derived from the git history of the repository. It describes how much
+// a single author contributed to the file.
+type HistorySignal struct {
+	RepoID      api.RepoID
+	Path        string
+	AuthorName  string
+	AuthorEmail string
+	// RecentCommitCount is the number of commits by the author that changed
+	// the file within the recent contributors window.
+	RecentCommitCount int32
+	// LastCommitAt is the date of the most recent commit by the author that
+	// changed the file, if any within the recent contributors window.
+	LastCommitAt *time.Time
+	// BlameLineShare is the share of the lines of the file that were last
+	// changed by the author, as per git blame. It is between 0 and 1.
+	BlameLineShare float64
+	UpdatedAt      time.Time
 }
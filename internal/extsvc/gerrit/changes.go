@@ -0,0 +1,282 @@
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// changeOptions are the options used to fetch changes with all the details
+// needed to sync them.
+var changeOptions = []string{
+	"CURRENT_COMMIT",
+	"CURRENT_REVISION",
+	"DETAILED_ACCOUNTS",
+	"DETAILED_LABELS",
+	"MESSAGES",
+}
+
+// GetChange fetches the change with the given change number, including its
+// labels, messages and current revision.
+func (c *Client) GetChange(ctx context.Context, changeNumber string) (*Change, error) {
+	query := url.Values{"o": changeOptions}
+	u := url.URL{Path: fmt.Sprintf("a/changes/%s", changeNumber), RawQuery: query.Encode()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var change Change
+	if _, err = c.do(ctx, req, &change); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// QueryChanges fetches the changes matching the given search query, including
+// their labels, messages and current revision.
+func (c *Client) QueryChanges(ctx context.Context, q string) ([]*Change, error) {
+	query := url.Values{"q": {q}, "o": changeOptions}
+	u := url.URL{Path: "a/changes/", RawQuery: query.Encode()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []*Change
+	if _, err = c.do(ctx, req, &changes); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// AbandonChange abandons the change with the given change number.
+func (c *Client) AbandonChange(ctx context.Context, changeNumber string) error {
+	return c.postChange(ctx, changeNumber, "abandon", nil)
+}
+
+// RestoreChange restores the abandoned change with the given change number.
+func (c *Client) RestoreChange(ctx context.Context, changeNumber string) error {
+	return c.postChange(ctx, changeNumber, "restore", nil)
+}
+
+// SubmitChange submits the change with the given change number, merging it
+// into its destination branch.
+func (c *Client) SubmitChange(ctx context.Context, changeNumber string) error {
+	return c.postChange(ctx, changeNumber, "submit", nil)
+}
+
+// MoveChange moves the change with the given change number to another
+// destination branch.
+func (c *Client) MoveChange(ctx context.Context, changeNumber string, input MoveChangeInput) error {
+	return c.postChange(ctx, changeNumber, "move", input)
+}
+
+// SetWorkInProgress marks the change with the given change number as work in
+// progress.
+func (c *Client) SetWorkInProgress(ctx context.Context, changeNumber string) error {
+	return c.postChange(ctx, changeNumber, "wip", nil)
+}
+
+// SetReadyForReview marks the change with the given change number as ready
+// for review.
+func (c *Client) SetReadyForReview(ctx context.Context, changeNumber string) error {
+	return c.postChange(ctx, changeNumber, "ready", nil)
+}
+
+// SetReview posts a review on the current revision of the change with the
+// given change number.
+func (c *Client) SetReview(ctx context.Context, changeNumber string, input ReviewInput) error {
+	return c.postChange(ctx, changeNumber, "revisions/current/review", input)
+}
+
+func (c *Client) postChange(ctx context.Context, changeNumber, action string, input any) error {
+	var body bytes.Buffer
+	if input != nil {
+		if err := json.NewEncoder(&body).Encode(input); err != nil {
+			return err
+		}
+	}
+
+	u := url.URL{Path: fmt.Sprintf("a/changes/%s/%s", changeNumber, action)}
+	req, err := http.NewRequest("POST", u.String(), &body)
+	if err != nil {
+		return err
+	}
+	if input != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	_, err = c.do(ctx, req, nil)
+	return err
+}
+
+type MoveChangeInput struct {
+	DestinationBranch string `json:"destination_branch"`
+}
+
+type ReviewInput struct {
+	Message string `json:"message"`
+}
+
+type ChangeStatus string
+
+const (
+	ChangeStatusNew       ChangeStatus = "NEW"
+	ChangeStatusMerged    ChangeStatus = "MERGED"
+	ChangeStatusAbandoned ChangeStatus = "ABANDONED"
+)
+
+// The labels of the default Gerrit review workflow.
+const (
+	LabelCodeReview = "Code-Review"
+	LabelVerified   = "Verified"
+)
+
+// The tags of the change messages created by Gerrit when the state of a change
+// is changed.
+const (
+	ChangeMessageTagAbandon           = "autogenerated:gerrit:abandon"
+	ChangeMessageTagRestore           = "autogenerated:gerrit:restore"
+	ChangeMessageTagMerged            = "autogenerated:gerrit:merged"
+	ChangeMessageTagSetWorkInProgress = "autogenerated:gerrit:setWorkInProgress"
+	ChangeMessageTagSetReadyForReview = "autogenerated:gerrit:setReadyForReview"
+)
+
+// Change is a Gerrit change, see
+// https://gerrit-review.googlesource.com/Documentation/rest-api-changes.html#change-info.
+type Change struct {
+	ID              string                 `json:"id"`
+	Project         string                 `json:"project"`
+	Branch          string                 `json:"branch"`
+	Topic           string                 `json:"topic,omitempty"`
+	ChangeID        string                 `json:"change_id"`
+	Subject         string                 `json:"subject"`
+	Status          ChangeStatus           `json:"status"`
+	Created         Timestamp              `json:"created"`
+	Updated         Timestamp              `json:"updated"`
+	WorkInProgress  bool                   `json:"work_in_progress,omitempty"`
+	Number          int                    `json:"_number"`
+	Owner           Account                `json:"owner"`
+	Labels          map[string]ChangeLabel `json:"labels,omitempty"`
+	Messages        []*ChangeMessage       `json:"messages,omitempty"`
+	CurrentRevision string                 `json:"current_revision,omitempty"`
+	Revisions       map[string]Revision    `json:"revisions,omitempty"`
+}
+
+// CurrentCommitMessage returns the commit message of the current revision of
+// the change, if it was fetched.
+func (c *Change) CurrentCommitMessage() string {
+	if r, ok := c.Revisions[c.CurrentRevision]; ok && r.Commit != nil {
+		return r.Commit.Message
+	}
+	return ""
+}
+
+// Votes returns the votes on the labels of the change, ordered by label.
+func (c *Change) Votes() []*Vote {
+	labels := make([]string, 0, len(c.Labels))
+	for label := range c.Labels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	var votes []*Vote
+	for _, label := range labels {
+		for _, a := range c.Labels[label].All {
+			if a.Value != 0 {
+				votes = append(votes, &Vote{Label: label, Approval: a})
+			}
+		}
+	}
+	return votes
+}
+
+type ChangeLabel struct {
+	// All are the approvals of all the reviewers of the change on the label,
+	// including the ones who didn't vote.
+	All []Approval `json:"all,omitempty"`
+}
+
+type Approval struct {
+	Account
+	Value int       `json:"value"`
+	Date  Timestamp `json:"date"`
+}
+
+// Vote is the vote of a reviewer on a label of a change. Gerrit doesn't
+// return votes as such, they are derived from the labels of the change.
+type Vote struct {
+	Label string `json:"label"`
+	Approval
+}
+
+func (v *Vote) Key() string {
+	return v.Label + ":" + strconv.Itoa(int(v.ID))
+}
+
+type ChangeMessage struct {
+	ID string `json:"id"`
+	// Author is not set for messages created by Gerrit.
+	Author         *Account  `json:"author,omitempty"`
+	Date           Timestamp `json:"date"`
+	Message        string    `json:"message"`
+	Tag            string    `json:"tag,omitempty"`
+	RevisionNumber int       `json:"_revision_number"`
+}
+
+func (m *ChangeMessage) Key() string {
+	return m.ID
+}
+
+type Revision struct {
+	Number int     `json:"_number"`
+	Ref    string  `json:"ref"`
+	Commit *Commit `json:"commit,omitempty"`
+}
+
+type Commit struct {
+	Subject string `json:"subject"`
+	Message string `json:"message"`
+}
+
+// Timestamp is a timestamp in the format used by the Gerrit API, which is
+// always in UTC.
+type Timestamp struct {
+	time.Time
+}
+
+const timestampLayout = "2006-01-02 15:04:05.000000000"
+
+// timestampParseLayout accepts any number of fractional second digits.
+const timestampParseLayout = "2006-01-02 15:04:05"
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(t.UTC().Format(timestampLayout))), nil
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*t = Timestamp{}
+		return nil
+	}
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return err
+	}
+	parsed, err := time.ParseInLocation(timestampParseLayout, s, time.UTC)
+	if err != nil {
+		return err
+	}
+	*t = Timestamp{Time: parsed}
+	return nil
+}
@@ -0,0 +1,81 @@
+package gerrit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestChange_UnmarshalJSON(t *testing.T) {
+	data := `{
+  "id": "my%2Fproject~main~I8473b95934b5732ac55d26311a706c9c2bde9940",
+  "project": "my/project",
+  "branch": "main",
+  "topic": "batch/my-change",
+  "change_id": "I8473b95934b5732ac55d26311a706c9c2bde9940",
+  "subject": "Fix things",
+  "status": "NEW",
+  "created": "2023-04-01 10:00:00.000000000",
+  "updated": "2023-04-02 11:30:15.123000000",
+  "_number": 42,
+  "owner": {"_account_id": 1000096, "username": "alice", "email": "alice@example.com"},
+  "labels": {
+    "Verified": {"all": [{"_account_id": 1000097, "username": "ci", "value": 1, "date": "2023-04-02 11:00:00.000000000"}]},
+    "Code-Review": {"all": [
+      {"_account_id": 1000098, "username": "bob", "value": 2, "date": "2023-04-02 11:30:15.000000000"},
+      {"_account_id": 1000099, "username": "carol", "value": 0}
+    ]}
+  },
+  "messages": [
+    {"id": "m1", "date": "2023-04-01 10:00:00.000000000", "message": "Uploaded patch set 1.", "_revision_number": 1},
+    {"id": "m2", "date": "2023-04-01 10:05:00.000000000", "message": "Set Ready For Review", "tag": "autogenerated:gerrit:setReadyForReview", "_revision_number": 1}
+  ],
+  "current_revision": "184ebe53805e102605d11f6b143486d15c23a09c",
+  "revisions": {
+    "184ebe53805e102605d11f6b143486d15c23a09c": {
+      "_number": 1,
+      "ref": "refs/changes/42/42/1",
+      "commit": {"subject": "Fix things", "message": "Fix things\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940\n"}
+    }
+  }
+}`
+
+	var change Change
+	if err := json.Unmarshal([]byte(data), &change); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Date(2023, 4, 2, 11, 30, 15, 123000000, time.UTC); !change.Updated.Equal(want) {
+		t.Errorf("wrong updated timestamp: have %s, want %s", change.Updated, want)
+	}
+	if want := "Fix things\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940\n"; change.CurrentCommitMessage() != want {
+		t.Errorf("wrong commit message: have %q, want %q", change.CurrentCommitMessage(), want)
+	}
+	if change.Messages[1].Tag != ChangeMessageTagSetReadyForReview {
+		t.Errorf("wrong message tag: %q", change.Messages[1].Tag)
+	}
+
+	votes := change.Votes()
+	var keys []string
+	for _, v := range votes {
+		keys = append(keys, v.Key())
+	}
+	// Votes are ordered by label, and reviewers who didn't vote are omitted.
+	if have, want := keys, []string{"Code-Review:1000098", "Verified:1000097"}; len(have) != len(want) || have[0] != want[0] || have[1] != want[1] {
+		t.Errorf("wrong votes: have %v, want %v", have, want)
+	}
+
+	// Timestamps are encoded in the format Gerrit uses, so that the change
+	// round trips.
+	encoded, err := json.Marshal(&change)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Change
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Updated.Equal(change.Updated.Time) || !decoded.Created.Equal(change.Created.Time) {
+		t.Errorf("timestamps didn't round trip: have %s and %s", decoded.Created, decoded.Updated)
+	}
+}
@@ -62,6 +62,11 @@ func (c *Client) WithAuthenticator(a auth.Authenticator) *Client {
 	}
 }
 
+// Authenticator returns the authenticator used to authenticate HTTP requests.
+func (c *Client) Authenticator() auth.Authenticator {
+	return c.auther
+}
+
 func (c *Client) GetAuthenticatedUserAccount(ctx context.Context) (*Account, error) {
 	req, err := http.NewRequest("GET", "a/accounts/self", nil)
 	if err != nil {
@@ -227,6 +232,11 @@ func (c *Client) do(ctx context.Context, req *http.Request, result any) (*http.R
 		}
 	}
 
+	// Some endpoints don't respond with a body.
+	if result == nil {
+		return resp, nil
+	}
+
 	// The first 4 characters of the Gerrit API responses need to be stripped, see: https://gerrit-review.googlesource.com/Documentation/rest-api.html#output .
 	if len(bs) < 4 {
 		return nil, &httpError{
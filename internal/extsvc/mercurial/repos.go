@@ -0,0 +1,8 @@
+package mercurial
+
+// Repo contains information of a Mercurial repository.
+type Repo struct {
+	// Path is the path of the repository relative to the base URL of the Mercurial
+	// server.
+	Path string `json:"path"`
+}
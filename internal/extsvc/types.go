@@ -129,6 +129,7 @@ const (
 	KindGitHub          = "GITHUB"
 	KindGitLab          = "GITLAB"
 	KindGitolite        = "GITOLITE"
+	KindMercurial       = "MERCURIAL"
 	KindPerforce        = "PERFORCE"
 	KindPhabricator     = "PHABRICATOR"
 	KindGoPackages      = "GOMODULES"
@@ -173,6 +174,10 @@ const (
 	// TypeGitolite is the (api.ExternalRepoSpec).ServiceType value for Gitolite projects.
 	TypeGitolite = "gitolite"
 
+	// TypeMercurial is the (api.ExternalRepoSpec).ServiceType value for Mercurial repositories. The
+	// ServiceID value is the normalized base URL of the Mercurial server.
+	TypeMercurial = "mercurial"
+
 	// TypePerforce is the (api.ExternalRepoSpec).ServiceType value for Perforce projects.
 	TypePerforce = "perforce"
 
@@ -227,6 +232,8 @@ func KindToType(kind string) string {
 		return TypeGitolite
 	case KindPhabricator:
 		return TypePhabricator
+	case KindMercurial:
+		return TypeMercurial
 	case KindPerforce:
 		return TypePerforce
 	case KindJVMPackages:
@@ -270,6 +277,8 @@ func TypeToKind(t string) string {
 		return KindGitLab
 	case TypeGitolite:
 		return KindGitolite
+	case TypeMercurial:
+		return KindMercurial
 	case TypePerforce:
 		return KindPerforce
 	case TypePhabricator:
@@ -327,6 +336,8 @@ func ParseServiceType(s string) (string, bool) {
 		return TypeGitLab, true
 	case TypeGitolite:
 		return TypeGitolite, true
+	case TypeMercurial:
+		return TypeMercurial, true
 	case TypePerforce:
 		return TypePerforce, true
 	case TypePhabricator:
@@ -372,6 +383,8 @@ func ParseServiceKind(s string) (string, bool) {
 		return KindGitLab, true
 	case KindGitolite:
 		return KindGitolite, true
+	case KindMercurial:
+		return KindMercurial, true
 	case KindPerforce:
 		return KindPerforce, true
 	case KindPhabricator:
@@ -471,6 +484,8 @@ func getConfigPrototype(kind string) (any, error) {
 		return &schema.GitLabConnection{}, nil
 	case KindGitolite:
 		return &schema.GitoliteConnection{}, nil
+	case KindMercurial:
+		return &schema.MercurialConnection{}, nil
 	case KindPerforce:
 		return &schema.PerforceConnection{}, nil
 	case KindPhabricator:
@@ -784,6 +799,8 @@ func uniqueCodeHostIdentifier(kind string, cfg any) (string, error) {
 		rawURL = c.Url
 	case *schema.OtherExternalServiceConnection:
 		rawURL = c.Url
+	case *schema.MercurialConnection:
+		rawURL = c.Url
 	case *schema.GitoliteConnection:
 		rawURL = c.Host
 	case *schema.AWSCodeCommitConnection:
@@ -321,6 +321,11 @@ func TestUniqueCodeHostIdentifier(t *testing.T) {
 			config: `{"p4.port": "ssl:111.222.333.444:1666"}`,
 			want:   "ssl:111.222.333.444:1666",
 		},
+		{
+			kind:   KindMercurial,
+			config: `{"url": "https://hg.sgdev.org/repos"}`,
+			want:   "https://hg.sgdev.org/repos/",
+		},
 		{
 			kind:   KindPhabricator,
 			config: `{"url": "https://phabricator.sgdev.org/"}`,
@@ -411,6 +411,10 @@ type CreateCommitFromPatchRequest struct {
 	// Push specifies whether the target ref will be pushed to the code host: if
 	// nil, no push will be attempted, if non-nil, a push will be attempted.
 	Push *PushConfig
+	// PushRef is the ref the commit is pushed to on the code host, if it
+	// differs from the target ref. This is needed for code hosts on which
+	// changes are created by pushing to a special ref, such as Gerrit.
+	PushRef *string
 	// GitApplyArgs are the arguments that will be passed to `git apply` along
 	// with `--cached`.
 	GitApplyArgs []string
@@ -0,0 +1,161 @@
+package repos
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/conf/reposource"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/mercurial"
+	"github.com/sourcegraph/sourcegraph/internal/jsonc"
+	"github.com/sourcegraph/sourcegraph/internal/types"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// A MercurialSource yields repositories from a single Mercurial connection
+// configured in Sourcegraph via the external services configuration.
+type MercurialSource struct {
+	svc     *types.ExternalService
+	config  *schema.MercurialConnection
+	baseURL *url.URL
+	exclude excludeFunc
+}
+
+// NewMercurialSource returns a new MercurialSource from the given external
+// service.
+func NewMercurialSource(ctx context.Context, svc *types.ExternalService) (*MercurialSource, error) {
+	rawConfig, err := svc.Config.Decrypt(ctx)
+	if err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	var c schema.MercurialConnection
+	if err := jsonc.Unmarshal(rawConfig, &c); err != nil {
+		return nil, errors.Errorf("external service id=%d config error: %s", svc.ID, err)
+	}
+	return newMercurialSource(svc, &c)
+}
+
+func newMercurialSource(svc *types.ExternalService, c *schema.MercurialConnection) (*MercurialSource, error) {
+	baseURL, err := url.Parse(c.Url)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse url")
+	}
+	baseURL = extsvc.NormalizeBaseURL(baseURL)
+
+	var eb excludeBuilder
+	for _, r := range c.Exclude {
+		eb.Exact(r.Name)
+		eb.Pattern(r.Pattern)
+	}
+	exclude, err := eb.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MercurialSource{
+		svc:     svc,
+		config:  c,
+		baseURL: baseURL,
+		exclude: exclude,
+	}, nil
+}
+
+// CheckConnection at this point assumes availability and relies on errors returned
+// from the subsequent calls. This is going to be expanded as part of issue #44683
+// to actually only return true if the source can serve requests.
+func (s MercurialSource) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
+// ListRepos returns all Mercurial repositories configured in the connection.
+// Mercurial servers don't offer a common API to enumerate repositories, so
+// only the repositories listed explicitly in the configuration are yielded.
+func (s MercurialSource) ListRepos(ctx context.Context, results chan SourceResult) {
+	for _, path := range s.config.Repos {
+		// Tiny optimization: exit early if context has been canceled.
+		if err := ctx.Err(); err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			return
+		}
+
+		r, err := s.makeRepo(path)
+		if err != nil {
+			results <- SourceResult{Source: s, Err: err}
+			continue
+		}
+		if s.exclude(string(r.Name)) {
+			continue
+		}
+		results <- SourceResult{Source: s, Repo: r}
+	}
+}
+
+// ExternalServices returns a singleton slice containing the external service.
+func (s MercurialSource) ExternalServices() types.ExternalServices {
+	return types.ExternalServices{s.svc}
+}
+
+func (s MercurialSource) makeRepo(path string) (*types.Repo, error) {
+	path = strings.Trim(path, "/")
+	cloneURL, err := mercurialAuthenticatedRepoURL(s.baseURL, path, s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	base := url.URL{Host: s.baseURL.Host, Path: s.baseURL.Path}
+	urn := s.svc.URN()
+
+	return &types.Repo{
+		Name: api.RepoName(reposource.OtherRepoName(s.config.RepositoryPathPattern, base.String(), path)),
+		URI:  reposource.OtherRepoName("", base.String(), path),
+		ExternalRepo: api.ExternalRepoSpec{
+			ID:          path,
+			ServiceType: extsvc.TypeMercurial,
+			ServiceID:   s.baseURL.String(),
+		},
+		Sources: map[string]*types.SourceInfo{
+			urn: {
+				ID:       urn,
+				CloneURL: cloneURL.String(),
+			},
+		},
+		Metadata: &mercurial.Repo{
+			Path: path,
+		},
+	}, nil
+}
+
+// mercurialRepoURL resolves the repository path against the base URL of a
+// Mercurial server. The base URL is always treated as a directory, so that a
+// base URL of https://hg.example.com/repos and a path of my/repo result in
+// https://hg.example.com/repos/my/repo.
+func mercurialRepoURL(base *url.URL, path string) (*url.URL, error) {
+	dir := *base
+	if !strings.HasSuffix(dir.Path, "/") {
+		dir.Path += "/"
+	}
+	return dir.Parse(strings.TrimPrefix(path, "/"))
+}
+
+// mercurialAuthenticatedRepoURL resolves the repository path against the base
+// URL of a Mercurial server like mercurialRepoURL, and adds the credentials
+// configured on the connection, if any. It is used both for the clone URL
+// stored on the repository and for the one composed by gitserver, so that
+// both code paths can clone private repositories.
+func mercurialAuthenticatedRepoURL(base *url.URL, path string, cfg *schema.MercurialConnection) (*url.URL, error) {
+	u, err := mercurialRepoURL(base, path)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Username != "" {
+		if cfg.Password != "" {
+			u.User = url.UserPassword(cfg.Username, cfg.Password)
+		} else {
+			u.User = url.User(cfg.Username)
+		}
+	}
+	return u, nil
+}
@@ -65,6 +65,8 @@ func NewSource(ctx context.Context, logger log.Logger, db database.DB, svc *type
 		return NewAWSCodeCommitSource(ctx, svc, cf)
 	case extsvc.KindPerforce:
 		return NewPerforceSource(ctx, svc)
+	case extsvc.KindMercurial:
+		return NewMercurialSource(ctx, svc)
 	case extsvc.KindGoPackages:
 		return NewGoPackagesSource(ctx, svc, cf)
 	case extsvc.KindJVMPackages:
@@ -25,6 +25,33 @@ type SyncWorkerOptions struct {
 	CleanupOldJobsInterval time.Duration // defaults to 1h
 }
 
+var syncJobColumns = []*sqlf.Query{
+	sqlf.Sprintf("id"),
+	sqlf.Sprintf("state"),
+	sqlf.Sprintf("failure_message"),
+	sqlf.Sprintf("started_at"),
+	sqlf.Sprintf("finished_at"),
+	sqlf.Sprintf("process_after"),
+	sqlf.Sprintf("num_resets"),
+	sqlf.Sprintf("num_failures"),
+	sqlf.Sprintf("execution_logs"),
+	sqlf.Sprintf("external_service_id"),
+	sqlf.Sprintf("next_sync_at"),
+}
+
+// SyncWorkerStoreOptions are the options of the dbworker store for the external service sync worker.
+var SyncWorkerStoreOptions = dbworkerstore.Options[*SyncJob]{
+	Name:              "repo_sync_worker_store",
+	TableName:         "external_service_sync_jobs",
+	ViewName:          "external_service_sync_jobs_with_next_sync_at",
+	Scan:              dbworkerstore.BuildWorkerScan(scanJob),
+	OrderByExpression: sqlf.Sprintf("next_sync_at"),
+	ColumnExpressions: syncJobColumns,
+	StalledMaxAge:     30 * time.Second,
+	MaxNumResets:      5,
+	MaxNumRetries:     0,
+}
+
 // NewSyncWorker creates a new external service sync worker.
 func NewSyncWorker(ctx context.Context, observationCtx *observation.Context, dbHandle basestore.TransactableHandle, handler workerutil.Handler[*SyncJob], opts SyncWorkerOptions) (*workerutil.Worker[*SyncJob], *dbworker.Resetter[*SyncJob]) {
 	if opts.NumHandlers == 0 {
@@ -37,33 +64,9 @@ func NewSyncWorker(ctx context.Context, observationCtx *observation.Context, dbH
 		opts.CleanupOldJobsInterval = time.Hour
 	}
 
-	syncJobColumns := []*sqlf.Query{
-		sqlf.Sprintf("id"),
-		sqlf.Sprintf("state"),
-		sqlf.Sprintf("failure_message"),
-		sqlf.Sprintf("started_at"),
-		sqlf.Sprintf("finished_at"),
-		sqlf.Sprintf("process_after"),
-		sqlf.Sprintf("num_resets"),
-		sqlf.Sprintf("num_failures"),
-		sqlf.Sprintf("execution_logs"),
-		sqlf.Sprintf("external_service_id"),
-		sqlf.Sprintf("next_sync_at"),
-	}
-
 	observationCtx = observation.ContextWithLogger(observationCtx.Logger.Scoped("repo.sync.workerstore.Store", ""), observationCtx)
 
-	store := dbworkerstore.New(observationCtx, dbHandle, dbworkerstore.Options[*SyncJob]{
-		Name:              "repo_sync_worker_store",
-		TableName:         "external_service_sync_jobs",
-		ViewName:          "external_service_sync_jobs_with_next_sync_at",
-		Scan:              dbworkerstore.BuildWorkerScan(scanJob),
-		OrderByExpression: sqlf.Sprintf("next_sync_at"),
-		ColumnExpressions: syncJobColumns,
-		StalledMaxAge:     30 * time.Second,
-		MaxNumResets:      5,
-		MaxNumRetries:     0,
-	})
+	store := dbworkerstore.New(observationCtx, dbHandle, SyncWorkerStoreOptions)
 
 	worker := dbworker.NewWorker(ctx, store, handler, workerutil.WorkerOptions{
 		Name:              "repo_sync_worker",
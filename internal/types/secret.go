@@ -75,6 +75,12 @@ func (e *ExternalService) RedactedConfig(ctx context.Context) (string, error) {
 		es.redactString(c.P4Passwd, "p4.passwd")
 	case *schema.GitoliteConnection:
 		// Nothing to redact
+	case *schema.MercurialConnection:
+		es.redactString(c.Password, "password")
+		err = es.redactURL(c.Url, "url")
+		if err != nil {
+			return "", err
+		}
 	case *schema.GoModulesConnection:
 		for i := range c.Urls {
 			err = es.redactURL(c.Urls[i], "urls", i)
@@ -210,6 +216,30 @@ func (e *ExternalService) UnredactConfig(ctx context.Context, old *ExternalServi
 		}
 	case *schema.GitoliteConnection:
 		// Nothing to redact
+	case *schema.MercurialConnection:
+		o := oldCfg.(*schema.MercurialConnection)
+		oldParsed, err := url.Parse(o.Url)
+		if err != nil {
+			return err
+		}
+		newParsed, err := url.Parse(c.Url)
+		if err != nil {
+			return err
+		}
+		urlPassword, _ := newParsed.User.Password()
+
+		// remove UserInfo so we can compare URLs
+		oldParsed.User = nil
+		newParsed.User = nil
+
+		if newParsed.String() != oldParsed.String() && (c.Password == RedactedSecret || urlPassword == RedactedSecret) {
+			return errCodeHostIdentityChanged{"url", "password"}
+		}
+		es.unredactString(c.Password, o.Password, "password")
+		err = es.unredactURL(c.Url, o.Url, "url")
+		if err != nil {
+			return err
+		}
 	case *schema.GoModulesConnection:
 		err = es.unredactURLs(c.Urls, oldCfg.(*schema.GoModulesConnection).Urls)
 		if err != nil {
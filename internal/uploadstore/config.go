@@ -14,6 +14,7 @@ type Config struct {
 	TTL          time.Duration
 	S3           S3Config
 	GCS          GCSConfig
+	Filesystem   FilesystemConfig
 }
 
 func normalizeConfig(t Config) Config {
@@ -28,5 +29,10 @@ func normalizeConfig(t Config) Config {
 		// No subdomains on built-in blobstore.
 		o.S3.UsePathStyle = true
 	}
+
+	if o.Backend == "filesystem" {
+		// Bucket directories have no configuration to provision, so always create them.
+		o.ManageBucket = true
+	}
 	return o
 }
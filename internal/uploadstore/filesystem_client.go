@@ -0,0 +1,356 @@
+package uploadstore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/inconshreveable/log15"
+	"github.com/opentracing/opentracing-go/log"
+	sglog "github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// filesystemTempDir is the directory below the root directory of a filesystem store
+// that in-progress writes are staged in. It is outside of every bucket directory so
+// that partially written objects are never visible to readers.
+const filesystemTempDir = ".tmp"
+
+type filesystemStore struct {
+	dir          string
+	bucket       string
+	manageBucket bool
+	operations   *Operations
+}
+
+var _ Store = &filesystemStore{}
+
+type FilesystemConfig struct {
+	// Dir is the directory that bucket directories are created in. It must be shared by
+	// all services reading and writing the store.
+	Dir string
+}
+
+// newFilesystemFromConfig creates a new store backed by a directory on the local filesystem.
+func newFilesystemFromConfig(ctx context.Context, config Config, operations *Operations) (Store, error) {
+	if config.Filesystem.Dir == "" {
+		return nil, errors.New("no directory configured for filesystem upload store")
+	}
+
+	return newFilesystemWithDir(config.Filesystem.Dir, config.Bucket, config.ManageBucket, operations), nil
+}
+
+func newFilesystemWithDir(dir, bucket string, manageBucket bool, operations *Operations) *filesystemStore {
+	return &filesystemStore{
+		dir:          dir,
+		bucket:       bucket,
+		manageBucket: manageBucket,
+		operations:   operations,
+	}
+}
+
+func (s *filesystemStore) Init(ctx context.Context) error {
+	if !s.manageBucket {
+		return nil
+	}
+
+	if err := os.MkdirAll(s.bucketDir(), 0o755); err != nil {
+		return errors.Wrap(err, "failed to create bucket directory")
+	}
+
+	return nil
+}
+
+func (s *filesystemStore) Get(ctx context.Context, key string) (_ io.ReadCloser, err error) {
+	_, _, endObservation := s.operations.Get.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	filename, err := s.objectPath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get object")
+	}
+
+	return f, nil
+}
+
+func (s *filesystemStore) Upload(ctx context.Context, key string, r io.Reader) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Upload.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	n, err := s.write(ctx, key, func(w io.Writer) (int64, error) {
+		return io.Copy(w, readerWithContext(ctx, r))
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to upload object")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Compose(ctx context.Context, destination string, sources ...string) (_ int64, err error) {
+	ctx, _, endObservation := s.operations.Compose.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("destination", destination),
+		log.String("sources", strings.Join(sources, ", ")),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	defer func() {
+		if err == nil {
+			// Delete sources on success
+			if err := s.deleteSources(sources); err != nil {
+				log15.Error("Failed to delete source objects", "error", err)
+			}
+		}
+	}()
+
+	n, err := s.write(ctx, destination, func(w io.Writer) (int64, error) {
+		var total int64
+		for _, source := range sources {
+			n, err := s.copyObject(ctx, w, source)
+			total += n
+			if err != nil {
+				return total, err
+			}
+		}
+
+		return total, nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to compose objects")
+	}
+
+	return n, nil
+}
+
+func (s *filesystemStore) Delete(ctx context.Context, key string) (err error) {
+	_, _, endObservation := s.operations.Delete.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("key", key),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	return errors.Wrap(s.delete(key), "failed to delete object")
+}
+
+func (s *filesystemStore) ExpireObjects(ctx context.Context, prefix string, maxAge time.Duration) (err error) {
+	ctx, _, endObservation := s.operations.ExpireObjects.With(ctx, &err, observation.Args{LogFields: []log.Field{
+		log.String("prefix", prefix),
+		log.String("maxAge", maxAge.String()),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	// Only walk the directory containing all keys with the given prefix, rather than
+	// the entire bucket.
+	root := s.bucketDir()
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir, err := s.objectPath(prefix[:i])
+		if err != nil {
+			return err
+		}
+		root = dir
+	}
+
+	walkErr := filepath.WalkDir(root, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.bucketDir(), filename)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+
+		// Objects are never modified after they have been moved into place, so the
+		// modification time is the time the object was created.
+		if time.Since(info.ModTime()) >= maxAge {
+			if err := s.delete(key); err != nil {
+				s.operations.ExpireObjects.Logger.Error("Failed to delete expired object",
+					sglog.Error(err),
+					sglog.String("bucket", s.bucket),
+					sglog.String("object", key))
+			}
+		}
+
+		return nil
+	})
+	if walkErr != nil {
+		s.operations.ExpireObjects.Logger.Error("Failed to iterate bucket directory", sglog.Error(walkErr))
+		// we'll try again later
+	}
+
+	return nil
+}
+
+// write atomically writes the object at the given key. The content produced by the
+// given function is written to a temporary file, which is moved into place only once
+// the function has succeeded, so readers either see the previous object or the new
+// object in full.
+func (s *filesystemStore) write(ctx context.Context, key string, writeContent func(w io.Writer) (int64, error)) (_ int64, err error) {
+	filename, err := s.objectPath(key)
+	if err != nil {
+		return 0, err
+	}
+
+	tempDir := filepath.Join(s.dir, filesystemTempDir)
+	if err := os.MkdirAll(tempDir, 0o755); err != nil {
+		return 0, err
+	}
+
+	tmp, err := os.CreateTemp(tempDir, "upload-*")
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	n, err := writeContent(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if err := tmp.Sync(); err != nil {
+		return 0, err
+	}
+	if err := tmp.Close(); err != nil {
+		return 0, err
+	}
+
+	// The parent directory can be removed by a concurrent delete of its last object
+	// between creating it and moving the object into place, so retry once.
+	for attempt := 0; ; attempt++ {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			return 0, err
+		}
+		err := os.Rename(tmp.Name(), filename)
+		if err == nil {
+			return n, nil
+		}
+		if attempt > 0 || !errors.Is(err, fs.ErrNotExist) {
+			return 0, err
+		}
+	}
+}
+
+func (s *filesystemStore) copyObject(ctx context.Context, w io.Writer, key string) (int64, error) {
+	filename, err := s.objectPath(key)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	return io.Copy(w, readerWithContext(ctx, f))
+}
+
+func (s *filesystemStore) deleteSources(sources []string) error {
+	var errs error
+	for _, source := range sources {
+		if err := s.delete(source); err != nil {
+			errs = errors.Append(errs, errors.Wrap(err, "failed to delete source object"))
+		}
+	}
+
+	return errs
+}
+
+// delete removes the object at the given key, along with the directories containing
+// it that became empty. Deleting an object that does not exist is not an error.
+func (s *filesystemStore) delete(key string) error {
+	filename, err := s.objectPath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	bucketDir := s.bucketDir()
+	for dir := filepath.Dir(filename); dir != bucketDir && strings.HasPrefix(dir, bucketDir); dir = filepath.Dir(dir) {
+		// Fails when the directory is not empty, which ends the cleanup.
+		if err := os.Remove(dir); err != nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *filesystemStore) bucketDir() string {
+	return filepath.Join(s.dir, s.bucket)
+}
+
+// objectPath returns the path of the file storing the object at the given key. Keys
+// that would resolve to a path outside of the bucket directory are rejected.
+func (s *filesystemStore) objectPath(key string) (string, error) {
+	if key == "" || path.IsAbs(key) || path.Clean(key) != key || key == ".." || strings.HasPrefix(key, "../") {
+		return "", errors.Errorf("invalid object key %q", key)
+	}
+
+	return filepath.Join(s.bucketDir(), filepath.FromSlash(key)), nil
+}
+
+// readerWithContext wraps the given reader so reads fail once the given context is
+// canceled.
+func readerWithContext(ctx context.Context, r io.Reader) io.Reader {
+	return &contextReader{ctx: ctx, r: r}
+}
+
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return r.r.Read(p)
+}
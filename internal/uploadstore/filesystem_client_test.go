@@ -0,0 +1,271 @@
+package uploadstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+)
+
+func TestFilesystemInit(t *testing.T) {
+	dir := t.TempDir()
+
+	client := testFilesystemClient(dir, true)
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if info, err := os.Stat(filepath.Join(dir, "test-bucket")); err != nil {
+		t.Fatalf("unexpected error reading bucket directory: %s", err)
+	} else if !info.IsDir() {
+		t.Errorf("expected bucket directory to be a directory")
+	}
+}
+
+func TestFilesystemUnmanagedInit(t *testing.T) {
+	dir := t.TempDir()
+
+	client := testFilesystemClient(dir, false)
+	if err := client.Init(context.Background()); err != nil {
+		t.Fatalf("unexpected error initializing client: %s", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "test-bucket")); !os.IsNotExist(err) {
+		t.Errorf("expected bucket directory not to be created. err=%v", err)
+	}
+}
+
+func TestFilesystemUploadGet(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	size, err := client.Upload(context.Background(), "uploads/test-key", bytes.NewReader([]byte("TEST PAYLOAD")))
+	if err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if size != 12 {
+		t.Errorf("unexpected size. want=%d have=%d", 12, size)
+	}
+
+	// Overwrite the object, which must replace its content entirely.
+	if _, err := client.Upload(context.Background(), "uploads/test-key", bytes.NewReader([]byte("NEW"))); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if content := readObject(t, client, "uploads/test-key"); content != "NEW" {
+		t.Errorf("unexpected content. want=%s have=%s", "NEW", content)
+	}
+
+	// No temporary files are left behind.
+	if entries, err := os.ReadDir(filepath.Join(dir, filesystemTempDir)); err != nil {
+		t.Fatalf("unexpected error reading temporary directory: %s", err)
+	} else if len(entries) != 0 {
+		t.Errorf("unexpected temporary files. want=%d have=%d", 0, len(entries))
+	}
+}
+
+func TestFilesystemUploadFailure(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	r := io.MultiReader(strings.NewReader("PARTIAL"), &errorReader{err: io.ErrUnexpectedEOF})
+	if _, err := client.Upload(context.Background(), "test-key", r); err == nil {
+		t.Fatalf("expected error uploading object")
+	}
+
+	// A failed upload never makes a partial object visible.
+	if _, err := client.Get(context.Background(), "test-key"); err == nil {
+		t.Errorf("expected error getting object")
+	}
+}
+
+func TestFilesystemGetMissing(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), true)
+
+	if _, err := client.Get(context.Background(), "missing"); err == nil {
+		t.Fatalf("expected error getting object")
+	}
+}
+
+func TestFilesystemInvalidKeys(t *testing.T) {
+	client := testFilesystemClient(t.TempDir(), true)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "a/../../escape", "a//b"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("")); err == nil {
+			t.Errorf("expected error uploading object with key %q", key)
+		}
+	}
+}
+
+func TestFilesystemCompose(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	for i, content := range []string{"foo", "bar", "baz"} {
+		if _, err := client.Upload(context.Background(), "test-src"+string(rune('1'+i)), strings.NewReader(content)); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+	}
+
+	size, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2", "test-src3")
+	if err != nil {
+		t.Fatalf("unexpected error composing objects: %s", err)
+	}
+	if size != 9 {
+		t.Errorf("unexpected size. want=%d have=%d", 9, size)
+	}
+
+	if content := readObject(t, client, "test-key"); content != "foobarbaz" {
+		t.Errorf("unexpected content. want=%s have=%s", "foobarbaz", content)
+	}
+
+	if diff := cmp.Diff([]string{"test-key"}, listObjects(t, dir)); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+}
+
+func TestFilesystemComposeMissingSource(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	if _, err := client.Upload(context.Background(), "test-src1", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+
+	if _, err := client.Compose(context.Background(), "test-key", "test-src1", "test-src2"); err == nil {
+		t.Fatalf("expected error composing objects")
+	}
+
+	// Sources are kept when the composed write fails.
+	if diff := cmp.Diff([]string{"test-src1"}, listObjects(t, dir)); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+}
+
+func TestFilesystemDelete(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	if _, err := client.Upload(context.Background(), "a/b/test-key", strings.NewReader("foo")); err != nil {
+		t.Fatalf("unexpected error uploading object: %s", err)
+	}
+	if err := client.Delete(context.Background(), "a/b/test-key"); err != nil {
+		t.Fatalf("unexpected error deleting object: %s", err)
+	}
+
+	// Directories that became empty are removed, but not the bucket directory.
+	if entries, err := os.ReadDir(filepath.Join(dir, "test-bucket")); err != nil {
+		t.Fatalf("unexpected error reading bucket directory: %s", err)
+	} else if len(entries) != 0 {
+		t.Errorf("unexpected bucket entries. want=%d have=%d", 0, len(entries))
+	}
+
+	// Deleting an object that does not exist is not an error.
+	if err := client.Delete(context.Background(), "a/b/test-key"); err != nil {
+		t.Fatalf("unexpected error deleting object: %s", err)
+	}
+}
+
+func TestFilesystemExpireObjects(t *testing.T) {
+	dir := t.TempDir()
+	client := testFilesystemClient(dir, true)
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, key := range []string{"uploads/old", "uploads/new", "uploads-other/old", "indexes/old"} {
+		if _, err := client.Upload(context.Background(), key, strings.NewReader("foo")); err != nil {
+			t.Fatalf("unexpected error uploading object: %s", err)
+		}
+		if strings.HasSuffix(key, "old") {
+			filename := filepath.Join(dir, "test-bucket", filepath.FromSlash(key))
+			if err := os.Chtimes(filename, old, old); err != nil {
+				t.Fatalf("unexpected error changing modification time: %s", err)
+			}
+		}
+	}
+
+	if err := client.ExpireObjects(context.Background(), "uploads/", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+	if diff := cmp.Diff([]string{"indexes/old", "uploads-other/old", "uploads/new"}, listObjects(t, dir)); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+
+	// Prefixes do not have to end at a directory boundary.
+	if err := client.ExpireObjects(context.Background(), "upl", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+	if diff := cmp.Diff([]string{"indexes/old", "uploads/new"}, listObjects(t, dir)); diff != "" {
+		t.Errorf("unexpected objects (-want +got):\n%s", diff)
+	}
+
+	// A prefix matching no directory is not an error.
+	if err := client.ExpireObjects(context.Background(), "missing/", time.Hour); err != nil {
+		t.Fatalf("unexpected error expiring objects: %s", err)
+	}
+}
+
+func testFilesystemClient(dir string, manageBucket bool) Store {
+	return newLazyStore(rawFilesystemClient(dir, manageBucket))
+}
+
+func rawFilesystemClient(dir string, manageBucket bool) *filesystemStore {
+	return newFilesystemWithDir(dir, "test-bucket", manageBucket, NewOperations(&observation.TestContext, "test", "brittlestore"))
+}
+
+func readObject(t *testing.T, client Store, key string) string {
+	t.Helper()
+
+	rc, err := client.Get(context.Background(), key)
+	if err != nil {
+		t.Fatalf("unexpected error getting object: %s", err)
+	}
+	defer rc.Close()
+
+	contents, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("unexpected error reading object: %s", err)
+	}
+
+	return string(contents)
+}
+
+// listObjects returns the sorted keys of all objects in the test bucket.
+func listObjects(t *testing.T, dir string) []string {
+	t.Helper()
+
+	bucketDir := filepath.Join(dir, "test-bucket")
+	var keys []string
+	if err := filepath.Walk(bucketDir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(bucketDir, filename)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(rel))
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error listing objects: %s", err)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+type errorReader struct {
+	err error
+}
+
+func (r *errorReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
@@ -0,0 +1,59 @@
+package events
+
+import "github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+
+const (
+	RepoAdded       = "repo:added"
+	RepoRemoved     = "repo:removed"
+	RepoCloned      = "repo:cloned"
+	RepoCloneFailed = "repo:clone_failed"
+
+	PermissionsSyncCompleted = "permissions_sync:completed"
+	PermissionsSyncFailed    = "permissions_sync:failed"
+
+	EmbeddingsJobCompleted = "embeddings:job_completed"
+
+	CodeIntelUploadProcessed = "codeintel:upload_processed"
+)
+
+func init() {
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoAdded,
+		Description: "sent when a repository is added by a code host connection sync",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoRemoved,
+		Description: "sent when a repository is removed by a code host connection sync",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoCloned,
+		Description: "sent when gitserver finishes cloning a repository",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         RepoCloneFailed,
+		Description: "sent when cloning a repository fails",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         PermissionsSyncCompleted,
+		Description: "sent when a user or repository permissions sync job completes",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         PermissionsSyncFailed,
+		Description: "sent when a user or repository permissions sync job fails",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         EmbeddingsJobCompleted,
+		Description: "sent when a repository embeddings job completes",
+	})
+
+	outbound.RegisterEventType(outbound.EventType{
+		Key:         CodeIntelUploadProcessed,
+		Description: "sent when a precise code intelligence upload has been processed",
+	})
+}
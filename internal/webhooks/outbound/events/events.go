@@ -0,0 +1,83 @@
+// Package events defines outbound webhook event types for repositories, permissions syncing, embeddings and
+// code intelligence, along with the payloads sent for them.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/webhooks/outbound"
+)
+
+// Enqueue creates an outbound webhook job that will dispatch a webhook of the
+// given type with the given payload marshalled as JSON.
+//
+// Webhooks are intended to be fire and forget from the point of view of calling
+// code, so errors are logged rather than returned.
+func Enqueue(ctx context.Context, logger log.Logger, db basestore.ShareableStore, eventType string, payload any) {
+	logger = logger.With(log.String("event_type", eventType))
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logger.Error("error marshalling webhook payload", log.Error(err))
+		return
+	}
+
+	if err := outbound.NewOutboundWebhookService(db, nil).Enqueue(ctx, eventType, nil, data); err != nil {
+		logger.Error("error enqueuing webhook job", log.Error(err))
+	}
+}
+
+// Repo is the payload of the repo:added, repo:removed, repo:cloned and
+// repo:clone_failed events.
+type Repo struct {
+	// ID is omitted when the event source only knows the repository name, as
+	// is the case for gitserver.
+	ID    api.RepoID   `json:"id,omitempty"`
+	Name  api.RepoName `json:"name,omitempty"`
+	Error string       `json:"error,omitempty"`
+}
+
+// PermissionsSync is the payload of the permissions_sync:completed and
+// permissions_sync:failed events. Exactly one of UserID and RepositoryID is set.
+type PermissionsSync struct {
+	JobID        int        `json:"jobId"`
+	UserID       int32      `json:"userId,omitempty"`
+	RepositoryID api.RepoID `json:"repositoryId,omitempty"`
+	Added        int        `json:"permissionsAdded"`
+	Removed      int        `json:"permissionsRemoved"`
+	Found        int        `json:"permissionsFound"`
+	Error        string     `json:"error,omitempty"`
+}
+
+// EventType returns the event type matching the outcome of the sync.
+func (p PermissionsSync) EventType() string {
+	if p.Error != "" {
+		return PermissionsSyncFailed
+	}
+	return PermissionsSyncCompleted
+}
+
+// EmbeddingsJob is the payload of the embeddings:job_completed event.
+type EmbeddingsJob struct {
+	JobID          int          `json:"jobId"`
+	RepositoryID   api.RepoID   `json:"repositoryId"`
+	RepositoryName api.RepoName `json:"repositoryName"`
+	Revision       api.CommitID `json:"revision"`
+	Incremental    bool         `json:"incremental"`
+}
+
+// CodeIntelUpload is the payload of the codeintel:upload_processed event.
+type CodeIntelUpload struct {
+	ID           int       `json:"id"`
+	RepositoryID int       `json:"repositoryId"`
+	Commit       string    `json:"commit"`
+	Root         string    `json:"root"`
+	Indexer      string    `json:"indexer"`
+	UploadedAt   time.Time `json:"uploadedAt"`
+}
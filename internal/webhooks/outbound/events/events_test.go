@@ -0,0 +1,41 @@
+package events
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sourcegraph/sourcegraph/internal/api"
+)
+
+func TestPermissionsSyncEventType(t *testing.T) {
+	assert.Equal(t, PermissionsSyncCompleted, PermissionsSync{JobID: 1, UserID: 2}.EventType())
+	assert.Equal(t, PermissionsSyncFailed, PermissionsSync{JobID: 1, UserID: 2, Error: "boom"}.EventType())
+}
+
+func TestPayloads(t *testing.T) {
+	for name, tc := range map[string]struct {
+		payload any
+		want    string
+	}{
+		"repo without ID": {
+			payload: Repo{Name: "github.com/sourcegraph/sourcegraph"},
+			want:    `{"name":"github.com/sourcegraph/sourcegraph"}`,
+		},
+		"repo clone failure": {
+			payload: Repo{Name: "github.com/sourcegraph/sourcegraph", Error: "exit status 128"},
+			want:    `{"name":"github.com/sourcegraph/sourcegraph","error":"exit status 128"}`,
+		},
+		"repository permissions sync": {
+			payload: PermissionsSync{JobID: 3, RepositoryID: api.RepoID(4), Added: 1, Found: 2},
+			want:    `{"jobId":3,"repositoryId":4,"permissionsAdded":1,"permissionsRemoved":0,"permissionsFound":2}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			have, err := json.Marshal(tc.payload)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(have))
+		})
+	}
+}
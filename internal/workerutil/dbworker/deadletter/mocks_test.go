@@ -0,0 +1,665 @@
+// Code generated by go-mockgen 1.3.7; DO NOT EDIT.
+//
+// This file was generated by running `sg generate` (or `go-mockgen`) at the root of
+// this repository. To add additional mocks to this or another package, add a new entry
+// to the mockgen.yaml file in the root of this repository.
+
+package deadletter
+
+import (
+	"context"
+	"sync"
+
+	store "github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+)
+
+// MockFailedRecordStore is a mock implementation of the FailedRecordStore
+// interface (from the package
+// github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store)
+// used for unit testing.
+type MockFailedRecordStore struct {
+	// CancelFailedFunc is an instance of a mock function object controlling
+	// the behavior of the method CancelFailed.
+	CancelFailedFunc *FailedRecordStoreCancelFailedFunc
+	// ListFailedFunc is an instance of a mock function object controlling
+	// the behavior of the method ListFailed.
+	ListFailedFunc *FailedRecordStoreListFailedFunc
+	// NameFunc is an instance of a mock function object controlling the
+	// behavior of the method Name.
+	NameFunc *FailedRecordStoreNameFunc
+	// PurgeFailedFunc is an instance of a mock function object controlling
+	// the behavior of the method PurgeFailed.
+	PurgeFailedFunc *FailedRecordStorePurgeFailedFunc
+	// RequeueFailedFunc is an instance of a mock function object
+	// controlling the behavior of the method RequeueFailed.
+	RequeueFailedFunc *FailedRecordStoreRequeueFailedFunc
+}
+
+// NewMockFailedRecordStore creates a new mock of the FailedRecordStore
+// interface. All methods return zero values for all results, unless
+// overwritten.
+func NewMockFailedRecordStore() *MockFailedRecordStore {
+	return &MockFailedRecordStore{
+		CancelFailedFunc: &FailedRecordStoreCancelFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (r0 int, r1 error) {
+				return
+			},
+		},
+		ListFailedFunc: &FailedRecordStoreListFailedFunc{
+			defaultHook: func(context.Context, store.ListFailedRecordsOptions) (r0 []store.FailedRecord, r1 int, r2 error) {
+				return
+			},
+		},
+		NameFunc: &FailedRecordStoreNameFunc{
+			defaultHook: func() (r0 string) {
+				return
+			},
+		},
+		PurgeFailedFunc: &FailedRecordStorePurgeFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (r0 int, r1 error) {
+				return
+			},
+		},
+		RequeueFailedFunc: &FailedRecordStoreRequeueFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (r0 int, r1 error) {
+				return
+			},
+		},
+	}
+}
+
+// NewStrictMockFailedRecordStore creates a new mock of the
+// FailedRecordStore interface. All methods panic on invocation, unless
+// overwritten.
+func NewStrictMockFailedRecordStore() *MockFailedRecordStore {
+	return &MockFailedRecordStore{
+		CancelFailedFunc: &FailedRecordStoreCancelFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (int, error) {
+				panic("unexpected invocation of MockFailedRecordStore.CancelFailed")
+			},
+		},
+		ListFailedFunc: &FailedRecordStoreListFailedFunc{
+			defaultHook: func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+				panic("unexpected invocation of MockFailedRecordStore.ListFailed")
+			},
+		},
+		NameFunc: &FailedRecordStoreNameFunc{
+			defaultHook: func() string {
+				panic("unexpected invocation of MockFailedRecordStore.Name")
+			},
+		},
+		PurgeFailedFunc: &FailedRecordStorePurgeFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (int, error) {
+				panic("unexpected invocation of MockFailedRecordStore.PurgeFailed")
+			},
+		},
+		RequeueFailedFunc: &FailedRecordStoreRequeueFailedFunc{
+			defaultHook: func(context.Context, store.FailedRecordFilter) (int, error) {
+				panic("unexpected invocation of MockFailedRecordStore.RequeueFailed")
+			},
+		},
+	}
+}
+
+// NewMockFailedRecordStoreFrom creates a new mock of the
+// MockFailedRecordStore interface. All methods delegate to the given
+// implementation, unless overwritten.
+func NewMockFailedRecordStoreFrom(i store.FailedRecordStore) *MockFailedRecordStore {
+	return &MockFailedRecordStore{
+		CancelFailedFunc: &FailedRecordStoreCancelFailedFunc{
+			defaultHook: i.CancelFailed,
+		},
+		ListFailedFunc: &FailedRecordStoreListFailedFunc{
+			defaultHook: i.ListFailed,
+		},
+		NameFunc: &FailedRecordStoreNameFunc{
+			defaultHook: i.Name,
+		},
+		PurgeFailedFunc: &FailedRecordStorePurgeFailedFunc{
+			defaultHook: i.PurgeFailed,
+		},
+		RequeueFailedFunc: &FailedRecordStoreRequeueFailedFunc{
+			defaultHook: i.RequeueFailed,
+		},
+	}
+}
+
+// FailedRecordStoreCancelFailedFunc describes the behavior when the
+// CancelFailed method of the parent MockFailedRecordStore instance is
+// invoked.
+type FailedRecordStoreCancelFailedFunc struct {
+	defaultHook func(context.Context, store.FailedRecordFilter) (int, error)
+	hooks       []func(context.Context, store.FailedRecordFilter) (int, error)
+	history     []FailedRecordStoreCancelFailedFuncCall
+	mutex       sync.Mutex
+}
+
+// CancelFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFailedRecordStore) CancelFailed(v0 context.Context, v1 store.FailedRecordFilter) (int, error) {
+	r0, r1 := m.CancelFailedFunc.nextHook()(v0, v1)
+	m.CancelFailedFunc.appendCall(FailedRecordStoreCancelFailedFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CancelFailed method
+// of the parent MockFailedRecordStore instance is invoked and the hook
+// queue is empty.
+func (f *FailedRecordStoreCancelFailedFunc) SetDefaultHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CancelFailed method of the parent MockFailedRecordStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *FailedRecordStoreCancelFailedFunc) PushHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FailedRecordStoreCancelFailedFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FailedRecordStoreCancelFailedFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *FailedRecordStoreCancelFailedFunc) nextHook() func(context.Context, store.FailedRecordFilter) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FailedRecordStoreCancelFailedFunc) appendCall(r0 FailedRecordStoreCancelFailedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FailedRecordStoreCancelFailedFuncCall
+// objects describing the invocations of this function.
+func (f *FailedRecordStoreCancelFailedFunc) History() []FailedRecordStoreCancelFailedFuncCall {
+	f.mutex.Lock()
+	history := make([]FailedRecordStoreCancelFailedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FailedRecordStoreCancelFailedFuncCall is an object that describes an
+// invocation of method CancelFailed on an instance of
+// MockFailedRecordStore.
+type FailedRecordStoreCancelFailedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.FailedRecordFilter
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FailedRecordStoreCancelFailedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FailedRecordStoreCancelFailedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// FailedRecordStoreListFailedFunc describes the behavior when the
+// ListFailed method of the parent MockFailedRecordStore instance is
+// invoked.
+type FailedRecordStoreListFailedFunc struct {
+	defaultHook func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error)
+	hooks       []func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error)
+	history     []FailedRecordStoreListFailedFuncCall
+	mutex       sync.Mutex
+}
+
+// ListFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFailedRecordStore) ListFailed(v0 context.Context, v1 store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+	r0, r1, r2 := m.ListFailedFunc.nextHook()(v0, v1)
+	m.ListFailedFunc.appendCall(FailedRecordStoreListFailedFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the ListFailed method of
+// the parent MockFailedRecordStore instance is invoked and the hook queue
+// is empty.
+func (f *FailedRecordStoreListFailedFunc) SetDefaultHook(hook func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListFailed method of the parent MockFailedRecordStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *FailedRecordStoreListFailedFunc) PushHook(hook func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FailedRecordStoreListFailedFunc) SetDefaultReturn(r0 []store.FailedRecord, r1 int, r2 error) {
+	f.SetDefaultHook(func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FailedRecordStoreListFailedFunc) PushReturn(r0 []store.FailedRecord, r1 int, r2 error) {
+	f.PushHook(func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *FailedRecordStoreListFailedFunc) nextHook() func(context.Context, store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FailedRecordStoreListFailedFunc) appendCall(r0 FailedRecordStoreListFailedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FailedRecordStoreListFailedFuncCall objects
+// describing the invocations of this function.
+func (f *FailedRecordStoreListFailedFunc) History() []FailedRecordStoreListFailedFuncCall {
+	f.mutex.Lock()
+	history := make([]FailedRecordStoreListFailedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FailedRecordStoreListFailedFuncCall is an object that describes an
+// invocation of method ListFailed on an instance of MockFailedRecordStore.
+type FailedRecordStoreListFailedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.ListFailedRecordsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []store.FailedRecord
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 int
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FailedRecordStoreListFailedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FailedRecordStoreListFailedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// FailedRecordStoreNameFunc describes the behavior when the Name method of
+// the parent MockFailedRecordStore instance is invoked.
+type FailedRecordStoreNameFunc struct {
+	defaultHook func() string
+	hooks       []func() string
+	history     []FailedRecordStoreNameFuncCall
+	mutex       sync.Mutex
+}
+
+// Name delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockFailedRecordStore) Name() string {
+	r0 := m.NameFunc.nextHook()()
+	m.NameFunc.appendCall(FailedRecordStoreNameFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Name method of the
+// parent MockFailedRecordStore instance is invoked and the hook queue is
+// empty.
+func (f *FailedRecordStoreNameFunc) SetDefaultHook(hook func() string) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Name method of the parent MockFailedRecordStore instance invokes the hook
+// at the front of the queue and discards it. After the queue is empty, the
+// default hook function is invoked for any future action.
+func (f *FailedRecordStoreNameFunc) PushHook(hook func() string) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FailedRecordStoreNameFunc) SetDefaultReturn(r0 string) {
+	f.SetDefaultHook(func() string {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FailedRecordStoreNameFunc) PushReturn(r0 string) {
+	f.PushHook(func() string {
+		return r0
+	})
+}
+
+func (f *FailedRecordStoreNameFunc) nextHook() func() string {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FailedRecordStoreNameFunc) appendCall(r0 FailedRecordStoreNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FailedRecordStoreNameFuncCall objects
+// describing the invocations of this function.
+func (f *FailedRecordStoreNameFunc) History() []FailedRecordStoreNameFuncCall {
+	f.mutex.Lock()
+	history := make([]FailedRecordStoreNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FailedRecordStoreNameFuncCall is an object that describes an invocation
+// of method Name on an instance of MockFailedRecordStore.
+type FailedRecordStoreNameFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FailedRecordStoreNameFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FailedRecordStoreNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// FailedRecordStorePurgeFailedFunc describes the behavior when the
+// PurgeFailed method of the parent MockFailedRecordStore instance is
+// invoked.
+type FailedRecordStorePurgeFailedFunc struct {
+	defaultHook func(context.Context, store.FailedRecordFilter) (int, error)
+	hooks       []func(context.Context, store.FailedRecordFilter) (int, error)
+	history     []FailedRecordStorePurgeFailedFuncCall
+	mutex       sync.Mutex
+}
+
+// PurgeFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFailedRecordStore) PurgeFailed(v0 context.Context, v1 store.FailedRecordFilter) (int, error) {
+	r0, r1 := m.PurgeFailedFunc.nextHook()(v0, v1)
+	m.PurgeFailedFunc.appendCall(FailedRecordStorePurgeFailedFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the PurgeFailed method
+// of the parent MockFailedRecordStore instance is invoked and the hook
+// queue is empty.
+func (f *FailedRecordStorePurgeFailedFunc) SetDefaultHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PurgeFailed method of the parent MockFailedRecordStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *FailedRecordStorePurgeFailedFunc) PushHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FailedRecordStorePurgeFailedFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FailedRecordStorePurgeFailedFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *FailedRecordStorePurgeFailedFunc) nextHook() func(context.Context, store.FailedRecordFilter) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FailedRecordStorePurgeFailedFunc) appendCall(r0 FailedRecordStorePurgeFailedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FailedRecordStorePurgeFailedFuncCall
+// objects describing the invocations of this function.
+func (f *FailedRecordStorePurgeFailedFunc) History() []FailedRecordStorePurgeFailedFuncCall {
+	f.mutex.Lock()
+	history := make([]FailedRecordStorePurgeFailedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FailedRecordStorePurgeFailedFuncCall is an object that describes an
+// invocation of method PurgeFailed on an instance of MockFailedRecordStore.
+type FailedRecordStorePurgeFailedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.FailedRecordFilter
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FailedRecordStorePurgeFailedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FailedRecordStorePurgeFailedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// FailedRecordStoreRequeueFailedFunc describes the behavior when the
+// RequeueFailed method of the parent MockFailedRecordStore instance is
+// invoked.
+type FailedRecordStoreRequeueFailedFunc struct {
+	defaultHook func(context.Context, store.FailedRecordFilter) (int, error)
+	hooks       []func(context.Context, store.FailedRecordFilter) (int, error)
+	history     []FailedRecordStoreRequeueFailedFuncCall
+	mutex       sync.Mutex
+}
+
+// RequeueFailed delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockFailedRecordStore) RequeueFailed(v0 context.Context, v1 store.FailedRecordFilter) (int, error) {
+	r0, r1 := m.RequeueFailedFunc.nextHook()(v0, v1)
+	m.RequeueFailedFunc.appendCall(FailedRecordStoreRequeueFailedFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the RequeueFailed method
+// of the parent MockFailedRecordStore instance is invoked and the hook
+// queue is empty.
+func (f *FailedRecordStoreRequeueFailedFunc) SetDefaultHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RequeueFailed method of the parent MockFailedRecordStore instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *FailedRecordStoreRequeueFailedFunc) PushHook(hook func(context.Context, store.FailedRecordFilter) (int, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *FailedRecordStoreRequeueFailedFunc) SetDefaultReturn(r0 int, r1 error) {
+	f.SetDefaultHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *FailedRecordStoreRequeueFailedFunc) PushReturn(r0 int, r1 error) {
+	f.PushHook(func(context.Context, store.FailedRecordFilter) (int, error) {
+		return r0, r1
+	})
+}
+
+func (f *FailedRecordStoreRequeueFailedFunc) nextHook() func(context.Context, store.FailedRecordFilter) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *FailedRecordStoreRequeueFailedFunc) appendCall(r0 FailedRecordStoreRequeueFailedFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of FailedRecordStoreRequeueFailedFuncCall
+// objects describing the invocations of this function.
+func (f *FailedRecordStoreRequeueFailedFunc) History() []FailedRecordStoreRequeueFailedFuncCall {
+	f.mutex.Lock()
+	history := make([]FailedRecordStoreRequeueFailedFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// FailedRecordStoreRequeueFailedFuncCall is an object that describes an
+// invocation of method RequeueFailed on an instance of
+// MockFailedRecordStore.
+type FailedRecordStoreRequeueFailedFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 store.FailedRecordFilter
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c FailedRecordStoreRequeueFailedFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c FailedRecordStoreRequeueFailedFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
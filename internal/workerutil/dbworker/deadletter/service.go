@@ -0,0 +1,121 @@
+// Package deadletter provides a view over the records of database-backed worker queues
+// that failed after exhausting their retries, across all queues registered with it.
+package deadletter
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrUnknownQueue is returned when a queue name does not belong to a registered store.
+var ErrUnknownQueue = errors.New("unknown queue")
+
+// Service lists, requeues, cancels, and purges the failed records of a set of queues,
+// each backed by a store.FailedRecordStore. Queues are identified by the name of their
+// store.
+type Service struct {
+	stores map[string]store.FailedRecordStore
+	logger log.Logger
+}
+
+// NewService creates a new service over the given stores. Stores must have distinct names.
+func NewService(observationCtx *observation.Context, stores ...store.FailedRecordStore) *Service {
+	storesByName := make(map[string]store.FailedRecordStore, len(stores))
+	for _, s := range stores {
+		storesByName[s.Name()] = s
+	}
+
+	return &Service{
+		stores: storesByName,
+		logger: observationCtx.Logger.Scoped("deadletter", "failed worker records across queues"),
+	}
+}
+
+// Queues returns the sorted names of the queues registered with the service.
+func (s *Service) Queues() []string {
+	names := make([]string, 0, len(s.stores))
+	for name := range s.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// HasQueue returns true if a queue with the given name is registered with the service.
+func (s *Service) HasQueue(queue string) bool {
+	_, ok := s.stores[queue]
+	return ok
+}
+
+// ListFailed returns the failed records of the given queue matching the given options,
+// most recently failed first, along with the total number of matching failed records.
+func (s *Service) ListFailed(ctx context.Context, queue string, opts store.ListFailedRecordsOptions) ([]store.FailedRecord, int, error) {
+	st, err := s.store(queue)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return st.ListFailed(ctx, opts)
+}
+
+// Requeue moves the failed records of the given queue matching the given filter back to
+// the queued state, and returns the number of requeued records.
+func (s *Service) Requeue(ctx context.Context, queue string, filter store.FailedRecordFilter) (int, error) {
+	return s.update(ctx, "requeue", queue, filter, store.FailedRecordStore.RequeueFailed)
+}
+
+// Cancel moves the failed records of the given queue matching the given filter to the
+// canceled state, and returns the number of canceled records.
+func (s *Service) Cancel(ctx context.Context, queue string, filter store.FailedRecordFilter) (int, error) {
+	return s.update(ctx, "cancel", queue, filter, store.FailedRecordStore.CancelFailed)
+}
+
+// Purge deletes the failed records of the given queue matching the given filter, and
+// returns the number of deleted records.
+func (s *Service) Purge(ctx context.Context, queue string, filter store.FailedRecordFilter) (int, error) {
+	return s.update(ctx, "purge", queue, filter, store.FailedRecordStore.PurgeFailed)
+}
+
+func (s *Service) update(
+	ctx context.Context,
+	action string,
+	queue string,
+	filter store.FailedRecordFilter,
+	f func(store.FailedRecordStore, context.Context, store.FailedRecordFilter) (int, error),
+) (int, error) {
+	st, err := s.store(queue)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := f(st, ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	// Bulk actions are rare and hard to undo, so leave a trace of each of them.
+	s.logger.Info("Updated failed records",
+		log.String("action", action),
+		log.String("queue", queue),
+		log.Int("numIDs", len(filter.IDs)),
+		log.String("failureMessage", filter.FailureMessage),
+		log.Int("count", count))
+
+	return count, nil
+}
+
+func (s *Service) store(queue string) (store.FailedRecordStore, error) {
+	st, ok := s.stores[queue]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownQueue, "%q", queue)
+	}
+
+	return st, nil
+}
@@ -0,0 +1,121 @@
+package deadletter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil/dbworker/store"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestQueues(t *testing.T) {
+	svc := NewService(&observation.TestContext, newMockStore("b"), newMockStore("a"))
+
+	if diff := cmp.Diff([]string{"a", "b"}, svc.Queues()); diff != "" {
+		t.Errorf("unexpected queues (-want +got):\n%s", diff)
+	}
+	if !svc.HasQueue("a") {
+		t.Errorf("expected queue a to be registered")
+	}
+	if svc.HasQueue("c") {
+		t.Errorf("expected queue c not to be registered")
+	}
+}
+
+func TestListFailed(t *testing.T) {
+	storeA := newMockStore("a")
+	storeB := newMockStore("b")
+	storeB.ListFailedFunc.SetDefaultReturn([]store.FailedRecord{{ID: 1}, {ID: 2}}, 5, nil)
+	svc := NewService(&observation.TestContext, storeA, storeB)
+
+	opts := store.ListFailedRecordsOptions{
+		FailedRecordFilter: store.FailedRecordFilter{FailureMessage: "timeout"},
+		Limit:              2,
+	}
+	records, totalCount, err := svc.ListFailed(context.Background(), "b", opts)
+	if err != nil {
+		t.Fatalf("unexpected error listing failed records: %s", err)
+	}
+	if len(records) != 2 {
+		t.Errorf("unexpected number of records. want=%d have=%d", 2, len(records))
+	}
+	if totalCount != 5 {
+		t.Errorf("unexpected total count. want=%d have=%d", 5, totalCount)
+	}
+
+	if len(storeA.ListFailedFunc.History()) != 0 {
+		t.Errorf("unexpected calls to store of other queue")
+	}
+	if history := storeB.ListFailedFunc.History(); len(history) != 1 {
+		t.Fatalf("unexpected number of calls. want=%d have=%d", 1, len(history))
+	} else if diff := cmp.Diff(opts, history[0].Arg1); diff != "" {
+		t.Errorf("unexpected options (-want +got):\n%s", diff)
+	}
+}
+
+func TestBulkActions(t *testing.T) {
+	filter := store.FailedRecordFilter{IDs: []int{1, 2, 3}}
+
+	testCases := []struct {
+		name   string
+		update func(*Service) (int, error)
+		calls  func(*MockFailedRecordStore) int
+	}{
+		{
+			name:   "requeue",
+			update: func(svc *Service) (int, error) { return svc.Requeue(context.Background(), "a", filter) },
+			calls:  func(s *MockFailedRecordStore) int { return len(s.RequeueFailedFunc.History()) },
+		},
+		{
+			name:   "cancel",
+			update: func(svc *Service) (int, error) { return svc.Cancel(context.Background(), "a", filter) },
+			calls:  func(s *MockFailedRecordStore) int { return len(s.CancelFailedFunc.History()) },
+		},
+		{
+			name:   "purge",
+			update: func(svc *Service) (int, error) { return svc.Purge(context.Background(), "a", filter) },
+			calls:  func(s *MockFailedRecordStore) int { return len(s.PurgeFailedFunc.History()) },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockStore := newMockStore("a")
+			mockStore.RequeueFailedFunc.SetDefaultReturn(3, nil)
+			mockStore.CancelFailedFunc.SetDefaultReturn(3, nil)
+			mockStore.PurgeFailedFunc.SetDefaultReturn(3, nil)
+			svc := NewService(&observation.TestContext, mockStore)
+
+			count, err := testCase.update(svc)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if count != 3 {
+				t.Errorf("unexpected count. want=%d have=%d", 3, count)
+			}
+			if calls := testCase.calls(mockStore); calls != 1 {
+				t.Errorf("unexpected number of calls. want=%d have=%d", 1, calls)
+			}
+		})
+	}
+}
+
+func TestUnknownQueue(t *testing.T) {
+	svc := NewService(&observation.TestContext, newMockStore("a"))
+
+	if _, _, err := svc.ListFailed(context.Background(), "b", store.ListFailedRecordsOptions{}); !errors.Is(err, ErrUnknownQueue) {
+		t.Errorf("unexpected error. want=%q have=%v", ErrUnknownQueue, err)
+	}
+	if _, err := svc.Purge(context.Background(), "b", store.FailedRecordFilter{}); !errors.Is(err, ErrUnknownQueue) {
+		t.Errorf("unexpected error. want=%q have=%v", ErrUnknownQueue, err)
+	}
+}
+
+func newMockStore(name string) *MockFailedRecordStore {
+	mockStore := NewMockFailedRecordStore()
+	mockStore.NameFunc.SetDefaultReturn(name)
+	return mockStore
+}
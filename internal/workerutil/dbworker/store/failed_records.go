@@ -0,0 +1,294 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	otlog "github.com/opentracing/opentracing-go/log"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/internal/database/dbutil"
+	"github.com/sourcegraph/sourcegraph/internal/executor"
+	"github.com/sourcegraph/sourcegraph/internal/observation"
+	"github.com/sourcegraph/sourcegraph/internal/workerutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// FailedRecordStore gives access to the records of a store that reached the failed state
+// after exhausting their retries. Failed records are never dequeued again, so this store
+// lets them be inspected and requeued, canceled, or purged in bulk.
+//
+// Unlike Store, FailedRecordStore only relies on the columns every work record table has,
+// so stores of different record types can be used interchangeably.
+type FailedRecordStore interface {
+	// Name returns the name of the underlying store.
+	Name() string
+
+	// ListFailed returns the failed records matching the given options, most recently
+	// failed first, along with the total number of failed records matching the filter.
+	ListFailed(ctx context.Context, opts ListFailedRecordsOptions) ([]FailedRecord, int, error)
+
+	// RequeueFailed moves the failed records matching the given filter back to the queued
+	// state and resets their failure and reset counts, so they are retried as often as a
+	// new record would be. This method returns the number of requeued records.
+	RequeueFailed(ctx context.Context, filter FailedRecordFilter) (int, error)
+
+	// CancelFailed moves the failed records matching the given filter to the canceled state.
+	// This method returns the number of canceled records, or ErrEmptyFailedRecordFilter if
+	// no field of the filter is set.
+	CancelFailed(ctx context.Context, filter FailedRecordFilter) (int, error)
+
+	// PurgeFailed deletes the failed records matching the given filter. This method returns
+	// the number of deleted records, or ErrEmptyFailedRecordFilter if no field of the filter
+	// is set.
+	PurgeFailed(ctx context.Context, filter FailedRecordFilter) (int, error)
+}
+
+// FailedRecord is a record in the failed state.
+type FailedRecord struct {
+	ID             int
+	FailureMessage *string
+	QueuedAt       time.Time
+	StartedAt      *time.Time
+	FinishedAt     *time.Time
+	NumResets      int
+	NumFailures    int
+	ExecutionLogs  []executor.ExecutionLogEntry
+}
+
+// FailedRecordFilter selects a subset of the failed records of a store. The zero value
+// selects all failed records, and is rejected by CancelFailed and PurgeFailed.
+type FailedRecordFilter struct {
+	// IDs, if set, restricts the records to the ones with the given identifiers.
+	IDs []int
+
+	// FailureMessage, if set, restricts the records to the ones whose failure message
+	// contains the given string, ignoring case.
+	FailureMessage string
+
+	// FailedBefore, if set, restricts the records to the ones that failed before the given time.
+	FailedBefore *time.Time
+
+	// FailedAfter, if set, restricts the records to the ones that failed after the given time.
+	FailedAfter *time.Time
+}
+
+// ErrEmptyFailedRecordFilter is returned when canceling or purging failed records with a
+// filter that has no field set, which would select all failed records of the store.
+var ErrEmptyFailedRecordFilter = errors.New("at least one field of the failed record filter must be set")
+
+// isEmpty returns true if no field of the filter is set.
+func (f FailedRecordFilter) isEmpty() bool {
+	return f.IDs == nil && f.FailureMessage == "" && f.FailedBefore == nil && f.FailedAfter == nil
+}
+
+// ListFailedRecordsOptions configure which failed records are returned by ListFailed.
+type ListFailedRecordsOptions struct {
+	FailedRecordFilter
+
+	// Limit is the maximum number of records to return. All matching records are
+	// returned if it is zero.
+	Limit int
+
+	// Offset is the number of matching records to skip.
+	Offset int
+}
+
+var _ FailedRecordStore = &store[workerutil.Record]{}
+
+// NewFailedRecordStore creates a FailedRecordStore over the table configured by the given
+// options. Only the name, table name, and alternate column names of the options are used.
+func NewFailedRecordStore[T workerutil.Record](observationCtx *observation.Context, handle basestore.TransactableHandle, options Options[T]) FailedRecordStore {
+	return newStore(observationCtx, handle, options)
+}
+
+// Name returns the name of the store.
+func (s *store[T]) Name() string {
+	return s.options.Name
+}
+
+// ListFailed returns the failed records matching the given options, most recently failed
+// first, along with the total number of failed records matching the filter.
+func (s *store[T]) ListFailed(ctx context.Context, opts ListFailedRecordsOptions) (_ []FailedRecord, _ int, err error) {
+	ctx, _, endObservation := s.operations.listFailed.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.Int("numIDs", len(opts.IDs)),
+		otlog.String("failureMessage", opts.FailureMessage),
+		otlog.Int("limit", opts.Limit),
+		otlog.Int("offset", opts.Offset),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	conds := s.makeFailedRecordConditions(opts.FailedRecordFilter)
+
+	totalCount, _, err := basestore.ScanFirstInt(s.Query(ctx, s.formatQuery(
+		countFailedQuery,
+		quote(s.options.TableName),
+		conds,
+	)))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit := sqlf.Sprintf("ALL")
+	if opts.Limit > 0 {
+		limit = sqlf.Sprintf("%s", opts.Limit)
+	}
+
+	records, err := scanFailedRecords(s.Query(ctx, s.formatQuery(
+		listFailedQuery,
+		quote(s.options.TableName),
+		conds,
+		limit,
+		opts.Offset,
+	)))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, totalCount, nil
+}
+
+const countFailedQuery = `
+SELECT COUNT(*) FROM %s WHERE %s
+`
+
+const listFailedQuery = `
+SELECT
+	{id},
+	{failure_message},
+	{queued_at},
+	{started_at},
+	{finished_at},
+	{num_resets},
+	{num_failures},
+	{execution_logs}
+FROM %s
+WHERE %s
+ORDER BY {finished_at} DESC, {id} DESC
+LIMIT %s OFFSET %s
+`
+
+// RequeueFailed moves the failed records matching the given filter back to the queued
+// state and resets their failure and reset counts. This method returns the number of
+// requeued records.
+func (s *store[T]) RequeueFailed(ctx context.Context, filter FailedRecordFilter) (_ int, err error) {
+	return s.updateFailed(ctx, s.operations.requeueFailed, requeueFailedQuery, filter)
+}
+
+const requeueFailedQuery = `
+UPDATE %s
+SET
+	{state} = 'queued',
+	{queued_at} = clock_timestamp(),
+	{started_at} = NULL,
+	{finished_at} = NULL,
+	{process_after} = NULL,
+	{failure_message} = NULL,
+	{num_resets} = 0,
+	{num_failures} = 0,
+	{cancel} = false
+WHERE %s
+RETURNING {id}
+`
+
+// CancelFailed moves the failed records matching the given filter to the canceled state.
+// This method returns the number of canceled records.
+func (s *store[T]) CancelFailed(ctx context.Context, filter FailedRecordFilter) (_ int, err error) {
+	// Canceling cannot be undone, so all failed records must be selected explicitly.
+	if filter.isEmpty() {
+		return 0, ErrEmptyFailedRecordFilter
+	}
+
+	return s.updateFailed(ctx, s.operations.cancelFailed, cancelFailedQuery, filter)
+}
+
+const cancelFailedQuery = `
+UPDATE %s
+SET {state} = 'canceled'
+WHERE %s
+RETURNING {id}
+`
+
+// PurgeFailed deletes the failed records matching the given filter. This method returns
+// the number of deleted records.
+func (s *store[T]) PurgeFailed(ctx context.Context, filter FailedRecordFilter) (_ int, err error) {
+	// Purging cannot be undone, so all failed records must be selected explicitly.
+	if filter.isEmpty() {
+		return 0, ErrEmptyFailedRecordFilter
+	}
+
+	return s.updateFailed(ctx, s.operations.purgeFailed, purgeFailedQuery, filter)
+}
+
+const purgeFailedQuery = `
+DELETE FROM %s
+WHERE %s
+RETURNING {id}
+`
+
+// updateFailed runs the given query over the failed records matching the given filter and
+// returns the number of affected records. The query is formatted with the table name and
+// the conditions selecting the records, and must return the identifier of each record.
+func (s *store[T]) updateFailed(ctx context.Context, operation *observation.Operation, query string, filter FailedRecordFilter) (_ int, err error) {
+	ctx, _, endObservation := operation.With(ctx, &err, observation.Args{LogFields: []otlog.Field{
+		otlog.Int("numIDs", len(filter.IDs)),
+		otlog.String("failureMessage", filter.FailureMessage),
+	}})
+	defer endObservation(1, observation.Args{})
+
+	ids, err := basestore.ScanInts(s.Query(ctx, s.formatQuery(
+		query,
+		quote(s.options.TableName),
+		s.makeFailedRecordConditions(filter),
+	)))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// likeEscaper escapes the wildcard characters of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (s *store[T]) makeFailedRecordConditions(filter FailedRecordFilter) *sqlf.Query {
+	conds := []*sqlf.Query{
+		s.formatQuery("{state} = 'failed'"),
+	}
+	if filter.IDs != nil {
+		conds = append(conds, s.formatQuery("{id} = ANY(%s)", pq.Array(filter.IDs)))
+	}
+	if filter.FailureMessage != "" {
+		conds = append(conds, s.formatQuery("{failure_message} ILIKE %s", "%"+likeEscaper.Replace(filter.FailureMessage)+"%"))
+	}
+	if filter.FailedBefore != nil {
+		conds = append(conds, s.formatQuery("{finished_at} < %s", *filter.FailedBefore))
+	}
+	if filter.FailedAfter != nil {
+		conds = append(conds, s.formatQuery("{finished_at} > %s", *filter.FailedAfter))
+	}
+
+	return sqlf.Join(conds, " AND ")
+}
+
+var scanFailedRecords = basestore.NewSliceScanner(func(s dbutil.Scanner) (record FailedRecord, _ error) {
+	var executionLogs []executor.ExecutionLogEntry
+	if err := s.Scan(
+		&record.ID,
+		&record.FailureMessage,
+		&record.QueuedAt,
+		&record.StartedAt,
+		&record.FinishedAt,
+		&record.NumResets,
+		&record.NumFailures,
+		pq.Array(&executionLogs),
+	); err != nil {
+		return record, err
+	}
+	record.ExecutionLogs = append(record.ExecutionLogs, executionLogs...)
+
+	return record, nil
+})
@@ -0,0 +1,255 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sourcegraph/sourcegraph/internal/database/basestore"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+func TestStoreListFailed(t *testing.T) {
+	db := setupFailedRecordsTest(t)
+	store := testStore(db, defaultTestStoreOptions(nil, testScanRecord))
+
+	testCases := []struct {
+		name               string
+		opts               ListFailedRecordsOptions
+		expectedIDs        []int
+		expectedTotalCount int
+	}{
+		{
+			name:               "all",
+			expectedIDs:        []int{1, 4, 2},
+			expectedTotalCount: 3,
+		},
+		{
+			name:               "ids",
+			opts:               ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{IDs: []int{2, 3, 4}}},
+			expectedIDs:        []int{4, 2},
+			expectedTotalCount: 2,
+		},
+		{
+			name:               "failure message",
+			opts:               ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{FailureMessage: "TIMEOUT"}},
+			expectedIDs:        []int{1, 4},
+			expectedTotalCount: 2,
+		},
+		{
+			name:               "failure message with wildcards",
+			opts:               ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{FailureMessage: "50%_"}},
+			expectedIDs:        []int{4},
+			expectedTotalCount: 1,
+		},
+		{
+			name:               "failed before",
+			opts:               ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{FailedBefore: timePtr(testNow().Add(-90 * time.Minute))}},
+			expectedIDs:        []int{4, 2},
+			expectedTotalCount: 2,
+		},
+		{
+			name:               "failed after",
+			opts:               ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{FailedAfter: timePtr(testNow().Add(-150 * time.Minute))}},
+			expectedIDs:        []int{1, 4},
+			expectedTotalCount: 2,
+		},
+		{
+			name:               "paginated",
+			opts:               ListFailedRecordsOptions{Limit: 1, Offset: 1},
+			expectedIDs:        []int{4},
+			expectedTotalCount: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			records, totalCount, err := store.ListFailed(context.Background(), testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error listing failed records: %s", err)
+			}
+
+			var ids []int
+			for _, record := range records {
+				ids = append(ids, record.ID)
+			}
+			if diff := cmp.Diff(testCase.expectedIDs, ids); diff != "" {
+				t.Errorf("unexpected record ids (-want +got):\n%s", diff)
+			}
+			if totalCount != testCase.expectedTotalCount {
+				t.Errorf("unexpected total count. want=%d have=%d", testCase.expectedTotalCount, totalCount)
+			}
+		})
+	}
+
+	records, _, err := store.ListFailed(context.Background(), ListFailedRecordsOptions{FailedRecordFilter: FailedRecordFilter{IDs: []int{2}}})
+	if err != nil {
+		t.Fatalf("unexpected error listing failed records: %s", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("unexpected number of records. want=%d have=%d", 1, len(records))
+	}
+	record := records[0]
+	if record.FailureMessage == nil || *record.FailureMessage != "process OOM killed" {
+		t.Errorf("unexpected failure message. want=%q have=%v", "process OOM killed", record.FailureMessage)
+	}
+	if record.NumFailures != 3 {
+		t.Errorf("unexpected number of failures. want=%d have=%d", 3, record.NumFailures)
+	}
+	if len(record.ExecutionLogs) != 1 || record.ExecutionLogs[0].Key != "step.0" {
+		t.Errorf("unexpected execution logs. have=%v", record.ExecutionLogs)
+	}
+}
+
+func TestStoreRequeueFailed(t *testing.T) {
+	db := setupFailedRecordsTest(t)
+
+	count, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).RequeueFailed(context.Background(), FailedRecordFilter{IDs: []int{1, 2, 3}})
+	if err != nil {
+		t.Fatalf("unexpected error requeueing failed records: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count. want=%d have=%d", 2, count)
+	}
+
+	rows, err := db.QueryContext(context.Background(), `SELECT id, state, failure_message, finished_at, num_failures FROM workerutil_test ORDER BY id`)
+	if err != nil {
+		t.Fatalf("unexpected error querying records: %s", err)
+	}
+	defer func() { _ = basestore.CloseRows(rows, nil) }()
+
+	type result struct {
+		id             int
+		state          string
+		failureMessage *string
+		finishedAt     *time.Time
+		numFailures    int
+	}
+	var results []result
+	for rows.Next() {
+		var r result
+		if err := rows.Scan(&r.id, &r.state, &r.failureMessage, &r.finishedAt, &r.numFailures); err != nil {
+			t.Fatalf("unexpected error scanning record: %s", err)
+		}
+		results = append(results, r)
+	}
+
+	for _, r := range results {
+		switch r.id {
+		case 1, 2:
+			if r.state != "queued" {
+				t.Errorf("unexpected state for record %d. want=%q have=%q", r.id, "queued", r.state)
+			}
+			if r.failureMessage != nil || r.finishedAt != nil || r.numFailures != 0 {
+				t.Errorf("expected failure of record %d to be reset", r.id)
+			}
+		case 3:
+			if r.state != "errored" {
+				t.Errorf("unexpected state for record %d. want=%q have=%q", r.id, "errored", r.state)
+			}
+		case 4:
+			if r.state != "failed" {
+				t.Errorf("unexpected state for record %d. want=%q have=%q", r.id, "failed", r.state)
+			}
+		}
+	}
+}
+
+func TestStoreCancelFailed(t *testing.T) {
+	db := setupFailedRecordsTest(t)
+
+	count, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).CancelFailed(context.Background(), FailedRecordFilter{FailureMessage: "timeout"})
+	if err != nil {
+		t.Fatalf("unexpected error canceling failed records: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count. want=%d have=%d", 2, count)
+	}
+
+	expectedStates := map[int]string{1: "canceled", 2: "failed", 3: "errored", 4: "canceled"}
+	if diff := cmp.Diff(expectedStates, readStates(t, db)); diff != "" {
+		t.Errorf("unexpected states (-want +got):\n%s", diff)
+	}
+}
+
+func TestStorePurgeFailed(t *testing.T) {
+	db := setupFailedRecordsTest(t)
+
+	count, err := testStore(db, defaultTestStoreOptions(nil, testScanRecord)).PurgeFailed(context.Background(), FailedRecordFilter{FailedBefore: timePtr(testNow().Add(-90 * time.Minute))})
+	if err != nil {
+		t.Fatalf("unexpected error purging failed records: %s", err)
+	}
+	if count != 2 {
+		t.Errorf("unexpected count. want=%d have=%d", 2, count)
+	}
+
+	// The errored record finished before the cutoff too, but is not failed.
+	expectedStates := map[int]string{1: "failed", 3: "errored"}
+	if diff := cmp.Diff(expectedStates, readStates(t, db)); diff != "" {
+		t.Errorf("unexpected states (-want +got):\n%s", diff)
+	}
+}
+
+func TestStoreCancelAndPurgeFailedEmptyFilter(t *testing.T) {
+	db := setupFailedRecordsTest(t)
+	store := testStore(db, defaultTestStoreOptions(nil, testScanRecord))
+
+	if _, err := store.CancelFailed(context.Background(), FailedRecordFilter{}); !errors.Is(err, ErrEmptyFailedRecordFilter) {
+		t.Errorf("unexpected error canceling failed records. want=%q have=%q", ErrEmptyFailedRecordFilter, err)
+	}
+	if _, err := store.PurgeFailed(context.Background(), FailedRecordFilter{}); !errors.Is(err, ErrEmptyFailedRecordFilter) {
+		t.Errorf("unexpected error purging failed records. want=%q have=%q", ErrEmptyFailedRecordFilter, err)
+	}
+
+	expectedStates := map[int]string{1: "failed", 2: "failed", 3: "errored", 4: "failed"}
+	if diff := cmp.Diff(expectedStates, readStates(t, db)); diff != "" {
+		t.Errorf("unexpected states (-want +got):\n%s", diff)
+	}
+}
+
+func setupFailedRecordsTest(t *testing.T) *sql.DB {
+	db := setupStoreTest(t)
+
+	now := testNow()
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, failure_message, finished_at, num_failures, execution_logs)
+		VALUES
+			(1, 'failed', 'timeout while cloning', $1, 3, NULL),
+			(2, 'failed', 'process OOM killed', $3, 3, ARRAY['{"key": "step.0", "command": ["true"], "startTime": "2020-01-01T00:00:00Z", "out": ""}'::json]),
+			(3, 'errored', 'timeout while cloning', $3, 1, NULL),
+			(4, 'failed', 'Timeout after reaching 50%_ of the limit', $2, 3, NULL)
+	`, now.Add(-time.Hour), now.Add(-2*time.Hour), now.Add(-3*time.Hour)); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	return db
+}
+
+func readStates(t *testing.T, db *sql.DB) map[int]string {
+	t.Helper()
+
+	rows, err := db.QueryContext(context.Background(), `SELECT id, state FROM workerutil_test`)
+	if err != nil {
+		t.Fatalf("unexpected error querying records: %s", err)
+	}
+	defer func() { _ = basestore.CloseRows(rows, nil) }()
+
+	states := map[int]string{}
+	for rows.Next() {
+		var id int
+		var state string
+		if err := rows.Scan(&id, &state); err != nil {
+			t.Fatalf("unexpected error scanning record: %s", err)
+		}
+		states[id] = state
+	}
+
+	return states
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
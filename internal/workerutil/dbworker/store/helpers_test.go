@@ -87,7 +87,9 @@ func setupStoreTest(t *testing.T) *sql.DB {
 			created_at        timestamp with time zone NOT NULL default NOW(),
 			execution_logs    json[],
 			worker_hostname   text NOT NULL default '',
-			cancel            boolean NOT NULL default false
+			cancel            boolean NOT NULL default false,
+			priority          integer NOT NULL default 0,
+			fairness_key      text
 		)
 	`); err != nil {
 		t.Fatalf("unexpected error creating test table: %s", err)
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+
 	"github.com/sourcegraph/sourcegraph/internal/metrics"
 	"github.com/sourcegraph/sourcegraph/internal/observation"
 )
@@ -21,6 +23,13 @@ type operations struct {
 	resetStalled            *observation.Operation
 	updateExecutionLogEntry *observation.Operation
 	canceledJobs            *observation.Operation
+	listFailed              *observation.Operation
+	requeueFailed           *observation.Operation
+	cancelFailed            *observation.Operation
+	purgeFailed             *observation.Operation
+
+	// queueTime is the time records spent in the queue before being dequeued, by lane.
+	queueTime *prometheus.HistogramVec
 }
 
 // as newOperations changes based on the store name passed in, and a dbworker store
@@ -47,6 +56,13 @@ func newOperations(observationCtx *observation.Context, storeName string) *opera
 		metricsMap[storeName] = red
 	}
 
+	queueTime := metrics.MustRegisterIgnoreDuplicate(observationCtx.Registerer, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "src",
+		Name:      fmt.Sprintf("workerutil_dbworker_store_%s_queue_time_seconds", storeName),
+		Help:      "Time in seconds records spent in the queue before being dequeued, by priority lane (high, default or low).",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 10),
+	}, []string{"lane"}))
+
 	metricsMu.Unlock()
 
 	op := func(opName string) *observation.Operation {
@@ -70,5 +86,10 @@ func newOperations(observationCtx *observation.Context, storeName string) *opera
 		resetStalled:            op("ResetStalled"),
 		updateExecutionLogEntry: op("UpdateExecutionLogEntry"),
 		canceledJobs:            op("CanceledJobs"),
+		listFailed:              op("ListFailed"),
+		requeueFailed:           op("RequeueFailed"),
+		cancelFailed:            op("CancelFailed"),
+		purgeFailed:             op("PurgeFailed"),
+		queueTime:               queueTime,
 	}
 }
@@ -200,11 +200,35 @@ type Options[T workerutil.Record] struct {
 	// Scan is the function used to scan a resultset into a slice of the expected type.
 	Scan ResultsetScanFn[T]
 
+	// ScanRecord is the function used to scan a single record from the columns in ColumnExpressions.
+	// It is required when PriorityExpression or FairnessKeyExpression is supplied, as the time the
+	// dequeued record spent in the queue is then selected after the columns in ColumnExpressions and
+	// scanned along with the record.
+	ScanRecord func(dbutil.Scanner) (T, error)
+
 	// OrderByExpression is the SQL expression used to order candidate records when selecting the next
 	// batch of work to perform. This expression may use the alias provided in `ViewName`, if one was
 	// supplied.
 	OrderByExpression *sqlf.Query
 
+	// PriorityExpression is an optional SQL expression evaluating to the integer priority of a record.
+	// Records with a higher priority are dequeued before records with a lower priority, regardless of
+	// OrderByExpression. The time records spend in the queue before being dequeued is reported by
+	// lane: records with a positive, zero or negative priority are in the high, default or low lane.
+	// This expression may use the alias provided in `ViewName`, if one was supplied.
+	PriorityExpression *sqlf.Query
+
+	// FairnessKeyExpression is an optional SQL expression, such as a repository or user identifier,
+	// that groups the records of a queue. When supplied, records of the same priority are dequeued
+	// round-robin between groups instead of strictly by OrderByExpression: the next record is taken
+	// from the group with the fewest records processing or ahead of it in the queue, so that a
+	// single group enqueueing many records cannot starve the others. OrderByExpression still
+	// determines the order of records within a group. This expression may use the alias provided
+	// in `ViewName`, if one was supplied.
+	//
+	// Only the records at the head of the queue are ranked by group, see fairPotentialCandidatesQuery.
+	FairnessKeyExpression *sqlf.Query
+
 	// ColumnExpressions are the target columns provided to the query when selecting a job record. These
 	// expressions may use the alias provided in `ViewName`, if one was supplied.
 	ColumnExpressions []*sqlf.Query
@@ -264,6 +288,10 @@ func newStore[T workerutil.Record](observationCtx *observation.Context, handle b
 		options.ViewName = options.TableName
 	}
 
+	if (options.PriorityExpression != nil || options.FairnessKeyExpression != nil) && options.ScanRecord == nil {
+		panic("no record scan function supplied with a priority or fairness expression to github.com/sourcegraph/sourcegraph/internal/dbworker/store:newStore")
+	}
+
 	if options.clock == nil {
 		options.clock = glock.NewRealClock()
 	}
@@ -471,7 +499,6 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 	}
 
 	now := s.now()
-	retryAfter := int(s.options.RetryAfter / time.Second)
 
 	var (
 		processingExpr     = sqlf.Sprintf("%s", "processing")
@@ -492,21 +519,35 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 		s.columnReplacer.Replace("{worker_hostname}"):   workerHostnameExpr,
 	}
 
-	records, err := s.options.Scan(s.Query(ctx, s.formatQuery(
+	selectExpressions := s.makeDequeueSelectExpressions(updatedColumns)
+	scan := s.options.Scan
+
+	// With priorities or fairness, the time the record spent in the queue is reported by lane.
+	// It is selected after the record columns, and scanned along with the record.
+	observeQueueTime := s.options.PriorityExpression != nil || s.options.FairnessKeyExpression != nil
+	var (
+		lane             int
+		queueTimeSeconds float64
+	)
+	if observeQueueTime {
+		priorityExpression := sqlf.Sprintf("0")
+		if s.options.PriorityExpression != nil {
+			priorityExpression = s.options.PriorityExpression
+		}
+		selectExpressions = append(selectExpressions, s.formatQuery(queueTimeSelectExpressions, priorityExpression, now))
+		scan = BuildWorkerScan(func(sc dbutil.Scanner) (T, error) {
+			return s.options.ScanRecord(&trailingColumnsScanner{Scanner: sc, dest: []any{&lane, &queueTimeSeconds}})
+		})
+	}
+
+	records, err := scan(s.Query(ctx, s.formatQuery(
 		dequeueQuery,
-		s.options.OrderByExpression,
-		quote(s.options.ViewName),
-		now,
-		retryAfter,
-		now,
-		retryAfter,
-		makeConditionSuffix(conditions),
-		s.options.OrderByExpression,
+		s.makeDequeuePotentialCandidatesQuery(now, conditions),
 		quote(s.options.TableName),
 		quote(s.options.TableName),
 		quote(s.options.TableName),
 		sqlf.Join(s.makeDequeueUpdateStatements(updatedColumns), ", "),
-		sqlf.Join(s.makeDequeueSelectExpressions(updatedColumns), ", "),
+		sqlf.Join(selectExpressions, ", "),
 		quote(s.options.ViewName),
 	)))
 	if err != nil {
@@ -520,29 +561,16 @@ func (s *store[T]) Dequeue(ctx context.Context, workerHostname string, condition
 	}
 	trace.AddEvent("TODO Domain Owner", attribute.Int("recordID", records[0].RecordID()))
 
+	if observeQueueTime {
+		s.operations.queueTime.WithLabelValues(laneLabel(lane)).Observe(queueTimeSeconds)
+	}
+
 	return records[0], true, nil
 }
 
 const dequeueQuery = `
 WITH potential_candidates AS (
-	SELECT
-		{id} AS candidate_id,
-		ROW_NUMBER() OVER (ORDER BY %s) AS order
-	FROM %s
-	WHERE
-		(
-			(
-				{state} = 'queued' AND
-				({process_after} IS NULL OR {process_after} <= %s)
-			) OR (
-				%s > 0 AND
-				{state} = 'errored' AND
-				%s - {finished_at} > (%s * '1 second'::interval)
-			)
-		)
-		%s
-	ORDER BY %s
-	LIMIT 50
+	%s
 ),
 candidate AS (
 	SELECT
@@ -571,6 +599,161 @@ WHERE
 	{id} IN (SELECT {id} FROM candidate)
 `
 
+// makeDequeuePotentialCandidatesQuery constructs the query selecting the records that the dequeue
+// query attempts to lock, along with their position in the queue. The position takes the configured
+// priority and fairness key into account, if any.
+func (s *store[T]) makeDequeuePotentialCandidatesQuery(now time.Time, conditions []*sqlf.Query) *sqlf.Query {
+	retryAfter := int(s.options.RetryAfter / time.Second)
+	dequeueableCondition := s.formatQuery(dequeueableConditionQuery, now, retryAfter, now, retryAfter)
+
+	orderByExpression := s.options.OrderByExpression
+	priorityExpression := sqlf.Sprintf("0")
+	if s.options.PriorityExpression != nil {
+		priorityExpression = s.options.PriorityExpression
+		orderByExpression = sqlf.Sprintf("%s DESC, %s", priorityExpression, orderByExpression)
+	}
+
+	if s.options.FairnessKeyExpression == nil {
+		return s.formatQuery(
+			potentialCandidatesQuery,
+			orderByExpression,
+			quote(s.options.ViewName),
+			dequeueableCondition,
+			makeConditionSuffix(conditions),
+			orderByExpression,
+		)
+	}
+
+	return s.formatQuery(
+		fairPotentialCandidatesQuery,
+		// processing_by_key
+		s.options.FairnessKeyExpression,
+		quote(s.options.ViewName),
+		// candidate_window
+		s.options.FairnessKeyExpression,
+		priorityExpression,
+		orderByExpression,
+		quote(s.options.ViewName),
+		dequeueableCondition,
+		makeConditionSuffix(conditions),
+		orderByExpression,
+		fairCandidateWindowSize,
+	)
+}
+
+const dequeueableConditionQuery = `
+(
+	(
+		{state} = 'queued' AND
+		({process_after} IS NULL OR {process_after} <= %s)
+	) OR (
+		%s > 0 AND
+		{state} = 'errored' AND
+		%s - {finished_at} > (%s * '1 second'::interval)
+	)
+)
+`
+
+const potentialCandidatesQuery = `
+SELECT
+	{id} AS candidate_id,
+	ROW_NUMBER() OVER (ORDER BY %s) AS order
+FROM %s
+WHERE
+	%s
+	%s
+ORDER BY %s
+LIMIT 50
+`
+
+// fairCandidateWindowSize is the number of dequeueable records at the head of the queue that are
+// ranked against each other by fairPotentialCandidatesQuery.
+const fairCandidateWindowSize = 1000
+
+// fairPotentialCandidatesQuery ranks each dequeueable record by the number of records of the same
+// group that are processing or ahead of it in the queue, so that groups take turns.
+//
+// Only the first fairCandidateWindowSize dequeueable records in priority and OrderByExpression
+// order are ranked, so the cost of a dequeue does not grow with the size of the queue: Postgres
+// reads the head of the queue in order (using an index on the columns of OrderByExpression, if
+// one exists) and stops once the window is full. Groups only take turns within that window, so a
+// group whose records are all behind it waits until the records ahead of it have been dequeued, as
+// it would without fairness.
+const fairPotentialCandidatesQuery = `
+WITH processing_by_key AS (
+	SELECT
+		%s AS fairness_key,
+		COUNT(*) AS num_processing
+	FROM %s
+	WHERE {state} = 'processing'
+	GROUP BY 1
+),
+candidate_window AS (
+	SELECT
+		{id} AS candidate_id,
+		%s AS fairness_key,
+		%s AS priority,
+		ROW_NUMBER() OVER (ORDER BY %s) AS rank_in_queue
+	FROM %s
+	WHERE
+		%s
+		%s
+	ORDER BY %s
+	LIMIT %s
+),
+ranked AS (
+	SELECT
+		candidate_id,
+		fairness_key,
+		priority,
+		rank_in_queue,
+		ROW_NUMBER() OVER (PARTITION BY fairness_key ORDER BY rank_in_queue) AS rank_in_group
+	FROM candidate_window
+)
+SELECT
+	r.candidate_id,
+	ROW_NUMBER() OVER (
+		ORDER BY
+			r.priority DESC,
+			r.rank_in_group + COALESCE(p.num_processing, 0),
+			r.rank_in_queue
+	) AS order
+FROM ranked r
+LEFT JOIN processing_by_key p ON p.fairness_key IS NOT DISTINCT FROM r.fairness_key
+ORDER BY 2
+LIMIT 50
+`
+
+// queueTimeSelectExpressions selects the lane of a dequeued record, which is the sign of its
+// priority so that the lanes are bounded, and the time in seconds it spent in the queue.
+const queueTimeSelectExpressions = `
+COALESCE(SIGN(%s), 0)::integer,
+COALESCE(EXTRACT(EPOCH FROM %s::timestamptz - GREATEST({queued_at}, {process_after})), 0)
+`
+
+// laneLabel returns the label of the queue time metric of the given lane.
+func laneLabel(lane int) string {
+	switch {
+	case lane > 0:
+		return "high"
+	case lane < 0:
+		return "low"
+	default:
+		return "default"
+	}
+}
+
+// trailingColumnsScanner scans the trailing columns of a row into dest, so that the remaining
+// columns can be scanned as usual.
+type trailingColumnsScanner struct {
+	dbutil.Scanner
+	dest []any
+}
+
+func (s *trailingColumnsScanner) Scan(dest ...any) error {
+	return s.Scanner.Scan(append(dest, s.dest...)...)
+}
+
 // makeDequeueSelectExpressions constructs the ordered set of SQL expressions that are returned
 // from the dequeue query. This method returns a copy of the configured column expressions slice
 // where expressions referencing one of the column updated by dequeue are replaced by the updated
@@ -300,6 +300,101 @@ func TestStoreDequeueView(t *testing.T) {
 	assertDequeueRecordViewResult(t, 2, 14, record, ok, err)
 }
 
+func TestStoreDequeuePriority(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, priority)
+		VALUES
+			(1, 'queued', NOW() - '1 minute'::interval, 1),
+			(2, 'queued', NOW() - '5 minute'::interval, 0),
+			(3, 'queued', NOW() - '2 minute'::interval, 1),
+			(4, 'queued', NOW() - '4 minute'::interval, 0),
+			(5, 'queued', NOW() - '3 minute'::interval, 2)
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.PriorityExpression = sqlf.Sprintf("workerutil_test.priority")
+	options.ScanRecord = testScanRecord
+	store := testStore(db, options)
+
+	// Higher priorities first, then by order within the same priority.
+	for _, expectedID := range []int{5, 3, 1, 2, 4} {
+		record, ok, err := store.Dequeue(context.Background(), "test", nil)
+		assertDequeueRecordResult(t, expectedID, record, ok, err)
+	}
+}
+
+func TestStoreDequeueFairness(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, fairness_key)
+		VALUES
+			(1, 'queued',     NOW() - '9 minute'::interval, 'busy'),
+			(2, 'queued',     NOW() - '8 minute'::interval, 'busy'),
+			(3, 'queued',     NOW() - '7 minute'::interval, 'busy'),
+			(4, 'queued',     NOW() - '6 minute'::interval, 'busy'),
+			(5, 'queued',     NOW() - '3 minute'::interval, 'quiet'),
+			(6, 'queued',     NOW() - '2 minute'::interval, 'quiet'),
+			(7, 'queued',     NOW() - '1 minute'::interval, 'other'),
+			(8, 'processing', NOW() - '5 minute'::interval, 'other')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.FairnessKeyExpression = sqlf.Sprintf("workerutil_test.fairness_key")
+	options.ScanRecord = testScanRecord
+	store := testStore(db, options)
+
+	// Groups take turns, oldest record first, and the group with a record that is
+	// already processing waits for its turn.
+	for _, expectedID := range []int{1, 5, 2, 6, 7, 3, 4} {
+		record, ok, err := store.Dequeue(context.Background(), "test", nil)
+		assertDequeueRecordResult(t, expectedID, record, ok, err)
+	}
+}
+
+func TestStoreDequeueFairnessLargeGroup(t *testing.T) {
+	db := setupStoreTest(t)
+
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, fairness_key)
+		SELECT id, 'queued', NOW() - '1 hour'::interval + id * '1 second'::interval, 'busy'
+		FROM generate_series(1, 100) id
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+	if _, err := db.ExecContext(context.Background(), `
+		INSERT INTO workerutil_test (id, state, created_at, fairness_key)
+		VALUES (101, 'queued', NOW(), 'quiet')
+	`); err != nil {
+		t.Fatalf("unexpected error inserting records: %s", err)
+	}
+
+	options := defaultTestStoreOptions(nil, testScanRecord)
+	options.FairnessKeyExpression = sqlf.Sprintf("workerutil_test.fairness_key")
+	options.ScanRecord = testScanRecord
+	store := testStore(db, options)
+
+	// Groups with fewer records take turns with the large group at the head of the queue.
+	for _, expectedID := range []int{1, 101, 2, 3} {
+		record, ok, err := store.Dequeue(context.Background(), "test", nil)
+		assertDequeueRecordResult(t, expectedID, record, ok, err)
+	}
+}
+
+func TestLaneLabel(t *testing.T) {
+	for priority, expected := range map[int]string{-3: "low", -1: "low", 0: "default", 1: "high", 1000: "high"} {
+		if label := laneLabel(priority); label != expected {
+			t.Errorf("unexpected lane for priority %d. want=%q have=%q", priority, expected, label)
+		}
+	}
+}
+
 func TestStoreDequeueConcurrent(t *testing.T) {
 	db := setupStoreTest(t)
 
@@ -2,6 +2,7 @@ package batches
 
 import (
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches/env"
@@ -94,6 +95,7 @@ type Step struct {
 	Files     map[string]string `json:"files,omitempty" yaml:"files,omitempty"`
 	Outputs   Outputs           `json:"outputs,omitempty" yaml:"outputs,omitempty"`
 	Mount     []Mount           `json:"mount,omitempty" yaml:"mount,omitempty"`
+	Artifacts []StepArtifact    `json:"artifacts,omitempty" yaml:"artifacts,omitempty"`
 	If        any               `json:"if,omitempty" yaml:"if,omitempty"`
 }
 
@@ -133,6 +135,13 @@ type Mount struct {
 	Path       string `json:"path" yaml:"path"`
 }
 
+// StepArtifact is a file produced by a step that is uploaded after the step
+// ran. Path is relative to the root of the workspace.
+type StepArtifact struct {
+	Name string `json:"name" yaml:"name"`
+	Path string `json:"path" yaml:"path"`
+}
+
 func ParseBatchSpec(data []byte) (*BatchSpec, error) {
 	return parseBatchSpec(schema.BatchSpecJSON, data)
 }
@@ -165,7 +174,18 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 		errs = errors.Append(errs, NewValidationError(errors.New("batch spec includes steps but no changesetTemplate")))
 	}
 
+	artifactNames := make(map[string]struct{})
 	for i, step := range spec.Steps {
+		for _, artifact := range step.Artifacts {
+			if _, ok := artifactNames[artifact.Name]; ok {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d artifact name %q is used more than once", i+1, artifact.Name)))
+			}
+			artifactNames[artifact.Name] = struct{}{}
+
+			if !isValidArtifactPath(artifact.Path) {
+				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d artifact %q must have a relative path within the workspace", i+1, artifact.Name)))
+			}
+		}
 		for _, mount := range step.Mount {
 			if strings.Contains(mount.Path, invalidMountCharacters) {
 				errs = errors.Append(errs, NewValidationError(errors.Newf("step %d mount path contains invalid characters", i+1)))
@@ -181,6 +201,16 @@ func parseBatchSpec(schema string, data []byte) (*BatchSpec, error) {
 
 const invalidMountCharacters = ","
 
+// isValidArtifactPath returns whether p is a relative path that doesn't leave
+// the workspace.
+func isValidArtifactPath(p string) bool {
+	if p == "" || path.IsAbs(p) {
+		return false
+	}
+	clean := path.Clean(p)
+	return clean != "." && clean != ".." && !strings.HasPrefix(clean, "../")
+}
+
 func (on *OnQueryOrRepository) String() string {
 	if on.RepositoriesMatchingQuery != "" {
 		return on.RepositoriesMatchingQuery
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v2"
 )
 
@@ -210,6 +211,75 @@ changesetTemplate:
 		_, err := ParseBatchSpec([]byte(spec))
 		assert.Equal(t, "step 1 mount mountpoint contains invalid characters", err.Error())
 	})
+
+	t.Run("artifacts", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: semgrep --sarif -o results.sarif .
+    container: returntocorp/semgrep
+    artifacts:
+      - name: semgrep-sarif
+        path: results.sarif
+changesetTemplate:
+  title: Test Artifacts
+  body: Test artifacts
+  branch: test
+  commit:
+    message: Test
+`
+		batchSpec, err := ParseBatchSpec([]byte(spec))
+		require.NoError(t, err)
+		assert.Equal(t, []StepArtifact{{Name: "semgrep-sarif", Path: "results.sarif"}}, batchSpec.Steps[0].Artifacts)
+	})
+
+	t.Run("artifact name used twice", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo 1 > a.txt
+    container: alpine:3
+    artifacts:
+      - name: report
+        path: a.txt
+  - run: echo 2 > b.txt
+    container: alpine:3
+    artifacts:
+      - name: report
+        path: b.txt
+changesetTemplate:
+  title: Test Artifacts
+  body: Test artifacts
+  branch: test
+  commit:
+    message: Test
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, `step 2 artifact name "report" is used more than once`, err.Error())
+	})
+
+	t.Run("artifact path outside of workspace", func(t *testing.T) {
+		const spec = `
+name: test-spec
+description: A test spec
+steps:
+  - run: echo 1 > ../a.txt
+    container: alpine:3
+    artifacts:
+      - name: report
+        path: sub/../../a.txt
+changesetTemplate:
+  title: Test Artifacts
+  body: Test artifacts
+  branch: test
+  commit:
+    message: Test
+`
+		_, err := ParseBatchSpec([]byte(spec))
+		assert.Equal(t, `step 1 artifact "report" must have a relative path within the workspace`, err.Error())
+	})
 }
 
 func TestOnQueryOrRepository_Branches(t *testing.T) {
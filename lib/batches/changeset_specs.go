@@ -47,7 +47,8 @@ func BuildChangesetSpecs(input *ChangesetSpecInput, binaryDiffs bool, fallbackAu
 			Changes: input.Result.ChangedFiles,
 			Path:    input.Path,
 		},
-		Outputs: input.Result.Outputs,
+		Outputs:   input.Result.Outputs,
+		Artifacts: input.Result.Artifacts,
 		Repository: template.Repository{
 			Name:        input.Repository.Name,
 			Branch:      strings.TrimPrefix(input.Repository.BaseRef, "refs/heads/"),
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches"
@@ -109,11 +110,34 @@ type CacheKey struct {
 // Key converts the key into a string form that can be used to uniquely identify
 // the cache key in a more concise form than the entire Task.
 func (key CacheKey) Key() (string, error) {
+	return key.hash(false)
+}
+
+// SharedKey converts the key into a string form that identifies the result of
+// the Steps independent of the batch change they are run for, so that the
+// result can be reused by other batch changes running the same Steps in the
+// same workspace. The batch change attributes only become part of the key if
+// one of the Steps references them in a template.
+func (key CacheKey) SharedKey() (string, error) {
+	return key.hash(true)
+}
+
+func (key CacheKey) hash(shared bool) (string, error) {
 	// Setup a copy of the cache key that only includes the Steps up to and
 	// including key.StepIndex.
 	clone := key
 	clone.Steps = key.Steps[0 : key.StepIndex+1]
 
+	if shared {
+		referenced, err := stepsReferenceBatchChange(clone.Steps)
+		if err != nil {
+			return "", err
+		}
+		if !referenced {
+			clone.BatchChangeAttributes = nil
+		}
+	}
+
 	// Resolve environment only for the subset of Steps.
 	envs, err := resolveStepsEnvironment(key.GlobalEnv, clone.Steps)
 	if err != nil {
@@ -131,11 +155,21 @@ func (key CacheKey) Key() (string, error) {
 	return fmt.Sprintf("%s-step-%d", hash, key.StepIndex), err
 }
 
+// stepsReferenceBatchChange returns true if any of the given steps may render
+// the batch change attributes through the batch_change template variable.
+func stepsReferenceBatchChange(steps []batches.Step) (bool, error) {
+	raw, err := json.Marshal(steps)
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(string(raw), "batch_change"), nil
+}
+
 func (key CacheKey) Slug() string {
 	return SlugForRepo(key.Repository.Name, key.Repository.BaseRev)
 }
 
-func KeyForWorkspace(batchChangeAttributes *template.BatchChangeAttributes, r batches.Repository, path string, globalEnv []string, onlyFetchWorkspace bool, steps []batches.Step, stepIndex int, retriever MetadataRetriever) Keyer {
+func KeyForWorkspace(batchChangeAttributes *template.BatchChangeAttributes, r batches.Repository, path string, globalEnv []string, onlyFetchWorkspace bool, steps []batches.Step, stepIndex int, retriever MetadataRetriever) CacheKey {
 	sort.Strings(r.FileMatches)
 
 	return CacheKey{
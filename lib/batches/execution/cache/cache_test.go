@@ -10,6 +10,7 @@ import (
 
 	"github.com/sourcegraph/sourcegraph/lib/batches"
 	"github.com/sourcegraph/sourcegraph/lib/batches/env"
+	"github.com/sourcegraph/sourcegraph/lib/batches/template"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
@@ -187,6 +188,56 @@ func TestKeyer_Key(t *testing.T) {
 	}
 }
 
+func TestCacheKey_SharedKey(t *testing.T) {
+	attributes := func(name string) *template.BatchChangeAttributes {
+		return &template.BatchChangeAttributes{Name: name, Description: "The description"}
+	}
+
+	key := func(attrs *template.BatchChangeAttributes, steps []batches.Step) CacheKey {
+		return CacheKey{
+			Repository:            repo,
+			Steps:                 steps,
+			BatchChangeAttributes: attrs,
+			StepIndex:             len(steps) - 1,
+		}
+	}
+
+	t.Run("independent of batch change", func(t *testing.T) {
+		steps := []batches.Step{{Run: "foo"}, {Run: "bar"}}
+
+		a, err := key(attributes("a"), steps).SharedKey()
+		require.NoError(t, err)
+		b, err := key(attributes("b"), steps).SharedKey()
+		require.NoError(t, err)
+		assert.Equal(t, a, b)
+
+		// The regular keys still differ.
+		a, err = key(attributes("a"), steps).Key()
+		require.NoError(t, err)
+		b, err = key(attributes("b"), steps).Key()
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("batch change referenced by step", func(t *testing.T) {
+		steps := []batches.Step{{Run: "echo ${{ batch_change.name }}"}}
+
+		a, err := key(attributes("a"), steps).SharedKey()
+		require.NoError(t, err)
+		b, err := key(attributes("b"), steps).SharedKey()
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+
+	t.Run("different steps", func(t *testing.T) {
+		a, err := key(attributes("a"), []batches.Step{{Run: "foo"}}).SharedKey()
+		require.NoError(t, err)
+		b, err := key(attributes("a"), []batches.Step{{Run: "bar"}}).SharedKey()
+		require.NoError(t, err)
+		assert.NotEqual(t, a, b)
+	})
+}
+
 type testM struct {
 	m   []MountMetadata
 	err error
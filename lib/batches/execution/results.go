@@ -1,6 +1,8 @@
 package execution
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/sourcegraph/sourcegraph/lib/batches/git"
@@ -22,6 +24,31 @@ type AfterStepResult struct {
 	Diff []byte `json:"diff"`
 	// Outputs is a copy of the Outputs after executing the Step.
 	Outputs map[string]any `json:"outputs"`
+	// Artifacts are the artifacts produced by the Step and all previous
+	// steps, keyed by name.
+	Artifacts map[string]Artifact `json:"artifacts,omitempty"`
+}
+
+// Artifact is a file that a step declared as an artifact. Its content is
+// uploaded separately and referenced by Key.
+type Artifact struct {
+	// Path is the path of the file relative to the root of the workspace.
+	Path string `json:"path"`
+	// StepIndex is the index of the step that produced the artifact.
+	StepIndex int `json:"stepIndex"`
+	// Key is the key the content of the file is uploaded under, see
+	// ArtifactKey.
+	Key string `json:"key"`
+	// ByteSize is the size of the file in bytes.
+	ByteSize int64 `json:"byteSize"`
+}
+
+// ArtifactKey returns the key the given artifact content is uploaded under.
+// Artifacts are content-addressed, so that artifacts with the same content, for
+// example from cached step results, share the same object.
+func ArtifactKey(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
 }
 
 func (a AfterStepResult) MarshalJSON() ([]byte, error) {
@@ -55,6 +82,7 @@ func (a *AfterStepResult) UnmarshalJSON(data []byte) error {
 		a.StepIndex = v2.StepIndex
 		a.Diff = v2.Diff
 		a.Outputs = v2.Outputs
+		a.Artifacts = v2.Artifacts
 		return nil
 	}
 	var v1 v1AfterStepResult
@@ -75,13 +103,14 @@ type versionAfterStepResult struct {
 }
 
 type v2AfterStepResult struct {
-	Version      int            `json:"version"`
-	ChangedFiles git.Changes    `json:"changedFiles"`
-	Stdout       string         `json:"stdout"`
-	Stderr       string         `json:"stderr"`
-	StepIndex    int            `json:"stepIndex"`
-	Diff         []byte         `json:"diff"`
-	Outputs      map[string]any `json:"outputs"`
+	Version      int                 `json:"version"`
+	ChangedFiles git.Changes         `json:"changedFiles"`
+	Stdout       string              `json:"stdout"`
+	Stderr       string              `json:"stderr"`
+	StepIndex    int                 `json:"stepIndex"`
+	Diff         []byte              `json:"diff"`
+	Outputs      map[string]any      `json:"outputs"`
+	Artifacts    map[string]Artifact `json:"artifacts,omitempty"`
 }
 
 type v1AfterStepResult struct {
@@ -237,6 +237,28 @@ const BatchSpecJSON = `{
                 }
               }
             }
+          },
+          "artifacts": {
+            "description": "Files produced by the step that are uploaded and kept as artifacts of the workspace. Only supported with server-side execution.",
+            "type": ["array", "null"],
+            "items": {
+              "type": "object",
+              "additionalProperties": false,
+              "required": ["name", "path"],
+              "properties": {
+                "name": {
+                  "type": "string",
+                  "description": "The name of the artifact. Must be unique across all steps and can be used to reference the artifact in templates.",
+                  "pattern": "^[\\w.-]+$",
+                  "examples": ["report", "semgrep-sarif"]
+                },
+                "path": {
+                  "type": "string",
+                  "description": "The path of the file relative to the root of the workspace.",
+                  "examples": ["report.json", "out/results.sarif"]
+                }
+              }
+            }
           }
         }
       }
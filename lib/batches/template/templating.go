@@ -55,11 +55,12 @@ func ValidateBatchSpecTemplate(spec string) (bool, error) {
 	cstc := &ChangesetTemplateContext{}
 	cstfm := cstc.ToFuncMap()
 
-	// Strip any use of `outputs` fields from the spec template. Without using real
-	// contexts for the `FuncMap`s, they'll fail to `template.Execute`, and it's difficult
-	// to statically validate them without deeper inspection of the YAML, so our
-	// validation is just a best-effort without them.
-	outputRe := regexp.MustCompile(`(?i)\$\{\{\s*[^}]*\s*outputs\.[^}]*\}\}`)
+	// Strip any use of `outputs` and `artifacts` fields from the spec template.
+	// Without using real contexts for the `FuncMap`s, they'll fail to
+	// `template.Execute`, and it's difficult to statically validate them without
+	// deeper inspection of the YAML, so our validation is just a best-effort
+	// without them.
+	outputRe := regexp.MustCompile(`(?i)\$\{\{\s*[^}]*\s*(outputs|artifacts)\.[^}]*\}\}`)
 	spec = outputRe.ReplaceAllString(spec, "")
 
 	// Also strip index references. We also can't validate whether or not an index is in
@@ -272,6 +273,9 @@ type ChangesetTemplateContext struct {
 
 	// Repository is the repository in which the steps were executed.
 	Repository Repository
+
+	// Artifacts are the artifacts produced by the steps, keyed by name.
+	Artifacts map[string]execution.Artifact
 }
 
 // ToFuncMap returns a template.FuncMap to access fields on the StepContext in a
@@ -294,6 +298,16 @@ func (tmplCtx *ChangesetTemplateContext) ToFuncMap() template.FuncMap {
 		"outputs": func() map[string]any {
 			return tmplCtx.Outputs
 		},
+		"artifacts": func() map[string]any {
+			artifacts := make(map[string]any, len(tmplCtx.Artifacts))
+			for name, a := range tmplCtx.Artifacts {
+				artifacts[name] = map[string]any{
+					"path": a.Path,
+					"size": a.ByteSize,
+				}
+			}
+			return artifacts
+		},
 		"steps": func() map[string]any {
 			return map[string]any{
 				"modified_files": tmplCtx.Steps.Changes.Modified,
@@ -124,6 +124,11 @@ func TestValidateBatchSpecTemplate(t *testing.T) {
 			wantValid: false,
 			wantErr:   errors.New("validating batch spec template: unknown templating variable: 'outputz'"),
 		},
+		{
+			name:      "artifact variables are ignored",
+			batchSpec: `${{ artifacts.report.size }} ${{ artifacts.report.path }}`,
+			wantValid: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -462,6 +467,19 @@ CGO_ENABLED=0
 [renamed-file.txt]
 infrastructure/sub-project
 ${{ batch_change_link }}`,
+		},
+		{
+			name: "artifacts",
+			tmplCtx: &ChangesetTemplateContext{
+				Artifacts: map[string]execution.Artifact{
+					"report": {Path: "out/report.md", StepIndex: 1, Key: "abc", ByteSize: 21},
+				},
+			},
+			tmpl: `${{ artifacts.report.path }}
+${{ artifacts.report.size }}
+`,
+			want: `out/report.md
+21`,
 		},
 		{
 			name:    "empty context",
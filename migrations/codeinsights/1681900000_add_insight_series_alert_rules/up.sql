@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS insight_series_alert_rules (
+    id SERIAL PRIMARY KEY,
+    series_id INT NOT NULL REFERENCES insight_series(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL,
+    comparator TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    window_size INT NOT NULL DEFAULT 1,
+    email_recipients TEXT[] NOT NULL DEFAULT '{}',
+    state TEXT NOT NULL DEFAULT 'OK',
+    last_value DOUBLE PRECISION,
+    last_evaluated_at TIMESTAMP WITH TIME ZONE,
+    last_fired_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS insight_series_alert_rules_series_id_idx ON insight_series_alert_rules(series_id);
+
+COMMENT ON TABLE insight_series_alert_rules IS 'Threshold and trend alert rules evaluated each time an insight series is recorded.';
+COMMENT ON COLUMN insight_series_alert_rules.kind IS 'THRESHOLD compares the latest value against value, TREND compares the change over the last window_size recordings against value.';
+COMMENT ON COLUMN insight_series_alert_rules.state IS 'OK or FIRING. Notifications are sent when a rule transitions from OK to FIRING.';
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS repo_embedding_jobs
+    DROP COLUMN IF EXISTS is_incremental;
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS repo_embedding_jobs
+    ADD COLUMN IF NOT EXISTS is_incremental BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS own_history_signals_repos;
+DROP TABLE IF EXISTS own_history_signals;
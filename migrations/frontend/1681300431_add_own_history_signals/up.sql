@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS own_history_signals (
+    id                  SERIAL PRIMARY KEY,
+    repo_id             INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    path                TEXT NOT NULL,
+    author_name         TEXT NOT NULL,
+    author_email        TEXT NOT NULL,
+    recent_commit_count INTEGER NOT NULL DEFAULT 0,
+    last_commit_at      TIMESTAMP WITH TIME ZONE,
+    blame_line_share    DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS own_history_signals_repo_id_path_author_email ON own_history_signals(repo_id, path, author_email);
+
+-- Repos for which computing the signals failed are recorded with a NULL
+-- commit_id and computed_at, so that they can be backed off.
+CREATE TABLE IF NOT EXISTS own_history_signals_repos (
+    repo_id         INTEGER PRIMARY KEY REFERENCES repo(id) ON DELETE CASCADE,
+    commit_id       TEXT,
+    computed_at     TIMESTAMP WITH TIME ZONE,
+    failure_count   INTEGER NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMP WITH TIME ZONE
+);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS codeowners_repo_patterns;
+
+ALTER TABLE codeowners DROP COLUMN IF EXISTS mode;
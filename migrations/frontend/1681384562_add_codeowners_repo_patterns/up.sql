@@ -0,0 +1,11 @@
+ALTER TABLE codeowners ADD COLUMN IF NOT EXISTS mode TEXT NOT NULL DEFAULT 'override';
+
+CREATE TABLE IF NOT EXISTS codeowners_repo_patterns (
+    id             SERIAL PRIMARY KEY,
+    repo_pattern   TEXT NOT NULL UNIQUE,
+    contents       TEXT NOT NULL,
+    contents_proto BYTEA NOT NULL,
+    mode           TEXT NOT NULL DEFAULT 'override',
+    created_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS cm_content_snapshots;
+
+ALTER TABLE cm_trigger_jobs DROP COLUMN IF EXISTS content_changes;
+
+ALTER TABLE cm_queries DROP COLUMN IF EXISTS content_snapshot_at;
+
+ALTER TABLE cm_queries DROP COLUMN IF EXISTS mode;
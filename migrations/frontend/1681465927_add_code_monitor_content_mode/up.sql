@@ -0,0 +1,16 @@
+ALTER TABLE cm_queries ADD COLUMN IF NOT EXISTS mode TEXT NOT NULL DEFAULT 'commit';
+
+ALTER TABLE cm_queries ADD COLUMN IF NOT EXISTS content_snapshot_at TIMESTAMP WITH TIME ZONE;
+
+COMMENT ON COLUMN cm_queries.content_snapshot_at IS 'When the snapshot of the lines matched by the query of a content mode code monitor was last stored. NULL until the first run of the current query, which stores the snapshot without triggering any actions.';
+
+ALTER TABLE cm_trigger_jobs ADD COLUMN IF NOT EXISTS content_changes JSONB;
+
+CREATE TABLE IF NOT EXISTS cm_content_snapshots (
+    monitor_id BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    repo_id    INTEGER NOT NULL REFERENCES repo(id) ON DELETE CASCADE,
+    matches    JSONB NOT NULL,
+    PRIMARY KEY (monitor_id, repo_id)
+);
+
+COMMENT ON TABLE cm_content_snapshots IS 'The lines matched by the query of a content mode code monitor on its previous run, per repository';
@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS cm_http_actions (
+    id                    BIGSERIAL PRIMARY KEY,
+    monitor               BIGINT NOT NULL REFERENCES cm_monitors(id) ON DELETE CASCADE,
+    url                   TEXT NOT NULL,
+    enabled               BOOLEAN NOT NULL,
+    include_results       BOOLEAN NOT NULL DEFAULT FALSE,
+    preset                TEXT NOT NULL DEFAULT 'custom',
+    payload_template      TEXT NOT NULL DEFAULT '',
+    routing_key           TEXT NOT NULL DEFAULT '',
+    max_retries           INTEGER NOT NULL DEFAULT 3,
+    retry_backoff_seconds INTEGER NOT NULL DEFAULT 10,
+    created_by            INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    changed_by            INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    changed_at            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS cm_http_actions_monitor ON cm_http_actions USING btree (monitor);
+
+COMMENT ON TABLE cm_http_actions IS 'HTTP actions configured on code monitors, which post a templated payload';
+
+COMMENT ON COLUMN cm_http_actions.preset IS 'The built-in payload template to use: custom, teams or pagerduty';
+
+COMMENT ON COLUMN cm_http_actions.payload_template IS 'The Go text/template rendering the request body. Only used by the custom preset';
+
+COMMENT ON COLUMN cm_http_actions.routing_key IS 'The PagerDuty integration key. Only used by the pagerduty preset';
+
+ALTER TABLE cm_action_jobs ADD COLUMN IF NOT EXISTS http_action BIGINT REFERENCES cm_http_actions(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN cm_action_jobs.http_action IS 'The ID of the cm_http_actions action to execute if this is an HTTP action job. Mutually exclusive with email, webhook and slack_webhook';
+
+ALTER TABLE cm_action_jobs DROP CONSTRAINT IF EXISTS cm_action_jobs_only_one_action_type;
+ALTER TABLE cm_action_jobs ADD CONSTRAINT cm_action_jobs_only_one_action_type CHECK ((
+    CASE WHEN email IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN slack_webhook IS NULL THEN 0 ELSE 1 END +
+    CASE WHEN http_action IS NULL THEN 0 ELSE 1 END
+) = 1);
+
+COMMENT ON CONSTRAINT cm_action_jobs_only_one_action_type ON cm_action_jobs IS 'Constrains that each queued code monitor action has exactly one action type';
@@ -0,0 +1,3 @@
+ALTER TABLE batch_spec_workspaces DROP COLUMN IF EXISTS shared_step_cache_keys;
+
+DROP TABLE IF EXISTS batch_spec_execution_shared_cache_entries;
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS batch_spec_execution_shared_cache_entries (
+    id bigserial PRIMARY KEY,
+    key text NOT NULL,
+    repo_id integer NOT NULL REFERENCES repo(id) ON DELETE CASCADE DEFERRABLE,
+    user_id integer REFERENCES users(id) ON DELETE SET NULL DEFERRABLE,
+    value text NOT NULL,
+    version integer NOT NULL,
+    last_used_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT batch_spec_execution_shared_cache_entries_key_unique UNIQUE (key)
+);
+
+COMMENT ON TABLE batch_spec_execution_shared_cache_entries IS 'Step results that are reused across batch changes and users, keyed by the steps and the workspace they ran in.';
+COMMENT ON COLUMN batch_spec_execution_shared_cache_entries.repo_id IS 'The repository the steps ran in. Only users with access to it can use the entry.';
+COMMENT ON COLUMN batch_spec_execution_shared_cache_entries.user_id IS 'The user whose execution created the entry.';
+
+ALTER TABLE batch_spec_workspaces ADD COLUMN IF NOT EXISTS shared_step_cache_keys jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+COMMENT ON COLUMN batch_spec_workspaces.shared_step_cache_keys IS 'The keys of the steps in batch_spec_execution_shared_cache_entries, by step number.';
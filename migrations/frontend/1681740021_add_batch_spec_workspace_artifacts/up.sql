@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS batch_spec_workspace_artifacts (
+    id bigserial PRIMARY KEY,
+    batch_spec_workspace_id integer NOT NULL REFERENCES batch_spec_workspaces(id) ON DELETE CASCADE DEFERRABLE,
+    step_index integer NOT NULL,
+    name text NOT NULL,
+    path text NOT NULL,
+    byte_size bigint NOT NULL,
+    object_key text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    CONSTRAINT batch_spec_workspace_artifacts_workspace_name_unique UNIQUE (batch_spec_workspace_id, name)
+);
+
+COMMENT ON TABLE batch_spec_workspace_artifacts IS 'Files that steps of a batch spec declared as artifacts, uploaded to the upload store.';
+COMMENT ON COLUMN batch_spec_workspace_artifacts.object_key IS 'The key of the uploaded content in the upload store.';
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS batch_change_schedules (
+    batch_change_id bigint PRIMARY KEY REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    schedule text NOT NULL,
+    next_run_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS batch_change_schedules_next_run_at ON batch_change_schedules(next_run_at);
+
+COMMENT ON TABLE batch_change_schedules IS 'Cron schedules at which the current batch spec of a batch change is re-executed server-side.';
+COMMENT ON COLUMN batch_change_schedules.schedule IS 'The cron expression of the schedule, evaluated in UTC.';
+
+CREATE TABLE IF NOT EXISTS batch_change_scheduled_runs (
+    id bigserial PRIMARY KEY,
+    batch_change_id bigint NOT NULL REFERENCES batch_changes(id) ON DELETE CASCADE DEFERRABLE,
+    batch_spec_id bigint REFERENCES batch_specs(id) ON DELETE SET NULL DEFERRABLE,
+    state text NOT NULL,
+    failure_message text,
+    started_at timestamp with time zone NOT NULL DEFAULT now(),
+    finished_at timestamp with time zone
+);
+
+CREATE INDEX IF NOT EXISTS batch_change_scheduled_runs_batch_change_id ON batch_change_scheduled_runs(batch_change_id);
+
+COMMENT ON TABLE batch_change_scheduled_runs IS 'The history of scheduled re-executions of batch changes.';
+COMMENT ON COLUMN batch_change_scheduled_runs.batch_spec_id IS 'The batch spec created by the run. Set to NULL when the batch spec was not applied and has been deleted.';
@@ -81,6 +81,12 @@ type AppNotifications struct {
 	// VersionMin description: If present, this message will only be shown to Sourcegraph App instances in this inclusive version range.
 	VersionMin string `json:"version.min,omitempty"`
 }
+type Artifacts struct {
+	// Name description: The name of the artifact. Must be unique across all steps and can be used to reference the artifact in templates.
+	Name string `json:"name"`
+	// Path description: The path of the file relative to the root of the workspace.
+	Path string `json:"path"`
+}
 
 // AuditLog description: EXPERIMENTAL: Configuration for audit logging (specially formatted log entries for tracking sensitive events)
 type AuditLog struct {
@@ -141,6 +147,7 @@ type AuthProviders struct {
 	Github         *GitHubAuthProvider
 	Gitlab         *GitLabAuthProvider
 	HttpHeader     *HTTPHeaderAuthProvider
+	Ldap           *LDAPAuthProvider
 	Openidconnect  *OpenIDConnectAuthProvider
 	Saml           *SAMLAuthProvider
 }
@@ -167,6 +174,9 @@ func (v AuthProviders) MarshalJSON() ([]byte, error) {
 	if v.HttpHeader != nil {
 		return json.Marshal(v.HttpHeader)
 	}
+	if v.Ldap != nil {
+		return json.Marshal(v.Ldap)
+	}
 	if v.Openidconnect != nil {
 		return json.Marshal(v.Openidconnect)
 	}
@@ -197,12 +207,14 @@ func (v *AuthProviders) UnmarshalJSON(data []byte) error {
 		return json.Unmarshal(data, &v.Gitlab)
 	case "http-header":
 		return json.Unmarshal(data, &v.HttpHeader)
+	case "ldap":
+		return json.Unmarshal(data, &v.Ldap)
 	case "openidconnect":
 		return json.Unmarshal(data, &v.Openidconnect)
 	case "saml":
 		return json.Unmarshal(data, &v.Saml)
 	}
-	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "openidconnect", "saml"})
+	return fmt.Errorf("tagged union type must have a %q property whose value is one of %s", "type", []string{"azureDevOps", "bitbucketcloud", "builtin", "gerrit", "github", "gitlab", "http-header", "ldap", "openidconnect", "saml"})
 }
 
 // AzureDevOpsAuthProvider description: Azure auth provider for dev.azure.com
@@ -611,20 +623,42 @@ type EmailTemplates struct {
 
 // Embeddings description: Configuration for embeddings service.
 type Embeddings struct {
-	// AccessToken description: The access token used to authenticate with the external embedding API service.
-	AccessToken string `json:"accessToken"`
+	// AccessToken description: The access token used to authenticate with the external embedding API service. Optional for the "self-hosted" provider.
+	AccessToken string `json:"accessToken,omitempty"`
+	// ApiVersion description: The API version used for requests to an Azure OpenAI deployment. Only used by the "azure-openai" provider.
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// ApproximateSearch description: Configures approximate nearest neighbor search for repository embedding indexes. When enabled, an inverted file index is built alongside each repository embedding index, and searches only score the rows in the clusters closest to the query. Indexes smaller than `minRows` are always searched exhaustively.
+	ApproximateSearch *EmbeddingsApproximateSearch `json:"approximateSearch,omitempty"`
 	// Dimensions description: The dimensionality of the embedding vectors.
 	Dimensions int `json:"dimensions"`
 	// Enabled description: Toggles whether embedding service is enabled.
 	Enabled bool `json:"enabled"`
 	// ExcludedFilePathPatterns description: A list of glob patterns that match file paths you want to exclude from embeddings. This is useful to exclude files with low information value (e.g., SVG files, test fixtures, mocks, auto-generated files, etc.).
 	ExcludedFilePathPatterns []string `json:"excludedFilePathPatterns,omitempty"`
-	// Model description: The model used for embedding.
-	Model string `json:"model"`
+	// Incremental description: Update the embedding index of a repository incrementally, by only embedding the files that changed since the previously indexed revision. When disabled, every repository embedding job embeds all files of the repository.
+	Incremental *bool `json:"incremental,omitempty"`
+	// Model description: The model used for embedding. Defaults to "text-embedding-ada-002" for the "openai" provider, and is ignored by the "azure-openai" provider, which uses the model of the deployment.
+	Model string `json:"model,omitempty"`
+	// Provider description: The API used to generate embeddings. "openai" uses the OpenAI embeddings API. "azure-openai" uses an Azure OpenAI deployment, in which case `url` is the URL of the deployment's embeddings endpoint. "self-hosted" uses a self-hosted HTTP endpoint, such as a sentence-transformers or text-embeddings-inference server, that accepts a JSON body of the form {"inputs": ["text", ...]} and responds with a JSON array of embedding vectors.
+	Provider string `json:"provider,omitempty"`
+	// QuantizeIndexes description: Store the embeddings of repository embedding indexes as 8-bit integers instead of 32-bit floats. Quantized indexes take roughly a quarter of the memory and storage space, at a small cost in search accuracy. Existing indexes are quantized when they are loaded by the embeddings service.
+	QuantizeIndexes bool `json:"quantizeIndexes,omitempty"`
 	// Url description: The url to the external embedding API service.
 	Url string `json:"url"`
 }
 
+// EmbeddingsApproximateSearch description: Configures approximate nearest neighbor search for repository embedding indexes. When enabled, an inverted file index is built alongside each repository embedding index, and searches only score the rows in the clusters closest to the query. Indexes smaller than `minRows` are always searched exhaustively.
+type EmbeddingsApproximateSearch struct {
+	// Enabled description: Toggles whether approximate indexes are built and used for search.
+	Enabled bool `json:"enabled,omitempty"`
+	// MinRows description: Embedding indexes with fewer rows than this are searched exhaustively, and no approximate index is built for them.
+	MinRows int `json:"minRows,omitempty"`
+	// NumClusters description: The number of clusters the rows of an embedding index are partitioned into. Defaults to the square root of the number of rows.
+	NumClusters int `json:"numClusters,omitempty"`
+	// Probes description: The number of clusters that are searched for each query. Higher values increase recall at the cost of latency.
+	Probes int `json:"probes,omitempty"`
+}
+
 // EncryptionKey description: Config for a key
 type EncryptionKey struct {
 	Cloudkms *CloudKMSEncryptionKey
@@ -736,6 +770,12 @@ type ExcludedGitoliteRepo struct {
 	// Pattern description: Regular expression which matches against the name of a Gitolite repo to exclude from mirroring.
 	Pattern string `json:"pattern,omitempty"`
 }
+type ExcludedMercurialRepo struct {
+	// Name description: The name of a Mercurial repo ("hg.example.com/my-repo") to exclude from mirroring.
+	Name string `json:"name,omitempty"`
+	// Pattern description: Regular expression which matches against the name of a Mercurial repo to exclude from mirroring.
+	Pattern string `json:"pattern,omitempty"`
+}
 type ExcludedOtherRepo struct {
 	// Name description: The name of a Other repo ("my-repo") to exclude from mirroring.
 	Name string `json:"name,omitempty"`
@@ -1360,6 +1400,40 @@ type JVMPackagesConnection struct {
 	Maven Maven `json:"maven"`
 }
 
+// LDAPAuthProvider description: Configures the LDAP authentication provider, which signs in users with their username and password by binding to an LDAP directory server such as OpenLDAP or Active Directory.
+type LDAPAuthProvider struct {
+	// AccountIDAttribute description: The attribute of the user entry that permanently identifies the user, such as `entryUUID` for OpenLDAP or `objectGUID` for Active Directory. Sourcegraph accounts are linked to this value, so that they stay linked when the entry is renamed or moved. Binary values are hex-encoded. Leave empty to link accounts to the DN of the entry.
+	AccountIDAttribute string `json:"accountIDAttribute,omitempty"`
+	// AllowSignup description: Allows new visitors to sign up for accounts via LDAP authentication. If false, users signing in via LDAP must have an existing Sourcegraph account, which will be linked to their LDAP identity after sign-in.
+	AllowSignup *bool `json:"allowSignup,omitempty"`
+	// BindDN description: The DN of the service account used to search for users. Leave empty to search anonymously.
+	BindDN string `json:"bindDN,omitempty"`
+	// BindPassword description: The password of the service account used to search for users.
+	BindPassword string `json:"bindPassword,omitempty"`
+	// Certificate description: TLS certificate (in PEM format) of the certificate authority that signed the certificate of the LDAP server. Only required if that certificate authority is not trusted by the system.
+	Certificate string `json:"certificate,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	// DisplayNameAttribute description: The attribute of the user entry that holds the display name.
+	DisplayNameAttribute string `json:"displayNameAttribute,omitempty"`
+	// EmailAttribute description: The attribute of the user entry that holds the email address.
+	EmailAttribute string `json:"emailAttribute,omitempty"`
+	// GroupFilter description: An LDAP filter that the entry of the user must match in order to sign in, typically to restrict sign-in to members of a group. Leave empty to allow all users found by userSearchFilter.
+	GroupFilter string `json:"groupFilter,omitempty"`
+	// InsecureSkipVerify description: Skip verification of the certificate chain and host name of the LDAP server. In this mode, TLS is susceptible to man-in-the-middle attacks. Only use for testing.
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// StartTLS description: Upgrade the connection to TLS with the StartTLS extended operation after connecting. Only applies to URLs with the ldap scheme.
+	StartTLS bool   `json:"startTLS,omitempty"`
+	Type     string `json:"type"`
+	// Url description: URL of the LDAP server. Use the ldaps scheme to connect over TLS.
+	Url string `json:"url"`
+	// UserSearchBaseDN description: The DN of the subtree to search for users in.
+	UserSearchBaseDN string `json:"userSearchBaseDN"`
+	// UserSearchFilter description: The LDAP filter used to find the entry of the user signing in. The `{username}` placeholder is replaced with the escaped username entered by the user. For Active Directory, use `(sAMAccountName={username})`.
+	UserSearchFilter string `json:"userSearchFilter,omitempty"`
+	// UsernameAttribute description: The attribute of the user entry that holds the username.
+	UsernameAttribute string `json:"usernameAttribute,omitempty"`
+}
+
 // Log description: Configuration for logging and alerting, including to external services.
 type Log struct {
 	// AuditLog description: EXPERIMENTAL: Configuration for audit logging (specially formatted log entries for tracking sensitive events)
@@ -1387,6 +1461,26 @@ type MavenRateLimit struct {
 	// RequestsPerHour description: Requests per hour permitted. This is an average, calculated per second. Internally, the burst limit is set to 100, which implies that for a requests per hour limit as low as 1, users will continue to be able to send a maximum of 100 requests immediately, provided that the complexity cost of each request is 1.
 	RequestsPerHour float64 `json:"requestsPerHour"`
 }
+
+// MercurialConnection description: Configuration for a connection to Mercurial (hg) repositories. Mercurial repositories are converted to Git repositories when they are cloned.
+type MercurialConnection struct {
+	// Exclude description: A list of repositories to never mirror by name after applying repositoryPathPattern. Supports excluding by exact name ({"name": "myrepo"}) or regular expression ({"pattern": ".*secret.*"}).
+	Exclude []*ExcludedMercurialRepo `json:"exclude,omitempty"`
+	// Password description: The password or access token used to authenticate against the Mercurial server when cloning and fetching.
+	Password string `json:"password,omitempty"`
+	// Repos description: List of repository paths, relative to `url`, to be mirrored.
+	Repos []string `json:"repos"`
+	// RepositoryPathPattern description: The pattern used to generate the corresponding Sourcegraph repository name for Mercurial repositories. In the pattern, the variable "{base}" is replaced with the host and path of `url`, and "{repo}" is replaced with the repository path taken from the `repos` field.
+	//
+	// For example, if your Mercurial base URL is https://hg.example.com/repos and `repos` contains the value "my/repo", then a repositoryPathPattern of "{base}/{repo}" would mean that the repository is available on Sourcegraph at https://sourcegraph.example.com/hg.example.com/repos/my/repo.
+	//
+	// It is important that the Sourcegraph repository name generated with this pattern be unique to this code host. If different code hosts generate repository names that collide, Sourcegraph's behavior is undefined.
+	RepositoryPathPattern string `json:"repositoryPathPattern,omitempty"`
+	// Url description: The base URL of the Mercurial server. Repository paths listed in `repos` are resolved relative to this URL.
+	Url string `json:"url"`
+	// Username description: The username used to authenticate against the Mercurial server when cloning and fetching.
+	Username string `json:"username,omitempty"`
+}
 type Mount struct {
 	// Mountpoint description: The path in the container to mount the path on the local machine to.
 	Mountpoint string `json:"mountpoint"`
@@ -1662,6 +1756,20 @@ type Overrides struct {
 	Limit any `json:"limit,omitempty"`
 }
 
+// OwnHistorySignals description: Configures ownership signals derived from the git history of repositories. When enabled, recent contributors and the authors of most lines of a file (as per git blame) are computed in the background and suggested as owners in addition to the owners from CODEOWNERS files.
+type OwnHistorySignals struct {
+	// Enabled description: Whether ownership signals are computed from the git history of repositories.
+	Enabled bool `json:"enabled,omitempty"`
+	// MaxBlamedFilesPerRepo description: The maximum number of files per repository for which blame is computed. The most frequently changed files are blamed first.
+	MaxBlamedFilesPerRepo int `json:"maxBlamedFilesPerRepo,omitempty"`
+	// MaxCommitsPerRepo description: The maximum number of recent commits inspected per repository when determining recent contributors.
+	MaxCommitsPerRepo int `json:"maxCommitsPerRepo,omitempty"`
+	// RecentContributorsWindowDays description: The number of days of history considered when determining the recent contributors of a file.
+	RecentContributorsWindowDays int `json:"recentContributorsWindowDays,omitempty"`
+	// RefreshIntervalHours description: The minimum number of hours between two computations of the signals of a repository.
+	RefreshIntervalHours int `json:"refreshIntervalHours,omitempty"`
+}
+
 // PagureConnection description: Configuration for a connection to Pagure.
 type PagureConnection struct {
 	// Forks description: If true, it includes forks in the returned projects.
@@ -2484,6 +2592,8 @@ type SiteConfiguration struct {
 	InsightsHistoricalWorkerRateLimitBurst int `json:"insights.historical.worker.rateLimitBurst,omitempty"`
 	// InsightsMaximumSampleSize description: The maximum number of data points that will be available to view for a series on a code insight. Points beyond that will be stored in a separate table and available for data export.
 	InsightsMaximumSampleSize int `json:"insights.maximumSampleSize,omitempty"`
+	// InsightsMetricsEndpoint description: Exposes the latest value of every code insight series visible to the requesting user as Prometheus gauges at /.api/insights/metrics.
+	InsightsMetricsEndpoint bool `json:"insights.metricsEndpoint,omitempty"`
 	// InsightsQueryWorkerConcurrency description: Number of concurrent executions of a code insight query on a worker node
 	InsightsQueryWorkerConcurrency int `json:"insights.query.worker.concurrency,omitempty"`
 	// InsightsQueryWorkerRateLimit description: Maximum number of Code Insights queries initiated per second on a worker node.
@@ -2520,6 +2630,8 @@ type SiteConfiguration struct {
 	OutboundRequestLogLimit int `json:"outboundRequestLogLimit,omitempty"`
 	// OwnBestEffortTeamMatching description: The Own service will attempt to match a Team by the last part of its handle if it contains a slash and no match is found for its full handle.
 	OwnBestEffortTeamMatching *bool `json:"own.bestEffortTeamMatching,omitempty"`
+	// OwnHistorySignals description: Configures ownership signals derived from the git history of repositories. When enabled, recent contributors and the authors of most lines of a file (as per git blame) are computed in the background and suggested as owners in addition to the owners from CODEOWNERS files.
+	OwnHistorySignals *OwnHistorySignals `json:"own.historySignals,omitempty"`
 	// ParentSourcegraph description: URL to fetch unreachable repository details from. Defaults to "https://sourcegraph.com"
 	ParentSourcegraph *ParentSourcegraph `json:"parentSourcegraph,omitempty"`
 	// PermissionsSyncJobCleanupInterval description: Time interval (in seconds) of how often cleanup worker should remove old jobs from permissions sync jobs table.
@@ -2679,6 +2791,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "insights.historical.worker.rateLimit")
 	delete(m, "insights.historical.worker.rateLimitBurst")
 	delete(m, "insights.maximumSampleSize")
+	delete(m, "insights.metricsEndpoint")
 	delete(m, "insights.query.worker.concurrency")
 	delete(m, "insights.query.worker.rateLimit")
 	delete(m, "insights.query.worker.rateLimitBurst")
@@ -2697,6 +2810,7 @@ func (v *SiteConfiguration) UnmarshalJSON(data []byte) error {
 	delete(m, "organizationInvitations")
 	delete(m, "outboundRequestLogLimit")
 	delete(m, "own.bestEffortTeamMatching")
+	delete(m, "own.historySignals")
 	delete(m, "parentSourcegraph")
 	delete(m, "permissions.syncJobCleanupInterval")
 	delete(m, "permissions.syncJobsHistorySize")
@@ -2741,6 +2855,8 @@ type SrcCliVersionCache struct {
 
 // Step description: A command to run (as part of a sequence) in a repository branch to produce the required changes.
 type Step struct {
+	// Artifacts description: Files produced by the step that are uploaded and kept as artifacts of the workspace. Only supported with server-side execution.
+	Artifacts []*Artifacts `json:"artifacts,omitempty"`
 	// Container description: The Docker image used to launch the Docker container in which the shell command is run.
 	Container string `json:"container"`
 	// Env description: Environment variables to set in the step environment.